@@ -237,24 +237,13 @@ type CreateAiIndexAudioRecordReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId     string  `protobuf:"bytes,1,opt,name=tenantId,proto3" json:"tenantId,omitempty"`           // 租户编号
-	AdminId      string  `protobuf:"bytes,2,opt,name=adminId,proto3" json:"adminId,omitempty"`             // 用户编号
-	Title        string  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                 // 音乐名称
-	Lyric        string  `protobuf:"bytes,4,opt,name=lyric,proto3" json:"lyric,omitempty"`                 // 歌词
-	ImageURL     string  `protobuf:"bytes,5,opt,name=imageURL,proto3" json:"imageURL,omitempty"`           // 图片地址
-	AudioURL     string  `protobuf:"bytes,6,opt,name=audioURL,proto3" json:"audioURL,omitempty"`           // 音频地址
-	Status       int32   `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`              // 音频状态
-	Description  string  `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`     // 描述词
-	Prompt       string  `protobuf:"bytes,9,opt,name=prompt,proto3" json:"prompt,omitempty"`               // 提示词
-	Platform     string  `protobuf:"bytes,10,opt,name=platform,proto3" json:"platform,omitempty"`          // 模型平台
-	ModelId      string  `protobuf:"bytes,11,opt,name=modelId,proto3" json:"modelId,omitempty"`            // 模型编号
-	Model        string  `protobuf:"bytes,12,opt,name=model,proto3" json:"model,omitempty"`                // 模型
-	GenerateMode int32   `protobuf:"varint,13,opt,name=generateMode,proto3" json:"generateMode,omitempty"` // 生成模式
-	Tags         string  `protobuf:"bytes,14,opt,name=tags,proto3" json:"tags,omitempty"`                  // 风格标签
-	Duration     float64 `protobuf:"fixed64,15,opt,name=duration,proto3" json:"duration,omitempty"`        // 时长
-	PublicStatus bool    `protobuf:"varint,16,opt,name=publicStatus,proto3" json:"publicStatus,omitempty"` // 是否发布
-	TaskId       string  `protobuf:"bytes,17,opt,name=taskId,proto3" json:"taskId,omitempty"`              // 任务编号
-	ErrorMessage string  `protobuf:"bytes,18,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`  // 错误信息
+	ModelId      string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"`            // 模型编号
+	GenerateMode int32  `protobuf:"varint,2,opt,name=generateMode,proto3" json:"generateMode,omitempty"` // 生成模式(1描述模式 2歌词模式)
+	Title        string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                // 音乐名称(歌词模式)
+	Description  string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`    // 描述词(描述模式必填)
+	Lyric        string `protobuf:"bytes,5,opt,name=lyric,proto3" json:"lyric,omitempty"`                // 歌词(歌词模式必填)
+	Tags         string `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`                  // 风格标签(歌词模式)
+	Instrumental bool   `protobuf:"varint,7,opt,name=instrumental,proto3" json:"instrumental,omitempty"` // 是否纯音乐
 }
 
 func (x *CreateAiIndexAudioRecordReq) Reset() {
@@ -289,18 +278,18 @@ func (*CreateAiIndexAudioRecordReq) Descriptor() ([]byte, []int) {
 	return file_admin_v1_ai_index_audio_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateAiIndexAudioRecordReq) GetTenantId() string {
+func (x *CreateAiIndexAudioRecordReq) GetModelId() string {
 	if x != nil {
-		return x.TenantId
+		return x.ModelId
 	}
 	return ""
 }
 
-func (x *CreateAiIndexAudioRecordReq) GetAdminId() string {
+func (x *CreateAiIndexAudioRecordReq) GetGenerateMode() int32 {
 	if x != nil {
-		return x.AdminId
+		return x.GenerateMode
 	}
-	return ""
+	return 0
 }
 
 func (x *CreateAiIndexAudioRecordReq) GetTitle() string {
@@ -310,34 +299,6 @@ func (x *CreateAiIndexAudioRecordReq) GetTitle() string {
 	return ""
 }
 
-func (x *CreateAiIndexAudioRecordReq) GetLyric() string {
-	if x != nil {
-		return x.Lyric
-	}
-	return ""
-}
-
-func (x *CreateAiIndexAudioRecordReq) GetImageURL() string {
-	if x != nil {
-		return x.ImageURL
-	}
-	return ""
-}
-
-func (x *CreateAiIndexAudioRecordReq) GetAudioURL() string {
-	if x != nil {
-		return x.AudioURL
-	}
-	return ""
-}
-
-func (x *CreateAiIndexAudioRecordReq) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
 func (x *CreateAiIndexAudioRecordReq) GetDescription() string {
 	if x != nil {
 		return x.Description
@@ -345,41 +306,13 @@ func (x *CreateAiIndexAudioRecordReq) GetDescription() string {
 	return ""
 }
 
-func (x *CreateAiIndexAudioRecordReq) GetPrompt() string {
-	if x != nil {
-		return x.Prompt
-	}
-	return ""
-}
-
-func (x *CreateAiIndexAudioRecordReq) GetPlatform() string {
-	if x != nil {
-		return x.Platform
-	}
-	return ""
-}
-
-func (x *CreateAiIndexAudioRecordReq) GetModelId() string {
-	if x != nil {
-		return x.ModelId
-	}
-	return ""
-}
-
-func (x *CreateAiIndexAudioRecordReq) GetModel() string {
+func (x *CreateAiIndexAudioRecordReq) GetLyric() string {
 	if x != nil {
-		return x.Model
+		return x.Lyric
 	}
 	return ""
 }
 
-func (x *CreateAiIndexAudioRecordReq) GetGenerateMode() int32 {
-	if x != nil {
-		return x.GenerateMode
-	}
-	return 0
-}
-
 func (x *CreateAiIndexAudioRecordReq) GetTags() string {
 	if x != nil {
 		return x.Tags
@@ -387,34 +320,13 @@ func (x *CreateAiIndexAudioRecordReq) GetTags() string {
 	return ""
 }
 
-func (x *CreateAiIndexAudioRecordReq) GetDuration() float64 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
-}
-
-func (x *CreateAiIndexAudioRecordReq) GetPublicStatus() bool {
+func (x *CreateAiIndexAudioRecordReq) GetInstrumental() bool {
 	if x != nil {
-		return x.PublicStatus
+		return x.Instrumental
 	}
 	return false
 }
 
-func (x *CreateAiIndexAudioRecordReq) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
-func (x *CreateAiIndexAudioRecordReq) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
-}
-
 // 响应-AI 音乐表-创建一条数据
 type CreateAiIndexAudioRecordReply struct {
 	state         protoimpl.MessageState
@@ -807,149 +719,117 @@ var file_admin_v1_ai_index_audio_proto_rawDesc = []byte{
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe1, 0x06, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdf, 0x02, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04,
-	0x10, 0x01, 0x18, 0x40, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xc8, 0x01, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x0e, 0x72,
-	0x0c, 0x10, 0x01, 0x18, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0x52, 0x05, 0x6c,
-	0x79, 0x72, 0x69, 0x63, 0x12, 0x2c, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10,
+	0x01, 0x18, 0x40, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x0c,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x30, 0x01, 0x30, 0x02, 0x52, 0x0c, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8,
+	0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xc8, 0x01, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01,
+	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xe8, 0x07, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x6c, 0x79, 0x72, 0x69, 0x63,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48,
-	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xd8, 0x04, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55,
-	0x52, 0x4c, 0x12, 0x2c, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x52, 0x4c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72,
-	0x05, 0x10, 0x01, 0x18, 0xd8, 0x04, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x52, 0x4c,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xba,
-	0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x0e, 0x72, 0x0c, 0x10, 0x01, 0x18, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x17, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x0e, 0x72, 0x0c,
-	0x10, 0x01, 0x18, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0x52, 0x06, 0x70, 0x72,
-	0x6f, 0x6d, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18,
-	0x40, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x23, 0x0a, 0x07, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48,
-	0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05,
-	0x10, 0x01, 0x18, 0xd8, 0x04, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x42, 0x06, 0xba,
-	0x48, 0x03, 0xd8, 0x01, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05,
-	0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x34, 0x0a,
-	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05,
-	0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x3a, 0x66, 0x92, 0x41, 0x63, 0x0a, 0x61, 0xd2, 0x01, 0x08, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x49, 0x64, 0xd2, 0x01, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0xd2,
-	0x01, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0xd2, 0x01, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0xd2, 0x01, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0xd2, 0x01, 0x07, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x49, 0x64, 0xd2, 0x01, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0xd2, 0x01, 0x0c,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x0c, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x1d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x1b,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
-	0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01,
-	0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64,
-	0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x1e,
-	0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34,
-	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41,
-	0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x7c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x01, 0x52,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x3a, 0x17, 0x92, 0x41, 0x14, 0x0a, 0x12,
-	0xd2, 0x01, 0x04, 0x70, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x22, 0x6c, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x88, 0x27, 0x52, 0x05, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x12,
+	0x24, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba,
+	0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xd8, 0x04, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x3a, 0x1e, 0x92, 0x41, 0x1b, 0x0a, 0x19,
+	0xd2, 0x01, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0xd2, 0x01, 0x0c, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2f, 0x0a, 0x1d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x1b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69,
+	0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a,
+	0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x04,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x22, 0x7c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78,
 	0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x32, 0x8e, 0x06, 0x0a, 0x0c, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x12, 0xbe, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x52,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f,
-	0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a,
-	0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x28, 0x01, 0x12, 0xbe, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
-	0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41,
-	0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x61, 0x75, 0x64, 0x69,
-	0x6f, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23,
-	0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x28, 0x01, 0x12, 0xbc, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x26, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41,
-	0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f,
-	0x61, 0x75, 0x64, 0x69, 0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a,
-	0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x28, 0x01, 0x12, 0xbc, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x26, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75,
+	0x65, 0x71, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x01, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x3a, 0x17, 0x92, 0x41, 0x14, 0x0a, 0x12, 0xd2, 0x01,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0xd2, 0x01, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x6c, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75,
 	0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x61,
-	0x75, 0x64, 0x69, 0x6f, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21,
+	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x32, 0x8e,
+	0x06, 0x0a, 0x0c, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12,
+	0xbe, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x52, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x2f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a,
+	0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01,
+	0x12, 0xbe, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x52, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x2f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21,
 	0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
 	0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28,
-	0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c,
-	0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x12, 0xbc, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x26, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a,
+	0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01,
+	0x12, 0xbc, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42,
+	0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x42,
+	0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a,
+	0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -201,41 +201,19 @@ func (m *CreateAiIndexAudioRecordReq) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for TenantId
+	// no validation rules for ModelId
 
-	// no validation rules for AdminId
+	// no validation rules for GenerateMode
 
 	// no validation rules for Title
 
-	// no validation rules for Lyric
-
-	// no validation rules for ImageURL
-
-	// no validation rules for AudioURL
-
-	// no validation rules for Status
-
 	// no validation rules for Description
 
-	// no validation rules for Prompt
-
-	// no validation rules for Platform
-
-	// no validation rules for ModelId
-
-	// no validation rules for Model
-
-	// no validation rules for GenerateMode
+	// no validation rules for Lyric
 
 	// no validation rules for Tags
 
-	// no validation rules for Duration
-
-	// no validation rules for PublicStatus
-
-	// no validation rules for TaskId
-
-	// no validation rules for ErrorMessage
+	// no validation rules for Instrumental
 
 	if len(errors) > 0 {
 		return CreateAiIndexAudioRecordReqMultiError(errors)
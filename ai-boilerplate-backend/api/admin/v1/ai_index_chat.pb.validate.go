@@ -320,6 +320,8 @@ func (m *AiIndexChatMessageItem) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for Partial
+
 	if len(errors) > 0 {
 		return AiIndexChatMessageItemMultiError(errors)
 	}
@@ -1999,6 +2001,8 @@ func (m *GetAiIndexChatMessageListReq) validate(all bool) error {
 
 	// no validation rules for PageSize
 
+	// no validation rules for ConversationId
+
 	if len(errors) > 0 {
 		return GetAiIndexChatMessageListReqMultiError(errors)
 	}
@@ -195,36 +195,16 @@ func (m *CreateAiIndexImageRecordReq) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for AdminId
-
-	// no validation rules for Prompt
-
-	// no validation rules for Platform
-
 	// no validation rules for ModelId
 
-	// no validation rules for Model
+	// no validation rules for Prompt
 
 	// no validation rules for Width
 
 	// no validation rules for Height
 
-	// no validation rules for Status
-
-	// no validation rules for FinishTime
-
-	// no validation rules for ErrorMessage
-
-	// no validation rules for PublicStatus
-
-	// no validation rules for PicURL
-
 	// no validation rules for Options
 
-	// no validation rules for TaskId
-
-	// no validation rules for Buttons
-
 	if len(errors) > 0 {
 		return CreateAiIndexImageRecordReqMultiError(errors)
 	}
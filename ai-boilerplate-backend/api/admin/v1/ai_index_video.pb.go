@@ -189,18 +189,9 @@ type CreateAiIndexVideoRecordReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdminId      string `protobuf:"bytes,1,opt,name=adminId,proto3" json:"adminId,omitempty"`            // 用户编号
-	Prompt       string `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`              // 提示词
-	Platform     string `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`          // 平台
-	ModelId      string `protobuf:"bytes,4,opt,name=modelId,proto3" json:"modelId,omitempty"`            // 模型编号
-	Model        string `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`                // 模型
-	Status       int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`             // 状态
-	FinishTime   string `protobuf:"bytes,7,opt,name=finishTime,proto3" json:"finishTime,omitempty"`      // 完成时间
-	ErrorMessage string `protobuf:"bytes,8,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`  // 错误信息
-	PublicStatus bool   `protobuf:"varint,9,opt,name=publicStatus,proto3" json:"publicStatus,omitempty"` // 是否发布
-	VideoURL     string `protobuf:"bytes,10,opt,name=videoURL,proto3" json:"videoURL,omitempty"`         // 视频地址
-	Options      string `protobuf:"bytes,11,opt,name=options,proto3" json:"options,omitempty"`           // 绘制参数
-	TaskId       string `protobuf:"bytes,12,opt,name=taskId,proto3" json:"taskId,omitempty"`             // 任务编号
+	ModelId string `protobuf:"bytes,1,opt,name=modelId,proto3" json:"modelId,omitempty"` // 模型编号
+	Prompt  string `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`   // 提示词
+	Options string `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"` // 生成参数(JSON, 透传给模型平台)
 }
 
 func (x *CreateAiIndexVideoRecordReq) Reset() {
@@ -235,27 +226,6 @@ func (*CreateAiIndexVideoRecordReq) Descriptor() ([]byte, []int) {
 	return file_admin_v1_ai_index_video_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateAiIndexVideoRecordReq) GetAdminId() string {
-	if x != nil {
-		return x.AdminId
-	}
-	return ""
-}
-
-func (x *CreateAiIndexVideoRecordReq) GetPrompt() string {
-	if x != nil {
-		return x.Prompt
-	}
-	return ""
-}
-
-func (x *CreateAiIndexVideoRecordReq) GetPlatform() string {
-	if x != nil {
-		return x.Platform
-	}
-	return ""
-}
-
 func (x *CreateAiIndexVideoRecordReq) GetModelId() string {
 	if x != nil {
 		return x.ModelId
@@ -263,44 +233,9 @@ func (x *CreateAiIndexVideoRecordReq) GetModelId() string {
 	return ""
 }
 
-func (x *CreateAiIndexVideoRecordReq) GetModel() string {
-	if x != nil {
-		return x.Model
-	}
-	return ""
-}
-
-func (x *CreateAiIndexVideoRecordReq) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *CreateAiIndexVideoRecordReq) GetFinishTime() string {
-	if x != nil {
-		return x.FinishTime
-	}
-	return ""
-}
-
-func (x *CreateAiIndexVideoRecordReq) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
-}
-
-func (x *CreateAiIndexVideoRecordReq) GetPublicStatus() bool {
-	if x != nil {
-		return x.PublicStatus
-	}
-	return false
-}
-
-func (x *CreateAiIndexVideoRecordReq) GetVideoURL() string {
+func (x *CreateAiIndexVideoRecordReq) GetPrompt() string {
 	if x != nil {
-		return x.VideoURL
+		return x.Prompt
 	}
 	return ""
 }
@@ -312,13 +247,6 @@ func (x *CreateAiIndexVideoRecordReq) GetOptions() string {
 	return ""
 }
 
-func (x *CreateAiIndexVideoRecordReq) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
 // 响应-AI 视频表-创建一条数据
 type CreateAiIndexVideoRecordReply struct {
 	state         protoimpl.MessageState
@@ -373,19 +301,8 @@ type UpdateAiIndexVideoRecordReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // 编号
-	AdminId      string `protobuf:"bytes,2,opt,name=adminId,proto3" json:"adminId,omitempty"`             // 用户编号
-	Prompt       string `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`               // 提示词
-	Platform     string `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`           // 平台
-	ModelId      string `protobuf:"bytes,5,opt,name=modelId,proto3" json:"modelId,omitempty"`             // 模型编号
-	Model        string `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`                 // 模型
-	Status       int32  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`              // 状态
-	FinishTime   string `protobuf:"bytes,8,opt,name=finishTime,proto3" json:"finishTime,omitempty"`       // 完成时间
-	ErrorMessage string `protobuf:"bytes,9,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`   // 错误信息
-	PublicStatus bool   `protobuf:"varint,10,opt,name=publicStatus,proto3" json:"publicStatus,omitempty"` // 是否发布
-	VideoURL     string `protobuf:"bytes,11,opt,name=videoURL,proto3" json:"videoURL,omitempty"`          // 视频地址
-	Options      string `protobuf:"bytes,12,opt,name=options,proto3" json:"options,omitempty"`            // 绘制参数
-	TaskId       string `protobuf:"bytes,13,opt,name=taskId,proto3" json:"taskId,omitempty"`              // 任务编号
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                      // 编号
+	PublicStatus bool   `protobuf:"varint,2,opt,name=publicStatus,proto3" json:"publicStatus,omitempty"` // 是否发布
 }
 
 func (x *UpdateAiIndexVideoRecordReq) Reset() {
@@ -427,62 +344,6 @@ func (x *UpdateAiIndexVideoRecordReq) GetId() string {
 	return ""
 }
 
-func (x *UpdateAiIndexVideoRecordReq) GetAdminId() string {
-	if x != nil {
-		return x.AdminId
-	}
-	return ""
-}
-
-func (x *UpdateAiIndexVideoRecordReq) GetPrompt() string {
-	if x != nil {
-		return x.Prompt
-	}
-	return ""
-}
-
-func (x *UpdateAiIndexVideoRecordReq) GetPlatform() string {
-	if x != nil {
-		return x.Platform
-	}
-	return ""
-}
-
-func (x *UpdateAiIndexVideoRecordReq) GetModelId() string {
-	if x != nil {
-		return x.ModelId
-	}
-	return ""
-}
-
-func (x *UpdateAiIndexVideoRecordReq) GetModel() string {
-	if x != nil {
-		return x.Model
-	}
-	return ""
-}
-
-func (x *UpdateAiIndexVideoRecordReq) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *UpdateAiIndexVideoRecordReq) GetFinishTime() string {
-	if x != nil {
-		return x.FinishTime
-	}
-	return ""
-}
-
-func (x *UpdateAiIndexVideoRecordReq) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
-}
-
 func (x *UpdateAiIndexVideoRecordReq) GetPublicStatus() bool {
 	if x != nil {
 		return x.PublicStatus
@@ -490,27 +351,6 @@ func (x *UpdateAiIndexVideoRecordReq) GetPublicStatus() bool {
 	return false
 }
 
-func (x *UpdateAiIndexVideoRecordReq) GetVideoURL() string {
-	if x != nil {
-		return x.VideoURL
-	}
-	return ""
-}
-
-func (x *UpdateAiIndexVideoRecordReq) GetOptions() string {
-	if x != nil {
-		return x.Options
-	}
-	return ""
-}
-
-func (x *UpdateAiIndexVideoRecordReq) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
 // 响应-AI 视频表-更新一条数据
 type UpdateAiIndexVideoRecordReply struct {
 	state         protoimpl.MessageState
@@ -557,7 +397,7 @@ type UpdateAiIndexVideoRecordStatusReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`          // 编号
-	Status int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // 状态
+	Status int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // 状态(仅支持取消: -2)
 }
 
 func (x *UpdateAiIndexVideoRecordStatusReq) Reset() {
@@ -979,207 +819,151 @@ var file_admin_v1_ai_index_video_proto_rawDesc = []byte{
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc9, 0x04, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
 	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10,
-	0x01, 0x18, 0x40, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06,
-	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xba, 0x48,
-	0x0e, 0x72, 0x0c, 0x10, 0x01, 0x18, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0x52,
-	0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04,
-	0x10, 0x01, 0x18, 0x40, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x29,
-	0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0f, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
-	0x52, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10,
-	0x01, 0x18, 0x40, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48,
-	0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01,
-	0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a,
-	0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x52, 0x4c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
-	0x04, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x27, 0x0a, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48,
-	0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72,
-	0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x3a, 0x43,
-	0x92, 0x41, 0x40, 0x0a, 0x3e, 0xd2, 0x01, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0xd2,
-	0x01, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0xd2, 0x01, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0xd2, 0x01, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0xd2, 0x01, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0xd2, 0x01, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49,
+	0x01, 0x18, 0x40, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x20, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x12, 0x27, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x18, 0x92, 0x41, 0x15, 0x0a, 0x13,
+	0xd2, 0x01, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0xd2, 0x01, 0x06, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x22, 0x2f, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49,
 	0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
 	0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0xea, 0x04, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
-	0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x23, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x07, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xba, 0x48, 0x0e, 0x72, 0x0c, 0x10, 0x01, 0x18, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
-	0x12, 0x25, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x08, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x29, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01,
-	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x05, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x66,
-	0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0f, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
-	0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0c,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10,
-	0x01, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55,
-	0x52, 0x4c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01,
-	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x04, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x55, 0x52, 0x4c, 0x12, 0x27, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a,
-	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba,
-	0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52,
-	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x3a, 0x48, 0x92, 0x41, 0x45, 0x0a, 0x43, 0xd2, 0x01,
-	0x02, 0x69, 0x64, 0xd2, 0x01, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0xd2, 0x01, 0x06,
-	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0xd2, 0x01, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0xd2, 0x01, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0xd2, 0x01, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0xd2, 0x01, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x6c, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x13, 0x92, 0x41, 0x10,
-	0x0a, 0x0e, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x25, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x45, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02,
-	0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x1f,
-	0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x78, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x3a, 0x19, 0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2,
+	0x01, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1f,
+	0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56,
 	0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12,
-	0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
-	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07,
-	0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x69,
+	0x7e, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x10, 0xba, 0x48, 0x0d, 0x1a, 0x0b, 0x30, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x13, 0x92, 0x41, 0x10, 0x0a,
+	0x0e, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x25, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x45, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a,
+	0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x46,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x1a,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a,
+	0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x7c,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1b,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x3a, 0x17, 0x92, 0x41, 0x14, 0x0a, 0x12, 0xd2, 0x01, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0xd2, 0x01, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6c, 0x0a, 0x1e,
+	0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x69,
 	0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22,
-	0x7c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba,
-	0x48, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
-	0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x3a, 0x17, 0x92, 0x41, 0x14, 0x0a, 0x12, 0xd2, 0x01, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0xd2, 0x01, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6c, 0x0a,
-	0x1e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x32, 0xa9, 0x09, 0x0a, 0x0c,
-	0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0xbe, 0x01, 0x0a,
-	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x32, 0xa9, 0x09, 0x0a, 0x0c, 0x41,
+	0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0xbe, 0x01, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a,
+	0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x22, 0x1f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xbe, 0x01, 0x0a,
+	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69,
 	0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64,
 	0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x1a, 0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x1a, 0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
 	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02,
 	0x24, 0x22, 0x1f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x63, 0x72, 0x65, 0x61,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x75, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75,
 	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61,
-	0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xbe, 0x01,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x1a, 0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65,
-	0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xd7,
-	0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x2b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x59, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a,
-	0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xbe, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01,
-	0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xbc, 0x01, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
-	0x28, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6f,
+	0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xd7, 0x01,
+	0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x59, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a, 0x92,
+	0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xbe, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x69, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a,
 	0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
 	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xbc, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
 	0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
 	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28,
+	0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x28,
 	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
+	0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
 	0x12, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x92,
+	0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x92,
 	0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61,
-	0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
-	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xbc, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41,
+	0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
+	0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x92, 0x41,
+	0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69,
+	0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76,
+	0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -189,30 +189,12 @@ func (m *CreateAiIndexVideoRecordReq) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for AdminId
-
-	// no validation rules for Prompt
-
-	// no validation rules for Platform
-
 	// no validation rules for ModelId
 
-	// no validation rules for Model
-
-	// no validation rules for Status
-
-	// no validation rules for FinishTime
-
-	// no validation rules for ErrorMessage
-
-	// no validation rules for PublicStatus
-
-	// no validation rules for VideoURL
+	// no validation rules for Prompt
 
 	// no validation rules for Options
 
-	// no validation rules for TaskId
-
 	if len(errors) > 0 {
 		return CreateAiIndexVideoRecordReqMultiError(errors)
 	}
@@ -423,30 +405,8 @@ func (m *UpdateAiIndexVideoRecordReq) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for AdminId
-
-	// no validation rules for Prompt
-
-	// no validation rules for Platform
-
-	// no validation rules for ModelId
-
-	// no validation rules for Model
-
-	// no validation rules for Status
-
-	// no validation rules for FinishTime
-
-	// no validation rules for ErrorMessage
-
 	// no validation rules for PublicStatus
 
-	// no validation rules for VideoURL
-
-	// no validation rules for Options
-
-	// no validation rules for TaskId
-
 	if len(errors) > 0 {
 		return UpdateAiIndexVideoRecordReqMultiError(errors)
 	}
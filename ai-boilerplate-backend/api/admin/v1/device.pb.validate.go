@@ -1610,3 +1610,1404 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetOnlineDeviceCountReplyValidationError{}
+
+// Validate checks the field values on DevicePushContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DevicePushContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DevicePushContent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DevicePushContentMultiError, or nil if none found.
+func (m *DevicePushContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DevicePushContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgType
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return DevicePushContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// DevicePushContentMultiError is an error wrapping multiple validation errors
+// returned by DevicePushContent.ValidateAll() if the designated constraints
+// aren't met.
+type DevicePushContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DevicePushContentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DevicePushContentMultiError) AllErrors() []error { return m }
+
+// DevicePushContentValidationError is the validation error returned by
+// DevicePushContent.Validate if the designated constraints aren't met.
+type DevicePushContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DevicePushContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DevicePushContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DevicePushContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DevicePushContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DevicePushContentValidationError) ErrorName() string {
+	return "DevicePushContentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DevicePushContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDevicePushContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DevicePushContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DevicePushContentValidationError{}
+
+// Validate checks the field values on PushDeviceReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PushDeviceReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushDeviceReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PushDeviceReqMultiError, or
+// nil if none found.
+func (m *PushDeviceReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushDeviceReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sn
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushDeviceReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushDeviceReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushDeviceReqValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PushDeviceReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushDeviceReqMultiError is an error wrapping multiple validation errors
+// returned by PushDeviceReq.ValidateAll() if the designated constraints
+// aren't met.
+type PushDeviceReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushDeviceReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushDeviceReqMultiError) AllErrors() []error { return m }
+
+// PushDeviceReqValidationError is the validation error returned by
+// PushDeviceReq.Validate if the designated constraints aren't met.
+type PushDeviceReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushDeviceReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushDeviceReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushDeviceReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushDeviceReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushDeviceReqValidationError) ErrorName() string { return "PushDeviceReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PushDeviceReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushDeviceReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushDeviceReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushDeviceReqValidationError{}
+
+// Validate checks the field values on PushDeviceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PushDeviceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushDeviceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushDeviceReplyMultiError, or nil if none found.
+func (m *PushDeviceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushDeviceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return PushDeviceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushDeviceReplyMultiError is an error wrapping multiple validation errors
+// returned by PushDeviceReply.ValidateAll() if the designated constraints
+// aren't met.
+type PushDeviceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushDeviceReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushDeviceReplyMultiError) AllErrors() []error { return m }
+
+// PushDeviceReplyValidationError is the validation error returned by
+// PushDeviceReply.Validate if the designated constraints aren't met.
+type PushDeviceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushDeviceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushDeviceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushDeviceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushDeviceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushDeviceReplyValidationError) ErrorName() string { return "PushDeviceReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PushDeviceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushDeviceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushDeviceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushDeviceReplyValidationError{}
+
+// Validate checks the field values on PushDeviceBatchReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PushDeviceBatchReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushDeviceBatchReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushDeviceBatchReqMultiError, or nil if none found.
+func (m *PushDeviceBatchReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushDeviceBatchReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushDeviceBatchReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushDeviceBatchReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushDeviceBatchReqValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PushDeviceBatchReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushDeviceBatchReqMultiError is an error wrapping multiple validation errors
+// returned by PushDeviceBatchReq.ValidateAll() if the designated constraints
+// aren't met.
+type PushDeviceBatchReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushDeviceBatchReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushDeviceBatchReqMultiError) AllErrors() []error { return m }
+
+// PushDeviceBatchReqValidationError is the validation error returned by
+// PushDeviceBatchReq.Validate if the designated constraints aren't met.
+type PushDeviceBatchReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushDeviceBatchReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushDeviceBatchReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushDeviceBatchReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushDeviceBatchReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushDeviceBatchReqValidationError) ErrorName() string {
+	return "PushDeviceBatchReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PushDeviceBatchReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushDeviceBatchReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushDeviceBatchReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushDeviceBatchReqValidationError{}
+
+// Validate checks the field values on PushDeviceBatchReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PushDeviceBatchReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushDeviceBatchReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushDeviceBatchReplyMultiError, or nil if none found.
+func (m *PushDeviceBatchReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushDeviceBatchReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return PushDeviceBatchReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushDeviceBatchReplyMultiError is an error wrapping multiple validation
+// errors returned by PushDeviceBatchReply.ValidateAll() if the designated
+// constraints aren't met.
+type PushDeviceBatchReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushDeviceBatchReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushDeviceBatchReplyMultiError) AllErrors() []error { return m }
+
+// PushDeviceBatchReplyValidationError is the validation error returned by
+// PushDeviceBatchReply.Validate if the designated constraints aren't met.
+type PushDeviceBatchReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushDeviceBatchReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushDeviceBatchReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushDeviceBatchReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushDeviceBatchReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushDeviceBatchReplyValidationError) ErrorName() string {
+	return "PushDeviceBatchReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PushDeviceBatchReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushDeviceBatchReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushDeviceBatchReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushDeviceBatchReplyValidationError{}
+
+// Validate checks the field values on PushDeviceTagReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PushDeviceTagReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushDeviceTagReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushDeviceTagReqMultiError, or nil if none found.
+func (m *PushDeviceTagReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushDeviceTagReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tag
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushDeviceTagReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushDeviceTagReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushDeviceTagReqValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PushDeviceTagReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushDeviceTagReqMultiError is an error wrapping multiple validation errors
+// returned by PushDeviceTagReq.ValidateAll() if the designated constraints
+// aren't met.
+type PushDeviceTagReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushDeviceTagReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushDeviceTagReqMultiError) AllErrors() []error { return m }
+
+// PushDeviceTagReqValidationError is the validation error returned by
+// PushDeviceTagReq.Validate if the designated constraints aren't met.
+type PushDeviceTagReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushDeviceTagReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushDeviceTagReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushDeviceTagReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushDeviceTagReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushDeviceTagReqValidationError) ErrorName() string { return "PushDeviceTagReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PushDeviceTagReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushDeviceTagReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushDeviceTagReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushDeviceTagReqValidationError{}
+
+// Validate checks the field values on PushDeviceTagReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PushDeviceTagReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushDeviceTagReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushDeviceTagReplyMultiError, or nil if none found.
+func (m *PushDeviceTagReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushDeviceTagReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return PushDeviceTagReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushDeviceTagReplyMultiError is an error wrapping multiple validation errors
+// returned by PushDeviceTagReply.ValidateAll() if the designated constraints
+// aren't met.
+type PushDeviceTagReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushDeviceTagReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushDeviceTagReplyMultiError) AllErrors() []error { return m }
+
+// PushDeviceTagReplyValidationError is the validation error returned by
+// PushDeviceTagReply.Validate if the designated constraints aren't met.
+type PushDeviceTagReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushDeviceTagReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushDeviceTagReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushDeviceTagReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushDeviceTagReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushDeviceTagReplyValidationError) ErrorName() string {
+	return "PushDeviceTagReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PushDeviceTagReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushDeviceTagReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushDeviceTagReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushDeviceTagReplyValidationError{}
+
+// Validate checks the field values on SendDeviceCommandReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendDeviceCommandReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendDeviceCommandReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendDeviceCommandReqMultiError, or nil if none found.
+func (m *SendDeviceCommandReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendDeviceCommandReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sn
+
+	// no validation rules for Command
+
+	// no validation rules for Params
+
+	if len(errors) > 0 {
+		return SendDeviceCommandReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendDeviceCommandReqMultiError is an error wrapping multiple validation
+// errors returned by SendDeviceCommandReq.ValidateAll() if the designated
+// constraints aren't met.
+type SendDeviceCommandReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendDeviceCommandReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendDeviceCommandReqMultiError) AllErrors() []error { return m }
+
+// SendDeviceCommandReqValidationError is the validation error returned by
+// SendDeviceCommandReq.Validate if the designated constraints aren't met.
+type SendDeviceCommandReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendDeviceCommandReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendDeviceCommandReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendDeviceCommandReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendDeviceCommandReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendDeviceCommandReqValidationError) ErrorName() string {
+	return "SendDeviceCommandReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendDeviceCommandReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendDeviceCommandReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendDeviceCommandReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendDeviceCommandReqValidationError{}
+
+// Validate checks the field values on SendDeviceCommandReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendDeviceCommandReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendDeviceCommandReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendDeviceCommandReplyMultiError, or nil if none found.
+func (m *SendDeviceCommandReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendDeviceCommandReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return SendDeviceCommandReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendDeviceCommandReplyMultiError is an error wrapping multiple validation
+// errors returned by SendDeviceCommandReply.ValidateAll() if the designated
+// constraints aren't met.
+type SendDeviceCommandReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendDeviceCommandReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendDeviceCommandReplyMultiError) AllErrors() []error { return m }
+
+// SendDeviceCommandReplyValidationError is the validation error returned by
+// SendDeviceCommandReply.Validate if the designated constraints aren't met.
+type SendDeviceCommandReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendDeviceCommandReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendDeviceCommandReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendDeviceCommandReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendDeviceCommandReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendDeviceCommandReplyValidationError) ErrorName() string {
+	return "SendDeviceCommandReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendDeviceCommandReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendDeviceCommandReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendDeviceCommandReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendDeviceCommandReplyValidationError{}
+
+// Validate checks the field values on DevicePushLogInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DevicePushLogInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DevicePushLogInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DevicePushLogInfoMultiError, or nil if none found.
+func (m *DevicePushLogInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DevicePushLogInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PushType
+
+	// no validation rules for MsgType
+
+	// no validation rules for Tag
+
+	// no validation rules for Command
+
+	// no validation rules for Msg
+
+	// no validation rules for Status
+
+	// no validation rules for MsgId
+
+	// no validation rules for Attempts
+
+	// no validation rules for ErrorMessage
+
+	// no validation rules for SendTime
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return DevicePushLogInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// DevicePushLogInfoMultiError is an error wrapping multiple validation errors
+// returned by DevicePushLogInfo.ValidateAll() if the designated constraints
+// aren't met.
+type DevicePushLogInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DevicePushLogInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DevicePushLogInfoMultiError) AllErrors() []error { return m }
+
+// DevicePushLogInfoValidationError is the validation error returned by
+// DevicePushLogInfo.Validate if the designated constraints aren't met.
+type DevicePushLogInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DevicePushLogInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DevicePushLogInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DevicePushLogInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DevicePushLogInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DevicePushLogInfoValidationError) ErrorName() string {
+	return "DevicePushLogInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DevicePushLogInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDevicePushLogInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DevicePushLogInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DevicePushLogInfoValidationError{}
+
+// Validate checks the field values on GetDevicePushLogListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDevicePushLogListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDevicePushLogListReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDevicePushLogListReqMultiError, or nil if none found.
+func (m *GetDevicePushLogListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDevicePushLogListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for PushType
+
+	// no validation rules for Command
+
+	if len(errors) > 0 {
+		return GetDevicePushLogListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDevicePushLogListReqMultiError is an error wrapping multiple validation
+// errors returned by GetDevicePushLogListReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetDevicePushLogListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDevicePushLogListReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDevicePushLogListReqMultiError) AllErrors() []error { return m }
+
+// GetDevicePushLogListReqValidationError is the validation error returned by
+// GetDevicePushLogListReq.Validate if the designated constraints aren't met.
+type GetDevicePushLogListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDevicePushLogListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDevicePushLogListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDevicePushLogListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDevicePushLogListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDevicePushLogListReqValidationError) ErrorName() string {
+	return "GetDevicePushLogListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDevicePushLogListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDevicePushLogListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDevicePushLogListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDevicePushLogListReqValidationError{}
+
+// Validate checks the field values on GetDevicePushLogListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDevicePushLogListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDevicePushLogListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDevicePushLogListReplyMultiError, or nil if none found.
+func (m *GetDevicePushLogListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDevicePushLogListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDevicePushLogListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDevicePushLogListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDevicePushLogListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDevicePushLogListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDevicePushLogListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetDevicePushLogListReply.ValidateAll() if the
+// designated constraints aren't met.
+type GetDevicePushLogListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDevicePushLogListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDevicePushLogListReplyMultiError) AllErrors() []error { return m }
+
+// GetDevicePushLogListReplyValidationError is the validation error returned by
+// GetDevicePushLogListReply.Validate if the designated constraints aren't met.
+type GetDevicePushLogListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDevicePushLogListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDevicePushLogListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDevicePushLogListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDevicePushLogListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDevicePushLogListReplyValidationError) ErrorName() string {
+	return "GetDevicePushLogListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDevicePushLogListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDevicePushLogListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDevicePushLogListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDevicePushLogListReplyValidationError{}
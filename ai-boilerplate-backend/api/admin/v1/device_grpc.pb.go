@@ -34,6 +34,16 @@ type DeviceClient interface {
 	GetDeviceList(ctx context.Context, in *GetDeviceListReq, opts ...grpc.CallOption) (*GetDeviceListReply, error)
 	// 设备表-在线设备数量统计
 	GetOnlineDeviceCount(ctx context.Context, in *GetOnlineDeviceCountReq, opts ...grpc.CallOption) (*GetOnlineDeviceCountReply, error)
+	// 设备表-单设备推送
+	PushDevice(ctx context.Context, in *PushDeviceReq, opts ...grpc.CallOption) (*PushDeviceReply, error)
+	// 设备表-批量设备推送
+	PushDeviceBatch(ctx context.Context, in *PushDeviceBatchReq, opts ...grpc.CallOption) (*PushDeviceBatchReply, error)
+	// 设备表-标签推送
+	PushDeviceTag(ctx context.Context, in *PushDeviceTagReq, opts ...grpc.CallOption) (*PushDeviceTagReply, error)
+	// 设备表-下发远程指令
+	SendDeviceCommand(ctx context.Context, in *SendDeviceCommandReq, opts ...grpc.CallOption) (*SendDeviceCommandReply, error)
+	// 设备表-推送日志列表
+	GetDevicePushLogList(ctx context.Context, in *GetDevicePushLogListReq, opts ...grpc.CallOption) (*GetDevicePushLogListReply, error)
 }
 
 type deviceClient struct {
@@ -98,6 +108,51 @@ func (c *deviceClient) GetOnlineDeviceCount(ctx context.Context, in *GetOnlineDe
 	return out, nil
 }
 
+func (c *deviceClient) PushDevice(ctx context.Context, in *PushDeviceReq, opts ...grpc.CallOption) (*PushDeviceReply, error) {
+	out := new(PushDeviceReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.Device/PushDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) PushDeviceBatch(ctx context.Context, in *PushDeviceBatchReq, opts ...grpc.CallOption) (*PushDeviceBatchReply, error) {
+	out := new(PushDeviceBatchReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.Device/PushDeviceBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) PushDeviceTag(ctx context.Context, in *PushDeviceTagReq, opts ...grpc.CallOption) (*PushDeviceTagReply, error) {
+	out := new(PushDeviceTagReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.Device/PushDeviceTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) SendDeviceCommand(ctx context.Context, in *SendDeviceCommandReq, opts ...grpc.CallOption) (*SendDeviceCommandReply, error) {
+	out := new(SendDeviceCommandReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.Device/SendDeviceCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) GetDevicePushLogList(ctx context.Context, in *GetDevicePushLogListReq, opts ...grpc.CallOption) (*GetDevicePushLogListReply, error) {
+	out := new(GetDevicePushLogListReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.Device/GetDevicePushLogList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceServer is the server API for Device service.
 // All implementations must embed UnimplementedDeviceServer
 // for forward compatibility
@@ -114,6 +169,16 @@ type DeviceServer interface {
 	GetDeviceList(context.Context, *GetDeviceListReq) (*GetDeviceListReply, error)
 	// 设备表-在线设备数量统计
 	GetOnlineDeviceCount(context.Context, *GetOnlineDeviceCountReq) (*GetOnlineDeviceCountReply, error)
+	// 设备表-单设备推送
+	PushDevice(context.Context, *PushDeviceReq) (*PushDeviceReply, error)
+	// 设备表-批量设备推送
+	PushDeviceBatch(context.Context, *PushDeviceBatchReq) (*PushDeviceBatchReply, error)
+	// 设备表-标签推送
+	PushDeviceTag(context.Context, *PushDeviceTagReq) (*PushDeviceTagReply, error)
+	// 设备表-下发远程指令
+	SendDeviceCommand(context.Context, *SendDeviceCommandReq) (*SendDeviceCommandReply, error)
+	// 设备表-推送日志列表
+	GetDevicePushLogList(context.Context, *GetDevicePushLogListReq) (*GetDevicePushLogListReply, error)
 	mustEmbedUnimplementedDeviceServer()
 }
 
@@ -139,6 +204,21 @@ func (UnimplementedDeviceServer) GetDeviceList(context.Context, *GetDeviceListRe
 func (UnimplementedDeviceServer) GetOnlineDeviceCount(context.Context, *GetOnlineDeviceCountReq) (*GetOnlineDeviceCountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOnlineDeviceCount not implemented")
 }
+func (UnimplementedDeviceServer) PushDevice(context.Context, *PushDeviceReq) (*PushDeviceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushDevice not implemented")
+}
+func (UnimplementedDeviceServer) PushDeviceBatch(context.Context, *PushDeviceBatchReq) (*PushDeviceBatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushDeviceBatch not implemented")
+}
+func (UnimplementedDeviceServer) PushDeviceTag(context.Context, *PushDeviceTagReq) (*PushDeviceTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushDeviceTag not implemented")
+}
+func (UnimplementedDeviceServer) SendDeviceCommand(context.Context, *SendDeviceCommandReq) (*SendDeviceCommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDeviceCommand not implemented")
+}
+func (UnimplementedDeviceServer) GetDevicePushLogList(context.Context, *GetDevicePushLogListReq) (*GetDevicePushLogListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevicePushLogList not implemented")
+}
 func (UnimplementedDeviceServer) mustEmbedUnimplementedDeviceServer() {}
 
 // UnsafeDeviceServer may be embedded to opt out of forward compatibility for this service.
@@ -260,6 +340,96 @@ func _Device_GetOnlineDeviceCount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Device_PushDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).PushDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.Device/PushDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).PushDevice(ctx, req.(*PushDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_PushDeviceBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushDeviceBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).PushDeviceBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.Device/PushDeviceBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).PushDeviceBatch(ctx, req.(*PushDeviceBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_PushDeviceTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushDeviceTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).PushDeviceTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.Device/PushDeviceTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).PushDeviceTag(ctx, req.(*PushDeviceTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_SendDeviceCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDeviceCommandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).SendDeviceCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.Device/SendDeviceCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).SendDeviceCommand(ctx, req.(*SendDeviceCommandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_GetDevicePushLogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDevicePushLogListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).GetDevicePushLogList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.Device/GetDevicePushLogList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).GetDevicePushLogList(ctx, req.(*GetDevicePushLogListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Device_ServiceDesc is the grpc.ServiceDesc for Device service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -291,6 +461,26 @@ var Device_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOnlineDeviceCount",
 			Handler:    _Device_GetOnlineDeviceCount_Handler,
 		},
+		{
+			MethodName: "PushDevice",
+			Handler:    _Device_PushDevice_Handler,
+		},
+		{
+			MethodName: "PushDeviceBatch",
+			Handler:    _Device_PushDeviceBatch_Handler,
+		},
+		{
+			MethodName: "PushDeviceTag",
+			Handler:    _Device_PushDeviceTag_Handler,
+		},
+		{
+			MethodName: "SendDeviceCommand",
+			Handler:    _Device_SendDeviceCommand_Handler,
+		},
+		{
+			MethodName: "GetDevicePushLogList",
+			Handler:    _Device_GetDevicePushLogList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/device.proto",
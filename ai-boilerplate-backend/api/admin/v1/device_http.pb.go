@@ -22,16 +22,26 @@ const _ = http.SupportPackageIsVersion1
 const OperationDeviceDeleteDevice = "/admin.v1.Device/DeleteDevice"
 const OperationDeviceGetDeviceInfo = "/admin.v1.Device/GetDeviceInfo"
 const OperationDeviceGetDeviceList = "/admin.v1.Device/GetDeviceList"
+const OperationDeviceGetDevicePushLogList = "/admin.v1.Device/GetDevicePushLogList"
 const OperationDeviceGetOnlineDeviceCount = "/admin.v1.Device/GetOnlineDeviceCount"
+const OperationDevicePushDevice = "/admin.v1.Device/PushDevice"
+const OperationDevicePushDeviceBatch = "/admin.v1.Device/PushDeviceBatch"
+const OperationDevicePushDeviceTag = "/admin.v1.Device/PushDeviceTag"
 const OperationDeviceRegisterDevice = "/admin.v1.Device/RegisterDevice"
+const OperationDeviceSendDeviceCommand = "/admin.v1.Device/SendDeviceCommand"
 const OperationDeviceUpdateDeviceStatus = "/admin.v1.Device/UpdateDeviceStatus"
 
 type DeviceHTTPServer interface {
 	DeleteDevice(context.Context, *DeleteDeviceReq) (*DeleteDeviceReply, error)
 	GetDeviceInfo(context.Context, *GetDeviceInfoReq) (*GetDeviceInfoReply, error)
 	GetDeviceList(context.Context, *GetDeviceListReq) (*GetDeviceListReply, error)
+	GetDevicePushLogList(context.Context, *GetDevicePushLogListReq) (*GetDevicePushLogListReply, error)
 	GetOnlineDeviceCount(context.Context, *GetOnlineDeviceCountReq) (*GetOnlineDeviceCountReply, error)
+	PushDevice(context.Context, *PushDeviceReq) (*PushDeviceReply, error)
+	PushDeviceBatch(context.Context, *PushDeviceBatchReq) (*PushDeviceBatchReply, error)
+	PushDeviceTag(context.Context, *PushDeviceTagReq) (*PushDeviceTagReply, error)
 	RegisterDevice(context.Context, *RegisterDeviceReq) (*RegisterDeviceReply, error)
+	SendDeviceCommand(context.Context, *SendDeviceCommandReq) (*SendDeviceCommandReply, error)
 	UpdateDeviceStatus(context.Context, *UpdateDeviceStatusReq) (*UpdateDeviceStatusReply, error)
 }
 
@@ -43,6 +53,11 @@ func RegisterDeviceHTTPServer(s *http.Server, srv DeviceHTTPServer) {
 	r.GET("/admin/v1/device/info", _Device_GetDeviceInfo0_HTTP_Handler(srv))
 	r.POST("/admin/v1/device/list", _Device_GetDeviceList0_HTTP_Handler(srv))
 	r.GET("/admin/v1/device/online/count", _Device_GetOnlineDeviceCount0_HTTP_Handler(srv))
+	r.POST("/admin/v1/device/push", _Device_PushDevice0_HTTP_Handler(srv))
+	r.POST("/admin/v1/device/push/batch", _Device_PushDeviceBatch0_HTTP_Handler(srv))
+	r.POST("/admin/v1/device/push/tag", _Device_PushDeviceTag0_HTTP_Handler(srv))
+	r.POST("/admin/v1/device/command", _Device_SendDeviceCommand0_HTTP_Handler(srv))
+	r.POST("/admin/v1/device/push/log/list", _Device_GetDevicePushLogList0_HTTP_Handler(srv))
 }
 
 func _Device_RegisterDevice0_HTTP_Handler(srv DeviceHTTPServer) func(ctx http.Context) error {
@@ -159,12 +174,112 @@ func _Device_GetOnlineDeviceCount0_HTTP_Handler(srv DeviceHTTPServer) func(ctx h
 	}
 }
 
+func _Device_PushDevice0_HTTP_Handler(srv DeviceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PushDeviceReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDevicePushDevice)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PushDevice(ctx, req.(*PushDeviceReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PushDeviceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Device_PushDeviceBatch0_HTTP_Handler(srv DeviceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PushDeviceBatchReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDevicePushDeviceBatch)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PushDeviceBatch(ctx, req.(*PushDeviceBatchReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PushDeviceBatchReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Device_PushDeviceTag0_HTTP_Handler(srv DeviceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PushDeviceTagReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDevicePushDeviceTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PushDeviceTag(ctx, req.(*PushDeviceTagReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PushDeviceTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Device_SendDeviceCommand0_HTTP_Handler(srv DeviceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendDeviceCommandReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDeviceSendDeviceCommand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendDeviceCommand(ctx, req.(*SendDeviceCommandReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendDeviceCommandReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Device_GetDevicePushLogList0_HTTP_Handler(srv DeviceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDevicePushLogListReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDeviceGetDevicePushLogList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDevicePushLogList(ctx, req.(*GetDevicePushLogListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetDevicePushLogListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DeviceHTTPClient interface {
 	DeleteDevice(ctx context.Context, req *DeleteDeviceReq, opts ...http.CallOption) (rsp *DeleteDeviceReply, err error)
 	GetDeviceInfo(ctx context.Context, req *GetDeviceInfoReq, opts ...http.CallOption) (rsp *GetDeviceInfoReply, err error)
 	GetDeviceList(ctx context.Context, req *GetDeviceListReq, opts ...http.CallOption) (rsp *GetDeviceListReply, err error)
+	GetDevicePushLogList(ctx context.Context, req *GetDevicePushLogListReq, opts ...http.CallOption) (rsp *GetDevicePushLogListReply, err error)
 	GetOnlineDeviceCount(ctx context.Context, req *GetOnlineDeviceCountReq, opts ...http.CallOption) (rsp *GetOnlineDeviceCountReply, err error)
+	PushDevice(ctx context.Context, req *PushDeviceReq, opts ...http.CallOption) (rsp *PushDeviceReply, err error)
+	PushDeviceBatch(ctx context.Context, req *PushDeviceBatchReq, opts ...http.CallOption) (rsp *PushDeviceBatchReply, err error)
+	PushDeviceTag(ctx context.Context, req *PushDeviceTagReq, opts ...http.CallOption) (rsp *PushDeviceTagReply, err error)
 	RegisterDevice(ctx context.Context, req *RegisterDeviceReq, opts ...http.CallOption) (rsp *RegisterDeviceReply, err error)
+	SendDeviceCommand(ctx context.Context, req *SendDeviceCommandReq, opts ...http.CallOption) (rsp *SendDeviceCommandReply, err error)
 	UpdateDeviceStatus(ctx context.Context, req *UpdateDeviceStatusReq, opts ...http.CallOption) (rsp *UpdateDeviceStatusReply, err error)
 }
 
@@ -215,6 +330,19 @@ func (c *DeviceHTTPClientImpl) GetDeviceList(ctx context.Context, in *GetDeviceL
 	return &out, err
 }
 
+func (c *DeviceHTTPClientImpl) GetDevicePushLogList(ctx context.Context, in *GetDevicePushLogListReq, opts ...http.CallOption) (*GetDevicePushLogListReply, error) {
+	var out GetDevicePushLogListReply
+	pattern := "/admin/v1/device/push/log/list"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDeviceGetDevicePushLogList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *DeviceHTTPClientImpl) GetOnlineDeviceCount(ctx context.Context, in *GetOnlineDeviceCountReq, opts ...http.CallOption) (*GetOnlineDeviceCountReply, error) {
 	var out GetOnlineDeviceCountReply
 	pattern := "/admin/v1/device/online/count"
@@ -228,6 +356,45 @@ func (c *DeviceHTTPClientImpl) GetOnlineDeviceCount(ctx context.Context, in *Get
 	return &out, err
 }
 
+func (c *DeviceHTTPClientImpl) PushDevice(ctx context.Context, in *PushDeviceReq, opts ...http.CallOption) (*PushDeviceReply, error) {
+	var out PushDeviceReply
+	pattern := "/admin/v1/device/push"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDevicePushDevice))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *DeviceHTTPClientImpl) PushDeviceBatch(ctx context.Context, in *PushDeviceBatchReq, opts ...http.CallOption) (*PushDeviceBatchReply, error) {
+	var out PushDeviceBatchReply
+	pattern := "/admin/v1/device/push/batch"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDevicePushDeviceBatch))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *DeviceHTTPClientImpl) PushDeviceTag(ctx context.Context, in *PushDeviceTagReq, opts ...http.CallOption) (*PushDeviceTagReply, error) {
+	var out PushDeviceTagReply
+	pattern := "/admin/v1/device/push/tag"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDevicePushDeviceTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *DeviceHTTPClientImpl) RegisterDevice(ctx context.Context, in *RegisterDeviceReq, opts ...http.CallOption) (*RegisterDeviceReply, error) {
 	var out RegisterDeviceReply
 	pattern := "/admin/v1/device/register"
@@ -241,6 +408,19 @@ func (c *DeviceHTTPClientImpl) RegisterDevice(ctx context.Context, in *RegisterD
 	return &out, err
 }
 
+func (c *DeviceHTTPClientImpl) SendDeviceCommand(ctx context.Context, in *SendDeviceCommandReq, opts ...http.CallOption) (*SendDeviceCommandReply, error) {
+	var out SendDeviceCommandReply
+	pattern := "/admin/v1/device/command"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDeviceSendDeviceCommand))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *DeviceHTTPClientImpl) UpdateDeviceStatus(ctx context.Context, in *UpdateDeviceStatusReq, opts ...http.CallOption) (*UpdateDeviceStatusReply, error) {
 	var out UpdateDeviceStatusReply
 	pattern := "/admin/v1/device/update/status"
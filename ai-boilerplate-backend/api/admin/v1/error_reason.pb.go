@@ -62,6 +62,32 @@ const (
 	ErrorReason_SmsFrequencyLimit ErrorReason = 26
 	// 短信验证码无效
 	ErrorReason_SmsCodeInvalid ErrorReason = 27
+	// AI Token 配额超限
+	ErrorReason_AiTokenQuotaExceeded ErrorReason = 28
+	// 账号无接口访问权限
+	ErrorReason_AccountNoAPIPermission ErrorReason = 29
+	// 账号登录已锁定
+	ErrorReason_AccountLocked ErrorReason = 30
+	// 两步验证码错误
+	ErrorReason_TwoFactorCodeError ErrorReason = 31
+	// 两步验证挑战无效
+	ErrorReason_TwoFactorChallengeInvalid ErrorReason = 32
+	// 两步验证已开启
+	ErrorReason_TwoFactorAlreadyEnabled ErrorReason = 33
+	// 两步验证未开启
+	ErrorReason_TwoFactorNotEnabled ErrorReason = 34
+	// 角色要求开启两步验证
+	ErrorReason_TwoFactorRequired ErrorReason = 35
+	// 收件人多次硬退信被暂停发送
+	ErrorReason_MailRecipientSuppressed ErrorReason = 36
+	// 公告当前发布状态不允许重新发布
+	ErrorReason_SysNoticePublishStatusInvalid ErrorReason = 37
+	// 设备未启用或没有推送通道
+	ErrorReason_DevicePushNoChannel ErrorReason = 38
+	// 设备正在执行同一远程指令
+	ErrorReason_DeviceCommandInProgress ErrorReason = 39
+	// 账号已禁用
+	ErrorReason_AccountDisabled ErrorReason = 40
 )
 
 // Enum value maps for ErrorReason.
@@ -95,36 +121,62 @@ var (
 		25: "StorageGetConfigFailed",
 		26: "SmsFrequencyLimit",
 		27: "SmsCodeInvalid",
+		28: "AiTokenQuotaExceeded",
+		29: "AccountNoAPIPermission",
+		30: "AccountLocked",
+		31: "TwoFactorCodeError",
+		32: "TwoFactorChallengeInvalid",
+		33: "TwoFactorAlreadyEnabled",
+		34: "TwoFactorNotEnabled",
+		35: "TwoFactorRequired",
+		36: "MailRecipientSuppressed",
+		37: "SysNoticePublishStatusInvalid",
+		38: "DevicePushNoChannel",
+		39: "DeviceCommandInProgress",
+		40: "AccountDisabled",
 	}
 	ErrorReason_value = map[string]int32{
-		"RequestCanceledErr":      0,
-		"RequestTimeoutErr":       1,
-		"RequestFrequentErr":      2,
-		"APIInternalErr":          3,
-		"APIThirdErr":             4,
-		"ParamError":              5,
-		"DataSQLError":            6,
-		"DataRedisErr":            7,
-		"DataMQErr":               8,
-		"DataFormattingError":     9,
-		"DataProcessingError":     10,
-		"DataRecordNotFound":      11,
-		"DataDuplicateRecord":     12,
-		"TokenNotRequest":         13,
-		"TokenFormatErr":          14,
-		"TokenExpiredErr":         15,
-		"TokenInvalidErr":         16,
-		"TokenErr":                17,
-		"AccountAlreadyExists":    18,
-		"AccountNotFound":         19,
-		"AccountPasswordError":    20,
-		"AccountNoDataPermission": 21,
-		"MenuOperationFailed":     22,
-		"MaterialUploadFailed":    23,
-		"StorageNotFound":         24,
-		"StorageGetConfigFailed":  25,
-		"SmsFrequencyLimit":       26,
-		"SmsCodeInvalid":          27,
+		"RequestCanceledErr":            0,
+		"RequestTimeoutErr":             1,
+		"RequestFrequentErr":            2,
+		"APIInternalErr":                3,
+		"APIThirdErr":                   4,
+		"ParamError":                    5,
+		"DataSQLError":                  6,
+		"DataRedisErr":                  7,
+		"DataMQErr":                     8,
+		"DataFormattingError":           9,
+		"DataProcessingError":           10,
+		"DataRecordNotFound":            11,
+		"DataDuplicateRecord":           12,
+		"TokenNotRequest":               13,
+		"TokenFormatErr":                14,
+		"TokenExpiredErr":               15,
+		"TokenInvalidErr":               16,
+		"TokenErr":                      17,
+		"AccountAlreadyExists":          18,
+		"AccountNotFound":               19,
+		"AccountPasswordError":          20,
+		"AccountNoDataPermission":       21,
+		"MenuOperationFailed":           22,
+		"MaterialUploadFailed":          23,
+		"StorageNotFound":               24,
+		"StorageGetConfigFailed":        25,
+		"SmsFrequencyLimit":             26,
+		"SmsCodeInvalid":                27,
+		"AiTokenQuotaExceeded":          28,
+		"AccountNoAPIPermission":        29,
+		"AccountLocked":                 30,
+		"TwoFactorCodeError":            31,
+		"TwoFactorChallengeInvalid":     32,
+		"TwoFactorAlreadyEnabled":       33,
+		"TwoFactorNotEnabled":           34,
+		"TwoFactorRequired":             35,
+		"MailRecipientSuppressed":       36,
+		"SysNoticePublishStatusInvalid": 37,
+		"DevicePushNoChannel":           38,
+		"DeviceCommandInProgress":       39,
+		"AccountDisabled":               40,
 	}
 )
 
@@ -161,7 +213,7 @@ var file_admin_v1_error_reason_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72,
 	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x61,
 	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xce, 0x16, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xdf, 0x24, 0x0a,
 	0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x12,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x45,
 	0x72, 0x72, 0x10, 0x00, 0x1a, 0x47, 0xa8, 0x45, 0x99, 0x03, 0xea, 0x83, 0x01, 0x12, 0x52, 0x65,
@@ -339,15 +391,128 @@ var file_admin_v1_error_reason_proto_rawDesc = []byte{
 	0x53, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63,
 	0x6f, 0x64, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x15, 0xe7, 0x9f, 0xad,
 	0xe4, 0xbf, 0xa1, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xe6, 0x97, 0xa0, 0xe6,
-	0x95, 0x88, 0x1a, 0x39, 0xa0, 0x45, 0xf4, 0x03, 0xe2, 0x83, 0x01, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0xa2, 0xc2, 0x01, 0x05, 0x7a, 0x68, 0x5f, 0x43, 0x4e, 0xe2, 0x80, 0x02, 0x1d,
-	0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x0c, 0xe6, 0x9c, 0xaa, 0xe7, 0x9f, 0xa5, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x42, 0x3c, 0x5a,
-	0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d,
-	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x95, 0x88, 0x12, 0x73, 0x0a, 0x14, 0x41, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x1c, 0x1a, 0x59, 0xa8, 0x45,
+	0xad, 0x03, 0xea, 0x83, 0x01, 0x14, 0x41, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0xea, 0x80, 0x02, 0x39, 0x0a, 0x17,
+	0x41, 0x49, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x20, 0x65,
+	0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x41, 0x49, 0x20, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x20, 0xe7, 0x94, 0xa8, 0xe9, 0x87, 0x8f, 0xe5, 0xb7, 0xb2, 0xe8, 0xb6, 0x85, 0xe5, 0x87,
+	0xba, 0xe9, 0x85, 0x8d, 0xe9, 0xa2, 0x9d, 0x12, 0x76, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x6f, 0x41, 0x50, 0x49, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x10, 0x1d, 0x1a, 0x5a, 0xa8, 0x45, 0x93, 0x03, 0xea, 0x83, 0x01, 0x16, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x41, 0x50, 0x49, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0xea, 0x80, 0x02, 0x38, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x20, 0x6e, 0x6f, 0x20, 0x61, 0x70, 0x69, 0x20, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0xe8, 0xb4, 0xa6, 0xe5, 0x8f, 0xb7, 0xe6, 0x97, 0xa0, 0xe6, 0x8e, 0xa5,
+	0xe5, 0x8f, 0xa3, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0x12,
+	0x98, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x10, 0x1e, 0x1a, 0x84, 0x01, 0xa8, 0x45, 0xad, 0x03, 0xea, 0x83, 0x01, 0x0d, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0xea, 0x80, 0x02, 0x6b, 0x0a,
+	0x3a, 0x54, 0x6f, 0x6f, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x2c,
+	0x20, 0x70, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x20, 0x72, 0x65, 0x74, 0x72, 0x79, 0x20, 0x69, 0x6e,
+	0x20, 0x25, 0x64, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2d, 0xe7, 0x99, 0xbb,
+	0xe5, 0xbd, 0x95, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0, 0xe8,
+	0xbf, 0x87, 0xe5, 0xa4, 0x9a, 0x2c, 0x20, 0xe8, 0xaf, 0xb7, 0x20, 0x25, 0x64, 0x20, 0xe7, 0xa7,
+	0x92, 0xe5, 0x90, 0x8e, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0x12, 0x6b, 0x0a, 0x12, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x10, 0x1f, 0x1a, 0x53, 0xa8, 0x45, 0x90, 0x03, 0xea, 0x83, 0x01, 0x12, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0xea, 0x80,
+	0x02, 0x35, 0x0a, 0x1c, 0x54, 0x77, 0x6f, 0x2d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x63,
+	0x6f, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x15, 0xe4, 0xb8, 0xa4, 0xe6, 0xad, 0xa5, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0,
+	0x81, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x12, 0x9e, 0x01, 0x0a, 0x19, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x20, 0x1a, 0x7f, 0xa8, 0x45, 0x91, 0x03, 0xea, 0x83, 0x01,
+	0x19, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0xea, 0x80, 0x02, 0x5a, 0x0a, 0x30,
+	0x54, 0x77, 0x6f, 0x2d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x20, 0x70, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e,
+	0x12, 0x26, 0xe4, 0xb8, 0xa4, 0xe6, 0xad, 0xa5, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe5, 0xb7,
+	0xb2, 0xe5, 0xa4, 0xb1, 0xe6, 0x95, 0x88, 0x2c, 0x20, 0xe8, 0xaf, 0xb7, 0xe9, 0x87, 0x8d, 0xe6,
+	0x96, 0xb0, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x12, 0x85, 0x01, 0x0a, 0x17, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x10, 0x21, 0x1a, 0x68, 0xa8, 0x45, 0x90, 0x03, 0xea, 0x83, 0x01, 0x17,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0xea, 0x80, 0x02, 0x45, 0x0a, 0x2c, 0x54, 0x77, 0x6f,
+	0x2d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x20, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x15, 0xe4, 0xb8, 0xa4, 0xe6, 0xad,
+	0xa5, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe5, 0xb7, 0xb2, 0xe5, 0xbc, 0x80, 0xe5, 0x90, 0xaf,
+	0x12, 0x79, 0x0a, 0x13, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x74,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x22, 0x1a, 0x60, 0xa8, 0x45, 0x90, 0x03, 0xea,
+	0x83, 0x01, 0x13, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0xea, 0x80, 0x02, 0x41, 0x0a, 0x28, 0x54, 0x77, 0x6f, 0x2d,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x15, 0xe4, 0xb8, 0xa4, 0xe6, 0xad, 0xa5, 0xe9, 0xaa, 0x8c, 0xe8,
+	0xaf, 0x81, 0xe6, 0x9c, 0xaa, 0xe5, 0xbc, 0x80, 0xe5, 0x90, 0xaf, 0x12, 0x8f, 0x01, 0x0a, 0x11,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x10, 0x23, 0x1a, 0x78, 0xa8, 0x45, 0x93, 0x03, 0xea, 0x83, 0x01, 0x11, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0xea, 0x80,
+	0x02, 0x5b, 0x0a, 0x33, 0x54, 0x77, 0x6f, 0x2d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x79, 0x6f,
+	0x75, 0x72, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x24, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8,
+	0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0xbc, 0x80, 0xe5, 0x90,
+	0xaf, 0xe4, 0xb8, 0xa4, 0xe6, 0xad, 0xa5, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0x12, 0xa2, 0x01,
+	0x0a, 0x17, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x10, 0x24, 0x1a, 0x84, 0x01, 0xa8, 0x45,
+	0x90, 0x03, 0xea, 0x83, 0x01, 0x17, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0xea, 0x80, 0x02,
+	0x61, 0x0a, 0x33, 0x54, 0x68, 0x65, 0x20, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x20, 0x69, 0x73, 0x20, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x20, 0x64,
+	0x75, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2a, 0xe6, 0x94, 0xb6, 0xe4, 0xbb, 0xb6, 0xe4, 0xba,
+	0xba, 0xe5, 0xb7, 0xb2, 0xe5, 0x9b, 0xa0, 0xe5, 0xa4, 0x9a, 0xe6, 0xac, 0xa1, 0xe9, 0x80, 0x80,
+	0xe4, 0xbf, 0xa1, 0xe8, 0xa2, 0xab, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe5, 0x8f, 0x91, 0xe9,
+	0x80, 0x81, 0x12, 0xa6, 0x01, 0x0a, 0x1d, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x10, 0x25, 0x1a, 0x82, 0x01, 0xa8, 0x45, 0x90, 0x03, 0xea, 0x83, 0x01,
+	0x1d, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0xea, 0x80,
+	0x02, 0x59, 0x0a, 0x34, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x20, 0x63,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x69, 0x74, 0x73, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0xe5, 0x85, 0xac, 0xe5, 0x91, 0x8a,
+	0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe4, 0xb8, 0x8d, 0xe5,
+	0x85, 0x81, 0xe8, 0xae, 0xb8, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0x12, 0x8d, 0x01, 0x0a, 0x13,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x75, 0x73, 0x68, 0x4e, 0x6f, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x10, 0x26, 0x1a, 0x74, 0xa8, 0x45, 0x90, 0x03, 0xea, 0x83, 0x01, 0x13, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x75, 0x73, 0x68, 0x4e, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0xea, 0x80, 0x02, 0x55, 0x0a, 0x2d, 0x54, 0x68, 0x65, 0x20, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x20, 0x6f,
+	0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x24, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xe6, 0x9c, 0xaa,
+	0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0x96, 0xe6, 0xb2, 0xa1, 0xe6, 0x9c, 0x89, 0xe6,
+	0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe9, 0x80, 0x9a, 0xe9, 0x81, 0x93, 0x12, 0xad, 0x01, 0x0a, 0x17,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x6e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x27, 0x1a, 0x8f, 0x01, 0xa8, 0x45, 0xad, 0x03,
+	0xea, 0x83, 0x01, 0x17, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0xea, 0x80, 0x02, 0x6c, 0x0a,
+	0x3c, 0x54, 0x68, 0x65, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x73, 0x20, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2c, 0x20, 0x70, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x20, 0x74, 0x72,
+	0x79, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x20, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x12, 0x2c, 0xe8,
+	0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xe6, 0xad, 0xa3, 0xe5, 0x9c, 0xa8, 0xe6, 0x89, 0xa7, 0xe8, 0xa1,
+	0x8c, 0xe8, 0xaf, 0xa5, 0xe6, 0x8c, 0x87, 0xe4, 0xbb, 0xa4, 0x2c, 0x20, 0xe8, 0xaf, 0xb7, 0xe7,
+	0xa8, 0x8d, 0xe5, 0x90, 0x8e, 0xe5, 0x86, 0x8d, 0xe8, 0xaf, 0x95, 0x12, 0x56, 0x0a, 0x0f, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x28,
+	0x1a, 0x41, 0xa8, 0x45, 0x93, 0x03, 0xea, 0x83, 0x01, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0xea, 0x80, 0x02, 0x26, 0x0a, 0x13, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x0f, 0xe8, 0xb4, 0xa6, 0xe5, 0x8f, 0xb7, 0xe5, 0xb7, 0xb2, 0xe7, 0xa6, 0x81,
+	0xe7, 0x94, 0xa8, 0x1a, 0x39, 0xa0, 0x45, 0xf4, 0x03, 0xe2, 0x83, 0x01, 0x07, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0xa2, 0xc2, 0x01, 0x05, 0x7a, 0x68, 0x5f, 0x43, 0x4e, 0xe2, 0x80, 0x02,
+	0x1d, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x0c, 0xe6, 0x9c, 0xaa, 0xe7, 0x9f, 0xa5, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x42, 0x3c,
+	0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66,
+	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
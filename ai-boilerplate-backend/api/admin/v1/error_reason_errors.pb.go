@@ -916,3 +916,419 @@ func ErrorReasonSmsCodeInvalid(opts ...Option) *errors.Error {
 	}
 	return e.Error()
 }
+
+// AI Token 配额超限
+func IsAiTokenQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_AiTokenQuotaExceeded.String() && e.Code == 429
+}
+
+// AI Token 配额超限
+func ErrorAiTokenQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_AiTokenQuotaExceeded.String(), fmt.Sprintf(format, args...))
+}
+
+// AI Token 配额超限
+func ErrorReasonAiTokenQuotaExceeded(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    429,
+		reason:  ErrorReason_AiTokenQuotaExceeded.String(),
+		message: "AiTokenQuotaExceeded",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "AI token quota exceeded",
+			"zh_CN": "AI Token 用量已超出配额",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 账号无接口访问权限
+func IsAccountNoAPIPermission(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_AccountNoAPIPermission.String() && e.Code == 403
+}
+
+// 账号无接口访问权限
+func ErrorAccountNoAPIPermission(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_AccountNoAPIPermission.String(), fmt.Sprintf(format, args...))
+}
+
+// 账号无接口访问权限
+func ErrorReasonAccountNoAPIPermission(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    403,
+		reason:  ErrorReason_AccountNoAPIPermission.String(),
+		message: "AccountNoAPIPermission",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Account no api permission",
+			"zh_CN": "账号无接口访问权限",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 账号登录已锁定
+func IsAccountLocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_AccountLocked.String() && e.Code == 429
+}
+
+// 账号登录已锁定
+func ErrorAccountLocked(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_AccountLocked.String(), fmt.Sprintf(format, args...))
+}
+
+// 账号登录已锁定
+func ErrorReasonAccountLocked(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    429,
+		reason:  ErrorReason_AccountLocked.String(),
+		message: "AccountLocked",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Too many failed login attempts, please retry in %d seconds",
+			"zh_CN": "登录失败次数过多, 请 %d 秒后重试",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 两步验证码错误
+func IsTwoFactorCodeError(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TwoFactorCodeError.String() && e.Code == 400
+}
+
+// 两步验证码错误
+func ErrorTwoFactorCodeError(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_TwoFactorCodeError.String(), fmt.Sprintf(format, args...))
+}
+
+// 两步验证码错误
+func ErrorReasonTwoFactorCodeError(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    400,
+		reason:  ErrorReason_TwoFactorCodeError.String(),
+		message: "TwoFactorCodeError",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Two-factor code is incorrect",
+			"zh_CN": "两步验证码错误",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 两步验证挑战无效
+func IsTwoFactorChallengeInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TwoFactorChallengeInvalid.String() && e.Code == 401
+}
+
+// 两步验证挑战无效
+func ErrorTwoFactorChallengeInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_TwoFactorChallengeInvalid.String(), fmt.Sprintf(format, args...))
+}
+
+// 两步验证挑战无效
+func ErrorReasonTwoFactorChallengeInvalid(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    401,
+		reason:  ErrorReason_TwoFactorChallengeInvalid.String(),
+		message: "TwoFactorChallengeInvalid",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Two-factor challenge expired, please login again",
+			"zh_CN": "两步验证已失效, 请重新登录",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 两步验证已开启
+func IsTwoFactorAlreadyEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TwoFactorAlreadyEnabled.String() && e.Code == 400
+}
+
+// 两步验证已开启
+func ErrorTwoFactorAlreadyEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_TwoFactorAlreadyEnabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 两步验证已开启
+func ErrorReasonTwoFactorAlreadyEnabled(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    400,
+		reason:  ErrorReason_TwoFactorAlreadyEnabled.String(),
+		message: "TwoFactorAlreadyEnabled",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Two-factor authentication is already enabled",
+			"zh_CN": "两步验证已开启",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 两步验证未开启
+func IsTwoFactorNotEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TwoFactorNotEnabled.String() && e.Code == 400
+}
+
+// 两步验证未开启
+func ErrorTwoFactorNotEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_TwoFactorNotEnabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 两步验证未开启
+func ErrorReasonTwoFactorNotEnabled(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    400,
+		reason:  ErrorReason_TwoFactorNotEnabled.String(),
+		message: "TwoFactorNotEnabled",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Two-factor authentication is not enabled",
+			"zh_CN": "两步验证未开启",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 角色要求开启两步验证
+func IsTwoFactorRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TwoFactorRequired.String() && e.Code == 403
+}
+
+// 角色要求开启两步验证
+func ErrorTwoFactorRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_TwoFactorRequired.String(), fmt.Sprintf(format, args...))
+}
+
+// 角色要求开启两步验证
+func ErrorReasonTwoFactorRequired(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    403,
+		reason:  ErrorReason_TwoFactorRequired.String(),
+		message: "TwoFactorRequired",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Two-factor authentication is required for your role",
+			"zh_CN": "当前角色必须开启两步验证",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 收件人多次硬退信被暂停发送
+func IsMailRecipientSuppressed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MailRecipientSuppressed.String() && e.Code == 400
+}
+
+// 收件人多次硬退信被暂停发送
+func ErrorMailRecipientSuppressed(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_MailRecipientSuppressed.String(), fmt.Sprintf(format, args...))
+}
+
+// 收件人多次硬退信被暂停发送
+func ErrorReasonMailRecipientSuppressed(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    400,
+		reason:  ErrorReason_MailRecipientSuppressed.String(),
+		message: "MailRecipientSuppressed",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "The recipient is suppressed due to repeated bounces",
+			"zh_CN": "收件人已因多次退信被暂停发送",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 公告当前发布状态不允许重新发布
+func IsSysNoticePublishStatusInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SysNoticePublishStatusInvalid.String() && e.Code == 400
+}
+
+// 公告当前发布状态不允许重新发布
+func ErrorSysNoticePublishStatusInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_SysNoticePublishStatusInvalid.String(), fmt.Sprintf(format, args...))
+}
+
+// 公告当前发布状态不允许重新发布
+func ErrorReasonSysNoticePublishStatusInvalid(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    400,
+		reason:  ErrorReason_SysNoticePublishStatusInvalid.String(),
+		message: "SysNoticePublishStatusInvalid",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "The notice cannot be published in its current status",
+			"zh_CN": "公告当前状态不允许发布",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 设备未启用或没有推送通道
+func IsDevicePushNoChannel(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_DevicePushNoChannel.String() && e.Code == 400
+}
+
+// 设备未启用或没有推送通道
+func ErrorDevicePushNoChannel(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_DevicePushNoChannel.String(), fmt.Sprintf(format, args...))
+}
+
+// 设备未启用或没有推送通道
+func ErrorReasonDevicePushNoChannel(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    400,
+		reason:  ErrorReason_DevicePushNoChannel.String(),
+		message: "DevicePushNoChannel",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "The device is disabled or has no push channel",
+			"zh_CN": "设备未启用或没有推送通道",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 设备正在执行同一远程指令
+func IsDeviceCommandInProgress(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_DeviceCommandInProgress.String() && e.Code == 429
+}
+
+// 设备正在执行同一远程指令
+func ErrorDeviceCommandInProgress(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_DeviceCommandInProgress.String(), fmt.Sprintf(format, args...))
+}
+
+// 设备正在执行同一远程指令
+func ErrorReasonDeviceCommandInProgress(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    429,
+		reason:  ErrorReason_DeviceCommandInProgress.String(),
+		message: "DeviceCommandInProgress",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "The device is executing this command, please try again later",
+			"zh_CN": "设备正在执行该指令, 请稍后再试",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 账号已禁用
+func IsAccountDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_AccountDisabled.String() && e.Code == 403
+}
+
+// 账号已禁用
+func ErrorAccountDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_AccountDisabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 账号已禁用
+func ErrorReasonAccountDisabled(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    403,
+		reason:  ErrorReason_AccountDisabled.String(),
+		message: "AccountDisabled",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Account is disabled",
+			"zh_CN": "账号已禁用",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
@@ -29,17 +29,18 @@ type MailAccountInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                // id
-	Mail      string `protobuf:"bytes,2,opt,name=mail,proto3" json:"mail,omitempty"`            // 邮箱
-	Username  string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`    // 用户名
-	Password  string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`    // 密码
-	Host      string `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`            // SMTP 服务器域名
-	Port      int32  `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`           // SMTP 服务器端口
-	SslEnable bool   `protobuf:"varint,7,opt,name=sslEnable,proto3" json:"sslEnable,omitempty"` // 是否开启 SSL
-	Remark    string `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark,omitempty"`        // 备注
-	Status    int32  `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`       // 开启状态
-	CreatedAt string `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // 创建时间
-	UpdatedAt string `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` // 更新时间
+	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                  // id
+	Mail               string `protobuf:"bytes,2,opt,name=mail,proto3" json:"mail,omitempty"`                              // 邮箱
+	Username           string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`                      // 用户名
+	Password           string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`                      // 密码
+	Host               string `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`                              // SMTP 服务器域名
+	Port               int32  `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`                             // SMTP 服务器端口
+	SslEnable          bool   `protobuf:"varint,7,opt,name=sslEnable,proto3" json:"sslEnable,omitempty"`                   // 是否开启 SSL
+	Remark             string `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark,omitempty"`                          // 备注
+	Status             int32  `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`                         // 开启状态
+	CreatedAt          string `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                   // 创建时间
+	UpdatedAt          string `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`                   // 更新时间
+	BounceCallbackPath string `protobuf:"bytes,12,opt,name=bounceCallbackPath,proto3" json:"bounceCallbackPath,omitempty"` // 退信回调地址(相对路径)
 }
 
 func (x *MailAccountInfo) Reset() {
@@ -151,6 +152,13 @@ func (x *MailAccountInfo) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *MailAccountInfo) GetBounceCallbackPath() string {
+	if x != nil {
+		return x.BounceCallbackPath
+	}
+	return ""
+}
+
 // 请求-邮箱账号表-创建一条数据
 type CreateMailAccountReq struct {
 	state         protoimpl.MessageState
@@ -1015,7 +1023,7 @@ var file_admin_v1_mail_account_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
 	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x9f, 0x02, 0x0a, 0x0f, 0x4d, 0x61, 0x69, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x74, 0x6f, 0x22, 0xcf, 0x02, 0x0a, 0x0f, 0x4d, 0x61, 0x69, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
@@ -1033,7 +1041,10 @@ var file_admin_v1_mail_account_proto_rawDesc = []byte{
 	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0xd1, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x50, 0x61, 0x74, 0x68, 0x22, 0xd1, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
 	0x61, 0x69, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a,
 	0x04, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
 	0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a,
@@ -79,6 +79,8 @@ func (m *MailAccountInfo) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for BounceCallbackPath
+
 	if len(errors) > 0 {
 		return MailAccountInfoMultiError(errors)
 	}
@@ -89,6 +89,16 @@ func (m *MailLogInfo) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for OpenCount
+
+	// no validation rules for OpenTime
+
+	// no validation rules for ClickCount
+
+	// no validation rules for BounceTime
+
+	// no validation rules for BounceReason
+
 	if len(errors) > 0 {
 		return MailLogInfoMultiError(errors)
 	}
@@ -856,3 +866,419 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetMailLogListReplyValidationError{}
+
+// Validate checks the field values on GetMailSuppressionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMailSuppressionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMailSuppressionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMailSuppressionReqMultiError, or nil if none found.
+func (m *GetMailSuppressionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMailSuppressionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ToMail
+
+	if len(errors) > 0 {
+		return GetMailSuppressionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMailSuppressionReqMultiError is an error wrapping multiple validation
+// errors returned by GetMailSuppressionReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetMailSuppressionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMailSuppressionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMailSuppressionReqMultiError) AllErrors() []error { return m }
+
+// GetMailSuppressionReqValidationError is the validation error returned by
+// GetMailSuppressionReq.Validate if the designated constraints aren't met.
+type GetMailSuppressionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMailSuppressionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMailSuppressionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMailSuppressionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMailSuppressionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMailSuppressionReqValidationError) ErrorName() string {
+	return "GetMailSuppressionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMailSuppressionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMailSuppressionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMailSuppressionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMailSuppressionReqValidationError{}
+
+// Validate checks the field values on GetMailSuppressionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMailSuppressionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMailSuppressionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMailSuppressionReplyMultiError, or nil if none found.
+func (m *GetMailSuppressionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMailSuppressionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Suppressed
+
+	// no validation rules for HardBounceCount
+
+	if len(errors) > 0 {
+		return GetMailSuppressionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMailSuppressionReplyMultiError is an error wrapping multiple validation
+// errors returned by GetMailSuppressionReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetMailSuppressionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMailSuppressionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMailSuppressionReplyMultiError) AllErrors() []error { return m }
+
+// GetMailSuppressionReplyValidationError is the validation error returned by
+// GetMailSuppressionReply.Validate if the designated constraints aren't met.
+type GetMailSuppressionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMailSuppressionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMailSuppressionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMailSuppressionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMailSuppressionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMailSuppressionReplyValidationError) ErrorName() string {
+	return "GetMailSuppressionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMailSuppressionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMailSuppressionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMailSuppressionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMailSuppressionReplyValidationError{}
+
+// Validate checks the field values on LiftMailSuppressionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LiftMailSuppressionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LiftMailSuppressionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LiftMailSuppressionReqMultiError, or nil if none found.
+func (m *LiftMailSuppressionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LiftMailSuppressionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ToMail
+
+	if len(errors) > 0 {
+		return LiftMailSuppressionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// LiftMailSuppressionReqMultiError is an error wrapping multiple validation
+// errors returned by LiftMailSuppressionReq.ValidateAll() if the designated
+// constraints aren't met.
+type LiftMailSuppressionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LiftMailSuppressionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LiftMailSuppressionReqMultiError) AllErrors() []error { return m }
+
+// LiftMailSuppressionReqValidationError is the validation error returned by
+// LiftMailSuppressionReq.Validate if the designated constraints aren't met.
+type LiftMailSuppressionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LiftMailSuppressionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LiftMailSuppressionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LiftMailSuppressionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LiftMailSuppressionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LiftMailSuppressionReqValidationError) ErrorName() string {
+	return "LiftMailSuppressionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LiftMailSuppressionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLiftMailSuppressionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LiftMailSuppressionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LiftMailSuppressionReqValidationError{}
+
+// Validate checks the field values on LiftMailSuppressionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LiftMailSuppressionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LiftMailSuppressionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LiftMailSuppressionReplyMultiError, or nil if none found.
+func (m *LiftMailSuppressionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LiftMailSuppressionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LiftMailSuppressionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LiftMailSuppressionReplyMultiError is an error wrapping multiple validation
+// errors returned by LiftMailSuppressionReply.ValidateAll() if the designated
+// constraints aren't met.
+type LiftMailSuppressionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LiftMailSuppressionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LiftMailSuppressionReplyMultiError) AllErrors() []error { return m }
+
+// LiftMailSuppressionReplyValidationError is the validation error returned by
+// LiftMailSuppressionReply.Validate if the designated constraints aren't met.
+type LiftMailSuppressionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LiftMailSuppressionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LiftMailSuppressionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LiftMailSuppressionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LiftMailSuppressionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LiftMailSuppressionReplyValidationError) ErrorName() string {
+	return "LiftMailSuppressionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LiftMailSuppressionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLiftMailSuppressionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LiftMailSuppressionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LiftMailSuppressionReplyValidationError{}
@@ -28,6 +28,10 @@ type MailLogClient interface {
 	GetMailLogInfo(ctx context.Context, in *GetMailLogInfoReq, opts ...grpc.CallOption) (*GetMailLogInfoReply, error)
 	// 邮件日志表-列表数据查询
 	GetMailLogList(ctx context.Context, in *GetMailLogListReq, opts ...grpc.CallOption) (*GetMailLogListReply, error)
+	// 邮件日志表-收件人退信暂停状态
+	GetMailSuppression(ctx context.Context, in *GetMailSuppressionReq, opts ...grpc.CallOption) (*GetMailSuppressionReply, error)
+	// 邮件日志表-解除收件人退信暂停
+	LiftMailSuppression(ctx context.Context, in *LiftMailSuppressionReq, opts ...grpc.CallOption) (*LiftMailSuppressionReply, error)
 }
 
 type mailLogClient struct {
@@ -65,6 +69,24 @@ func (c *mailLogClient) GetMailLogList(ctx context.Context, in *GetMailLogListRe
 	return out, nil
 }
 
+func (c *mailLogClient) GetMailSuppression(ctx context.Context, in *GetMailSuppressionReq, opts ...grpc.CallOption) (*GetMailSuppressionReply, error) {
+	out := new(GetMailSuppressionReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.MailLog/GetMailSuppression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailLogClient) LiftMailSuppression(ctx context.Context, in *LiftMailSuppressionReq, opts ...grpc.CallOption) (*LiftMailSuppressionReply, error) {
+	out := new(LiftMailSuppressionReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.MailLog/LiftMailSuppression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MailLogServer is the server API for MailLog service.
 // All implementations must embed UnimplementedMailLogServer
 // for forward compatibility
@@ -75,6 +97,10 @@ type MailLogServer interface {
 	GetMailLogInfo(context.Context, *GetMailLogInfoReq) (*GetMailLogInfoReply, error)
 	// 邮件日志表-列表数据查询
 	GetMailLogList(context.Context, *GetMailLogListReq) (*GetMailLogListReply, error)
+	// 邮件日志表-收件人退信暂停状态
+	GetMailSuppression(context.Context, *GetMailSuppressionReq) (*GetMailSuppressionReply, error)
+	// 邮件日志表-解除收件人退信暂停
+	LiftMailSuppression(context.Context, *LiftMailSuppressionReq) (*LiftMailSuppressionReply, error)
 	mustEmbedUnimplementedMailLogServer()
 }
 
@@ -91,6 +117,12 @@ func (UnimplementedMailLogServer) GetMailLogInfo(context.Context, *GetMailLogInf
 func (UnimplementedMailLogServer) GetMailLogList(context.Context, *GetMailLogListReq) (*GetMailLogListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMailLogList not implemented")
 }
+func (UnimplementedMailLogServer) GetMailSuppression(context.Context, *GetMailSuppressionReq) (*GetMailSuppressionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMailSuppression not implemented")
+}
+func (UnimplementedMailLogServer) LiftMailSuppression(context.Context, *LiftMailSuppressionReq) (*LiftMailSuppressionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftMailSuppression not implemented")
+}
 func (UnimplementedMailLogServer) mustEmbedUnimplementedMailLogServer() {}
 
 // UnsafeMailLogServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +190,42 @@ func _MailLog_GetMailLogList_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MailLog_GetMailSuppression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMailSuppressionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailLogServer).GetMailSuppression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.MailLog/GetMailSuppression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailLogServer).GetMailSuppression(ctx, req.(*GetMailSuppressionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailLog_LiftMailSuppression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiftMailSuppressionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailLogServer).LiftMailSuppression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.MailLog/LiftMailSuppression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailLogServer).LiftMailSuppression(ctx, req.(*LiftMailSuppressionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MailLog_ServiceDesc is the grpc.ServiceDesc for MailLog service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +245,14 @@ var MailLog_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMailLogList",
 			Handler:    _MailLog_GetMailLogList_Handler,
 		},
+		{
+			MethodName: "GetMailSuppression",
+			Handler:    _MailLog_GetMailSuppression_Handler,
+		},
+		{
+			MethodName: "LiftMailSuppression",
+			Handler:    _MailLog_LiftMailSuppression_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/mail_log.proto",
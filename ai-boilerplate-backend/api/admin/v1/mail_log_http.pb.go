@@ -22,11 +22,15 @@ const _ = http.SupportPackageIsVersion1
 const OperationMailLogDeleteMailLog = "/admin.v1.MailLog/DeleteMailLog"
 const OperationMailLogGetMailLogInfo = "/admin.v1.MailLog/GetMailLogInfo"
 const OperationMailLogGetMailLogList = "/admin.v1.MailLog/GetMailLogList"
+const OperationMailLogGetMailSuppression = "/admin.v1.MailLog/GetMailSuppression"
+const OperationMailLogLiftMailSuppression = "/admin.v1.MailLog/LiftMailSuppression"
 
 type MailLogHTTPServer interface {
 	DeleteMailLog(context.Context, *DeleteMailLogReq) (*DeleteMailLogReply, error)
 	GetMailLogInfo(context.Context, *GetMailLogInfoReq) (*GetMailLogInfoReply, error)
 	GetMailLogList(context.Context, *GetMailLogListReq) (*GetMailLogListReply, error)
+	GetMailSuppression(context.Context, *GetMailSuppressionReq) (*GetMailSuppressionReply, error)
+	LiftMailSuppression(context.Context, *LiftMailSuppressionReq) (*LiftMailSuppressionReply, error)
 }
 
 func RegisterMailLogHTTPServer(s *http.Server, srv MailLogHTTPServer) {
@@ -34,6 +38,8 @@ func RegisterMailLogHTTPServer(s *http.Server, srv MailLogHTTPServer) {
 	r.POST("/admin/v1/mail_log/delete", _MailLog_DeleteMailLog0_HTTP_Handler(srv))
 	r.GET("/admin/v1/mail_log/info", _MailLog_GetMailLogInfo0_HTTP_Handler(srv))
 	r.GET("/admin/v1/mail_log/list", _MailLog_GetMailLogList0_HTTP_Handler(srv))
+	r.GET("/admin/v1/mail_log/suppression", _MailLog_GetMailSuppression0_HTTP_Handler(srv))
+	r.POST("/admin/v1/mail_log/suppression/lift", _MailLog_LiftMailSuppression0_HTTP_Handler(srv))
 }
 
 func _MailLog_DeleteMailLog0_HTTP_Handler(srv MailLogHTTPServer) func(ctx http.Context) error {
@@ -93,10 +99,50 @@ func _MailLog_GetMailLogList0_HTTP_Handler(srv MailLogHTTPServer) func(ctx http.
 	}
 }
 
+func _MailLog_GetMailSuppression0_HTTP_Handler(srv MailLogHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMailSuppressionReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMailLogGetMailSuppression)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMailSuppression(ctx, req.(*GetMailSuppressionReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMailSuppressionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MailLog_LiftMailSuppression0_HTTP_Handler(srv MailLogHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LiftMailSuppressionReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMailLogLiftMailSuppression)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LiftMailSuppression(ctx, req.(*LiftMailSuppressionReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LiftMailSuppressionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type MailLogHTTPClient interface {
 	DeleteMailLog(ctx context.Context, req *DeleteMailLogReq, opts ...http.CallOption) (rsp *DeleteMailLogReply, err error)
 	GetMailLogInfo(ctx context.Context, req *GetMailLogInfoReq, opts ...http.CallOption) (rsp *GetMailLogInfoReply, err error)
 	GetMailLogList(ctx context.Context, req *GetMailLogListReq, opts ...http.CallOption) (rsp *GetMailLogListReply, err error)
+	GetMailSuppression(ctx context.Context, req *GetMailSuppressionReq, opts ...http.CallOption) (rsp *GetMailSuppressionReply, err error)
+	LiftMailSuppression(ctx context.Context, req *LiftMailSuppressionReq, opts ...http.CallOption) (rsp *LiftMailSuppressionReply, err error)
 }
 
 type MailLogHTTPClientImpl struct {
@@ -145,3 +191,29 @@ func (c *MailLogHTTPClientImpl) GetMailLogList(ctx context.Context, in *GetMailL
 	}
 	return &out, err
 }
+
+func (c *MailLogHTTPClientImpl) GetMailSuppression(ctx context.Context, in *GetMailSuppressionReq, opts ...http.CallOption) (*GetMailSuppressionReply, error) {
+	var out GetMailSuppressionReply
+	pattern := "/admin/v1/mail_log/suppression"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMailLogGetMailSuppression))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *MailLogHTTPClientImpl) LiftMailSuppression(ctx context.Context, in *LiftMailSuppressionReq, opts ...http.CallOption) (*LiftMailSuppressionReply, error) {
+	var out LiftMailSuppressionReply
+	pattern := "/admin/v1/mail_log/suppression/lift"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMailLogLiftMailSuppression))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
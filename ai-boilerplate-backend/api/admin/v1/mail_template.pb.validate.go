@@ -81,6 +81,10 @@ func (m *MailTemplateInfo) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for TrackOpen
+
+	// no validation rules for TrackClick
+
 	if len(errors) > 0 {
 		return MailTemplateInfoMultiError(errors)
 	}
@@ -199,6 +203,10 @@ func (m *CreateMailTemplateReq) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for TrackOpen
+
+	// no validation rules for TrackClick
+
 	if len(errors) > 0 {
 		return CreateMailTemplateReqMultiError(errors)
 	}
@@ -425,6 +433,10 @@ func (m *UpdateMailTemplateReq) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for TrackOpen
+
+	// no validation rules for TrackClick
+
 	if len(errors) > 0 {
 		return UpdateMailTemplateReqMultiError(errors)
 	}
@@ -1856,3 +1868,210 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetMailTemplateSelectorReplyValidationError{}
+
+// Validate checks the field values on SendMailReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendMailReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendMailReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SendMailReqMultiError, or
+// nil if none found.
+func (m *SendMailReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendMailReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateCode
+
+	// no validation rules for ToMail
+
+	// no validation rules for Params
+
+	if len(errors) > 0 {
+		return SendMailReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendMailReqMultiError is an error wrapping multiple validation errors
+// returned by SendMailReq.ValidateAll() if the designated constraints aren't met.
+type SendMailReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendMailReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendMailReqMultiError) AllErrors() []error { return m }
+
+// SendMailReqValidationError is the validation error returned by
+// SendMailReq.Validate if the designated constraints aren't met.
+type SendMailReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMailReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMailReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMailReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMailReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMailReqValidationError) ErrorName() string { return "SendMailReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendMailReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMailReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMailReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMailReqValidationError{}
+
+// Validate checks the field values on SendMailReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendMailReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendMailReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SendMailReplyMultiError, or
+// nil if none found.
+func (m *SendMailReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendMailReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MailLogId
+
+	if len(errors) > 0 {
+		return SendMailReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendMailReplyMultiError is an error wrapping multiple validation errors
+// returned by SendMailReply.ValidateAll() if the designated constraints
+// aren't met.
+type SendMailReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendMailReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendMailReplyMultiError) AllErrors() []error { return m }
+
+// SendMailReplyValidationError is the validation error returned by
+// SendMailReply.Validate if the designated constraints aren't met.
+type SendMailReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMailReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMailReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMailReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMailReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMailReplyValidationError) ErrorName() string { return "SendMailReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendMailReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMailReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMailReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMailReplyValidationError{}
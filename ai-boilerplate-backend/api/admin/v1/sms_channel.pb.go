@@ -23,29 +23,111 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 短信渠道配置, 按运营商填写所需字段
+type SmsChannelConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignName string `protobuf:"bytes,1,opt,name=signName,proto3" json:"signName,omitempty"` // 短信签名
+	Region   string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`     // 地域(阿里云、腾讯云)
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // 接入地址(华为云必填, 其余为空时使用默认地址)
+	SdkAppId string `protobuf:"bytes,4,opt,name=sdkAppId,proto3" json:"sdkAppId,omitempty"` // 短信应用ID(腾讯云 SdkAppId)
+	Sender   string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`     // 短信通道号(华为云)
+}
+
+func (x *SmsChannelConfig) Reset() {
+	*x = SmsChannelConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmsChannelConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmsChannelConfig) ProtoMessage() {}
+
+func (x *SmsChannelConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmsChannelConfig.ProtoReflect.Descriptor instead.
+func (*SmsChannelConfig) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SmsChannelConfig) GetSignName() string {
+	if x != nil {
+		return x.SignName
+	}
+	return ""
+}
+
+func (x *SmsChannelConfig) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SmsChannelConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SmsChannelConfig) GetSdkAppId() string {
+	if x != nil {
+		return x.SdkAppId
+	}
+	return ""
+}
+
+func (x *SmsChannelConfig) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
 // 短信渠道信息
 type SmsChannelInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                      // id
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                  // 渠道名称
-	Operator     string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`          // 运营商
-	Remark       string `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`              // 备注
-	APIKey       string `protobuf:"bytes,5,opt,name=APIKey,proto3" json:"APIKey,omitempty"`              // 短信 API 的账号
-	APISecret    string `protobuf:"bytes,6,opt,name=APISecret,proto3" json:"APISecret,omitempty"`        // 短信 API 的秘钥
-	CallbackURL  string `protobuf:"bytes,7,opt,name=callbackURL,proto3" json:"callbackURL,omitempty"`    // 短信发送回调 URL
-	Status       int32  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`             // 状态(-1禁用,1开启)
-	CreatedAt    string `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`        // 创建时间
-	UpdatedAt    string `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`       // 更新时间
-	OperatorName string `protobuf:"bytes,11,opt,name=operatorName,proto3" json:"operatorName,omitempty"` // 运营商名称
+	Id                  string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                    // id
+	Name                string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                // 渠道名称
+	Operator            string            `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`                        // 运营商
+	Remark              string            `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`                            // 备注
+	APIKey              string            `protobuf:"bytes,5,opt,name=APIKey,proto3" json:"APIKey,omitempty"`                            // 短信 API 的账号
+	APISecret           string            `protobuf:"bytes,6,opt,name=APISecret,proto3" json:"APISecret,omitempty"`                      // 短信 API 的秘钥
+	CallbackURL         string            `protobuf:"bytes,7,opt,name=callbackURL,proto3" json:"callbackURL,omitempty"`                  // 短信发送回调 URL
+	Status              int32             `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`                           // 状态(-1禁用,1开启)
+	CreatedAt           string            `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                      // 创建时间
+	UpdatedAt           string            `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`                     // 更新时间
+	OperatorName        string            `protobuf:"bytes,11,opt,name=operatorName,proto3" json:"operatorName,omitempty"`               // 运营商名称
+	Config              *SmsChannelConfig `protobuf:"bytes,12,opt,name=config,proto3" json:"config,omitempty"`                           // 渠道配置
+	ReceiptCallbackPath string            `protobuf:"bytes,13,opt,name=receiptCallbackPath,proto3" json:"receiptCallbackPath,omitempty"` // 回执回调地址(相对路径, 拼接服务域名后配置到运营商后台)
 }
 
 func (x *SmsChannelInfo) Reset() {
 	*x = SmsChannelInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[0]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -58,7 +140,7 @@ func (x *SmsChannelInfo) String() string {
 func (*SmsChannelInfo) ProtoMessage() {}
 
 func (x *SmsChannelInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[0]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -71,7 +153,7 @@ func (x *SmsChannelInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmsChannelInfo.ProtoReflect.Descriptor instead.
 func (*SmsChannelInfo) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{0}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *SmsChannelInfo) GetId() string {
@@ -151,25 +233,40 @@ func (x *SmsChannelInfo) GetOperatorName() string {
 	return ""
 }
 
+func (x *SmsChannelInfo) GetConfig() *SmsChannelConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *SmsChannelInfo) GetReceiptCallbackPath() string {
+	if x != nil {
+		return x.ReceiptCallbackPath
+	}
+	return ""
+}
+
 // 请求-短信渠道-创建一条数据
 type CreateSmsChannelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // 渠道名称
-	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`       // 运营商
-	Remark      string `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`           // 备注
-	APIKey      string `protobuf:"bytes,4,opt,name=APIKey,proto3" json:"APIKey,omitempty"`           // 短信 API 的账号
-	APISecret   string `protobuf:"bytes,5,opt,name=APISecret,proto3" json:"APISecret,omitempty"`     // 短信 API 的秘钥
-	CallbackURL string `protobuf:"bytes,6,opt,name=callbackURL,proto3" json:"callbackURL,omitempty"` // 短信发送回调 URL
-	Status      int32  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`          // 状态(-1禁用,1开启)
+	Name        string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // 渠道名称
+	Operator    string            `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`       // 运营商
+	Remark      string            `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`           // 备注
+	APIKey      string            `protobuf:"bytes,4,opt,name=APIKey,proto3" json:"APIKey,omitempty"`           // 短信 API 的账号
+	APISecret   string            `protobuf:"bytes,5,opt,name=APISecret,proto3" json:"APISecret,omitempty"`     // 短信 API 的秘钥
+	CallbackURL string            `protobuf:"bytes,6,opt,name=callbackURL,proto3" json:"callbackURL,omitempty"` // 短信发送回调 URL
+	Status      int32             `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`          // 状态(-1禁用,1开启)
+	Config      *SmsChannelConfig `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`           // 渠道配置
 }
 
 func (x *CreateSmsChannelReq) Reset() {
 	*x = CreateSmsChannelReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[1]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -182,7 +279,7 @@ func (x *CreateSmsChannelReq) String() string {
 func (*CreateSmsChannelReq) ProtoMessage() {}
 
 func (x *CreateSmsChannelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[1]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,7 +292,7 @@ func (x *CreateSmsChannelReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSmsChannelReq.ProtoReflect.Descriptor instead.
 func (*CreateSmsChannelReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{1}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateSmsChannelReq) GetName() string {
@@ -247,6 +344,13 @@ func (x *CreateSmsChannelReq) GetStatus() int32 {
 	return 0
 }
 
+func (x *CreateSmsChannelReq) GetConfig() *SmsChannelConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
 // 响应-短信渠道-创建一条数据
 type CreateSmsChannelReply struct {
 	state         protoimpl.MessageState
@@ -259,7 +363,7 @@ type CreateSmsChannelReply struct {
 func (x *CreateSmsChannelReply) Reset() {
 	*x = CreateSmsChannelReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[2]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -272,7 +376,7 @@ func (x *CreateSmsChannelReply) String() string {
 func (*CreateSmsChannelReply) ProtoMessage() {}
 
 func (x *CreateSmsChannelReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[2]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +389,7 @@ func (x *CreateSmsChannelReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSmsChannelReply.ProtoReflect.Descriptor instead.
 func (*CreateSmsChannelReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{2}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateSmsChannelReply) GetId() string {
@@ -301,20 +405,21 @@ type UpdateSmsChannelReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // id
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // 渠道名称
-	Operator    string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`       // 运营商
-	Remark      string `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`           // 备注
-	APIKey      string `protobuf:"bytes,5,opt,name=APIKey,proto3" json:"APIKey,omitempty"`           // 短信 API 的账号
-	APISecret   string `protobuf:"bytes,6,opt,name=APISecret,proto3" json:"APISecret,omitempty"`     // 短信 API 的秘钥
-	CallbackURL string `protobuf:"bytes,7,opt,name=callbackURL,proto3" json:"callbackURL,omitempty"` // 短信发送回调 URL
-	Status      int32  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`          // 状态(-1禁用,1开启)
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // id
+	Name        string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // 渠道名称
+	Operator    string            `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`       // 运营商
+	Remark      string            `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`           // 备注
+	APIKey      string            `protobuf:"bytes,5,opt,name=APIKey,proto3" json:"APIKey,omitempty"`           // 短信 API 的账号
+	APISecret   string            `protobuf:"bytes,6,opt,name=APISecret,proto3" json:"APISecret,omitempty"`     // 短信 API 的秘钥
+	CallbackURL string            `protobuf:"bytes,7,opt,name=callbackURL,proto3" json:"callbackURL,omitempty"` // 短信发送回调 URL
+	Status      int32             `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`          // 状态(-1禁用,1开启)
+	Config      *SmsChannelConfig `protobuf:"bytes,9,opt,name=config,proto3" json:"config,omitempty"`           // 渠道配置
 }
 
 func (x *UpdateSmsChannelReq) Reset() {
 	*x = UpdateSmsChannelReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[3]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -327,7 +432,7 @@ func (x *UpdateSmsChannelReq) String() string {
 func (*UpdateSmsChannelReq) ProtoMessage() {}
 
 func (x *UpdateSmsChannelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[3]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +445,7 @@ func (x *UpdateSmsChannelReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSmsChannelReq.ProtoReflect.Descriptor instead.
 func (*UpdateSmsChannelReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{3}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateSmsChannelReq) GetId() string {
@@ -399,6 +504,13 @@ func (x *UpdateSmsChannelReq) GetStatus() int32 {
 	return 0
 }
 
+func (x *UpdateSmsChannelReq) GetConfig() *SmsChannelConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
 // 响应-短信渠道-更新一条数据
 type UpdateSmsChannelReply struct {
 	state         protoimpl.MessageState
@@ -409,7 +521,7 @@ type UpdateSmsChannelReply struct {
 func (x *UpdateSmsChannelReply) Reset() {
 	*x = UpdateSmsChannelReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[4]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -422,7 +534,7 @@ func (x *UpdateSmsChannelReply) String() string {
 func (*UpdateSmsChannelReply) ProtoMessage() {}
 
 func (x *UpdateSmsChannelReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[4]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +547,7 @@ func (x *UpdateSmsChannelReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSmsChannelReply.ProtoReflect.Descriptor instead.
 func (*UpdateSmsChannelReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{4}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{5}
 }
 
 // 请求-短信渠道-更新状态
@@ -451,7 +563,7 @@ type UpdateSmsChannelStatusReq struct {
 func (x *UpdateSmsChannelStatusReq) Reset() {
 	*x = UpdateSmsChannelStatusReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[5]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -464,7 +576,7 @@ func (x *UpdateSmsChannelStatusReq) String() string {
 func (*UpdateSmsChannelStatusReq) ProtoMessage() {}
 
 func (x *UpdateSmsChannelStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[5]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +589,7 @@ func (x *UpdateSmsChannelStatusReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSmsChannelStatusReq.ProtoReflect.Descriptor instead.
 func (*UpdateSmsChannelStatusReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{5}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateSmsChannelStatusReq) GetId() string {
@@ -504,7 +616,7 @@ type UpdateSmsChannelStatusReply struct {
 func (x *UpdateSmsChannelStatusReply) Reset() {
 	*x = UpdateSmsChannelStatusReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[6]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -517,7 +629,7 @@ func (x *UpdateSmsChannelStatusReply) String() string {
 func (*UpdateSmsChannelStatusReply) ProtoMessage() {}
 
 func (x *UpdateSmsChannelStatusReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[6]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,7 +642,7 @@ func (x *UpdateSmsChannelStatusReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSmsChannelStatusReply.ProtoReflect.Descriptor instead.
 func (*UpdateSmsChannelStatusReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{6}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{7}
 }
 
 // 请求-短信渠道-删除一条数据
@@ -545,7 +657,7 @@ type DeleteSmsChannelReq struct {
 func (x *DeleteSmsChannelReq) Reset() {
 	*x = DeleteSmsChannelReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[7]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -558,7 +670,7 @@ func (x *DeleteSmsChannelReq) String() string {
 func (*DeleteSmsChannelReq) ProtoMessage() {}
 
 func (x *DeleteSmsChannelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[7]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,7 +683,7 @@ func (x *DeleteSmsChannelReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSmsChannelReq.ProtoReflect.Descriptor instead.
 func (*DeleteSmsChannelReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{7}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteSmsChannelReq) GetId() string {
@@ -591,7 +703,7 @@ type DeleteSmsChannelReply struct {
 func (x *DeleteSmsChannelReply) Reset() {
 	*x = DeleteSmsChannelReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[8]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -604,7 +716,7 @@ func (x *DeleteSmsChannelReply) String() string {
 func (*DeleteSmsChannelReply) ProtoMessage() {}
 
 func (x *DeleteSmsChannelReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[8]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +729,7 @@ func (x *DeleteSmsChannelReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSmsChannelReply.ProtoReflect.Descriptor instead.
 func (*DeleteSmsChannelReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{8}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{9}
 }
 
 // 请求-短信渠道-单条数据查询
@@ -632,7 +744,7 @@ type GetSmsChannelInfoReq struct {
 func (x *GetSmsChannelInfoReq) Reset() {
 	*x = GetSmsChannelInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[9]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +757,7 @@ func (x *GetSmsChannelInfoReq) String() string {
 func (*GetSmsChannelInfoReq) ProtoMessage() {}
 
 func (x *GetSmsChannelInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[9]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +770,7 @@ func (x *GetSmsChannelInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsChannelInfoReq.ProtoReflect.Descriptor instead.
 func (*GetSmsChannelInfoReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{9}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetSmsChannelInfoReq) GetId() string {
@@ -680,7 +792,7 @@ type GetSmsChannelInfoReply struct {
 func (x *GetSmsChannelInfoReply) Reset() {
 	*x = GetSmsChannelInfoReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[10]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -693,7 +805,7 @@ func (x *GetSmsChannelInfoReply) String() string {
 func (*GetSmsChannelInfoReply) ProtoMessage() {}
 
 func (x *GetSmsChannelInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[10]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +818,7 @@ func (x *GetSmsChannelInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsChannelInfoReply.ProtoReflect.Descriptor instead.
 func (*GetSmsChannelInfoReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{10}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetSmsChannelInfoReply) GetInfo() *SmsChannelInfo {
@@ -733,7 +845,7 @@ type GetSmsChannelListReq struct {
 func (x *GetSmsChannelListReq) Reset() {
 	*x = GetSmsChannelListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[11]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -746,7 +858,7 @@ func (x *GetSmsChannelListReq) String() string {
 func (*GetSmsChannelListReq) ProtoMessage() {}
 
 func (x *GetSmsChannelListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[11]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,7 +871,7 @@ func (x *GetSmsChannelListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsChannelListReq.ProtoReflect.Descriptor instead.
 func (*GetSmsChannelListReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{11}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetSmsChannelListReq) GetPage() int32 {
@@ -817,7 +929,7 @@ type GetSmsChannelListReply struct {
 func (x *GetSmsChannelListReply) Reset() {
 	*x = GetSmsChannelListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[12]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -830,7 +942,7 @@ func (x *GetSmsChannelListReply) String() string {
 func (*GetSmsChannelListReply) ProtoMessage() {}
 
 func (x *GetSmsChannelListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[12]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +955,7 @@ func (x *GetSmsChannelListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsChannelListReply.ProtoReflect.Descriptor instead.
 func (*GetSmsChannelListReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{12}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetSmsChannelListReply) GetTotal() int32 {
@@ -872,7 +984,7 @@ type SmsChannelOperator struct {
 func (x *SmsChannelOperator) Reset() {
 	*x = SmsChannelOperator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[13]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -885,7 +997,7 @@ func (x *SmsChannelOperator) String() string {
 func (*SmsChannelOperator) ProtoMessage() {}
 
 func (x *SmsChannelOperator) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[13]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +1010,7 @@ func (x *SmsChannelOperator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmsChannelOperator.ProtoReflect.Descriptor instead.
 func (*SmsChannelOperator) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{13}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SmsChannelOperator) GetName() string {
@@ -925,7 +1037,7 @@ type GetSmsChannelOperatorReq struct {
 func (x *GetSmsChannelOperatorReq) Reset() {
 	*x = GetSmsChannelOperatorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[14]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -938,7 +1050,7 @@ func (x *GetSmsChannelOperatorReq) String() string {
 func (*GetSmsChannelOperatorReq) ProtoMessage() {}
 
 func (x *GetSmsChannelOperatorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[14]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,7 +1063,7 @@ func (x *GetSmsChannelOperatorReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsChannelOperatorReq.ProtoReflect.Descriptor instead.
 func (*GetSmsChannelOperatorReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{14}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{15}
 }
 
 // 响应-短信渠道-运营商
@@ -966,7 +1078,7 @@ type GetSmsChannelOperatorReply struct {
 func (x *GetSmsChannelOperatorReply) Reset() {
 	*x = GetSmsChannelOperatorReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[15]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -979,7 +1091,7 @@ func (x *GetSmsChannelOperatorReply) String() string {
 func (*GetSmsChannelOperatorReply) ProtoMessage() {}
 
 func (x *GetSmsChannelOperatorReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[15]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,7 +1104,7 @@ func (x *GetSmsChannelOperatorReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsChannelOperatorReply.ProtoReflect.Descriptor instead.
 func (*GetSmsChannelOperatorReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{15}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetSmsChannelOperatorReply) GetList() []*SmsChannelOperator {
@@ -1016,7 +1128,7 @@ type SmsChannelSelector struct {
 func (x *SmsChannelSelector) Reset() {
 	*x = SmsChannelSelector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[16]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1029,7 +1141,7 @@ func (x *SmsChannelSelector) String() string {
 func (*SmsChannelSelector) ProtoMessage() {}
 
 func (x *SmsChannelSelector) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[16]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,7 +1154,7 @@ func (x *SmsChannelSelector) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmsChannelSelector.ProtoReflect.Descriptor instead.
 func (*SmsChannelSelector) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{16}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SmsChannelSelector) GetId() string {
@@ -1083,7 +1195,7 @@ type GetSmsChannelSelectorReq struct {
 func (x *GetSmsChannelSelectorReq) Reset() {
 	*x = GetSmsChannelSelectorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[17]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1096,7 +1208,7 @@ func (x *GetSmsChannelSelectorReq) String() string {
 func (*GetSmsChannelSelectorReq) ProtoMessage() {}
 
 func (x *GetSmsChannelSelectorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[17]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,7 +1221,7 @@ func (x *GetSmsChannelSelectorReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsChannelSelectorReq.ProtoReflect.Descriptor instead.
 func (*GetSmsChannelSelectorReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{17}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{18}
 }
 
 // 响应-短信渠道-选择器
@@ -1124,7 +1236,7 @@ type GetSmsChannelSelectorReply struct {
 func (x *GetSmsChannelSelectorReply) Reset() {
 	*x = GetSmsChannelSelectorReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sms_channel_proto_msgTypes[18]
+		mi := &file_admin_v1_sms_channel_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1137,7 +1249,7 @@ func (x *GetSmsChannelSelectorReply) String() string {
 func (*GetSmsChannelSelectorReply) ProtoMessage() {}
 
 func (x *GetSmsChannelSelectorReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sms_channel_proto_msgTypes[18]
+	mi := &file_admin_v1_sms_channel_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +1262,7 @@ func (x *GetSmsChannelSelectorReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsChannelSelectorReply.ProtoReflect.Descriptor instead.
 func (*GetSmsChannelSelectorReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{18}
+	return file_admin_v1_sms_channel_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetSmsChannelSelectorReply) GetList() []*SmsChannelSelector {
@@ -1172,233 +1284,255 @@ var file_admin_v1_sms_channel_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70,
 	0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
 	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xb8, 0x02, 0x0a, 0x0e, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x16, 0x0a, 0x06,
-	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50,
-	0x49, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x50, 0x49, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x50, 0x49, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52,
-	0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xdb, 0x02, 0x0a,
-	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
-	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8,
-	0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x06, 0x72, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01,
-	0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x41, 0x50, 0x49, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03,
-	0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x09, 0x41,
-	0x50, 0x49, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c,
-	0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba,
-	0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52,
-	0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x12, 0xba, 0x48,
-	0x0f, 0x1a, 0x0d, 0x30, 0x01, 0x30, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x20, 0x92, 0x41, 0x1d, 0x0a, 0x1b, 0xd2,
-	0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0xd2, 0x01, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0xfc, 0x02, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d,
-	0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
-	0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10,
-	0x01, 0x18, 0x40, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a,
-	0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba,
-	0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52,
-	0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
-	0x18, 0x80, 0x01, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x41,
-	0x50, 0x49, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
-	0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01,
-	0x52, 0x09, 0x41, 0x50, 0x49, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x63,
+	0x6f, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x64, 0x6b, 0x41, 0x70, 0x70,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x64, 0x6b, 0x41, 0x70, 0x70,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x9e, 0x03, 0x0a, 0x0e, 0x53,
+	0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x41, 0x50, 0x49, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x41, 0x50, 0x49, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63,
 	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x22, 0x8f, 0x03, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01,
+	0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x06, 0x72, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52,
+	0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x41, 0x50, 0x49, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8,
+	0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x09, 0x41, 0x50,
+	0x49, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48,
+	0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x0b,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x12, 0xba, 0x48, 0x0f,
+	0x1a, 0x0d, 0x30, 0x01, 0x30, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x20, 0x92, 0x41, 0x1d,
+	0x0a, 0x1b, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0xd2, 0x01, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x22, 0x27, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb0, 0x03, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1a,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10,
+	0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06,
+	0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x28, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
-	0xff, 0x01, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x12,
-	0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x12, 0xba, 0x48, 0x0f, 0x1a, 0x0d, 0x30, 0x01, 0x30, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x25, 0x92, 0x41, 0x22,
-	0x0a, 0x20, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0xd2, 0x01, 0x06, 0x41, 0x50, 0x49, 0x4b,
-	0x65, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6f, 0x0a, 0x19, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x10, 0x20, 0x00, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x13, 0x92, 0x41, 0x10, 0x0a, 0x0e, 0xd2, 0x01,
-	0x02, 0x69, 0x64, 0xd2, 0x01, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1d, 0x0a, 0x1b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3d, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0xff, 0x01, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2e,
+	0x0a, 0x09, 0x41, 0x50, 0x49, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x18, 0x80, 0x01, 0x52, 0x09, 0x41, 0x50, 0x49, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x32,
+	0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x03, 0xd8, 0x01, 0x01, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55,
+	0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x12, 0xba, 0x48, 0x0f, 0x1a, 0x0d, 0x30, 0x01, 0x30, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x3a, 0x25, 0x92, 0x41, 0x22, 0x0a, 0x20, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0xd2, 0x01, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x6f, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06,
+	0x1a, 0x04, 0x18, 0x10, 0x20, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x13,
+	0x92, 0x41, 0x10, 0x0a, 0x0e, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x3d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69,
+	0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3e, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
 	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
 	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a,
-	0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x3e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
-	0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01,
-	0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xac, 0x01, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5c, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x12, 0x53, 0x6d, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1a,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x22, 0x4e, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x12, 0x53, 0x6d,
+	0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6d,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x22, 0xac, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x5c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6d, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22,
+	0x44, 0x0a, 0x12, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x22, 0x4e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x22, 0x78, 0x0a, 0x12, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x22, 0x4e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6d,
 	0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
-	0x22, 0x4e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30,
-	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x32, 0xed, 0x0a, 0x0a, 0x0a, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
-	0xa3, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25,
-	0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d,
-	0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65,
-	0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xbc, 0x01, 0x0a, 0x16,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22, 0x23, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a,
-	0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
-	0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
-	0x1d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1f,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a,
-	0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c,
-	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01,
-	0x12, 0xa1, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
-	0x12, 0x1a, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x92, 0x41, 0x25, 0x72,
-	0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x28, 0x01, 0x12, 0xa1, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x6c, 0x69, 0x73, 0x74,
-	0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xb1, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x22, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x32, 0xed, 0x0a, 0x0a, 0x0a, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74,
 	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72,
-	0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xb1, 0x01, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a,
-	0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c,
-	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01,
-	0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66,
-	0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65,
-	0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xa3, 0x01, 0x0a,
+	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x1d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a,
+	0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x28, 0x01, 0x12, 0xbc, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x22, 0x23, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21,
+	0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28,
+	0x01, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92,
+	0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xa1, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
+	0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x69,
+	0x6e, 0x66, 0x6f, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xa1, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42,
+	0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12,
+	0xb1, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x92, 0x41, 0x25, 0x72, 0x23,
+	0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x28, 0x01, 0x12, 0xb1, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x1a, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12,
+	0x1e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x92,
+	0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61,
+	0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1413,54 +1547,58 @@ func file_admin_v1_sms_channel_proto_rawDescGZIP() []byte {
 	return file_admin_v1_sms_channel_proto_rawDescData
 }
 
-var file_admin_v1_sms_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_admin_v1_sms_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_admin_v1_sms_channel_proto_goTypes = []interface{}{
-	(*SmsChannelInfo)(nil),              // 0: admin.v1.SmsChannelInfo
-	(*CreateSmsChannelReq)(nil),         // 1: admin.v1.CreateSmsChannelReq
-	(*CreateSmsChannelReply)(nil),       // 2: admin.v1.CreateSmsChannelReply
-	(*UpdateSmsChannelReq)(nil),         // 3: admin.v1.UpdateSmsChannelReq
-	(*UpdateSmsChannelReply)(nil),       // 4: admin.v1.UpdateSmsChannelReply
-	(*UpdateSmsChannelStatusReq)(nil),   // 5: admin.v1.UpdateSmsChannelStatusReq
-	(*UpdateSmsChannelStatusReply)(nil), // 6: admin.v1.UpdateSmsChannelStatusReply
-	(*DeleteSmsChannelReq)(nil),         // 7: admin.v1.DeleteSmsChannelReq
-	(*DeleteSmsChannelReply)(nil),       // 8: admin.v1.DeleteSmsChannelReply
-	(*GetSmsChannelInfoReq)(nil),        // 9: admin.v1.GetSmsChannelInfoReq
-	(*GetSmsChannelInfoReply)(nil),      // 10: admin.v1.GetSmsChannelInfoReply
-	(*GetSmsChannelListReq)(nil),        // 11: admin.v1.GetSmsChannelListReq
-	(*GetSmsChannelListReply)(nil),      // 12: admin.v1.GetSmsChannelListReply
-	(*SmsChannelOperator)(nil),          // 13: admin.v1.SmsChannelOperator
-	(*GetSmsChannelOperatorReq)(nil),    // 14: admin.v1.GetSmsChannelOperatorReq
-	(*GetSmsChannelOperatorReply)(nil),  // 15: admin.v1.GetSmsChannelOperatorReply
-	(*SmsChannelSelector)(nil),          // 16: admin.v1.SmsChannelSelector
-	(*GetSmsChannelSelectorReq)(nil),    // 17: admin.v1.GetSmsChannelSelectorReq
-	(*GetSmsChannelSelectorReply)(nil),  // 18: admin.v1.GetSmsChannelSelectorReply
+	(*SmsChannelConfig)(nil),            // 0: admin.v1.SmsChannelConfig
+	(*SmsChannelInfo)(nil),              // 1: admin.v1.SmsChannelInfo
+	(*CreateSmsChannelReq)(nil),         // 2: admin.v1.CreateSmsChannelReq
+	(*CreateSmsChannelReply)(nil),       // 3: admin.v1.CreateSmsChannelReply
+	(*UpdateSmsChannelReq)(nil),         // 4: admin.v1.UpdateSmsChannelReq
+	(*UpdateSmsChannelReply)(nil),       // 5: admin.v1.UpdateSmsChannelReply
+	(*UpdateSmsChannelStatusReq)(nil),   // 6: admin.v1.UpdateSmsChannelStatusReq
+	(*UpdateSmsChannelStatusReply)(nil), // 7: admin.v1.UpdateSmsChannelStatusReply
+	(*DeleteSmsChannelReq)(nil),         // 8: admin.v1.DeleteSmsChannelReq
+	(*DeleteSmsChannelReply)(nil),       // 9: admin.v1.DeleteSmsChannelReply
+	(*GetSmsChannelInfoReq)(nil),        // 10: admin.v1.GetSmsChannelInfoReq
+	(*GetSmsChannelInfoReply)(nil),      // 11: admin.v1.GetSmsChannelInfoReply
+	(*GetSmsChannelListReq)(nil),        // 12: admin.v1.GetSmsChannelListReq
+	(*GetSmsChannelListReply)(nil),      // 13: admin.v1.GetSmsChannelListReply
+	(*SmsChannelOperator)(nil),          // 14: admin.v1.SmsChannelOperator
+	(*GetSmsChannelOperatorReq)(nil),    // 15: admin.v1.GetSmsChannelOperatorReq
+	(*GetSmsChannelOperatorReply)(nil),  // 16: admin.v1.GetSmsChannelOperatorReply
+	(*SmsChannelSelector)(nil),          // 17: admin.v1.SmsChannelSelector
+	(*GetSmsChannelSelectorReq)(nil),    // 18: admin.v1.GetSmsChannelSelectorReq
+	(*GetSmsChannelSelectorReply)(nil),  // 19: admin.v1.GetSmsChannelSelectorReply
 }
 var file_admin_v1_sms_channel_proto_depIdxs = []int32{
-	0,  // 0: admin.v1.GetSmsChannelInfoReply.info:type_name -> admin.v1.SmsChannelInfo
-	0,  // 1: admin.v1.GetSmsChannelListReply.list:type_name -> admin.v1.SmsChannelInfo
-	13, // 2: admin.v1.GetSmsChannelOperatorReply.list:type_name -> admin.v1.SmsChannelOperator
-	16, // 3: admin.v1.GetSmsChannelSelectorReply.list:type_name -> admin.v1.SmsChannelSelector
-	1,  // 4: admin.v1.SmsChannel.CreateSmsChannel:input_type -> admin.v1.CreateSmsChannelReq
-	3,  // 5: admin.v1.SmsChannel.UpdateSmsChannel:input_type -> admin.v1.UpdateSmsChannelReq
-	5,  // 6: admin.v1.SmsChannel.UpdateSmsChannelStatus:input_type -> admin.v1.UpdateSmsChannelStatusReq
-	7,  // 7: admin.v1.SmsChannel.DeleteSmsChannel:input_type -> admin.v1.DeleteSmsChannelReq
-	9,  // 8: admin.v1.SmsChannel.GetSmsChannelInfo:input_type -> admin.v1.GetSmsChannelInfoReq
-	11, // 9: admin.v1.SmsChannel.GetSmsChannelList:input_type -> admin.v1.GetSmsChannelListReq
-	14, // 10: admin.v1.SmsChannel.GetSmsChannelOperator:input_type -> admin.v1.GetSmsChannelOperatorReq
-	17, // 11: admin.v1.SmsChannel.GetSmsChannelSelector:input_type -> admin.v1.GetSmsChannelSelectorReq
-	2,  // 12: admin.v1.SmsChannel.CreateSmsChannel:output_type -> admin.v1.CreateSmsChannelReply
-	4,  // 13: admin.v1.SmsChannel.UpdateSmsChannel:output_type -> admin.v1.UpdateSmsChannelReply
-	6,  // 14: admin.v1.SmsChannel.UpdateSmsChannelStatus:output_type -> admin.v1.UpdateSmsChannelStatusReply
-	8,  // 15: admin.v1.SmsChannel.DeleteSmsChannel:output_type -> admin.v1.DeleteSmsChannelReply
-	10, // 16: admin.v1.SmsChannel.GetSmsChannelInfo:output_type -> admin.v1.GetSmsChannelInfoReply
-	12, // 17: admin.v1.SmsChannel.GetSmsChannelList:output_type -> admin.v1.GetSmsChannelListReply
-	15, // 18: admin.v1.SmsChannel.GetSmsChannelOperator:output_type -> admin.v1.GetSmsChannelOperatorReply
-	18, // 19: admin.v1.SmsChannel.GetSmsChannelSelector:output_type -> admin.v1.GetSmsChannelSelectorReply
-	12, // [12:20] is the sub-list for method output_type
-	4,  // [4:12] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	0,  // 0: admin.v1.SmsChannelInfo.config:type_name -> admin.v1.SmsChannelConfig
+	0,  // 1: admin.v1.CreateSmsChannelReq.config:type_name -> admin.v1.SmsChannelConfig
+	0,  // 2: admin.v1.UpdateSmsChannelReq.config:type_name -> admin.v1.SmsChannelConfig
+	1,  // 3: admin.v1.GetSmsChannelInfoReply.info:type_name -> admin.v1.SmsChannelInfo
+	1,  // 4: admin.v1.GetSmsChannelListReply.list:type_name -> admin.v1.SmsChannelInfo
+	14, // 5: admin.v1.GetSmsChannelOperatorReply.list:type_name -> admin.v1.SmsChannelOperator
+	17, // 6: admin.v1.GetSmsChannelSelectorReply.list:type_name -> admin.v1.SmsChannelSelector
+	2,  // 7: admin.v1.SmsChannel.CreateSmsChannel:input_type -> admin.v1.CreateSmsChannelReq
+	4,  // 8: admin.v1.SmsChannel.UpdateSmsChannel:input_type -> admin.v1.UpdateSmsChannelReq
+	6,  // 9: admin.v1.SmsChannel.UpdateSmsChannelStatus:input_type -> admin.v1.UpdateSmsChannelStatusReq
+	8,  // 10: admin.v1.SmsChannel.DeleteSmsChannel:input_type -> admin.v1.DeleteSmsChannelReq
+	10, // 11: admin.v1.SmsChannel.GetSmsChannelInfo:input_type -> admin.v1.GetSmsChannelInfoReq
+	12, // 12: admin.v1.SmsChannel.GetSmsChannelList:input_type -> admin.v1.GetSmsChannelListReq
+	15, // 13: admin.v1.SmsChannel.GetSmsChannelOperator:input_type -> admin.v1.GetSmsChannelOperatorReq
+	18, // 14: admin.v1.SmsChannel.GetSmsChannelSelector:input_type -> admin.v1.GetSmsChannelSelectorReq
+	3,  // 15: admin.v1.SmsChannel.CreateSmsChannel:output_type -> admin.v1.CreateSmsChannelReply
+	5,  // 16: admin.v1.SmsChannel.UpdateSmsChannel:output_type -> admin.v1.UpdateSmsChannelReply
+	7,  // 17: admin.v1.SmsChannel.UpdateSmsChannelStatus:output_type -> admin.v1.UpdateSmsChannelStatusReply
+	9,  // 18: admin.v1.SmsChannel.DeleteSmsChannel:output_type -> admin.v1.DeleteSmsChannelReply
+	11, // 19: admin.v1.SmsChannel.GetSmsChannelInfo:output_type -> admin.v1.GetSmsChannelInfoReply
+	13, // 20: admin.v1.SmsChannel.GetSmsChannelList:output_type -> admin.v1.GetSmsChannelListReply
+	16, // 21: admin.v1.SmsChannel.GetSmsChannelOperator:output_type -> admin.v1.GetSmsChannelOperatorReply
+	19, // 22: admin.v1.SmsChannel.GetSmsChannelSelector:output_type -> admin.v1.GetSmsChannelSelectorReply
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_admin_v1_sms_channel_proto_init() }
@@ -1470,7 +1608,7 @@ func file_admin_v1_sms_channel_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_admin_v1_sms_channel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmsChannelInfo); i {
+			switch v := v.(*SmsChannelConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1482,7 +1620,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSmsChannelReq); i {
+			switch v := v.(*SmsChannelInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1494,7 +1632,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSmsChannelReply); i {
+			switch v := v.(*CreateSmsChannelReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1506,7 +1644,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSmsChannelReq); i {
+			switch v := v.(*CreateSmsChannelReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,7 +1656,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSmsChannelReply); i {
+			switch v := v.(*UpdateSmsChannelReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,7 +1668,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSmsChannelStatusReq); i {
+			switch v := v.(*UpdateSmsChannelReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1542,7 +1680,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSmsChannelStatusReply); i {
+			switch v := v.(*UpdateSmsChannelStatusReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1554,7 +1692,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSmsChannelReq); i {
+			switch v := v.(*UpdateSmsChannelStatusReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1566,7 +1704,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSmsChannelReply); i {
+			switch v := v.(*DeleteSmsChannelReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1578,7 +1716,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSmsChannelInfoReq); i {
+			switch v := v.(*DeleteSmsChannelReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1590,7 +1728,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSmsChannelInfoReply); i {
+			switch v := v.(*GetSmsChannelInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1602,7 +1740,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSmsChannelListReq); i {
+			switch v := v.(*GetSmsChannelInfoReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1614,7 +1752,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSmsChannelListReply); i {
+			switch v := v.(*GetSmsChannelListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1626,7 +1764,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmsChannelOperator); i {
+			switch v := v.(*GetSmsChannelListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1638,7 +1776,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSmsChannelOperatorReq); i {
+			switch v := v.(*SmsChannelOperator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1650,7 +1788,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSmsChannelOperatorReply); i {
+			switch v := v.(*GetSmsChannelOperatorReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1662,7 +1800,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmsChannelSelector); i {
+			switch v := v.(*GetSmsChannelOperatorReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1674,7 +1812,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSmsChannelSelectorReq); i {
+			switch v := v.(*SmsChannelSelector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1686,6 +1824,18 @@ func file_admin_v1_sms_channel_proto_init() {
 			}
 		}
 		file_admin_v1_sms_channel_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSmsChannelSelectorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sms_channel_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSmsChannelSelectorReply); i {
 			case 0:
 				return &v.state
@@ -1704,7 +1854,7 @@ func file_admin_v1_sms_channel_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_admin_v1_sms_channel_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
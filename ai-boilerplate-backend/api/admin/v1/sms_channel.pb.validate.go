@@ -35,6 +35,116 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on SmsChannelConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SmsChannelConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SmsChannelConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SmsChannelConfigMultiError, or nil if none found.
+func (m *SmsChannelConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SmsChannelConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SignName
+
+	// no validation rules for Region
+
+	// no validation rules for Endpoint
+
+	// no validation rules for SdkAppId
+
+	// no validation rules for Sender
+
+	if len(errors) > 0 {
+		return SmsChannelConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// SmsChannelConfigMultiError is an error wrapping multiple validation errors
+// returned by SmsChannelConfig.ValidateAll() if the designated constraints
+// aren't met.
+type SmsChannelConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SmsChannelConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SmsChannelConfigMultiError) AllErrors() []error { return m }
+
+// SmsChannelConfigValidationError is the validation error returned by
+// SmsChannelConfig.Validate if the designated constraints aren't met.
+type SmsChannelConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SmsChannelConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SmsChannelConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SmsChannelConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SmsChannelConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SmsChannelConfigValidationError) ErrorName() string { return "SmsChannelConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SmsChannelConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSmsChannelConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SmsChannelConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SmsChannelConfigValidationError{}
+
 // Validate checks the field values on SmsChannelInfo with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -79,6 +189,37 @@ func (m *SmsChannelInfo) validate(all bool) error {
 
 	// no validation rules for OperatorName
 
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SmsChannelInfoValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SmsChannelInfoValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SmsChannelInfoValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReceiptCallbackPath
+
 	if len(errors) > 0 {
 		return SmsChannelInfoMultiError(errors)
 	}
@@ -193,6 +334,35 @@ func (m *CreateSmsChannelReq) validate(all bool) error {
 
 	// no validation rules for Status
 
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSmsChannelReqValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSmsChannelReqValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSmsChannelReqValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateSmsChannelReqMultiError(errors)
 	}
@@ -415,6 +585,35 @@ func (m *UpdateSmsChannelReq) validate(all bool) error {
 
 	// no validation rules for Status
 
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSmsChannelReqValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSmsChannelReqValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSmsChannelReqValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpdateSmsChannelReqMultiError(errors)
 	}
@@ -407,6 +407,214 @@ func (x *GetSmsLogListReply) GetList() []*SmsLogInfo {
 	return nil
 }
 
+// 请求-短信日志-渠道发送统计
+type GetSmsLogChannelStatsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt []string `protobuf:"bytes,1,rep,name=createdAt,proto3" json:"createdAt,omitempty"` // 创建时间
+}
+
+func (x *GetSmsLogChannelStatsReq) Reset() {
+	*x = GetSmsLogChannelStatsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sms_log_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSmsLogChannelStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSmsLogChannelStatsReq) ProtoMessage() {}
+
+func (x *GetSmsLogChannelStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sms_log_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSmsLogChannelStatsReq.ProtoReflect.Descriptor instead.
+func (*GetSmsLogChannelStatsReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sms_log_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSmsLogChannelStatsReq) GetCreatedAt() []string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 短信渠道发送统计项
+type SmsLogChannelStatsItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SmsChannelId   string  `protobuf:"bytes,1,opt,name=smsChannelId,proto3" json:"smsChannelId,omitempty"`      // 短信渠道编号
+	SmsChannelName string  `protobuf:"bytes,2,opt,name=smsChannelName,proto3" json:"smsChannelName,omitempty"`  // 短信渠道名称
+	Total          int64   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                   // 发送总数
+	SendFailed     int64   `protobuf:"varint,4,opt,name=sendFailed,proto3" json:"sendFailed,omitempty"`         // 发送失败数
+	ReceiveSuccess int64   `protobuf:"varint,5,opt,name=receiveSuccess,proto3" json:"receiveSuccess,omitempty"` // 接收成功数
+	ReceiveFailed  int64   `protobuf:"varint,6,opt,name=receiveFailed,proto3" json:"receiveFailed,omitempty"`   // 接收失败数
+	ReceivePending int64   `protobuf:"varint,7,opt,name=receivePending,proto3" json:"receivePending,omitempty"` // 等待回执数
+	FailureRate    float64 `protobuf:"fixed64,8,opt,name=failureRate,proto3" json:"failureRate,omitempty"`      // 失败率((发送失败+接收失败)/发送总数)
+	BreakerOpen    bool    `protobuf:"varint,9,opt,name=breakerOpen,proto3" json:"breakerOpen,omitempty"`       // 渠道是否熔断中
+}
+
+func (x *SmsLogChannelStatsItem) Reset() {
+	*x = SmsLogChannelStatsItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sms_log_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmsLogChannelStatsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmsLogChannelStatsItem) ProtoMessage() {}
+
+func (x *SmsLogChannelStatsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sms_log_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmsLogChannelStatsItem.ProtoReflect.Descriptor instead.
+func (*SmsLogChannelStatsItem) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sms_log_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SmsLogChannelStatsItem) GetSmsChannelId() string {
+	if x != nil {
+		return x.SmsChannelId
+	}
+	return ""
+}
+
+func (x *SmsLogChannelStatsItem) GetSmsChannelName() string {
+	if x != nil {
+		return x.SmsChannelName
+	}
+	return ""
+}
+
+func (x *SmsLogChannelStatsItem) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SmsLogChannelStatsItem) GetSendFailed() int64 {
+	if x != nil {
+		return x.SendFailed
+	}
+	return 0
+}
+
+func (x *SmsLogChannelStatsItem) GetReceiveSuccess() int64 {
+	if x != nil {
+		return x.ReceiveSuccess
+	}
+	return 0
+}
+
+func (x *SmsLogChannelStatsItem) GetReceiveFailed() int64 {
+	if x != nil {
+		return x.ReceiveFailed
+	}
+	return 0
+}
+
+func (x *SmsLogChannelStatsItem) GetReceivePending() int64 {
+	if x != nil {
+		return x.ReceivePending
+	}
+	return 0
+}
+
+func (x *SmsLogChannelStatsItem) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+func (x *SmsLogChannelStatsItem) GetBreakerOpen() bool {
+	if x != nil {
+		return x.BreakerOpen
+	}
+	return false
+}
+
+// 响应-短信日志-渠道发送统计
+type GetSmsLogChannelStatsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*SmsLogChannelStatsItem `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"` // 统计数据
+}
+
+func (x *GetSmsLogChannelStatsReply) Reset() {
+	*x = GetSmsLogChannelStatsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sms_log_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSmsLogChannelStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSmsLogChannelStatsReply) ProtoMessage() {}
+
+func (x *GetSmsLogChannelStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sms_log_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSmsLogChannelStatsReply.ProtoReflect.Descriptor instead.
+func (*GetSmsLogChannelStatsReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sms_log_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSmsLogChannelStatsReply) GetList() []*SmsLogChannelStatsItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
 var File_admin_v1_sms_log_proto protoreflect.FileDescriptor
 
 var file_admin_v1_sms_log_proto_rawDesc = []byte{
@@ -472,30 +680,72 @@ var file_admin_v1_sms_log_proto_rawDesc = []byte{
 	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
 	0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x32, 0xb0, 0x02, 0x0a, 0x06, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x12, 0x91, 0x01, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c,
-	0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
-	0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x6c,
-	0x6f, 0x67, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42,
-	0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12,
-	0x91, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c,
-	0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x46, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x6d, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x92, 0x41, 0x25, 0x72, 0x23,
-	0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x28, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f,
-	0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x38, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd4, 0x02, 0x0a, 0x16, 0x53,
+	0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6d, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6d, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x6e,
+	0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a,
+	0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x65,
+	0x6e, 0x22, 0x52, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x32, 0xe5, 0x03, 0x0a, 0x06, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67,
+	0x12, 0x91, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1c,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73,
+	0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x46, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x6d, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x92, 0x41, 0x25, 0x72,
+	0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x28, 0x01, 0x12, 0x91, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c,
+	0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6d, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x12, 0xb2, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6d, 0x73, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x6d, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x92, 0x41, 0x25, 0x72, 0x23, 0x0a, 0x21, 0x0a, 0x0d, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x28, 0x01, 0x42, 0x3c, 0x5a,
+	0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -510,26 +760,32 @@ func file_admin_v1_sms_log_proto_rawDescGZIP() []byte {
 	return file_admin_v1_sms_log_proto_rawDescData
 }
 
-var file_admin_v1_sms_log_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_admin_v1_sms_log_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_admin_v1_sms_log_proto_goTypes = []interface{}{
-	(*SmsLogInfo)(nil),         // 0: admin.v1.SmsLogInfo
-	(*GetSmsLogInfoReq)(nil),   // 1: admin.v1.GetSmsLogInfoReq
-	(*GetSmsLogInfoReply)(nil), // 2: admin.v1.GetSmsLogInfoReply
-	(*GetSmsLogListReq)(nil),   // 3: admin.v1.GetSmsLogListReq
-	(*GetSmsLogListReply)(nil), // 4: admin.v1.GetSmsLogListReply
+	(*SmsLogInfo)(nil),                 // 0: admin.v1.SmsLogInfo
+	(*GetSmsLogInfoReq)(nil),           // 1: admin.v1.GetSmsLogInfoReq
+	(*GetSmsLogInfoReply)(nil),         // 2: admin.v1.GetSmsLogInfoReply
+	(*GetSmsLogListReq)(nil),           // 3: admin.v1.GetSmsLogListReq
+	(*GetSmsLogListReply)(nil),         // 4: admin.v1.GetSmsLogListReply
+	(*GetSmsLogChannelStatsReq)(nil),   // 5: admin.v1.GetSmsLogChannelStatsReq
+	(*SmsLogChannelStatsItem)(nil),     // 6: admin.v1.SmsLogChannelStatsItem
+	(*GetSmsLogChannelStatsReply)(nil), // 7: admin.v1.GetSmsLogChannelStatsReply
 }
 var file_admin_v1_sms_log_proto_depIdxs = []int32{
 	0, // 0: admin.v1.GetSmsLogInfoReply.info:type_name -> admin.v1.SmsLogInfo
 	0, // 1: admin.v1.GetSmsLogListReply.list:type_name -> admin.v1.SmsLogInfo
-	1, // 2: admin.v1.SmsLog.GetSmsLogInfo:input_type -> admin.v1.GetSmsLogInfoReq
-	3, // 3: admin.v1.SmsLog.GetSmsLogList:input_type -> admin.v1.GetSmsLogListReq
-	2, // 4: admin.v1.SmsLog.GetSmsLogInfo:output_type -> admin.v1.GetSmsLogInfoReply
-	4, // 5: admin.v1.SmsLog.GetSmsLogList:output_type -> admin.v1.GetSmsLogListReply
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 2: admin.v1.GetSmsLogChannelStatsReply.list:type_name -> admin.v1.SmsLogChannelStatsItem
+	1, // 3: admin.v1.SmsLog.GetSmsLogInfo:input_type -> admin.v1.GetSmsLogInfoReq
+	3, // 4: admin.v1.SmsLog.GetSmsLogList:input_type -> admin.v1.GetSmsLogListReq
+	5, // 5: admin.v1.SmsLog.GetSmsLogChannelStats:input_type -> admin.v1.GetSmsLogChannelStatsReq
+	2, // 6: admin.v1.SmsLog.GetSmsLogInfo:output_type -> admin.v1.GetSmsLogInfoReply
+	4, // 7: admin.v1.SmsLog.GetSmsLogList:output_type -> admin.v1.GetSmsLogListReply
+	7, // 8: admin.v1.SmsLog.GetSmsLogChannelStats:output_type -> admin.v1.GetSmsLogChannelStatsReply
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_admin_v1_sms_log_proto_init() }
@@ -598,6 +854,42 @@ func file_admin_v1_sms_log_proto_init() {
 				return nil
 			}
 		}
+		file_admin_v1_sms_log_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSmsLogChannelStatsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sms_log_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmsLogChannelStatsItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sms_log_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSmsLogChannelStatsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -605,7 +897,7 @@ func file_admin_v1_sms_log_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_admin_v1_sms_log_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -642,3 +642,361 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetSmsLogListReplyValidationError{}
+
+// Validate checks the field values on GetSmsLogChannelStatsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSmsLogChannelStatsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSmsLogChannelStatsReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSmsLogChannelStatsReqMultiError, or nil if none found.
+func (m *GetSmsLogChannelStatsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSmsLogChannelStatsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetSmsLogChannelStatsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSmsLogChannelStatsReqMultiError is an error wrapping multiple validation
+// errors returned by GetSmsLogChannelStatsReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetSmsLogChannelStatsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSmsLogChannelStatsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSmsLogChannelStatsReqMultiError) AllErrors() []error { return m }
+
+// GetSmsLogChannelStatsReqValidationError is the validation error returned by
+// GetSmsLogChannelStatsReq.Validate if the designated constraints aren't met.
+type GetSmsLogChannelStatsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSmsLogChannelStatsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSmsLogChannelStatsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSmsLogChannelStatsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSmsLogChannelStatsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSmsLogChannelStatsReqValidationError) ErrorName() string {
+	return "GetSmsLogChannelStatsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSmsLogChannelStatsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSmsLogChannelStatsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSmsLogChannelStatsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSmsLogChannelStatsReqValidationError{}
+
+// Validate checks the field values on SmsLogChannelStatsItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SmsLogChannelStatsItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SmsLogChannelStatsItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SmsLogChannelStatsItemMultiError, or nil if none found.
+func (m *SmsLogChannelStatsItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SmsLogChannelStatsItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SmsChannelId
+
+	// no validation rules for SmsChannelName
+
+	// no validation rules for Total
+
+	// no validation rules for SendFailed
+
+	// no validation rules for ReceiveSuccess
+
+	// no validation rules for ReceiveFailed
+
+	// no validation rules for ReceivePending
+
+	// no validation rules for FailureRate
+
+	// no validation rules for BreakerOpen
+
+	if len(errors) > 0 {
+		return SmsLogChannelStatsItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SmsLogChannelStatsItemMultiError is an error wrapping multiple validation
+// errors returned by SmsLogChannelStatsItem.ValidateAll() if the designated
+// constraints aren't met.
+type SmsLogChannelStatsItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SmsLogChannelStatsItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SmsLogChannelStatsItemMultiError) AllErrors() []error { return m }
+
+// SmsLogChannelStatsItemValidationError is the validation error returned by
+// SmsLogChannelStatsItem.Validate if the designated constraints aren't met.
+type SmsLogChannelStatsItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SmsLogChannelStatsItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SmsLogChannelStatsItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SmsLogChannelStatsItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SmsLogChannelStatsItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SmsLogChannelStatsItemValidationError) ErrorName() string {
+	return "SmsLogChannelStatsItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SmsLogChannelStatsItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSmsLogChannelStatsItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SmsLogChannelStatsItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SmsLogChannelStatsItemValidationError{}
+
+// Validate checks the field values on GetSmsLogChannelStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSmsLogChannelStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSmsLogChannelStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSmsLogChannelStatsReplyMultiError, or nil if none found.
+func (m *GetSmsLogChannelStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSmsLogChannelStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSmsLogChannelStatsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSmsLogChannelStatsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSmsLogChannelStatsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSmsLogChannelStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSmsLogChannelStatsReplyMultiError is an error wrapping multiple
+// validation errors returned by GetSmsLogChannelStatsReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetSmsLogChannelStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSmsLogChannelStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSmsLogChannelStatsReplyMultiError) AllErrors() []error { return m }
+
+// GetSmsLogChannelStatsReplyValidationError is the validation error returned
+// by GetSmsLogChannelStatsReply.Validate if the designated constraints aren't met.
+type GetSmsLogChannelStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSmsLogChannelStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSmsLogChannelStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSmsLogChannelStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSmsLogChannelStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSmsLogChannelStatsReplyValidationError) ErrorName() string {
+	return "GetSmsLogChannelStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSmsLogChannelStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSmsLogChannelStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSmsLogChannelStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSmsLogChannelStatsReplyValidationError{}
@@ -26,6 +26,8 @@ type SmsLogClient interface {
 	GetSmsLogInfo(ctx context.Context, in *GetSmsLogInfoReq, opts ...grpc.CallOption) (*GetSmsLogInfoReply, error)
 	// 短信日志-列表数据查询
 	GetSmsLogList(ctx context.Context, in *GetSmsLogListReq, opts ...grpc.CallOption) (*GetSmsLogListReply, error)
+	// 短信日志-渠道发送统计
+	GetSmsLogChannelStats(ctx context.Context, in *GetSmsLogChannelStatsReq, opts ...grpc.CallOption) (*GetSmsLogChannelStatsReply, error)
 }
 
 type smsLogClient struct {
@@ -54,6 +56,15 @@ func (c *smsLogClient) GetSmsLogList(ctx context.Context, in *GetSmsLogListReq,
 	return out, nil
 }
 
+func (c *smsLogClient) GetSmsLogChannelStats(ctx context.Context, in *GetSmsLogChannelStatsReq, opts ...grpc.CallOption) (*GetSmsLogChannelStatsReply, error) {
+	out := new(GetSmsLogChannelStatsReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SmsLog/GetSmsLogChannelStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SmsLogServer is the server API for SmsLog service.
 // All implementations must embed UnimplementedSmsLogServer
 // for forward compatibility
@@ -62,6 +73,8 @@ type SmsLogServer interface {
 	GetSmsLogInfo(context.Context, *GetSmsLogInfoReq) (*GetSmsLogInfoReply, error)
 	// 短信日志-列表数据查询
 	GetSmsLogList(context.Context, *GetSmsLogListReq) (*GetSmsLogListReply, error)
+	// 短信日志-渠道发送统计
+	GetSmsLogChannelStats(context.Context, *GetSmsLogChannelStatsReq) (*GetSmsLogChannelStatsReply, error)
 	mustEmbedUnimplementedSmsLogServer()
 }
 
@@ -75,6 +88,9 @@ func (UnimplementedSmsLogServer) GetSmsLogInfo(context.Context, *GetSmsLogInfoRe
 func (UnimplementedSmsLogServer) GetSmsLogList(context.Context, *GetSmsLogListReq) (*GetSmsLogListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSmsLogList not implemented")
 }
+func (UnimplementedSmsLogServer) GetSmsLogChannelStats(context.Context, *GetSmsLogChannelStatsReq) (*GetSmsLogChannelStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSmsLogChannelStats not implemented")
+}
 func (UnimplementedSmsLogServer) mustEmbedUnimplementedSmsLogServer() {}
 
 // UnsafeSmsLogServer may be embedded to opt out of forward compatibility for this service.
@@ -124,6 +140,24 @@ func _SmsLog_GetSmsLogList_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SmsLog_GetSmsLogChannelStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSmsLogChannelStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SmsLogServer).GetSmsLogChannelStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SmsLog/GetSmsLogChannelStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SmsLogServer).GetSmsLogChannelStats(ctx, req.(*GetSmsLogChannelStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SmsLog_ServiceDesc is the grpc.ServiceDesc for SmsLog service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -139,6 +173,10 @@ var SmsLog_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSmsLogList",
 			Handler:    _SmsLog_GetSmsLogList_Handler,
 		},
+		{
+			MethodName: "GetSmsLogChannelStats",
+			Handler:    _SmsLog_GetSmsLogChannelStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/sms_log.proto",
@@ -19,10 +19,12 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationSmsLogGetSmsLogChannelStats = "/admin.v1.SmsLog/GetSmsLogChannelStats"
 const OperationSmsLogGetSmsLogInfo = "/admin.v1.SmsLog/GetSmsLogInfo"
 const OperationSmsLogGetSmsLogList = "/admin.v1.SmsLog/GetSmsLogList"
 
 type SmsLogHTTPServer interface {
+	GetSmsLogChannelStats(context.Context, *GetSmsLogChannelStatsReq) (*GetSmsLogChannelStatsReply, error)
 	GetSmsLogInfo(context.Context, *GetSmsLogInfoReq) (*GetSmsLogInfoReply, error)
 	GetSmsLogList(context.Context, *GetSmsLogListReq) (*GetSmsLogListReply, error)
 }
@@ -31,6 +33,7 @@ func RegisterSmsLogHTTPServer(s *http.Server, srv SmsLogHTTPServer) {
 	r := s.Route("/")
 	r.GET("/admin/v1/sms_log/info", _SmsLog_GetSmsLogInfo0_HTTP_Handler(srv))
 	r.GET("/admin/v1/sms_log/list", _SmsLog_GetSmsLogList0_HTTP_Handler(srv))
+	r.GET("/admin/v1/sms_log/channel_stats", _SmsLog_GetSmsLogChannelStats0_HTTP_Handler(srv))
 }
 
 func _SmsLog_GetSmsLogInfo0_HTTP_Handler(srv SmsLogHTTPServer) func(ctx http.Context) error {
@@ -71,7 +74,27 @@ func _SmsLog_GetSmsLogList0_HTTP_Handler(srv SmsLogHTTPServer) func(ctx http.Con
 	}
 }
 
+func _SmsLog_GetSmsLogChannelStats0_HTTP_Handler(srv SmsLogHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSmsLogChannelStatsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSmsLogGetSmsLogChannelStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSmsLogChannelStats(ctx, req.(*GetSmsLogChannelStatsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSmsLogChannelStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type SmsLogHTTPClient interface {
+	GetSmsLogChannelStats(ctx context.Context, req *GetSmsLogChannelStatsReq, opts ...http.CallOption) (rsp *GetSmsLogChannelStatsReply, err error)
 	GetSmsLogInfo(ctx context.Context, req *GetSmsLogInfoReq, opts ...http.CallOption) (rsp *GetSmsLogInfoReply, err error)
 	GetSmsLogList(ctx context.Context, req *GetSmsLogListReq, opts ...http.CallOption) (rsp *GetSmsLogListReply, err error)
 }
@@ -84,6 +107,19 @@ func NewSmsLogHTTPClient(client *http.Client) SmsLogHTTPClient {
 	return &SmsLogHTTPClientImpl{client}
 }
 
+func (c *SmsLogHTTPClientImpl) GetSmsLogChannelStats(ctx context.Context, in *GetSmsLogChannelStatsReq, opts ...http.CallOption) (*GetSmsLogChannelStatsReply, error) {
+	var out GetSmsLogChannelStatsReply
+	pattern := "/admin/v1/sms_log/channel_stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSmsLogGetSmsLogChannelStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *SmsLogHTTPClientImpl) GetSmsLogInfo(ctx context.Context, in *GetSmsLogInfoReq, opts ...http.CallOption) (*GetSmsLogInfoReply, error) {
 	var out GetSmsLogInfoReply
 	pattern := "/admin/v1/sms_log/info"
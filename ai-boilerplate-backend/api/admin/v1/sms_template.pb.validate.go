@@ -83,6 +83,10 @@ func (m *SmsTemplateInfo) validate(all bool) error {
 
 	// no validation rules for SmsChannelName
 
+	// no validation rules for Priority
+
+	// no validation rules for Weight
+
 	if len(errors) > 0 {
 		return SmsTemplateInfoMultiError(errors)
 	}
@@ -201,6 +205,10 @@ func (m *CreateSmsTemplateReq) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for Priority
+
+	// no validation rules for Weight
+
 	if len(errors) > 0 {
 		return CreateSmsTemplateReqMultiError(errors)
 	}
@@ -427,6 +435,10 @@ func (m *UpdateSmsTemplateReq) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for Priority
+
+	// no validation rules for Weight
+
 	if len(errors) > 0 {
 		return UpdateSmsTemplateReqMultiError(errors)
 	}
@@ -1978,6 +1990,8 @@ func (m *SendSmsTemplateMsgReply) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for SmsLogId
+
 	if len(errors) > 0 {
 		return SendSmsTemplateMsgReplyMultiError(errors)
 	}
@@ -195,6 +195,8 @@ func (m *SysAdminInfo) validate(all bool) error {
 
 	// no validation rules for PostName
 
+	// no validation rules for TwoFactorEnabled
+
 	if len(errors) > 0 {
 		return SysAdminInfoMultiError(errors)
 	}
@@ -2079,3 +2081,983 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetSysAdminSelectorReplyValidationError{}
+
+// Validate checks the field values on SysAdminSessionInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAdminSessionInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAdminSessionInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAdminSessionInfoMultiError, or nil if none found.
+func (m *SysAdminSessionInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAdminSessionInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Device
+
+	// no validation rules for Ip
+
+	// no validation rules for UserAgent
+
+	// no validation rules for LoginAt
+
+	// no validation rules for LastSeenAt
+
+	// no validation rules for ExpiredAt
+
+	if len(errors) > 0 {
+		return SysAdminSessionInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAdminSessionInfoMultiError is an error wrapping multiple validation
+// errors returned by SysAdminSessionInfo.ValidateAll() if the designated
+// constraints aren't met.
+type SysAdminSessionInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAdminSessionInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAdminSessionInfoMultiError) AllErrors() []error { return m }
+
+// SysAdminSessionInfoValidationError is the validation error returned by
+// SysAdminSessionInfo.Validate if the designated constraints aren't met.
+type SysAdminSessionInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAdminSessionInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAdminSessionInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAdminSessionInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAdminSessionInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAdminSessionInfoValidationError) ErrorName() string {
+	return "SysAdminSessionInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAdminSessionInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAdminSessionInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAdminSessionInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAdminSessionInfoValidationError{}
+
+// Validate checks the field values on GetSysAdminSessionListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSysAdminSessionListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSysAdminSessionListReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSysAdminSessionListReqMultiError, or nil if none found.
+func (m *GetSysAdminSessionListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSysAdminSessionListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetSysAdminSessionListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSysAdminSessionListReqMultiError is an error wrapping multiple validation
+// errors returned by GetSysAdminSessionListReq.ValidateAll() if the
+// designated constraints aren't met.
+type GetSysAdminSessionListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSysAdminSessionListReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSysAdminSessionListReqMultiError) AllErrors() []error { return m }
+
+// GetSysAdminSessionListReqValidationError is the validation error returned by
+// GetSysAdminSessionListReq.Validate if the designated constraints aren't met.
+type GetSysAdminSessionListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSysAdminSessionListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSysAdminSessionListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSysAdminSessionListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSysAdminSessionListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSysAdminSessionListReqValidationError) ErrorName() string {
+	return "GetSysAdminSessionListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSysAdminSessionListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSysAdminSessionListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSysAdminSessionListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSysAdminSessionListReqValidationError{}
+
+// Validate checks the field values on GetSysAdminSessionListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSysAdminSessionListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSysAdminSessionListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSysAdminSessionListReplyMultiError, or nil if none found.
+func (m *GetSysAdminSessionListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSysAdminSessionListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSysAdminSessionListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSysAdminSessionListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSysAdminSessionListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSysAdminSessionListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSysAdminSessionListReplyMultiError is an error wrapping multiple
+// validation errors returned by GetSysAdminSessionListReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetSysAdminSessionListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSysAdminSessionListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSysAdminSessionListReplyMultiError) AllErrors() []error { return m }
+
+// GetSysAdminSessionListReplyValidationError is the validation error returned
+// by GetSysAdminSessionListReply.Validate if the designated constraints
+// aren't met.
+type GetSysAdminSessionListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSysAdminSessionListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSysAdminSessionListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSysAdminSessionListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSysAdminSessionListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSysAdminSessionListReplyValidationError) ErrorName() string {
+	return "GetSysAdminSessionListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSysAdminSessionListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSysAdminSessionListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSysAdminSessionListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSysAdminSessionListReplyValidationError{}
+
+// Validate checks the field values on DeleteSysAdminSessionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSysAdminSessionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSysAdminSessionReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSysAdminSessionReqMultiError, or nil if none found.
+func (m *DeleteSysAdminSessionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSysAdminSessionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SessionId
+
+	if len(errors) > 0 {
+		return DeleteSysAdminSessionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSysAdminSessionReqMultiError is an error wrapping multiple validation
+// errors returned by DeleteSysAdminSessionReq.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSysAdminSessionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSysAdminSessionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSysAdminSessionReqMultiError) AllErrors() []error { return m }
+
+// DeleteSysAdminSessionReqValidationError is the validation error returned by
+// DeleteSysAdminSessionReq.Validate if the designated constraints aren't met.
+type DeleteSysAdminSessionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSysAdminSessionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSysAdminSessionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSysAdminSessionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSysAdminSessionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSysAdminSessionReqValidationError) ErrorName() string {
+	return "DeleteSysAdminSessionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSysAdminSessionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSysAdminSessionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSysAdminSessionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSysAdminSessionReqValidationError{}
+
+// Validate checks the field values on DeleteSysAdminSessionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSysAdminSessionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSysAdminSessionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSysAdminSessionReplyMultiError, or nil if none found.
+func (m *DeleteSysAdminSessionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSysAdminSessionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSysAdminSessionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSysAdminSessionReplyMultiError is an error wrapping multiple
+// validation errors returned by DeleteSysAdminSessionReply.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteSysAdminSessionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSysAdminSessionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSysAdminSessionReplyMultiError) AllErrors() []error { return m }
+
+// DeleteSysAdminSessionReplyValidationError is the validation error returned
+// by DeleteSysAdminSessionReply.Validate if the designated constraints aren't met.
+type DeleteSysAdminSessionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSysAdminSessionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSysAdminSessionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSysAdminSessionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSysAdminSessionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSysAdminSessionReplyValidationError) ErrorName() string {
+	return "DeleteSysAdminSessionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSysAdminSessionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSysAdminSessionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSysAdminSessionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSysAdminSessionReplyValidationError{}
+
+// Validate checks the field values on UnlockSysAdminReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnlockSysAdminReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockSysAdminReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockSysAdminReqMultiError, or nil if none found.
+func (m *UnlockSysAdminReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockSysAdminReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Ip
+
+	if len(errors) > 0 {
+		return UnlockSysAdminReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockSysAdminReqMultiError is an error wrapping multiple validation errors
+// returned by UnlockSysAdminReq.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockSysAdminReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockSysAdminReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockSysAdminReqMultiError) AllErrors() []error { return m }
+
+// UnlockSysAdminReqValidationError is the validation error returned by
+// UnlockSysAdminReq.Validate if the designated constraints aren't met.
+type UnlockSysAdminReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockSysAdminReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockSysAdminReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockSysAdminReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockSysAdminReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockSysAdminReqValidationError) ErrorName() string {
+	return "UnlockSysAdminReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockSysAdminReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockSysAdminReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockSysAdminReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockSysAdminReqValidationError{}
+
+// Validate checks the field values on UnlockSysAdminReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlockSysAdminReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockSysAdminReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockSysAdminReplyMultiError, or nil if none found.
+func (m *UnlockSysAdminReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockSysAdminReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnlockSysAdminReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockSysAdminReplyMultiError is an error wrapping multiple validation
+// errors returned by UnlockSysAdminReply.ValidateAll() if the designated
+// constraints aren't met.
+type UnlockSysAdminReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockSysAdminReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockSysAdminReplyMultiError) AllErrors() []error { return m }
+
+// UnlockSysAdminReplyValidationError is the validation error returned by
+// UnlockSysAdminReply.Validate if the designated constraints aren't met.
+type UnlockSysAdminReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockSysAdminReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockSysAdminReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockSysAdminReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockSysAdminReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockSysAdminReplyValidationError) ErrorName() string {
+	return "UnlockSysAdminReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockSysAdminReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockSysAdminReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockSysAdminReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockSysAdminReplyValidationError{}
+
+// Validate checks the field values on ResetSysAdminTwoFactorReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetSysAdminTwoFactorReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetSysAdminTwoFactorReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetSysAdminTwoFactorReqMultiError, or nil if none found.
+func (m *ResetSysAdminTwoFactorReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetSysAdminTwoFactorReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ResetSysAdminTwoFactorReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetSysAdminTwoFactorReqMultiError is an error wrapping multiple validation
+// errors returned by ResetSysAdminTwoFactorReq.ValidateAll() if the
+// designated constraints aren't met.
+type ResetSysAdminTwoFactorReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetSysAdminTwoFactorReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetSysAdminTwoFactorReqMultiError) AllErrors() []error { return m }
+
+// ResetSysAdminTwoFactorReqValidationError is the validation error returned by
+// ResetSysAdminTwoFactorReq.Validate if the designated constraints aren't met.
+type ResetSysAdminTwoFactorReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetSysAdminTwoFactorReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetSysAdminTwoFactorReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetSysAdminTwoFactorReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetSysAdminTwoFactorReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetSysAdminTwoFactorReqValidationError) ErrorName() string {
+	return "ResetSysAdminTwoFactorReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetSysAdminTwoFactorReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetSysAdminTwoFactorReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetSysAdminTwoFactorReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetSysAdminTwoFactorReqValidationError{}
+
+// Validate checks the field values on ResetSysAdminTwoFactorReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetSysAdminTwoFactorReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetSysAdminTwoFactorReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetSysAdminTwoFactorReplyMultiError, or nil if none found.
+func (m *ResetSysAdminTwoFactorReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetSysAdminTwoFactorReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResetSysAdminTwoFactorReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetSysAdminTwoFactorReplyMultiError is an error wrapping multiple
+// validation errors returned by ResetSysAdminTwoFactorReply.ValidateAll() if
+// the designated constraints aren't met.
+type ResetSysAdminTwoFactorReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetSysAdminTwoFactorReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetSysAdminTwoFactorReplyMultiError) AllErrors() []error { return m }
+
+// ResetSysAdminTwoFactorReplyValidationError is the validation error returned
+// by ResetSysAdminTwoFactorReply.Validate if the designated constraints
+// aren't met.
+type ResetSysAdminTwoFactorReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetSysAdminTwoFactorReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetSysAdminTwoFactorReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetSysAdminTwoFactorReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetSysAdminTwoFactorReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetSysAdminTwoFactorReplyValidationError) ErrorName() string {
+	return "ResetSysAdminTwoFactorReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetSysAdminTwoFactorReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetSysAdminTwoFactorReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetSysAdminTwoFactorReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetSysAdminTwoFactorReplyValidationError{}
@@ -38,6 +38,14 @@ type SysAdminClient interface {
 	GetSysAdminList(ctx context.Context, in *GetSysAdminListReq, opts ...grpc.CallOption) (*GetSysAdminListReply, error)
 	// 系统-用户-选择器
 	GetSysAdminSelector(ctx context.Context, in *GetSysAdminSelectorReq, opts ...grpc.CallOption) (*GetSysAdminSelectorReply, error)
+	// 系统-用户-登录会话列表
+	GetSysAdminSessionList(ctx context.Context, in *GetSysAdminSessionListReq, opts ...grpc.CallOption) (*GetSysAdminSessionListReply, error)
+	// 系统-用户-强制下线登录会话
+	DeleteSysAdminSession(ctx context.Context, in *DeleteSysAdminSessionReq, opts ...grpc.CallOption) (*DeleteSysAdminSessionReply, error)
+	// 系统-用户-重置两步验证
+	ResetSysAdminTwoFactor(ctx context.Context, in *ResetSysAdminTwoFactorReq, opts ...grpc.CallOption) (*ResetSysAdminTwoFactorReply, error)
+	// 系统-用户-解除登录锁定
+	UnlockSysAdmin(ctx context.Context, in *UnlockSysAdminReq, opts ...grpc.CallOption) (*UnlockSysAdminReply, error)
 }
 
 type sysAdminClient struct {
@@ -120,6 +128,42 @@ func (c *sysAdminClient) GetSysAdminSelector(ctx context.Context, in *GetSysAdmi
 	return out, nil
 }
 
+func (c *sysAdminClient) GetSysAdminSessionList(ctx context.Context, in *GetSysAdminSessionListReq, opts ...grpc.CallOption) (*GetSysAdminSessionListReply, error) {
+	out := new(GetSysAdminSessionListReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAdmin/GetSysAdminSessionList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAdminClient) DeleteSysAdminSession(ctx context.Context, in *DeleteSysAdminSessionReq, opts ...grpc.CallOption) (*DeleteSysAdminSessionReply, error) {
+	out := new(DeleteSysAdminSessionReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAdmin/DeleteSysAdminSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAdminClient) ResetSysAdminTwoFactor(ctx context.Context, in *ResetSysAdminTwoFactorReq, opts ...grpc.CallOption) (*ResetSysAdminTwoFactorReply, error) {
+	out := new(ResetSysAdminTwoFactorReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAdmin/ResetSysAdminTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAdminClient) UnlockSysAdmin(ctx context.Context, in *UnlockSysAdminReq, opts ...grpc.CallOption) (*UnlockSysAdminReply, error) {
+	out := new(UnlockSysAdminReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAdmin/UnlockSysAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SysAdminServer is the server API for SysAdmin service.
 // All implementations must embed UnimplementedSysAdminServer
 // for forward compatibility
@@ -140,6 +184,14 @@ type SysAdminServer interface {
 	GetSysAdminList(context.Context, *GetSysAdminListReq) (*GetSysAdminListReply, error)
 	// 系统-用户-选择器
 	GetSysAdminSelector(context.Context, *GetSysAdminSelectorReq) (*GetSysAdminSelectorReply, error)
+	// 系统-用户-登录会话列表
+	GetSysAdminSessionList(context.Context, *GetSysAdminSessionListReq) (*GetSysAdminSessionListReply, error)
+	// 系统-用户-强制下线登录会话
+	DeleteSysAdminSession(context.Context, *DeleteSysAdminSessionReq) (*DeleteSysAdminSessionReply, error)
+	// 系统-用户-重置两步验证
+	ResetSysAdminTwoFactor(context.Context, *ResetSysAdminTwoFactorReq) (*ResetSysAdminTwoFactorReply, error)
+	// 系统-用户-解除登录锁定
+	UnlockSysAdmin(context.Context, *UnlockSysAdminReq) (*UnlockSysAdminReply, error)
 	mustEmbedUnimplementedSysAdminServer()
 }
 
@@ -171,6 +223,18 @@ func (UnimplementedSysAdminServer) GetSysAdminList(context.Context, *GetSysAdmin
 func (UnimplementedSysAdminServer) GetSysAdminSelector(context.Context, *GetSysAdminSelectorReq) (*GetSysAdminSelectorReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSysAdminSelector not implemented")
 }
+func (UnimplementedSysAdminServer) GetSysAdminSessionList(context.Context, *GetSysAdminSessionListReq) (*GetSysAdminSessionListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSysAdminSessionList not implemented")
+}
+func (UnimplementedSysAdminServer) DeleteSysAdminSession(context.Context, *DeleteSysAdminSessionReq) (*DeleteSysAdminSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSysAdminSession not implemented")
+}
+func (UnimplementedSysAdminServer) ResetSysAdminTwoFactor(context.Context, *ResetSysAdminTwoFactorReq) (*ResetSysAdminTwoFactorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetSysAdminTwoFactor not implemented")
+}
+func (UnimplementedSysAdminServer) UnlockSysAdmin(context.Context, *UnlockSysAdminReq) (*UnlockSysAdminReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSysAdmin not implemented")
+}
 func (UnimplementedSysAdminServer) mustEmbedUnimplementedSysAdminServer() {}
 
 // UnsafeSysAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -328,6 +392,78 @@ func _SysAdmin_GetSysAdminSelector_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysAdmin_GetSysAdminSessionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSysAdminSessionListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAdminServer).GetSysAdminSessionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAdmin/GetSysAdminSessionList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAdminServer).GetSysAdminSessionList(ctx, req.(*GetSysAdminSessionListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAdmin_DeleteSysAdminSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSysAdminSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAdminServer).DeleteSysAdminSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAdmin/DeleteSysAdminSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAdminServer).DeleteSysAdminSession(ctx, req.(*DeleteSysAdminSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAdmin_ResetSysAdminTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetSysAdminTwoFactorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAdminServer).ResetSysAdminTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAdmin/ResetSysAdminTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAdminServer).ResetSysAdminTwoFactor(ctx, req.(*ResetSysAdminTwoFactorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAdmin_UnlockSysAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockSysAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAdminServer).UnlockSysAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAdmin/UnlockSysAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAdminServer).UnlockSysAdmin(ctx, req.(*UnlockSysAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SysAdmin_ServiceDesc is the grpc.ServiceDesc for SysAdmin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -367,6 +503,22 @@ var SysAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSysAdminSelector",
 			Handler:    _SysAdmin_GetSysAdminSelector_Handler,
 		},
+		{
+			MethodName: "GetSysAdminSessionList",
+			Handler:    _SysAdmin_GetSysAdminSessionList_Handler,
+		},
+		{
+			MethodName: "DeleteSysAdminSession",
+			Handler:    _SysAdmin_DeleteSysAdminSession_Handler,
+		},
+		{
+			MethodName: "ResetSysAdminTwoFactor",
+			Handler:    _SysAdmin_ResetSysAdminTwoFactor_Handler,
+		},
+		{
+			MethodName: "UnlockSysAdmin",
+			Handler:    _SysAdmin_UnlockSysAdmin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/sys_admin.proto",
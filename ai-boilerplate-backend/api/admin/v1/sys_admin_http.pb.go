@@ -21,9 +21,13 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationSysAdminCreateSysAdmin = "/admin.v1.SysAdmin/CreateSysAdmin"
 const OperationSysAdminDeleteSysAdmin = "/admin.v1.SysAdmin/DeleteSysAdmin"
+const OperationSysAdminDeleteSysAdminSession = "/admin.v1.SysAdmin/DeleteSysAdminSession"
 const OperationSysAdminGetSysAdminInfo = "/admin.v1.SysAdmin/GetSysAdminInfo"
 const OperationSysAdminGetSysAdminList = "/admin.v1.SysAdmin/GetSysAdminList"
 const OperationSysAdminGetSysAdminSelector = "/admin.v1.SysAdmin/GetSysAdminSelector"
+const OperationSysAdminGetSysAdminSessionList = "/admin.v1.SysAdmin/GetSysAdminSessionList"
+const OperationSysAdminResetSysAdminTwoFactor = "/admin.v1.SysAdmin/ResetSysAdminTwoFactor"
+const OperationSysAdminUnlockSysAdmin = "/admin.v1.SysAdmin/UnlockSysAdmin"
 const OperationSysAdminUpdateSysAdmin = "/admin.v1.SysAdmin/UpdateSysAdmin"
 const OperationSysAdminUpdateSysAdminPassword = "/admin.v1.SysAdmin/UpdateSysAdminPassword"
 const OperationSysAdminUpdateSysAdminStatus = "/admin.v1.SysAdmin/UpdateSysAdminStatus"
@@ -31,9 +35,13 @@ const OperationSysAdminUpdateSysAdminStatus = "/admin.v1.SysAdmin/UpdateSysAdmin
 type SysAdminHTTPServer interface {
 	CreateSysAdmin(context.Context, *CreateSysAdminReq) (*CreateSysAdminReply, error)
 	DeleteSysAdmin(context.Context, *DeleteSysAdminReq) (*DeleteSysAdminReply, error)
+	DeleteSysAdminSession(context.Context, *DeleteSysAdminSessionReq) (*DeleteSysAdminSessionReply, error)
 	GetSysAdminInfo(context.Context, *GetSysAdminInfoReq) (*GetSysAdminInfoReply, error)
 	GetSysAdminList(context.Context, *GetSysAdminListReq) (*GetSysAdminListReply, error)
 	GetSysAdminSelector(context.Context, *GetSysAdminSelectorReq) (*GetSysAdminSelectorReply, error)
+	GetSysAdminSessionList(context.Context, *GetSysAdminSessionListReq) (*GetSysAdminSessionListReply, error)
+	ResetSysAdminTwoFactor(context.Context, *ResetSysAdminTwoFactorReq) (*ResetSysAdminTwoFactorReply, error)
+	UnlockSysAdmin(context.Context, *UnlockSysAdminReq) (*UnlockSysAdminReply, error)
 	UpdateSysAdmin(context.Context, *UpdateSysAdminReq) (*UpdateSysAdminReply, error)
 	UpdateSysAdminPassword(context.Context, *UpdateSysAdminPasswordReq) (*UpdateSysAdminPasswordReply, error)
 	UpdateSysAdminStatus(context.Context, *UpdateSysAdminStatusReq) (*UpdateSysAdminStatusReply, error)
@@ -49,6 +57,10 @@ func RegisterSysAdminHTTPServer(s *http.Server, srv SysAdminHTTPServer) {
 	r.GET("/admin/v1/sys_admin/info", _SysAdmin_GetSysAdminInfo0_HTTP_Handler(srv))
 	r.GET("/admin/v1/sys_admin/list", _SysAdmin_GetSysAdminList0_HTTP_Handler(srv))
 	r.GET("/admin/v1/sys_admin/selector", _SysAdmin_GetSysAdminSelector0_HTTP_Handler(srv))
+	r.GET("/admin/v1/sys_admin/session/list", _SysAdmin_GetSysAdminSessionList0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_admin/session/delete", _SysAdmin_DeleteSysAdminSession0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_admin/two_factor/reset", _SysAdmin_ResetSysAdminTwoFactor0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_admin/unlock", _SysAdmin_UnlockSysAdmin0_HTTP_Handler(srv))
 }
 
 func _SysAdmin_CreateSysAdmin0_HTTP_Handler(srv SysAdminHTTPServer) func(ctx http.Context) error {
@@ -203,12 +215,92 @@ func _SysAdmin_GetSysAdminSelector0_HTTP_Handler(srv SysAdminHTTPServer) func(ct
 	}
 }
 
+func _SysAdmin_GetSysAdminSessionList0_HTTP_Handler(srv SysAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSysAdminSessionListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAdminGetSysAdminSessionList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSysAdminSessionList(ctx, req.(*GetSysAdminSessionListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSysAdminSessionListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysAdmin_DeleteSysAdminSession0_HTTP_Handler(srv SysAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSysAdminSessionReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAdminDeleteSysAdminSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSysAdminSession(ctx, req.(*DeleteSysAdminSessionReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteSysAdminSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysAdmin_ResetSysAdminTwoFactor0_HTTP_Handler(srv SysAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetSysAdminTwoFactorReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAdminResetSysAdminTwoFactor)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetSysAdminTwoFactor(ctx, req.(*ResetSysAdminTwoFactorReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetSysAdminTwoFactorReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysAdmin_UnlockSysAdmin0_HTTP_Handler(srv SysAdminHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlockSysAdminReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAdminUnlockSysAdmin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockSysAdmin(ctx, req.(*UnlockSysAdminReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlockSysAdminReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type SysAdminHTTPClient interface {
 	CreateSysAdmin(ctx context.Context, req *CreateSysAdminReq, opts ...http.CallOption) (rsp *CreateSysAdminReply, err error)
 	DeleteSysAdmin(ctx context.Context, req *DeleteSysAdminReq, opts ...http.CallOption) (rsp *DeleteSysAdminReply, err error)
+	DeleteSysAdminSession(ctx context.Context, req *DeleteSysAdminSessionReq, opts ...http.CallOption) (rsp *DeleteSysAdminSessionReply, err error)
 	GetSysAdminInfo(ctx context.Context, req *GetSysAdminInfoReq, opts ...http.CallOption) (rsp *GetSysAdminInfoReply, err error)
 	GetSysAdminList(ctx context.Context, req *GetSysAdminListReq, opts ...http.CallOption) (rsp *GetSysAdminListReply, err error)
 	GetSysAdminSelector(ctx context.Context, req *GetSysAdminSelectorReq, opts ...http.CallOption) (rsp *GetSysAdminSelectorReply, err error)
+	GetSysAdminSessionList(ctx context.Context, req *GetSysAdminSessionListReq, opts ...http.CallOption) (rsp *GetSysAdminSessionListReply, err error)
+	ResetSysAdminTwoFactor(ctx context.Context, req *ResetSysAdminTwoFactorReq, opts ...http.CallOption) (rsp *ResetSysAdminTwoFactorReply, err error)
+	UnlockSysAdmin(ctx context.Context, req *UnlockSysAdminReq, opts ...http.CallOption) (rsp *UnlockSysAdminReply, err error)
 	UpdateSysAdmin(ctx context.Context, req *UpdateSysAdminReq, opts ...http.CallOption) (rsp *UpdateSysAdminReply, err error)
 	UpdateSysAdminPassword(ctx context.Context, req *UpdateSysAdminPasswordReq, opts ...http.CallOption) (rsp *UpdateSysAdminPasswordReply, err error)
 	UpdateSysAdminStatus(ctx context.Context, req *UpdateSysAdminStatusReq, opts ...http.CallOption) (rsp *UpdateSysAdminStatusReply, err error)
@@ -248,6 +340,19 @@ func (c *SysAdminHTTPClientImpl) DeleteSysAdmin(ctx context.Context, in *DeleteS
 	return &out, err
 }
 
+func (c *SysAdminHTTPClientImpl) DeleteSysAdminSession(ctx context.Context, in *DeleteSysAdminSessionReq, opts ...http.CallOption) (*DeleteSysAdminSessionReply, error) {
+	var out DeleteSysAdminSessionReply
+	pattern := "/admin/v1/sys_admin/session/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAdminDeleteSysAdminSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *SysAdminHTTPClientImpl) GetSysAdminInfo(ctx context.Context, in *GetSysAdminInfoReq, opts ...http.CallOption) (*GetSysAdminInfoReply, error) {
 	var out GetSysAdminInfoReply
 	pattern := "/admin/v1/sys_admin/info"
@@ -287,6 +392,45 @@ func (c *SysAdminHTTPClientImpl) GetSysAdminSelector(ctx context.Context, in *Ge
 	return &out, err
 }
 
+func (c *SysAdminHTTPClientImpl) GetSysAdminSessionList(ctx context.Context, in *GetSysAdminSessionListReq, opts ...http.CallOption) (*GetSysAdminSessionListReply, error) {
+	var out GetSysAdminSessionListReply
+	pattern := "/admin/v1/sys_admin/session/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSysAdminGetSysAdminSessionList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SysAdminHTTPClientImpl) ResetSysAdminTwoFactor(ctx context.Context, in *ResetSysAdminTwoFactorReq, opts ...http.CallOption) (*ResetSysAdminTwoFactorReply, error) {
+	var out ResetSysAdminTwoFactorReply
+	pattern := "/admin/v1/sys_admin/two_factor/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAdminResetSysAdminTwoFactor))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SysAdminHTTPClientImpl) UnlockSysAdmin(ctx context.Context, in *UnlockSysAdminReq, opts ...http.CallOption) (*UnlockSysAdminReply, error) {
+	var out UnlockSysAdminReply
+	pattern := "/admin/v1/sys_admin/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAdminUnlockSysAdmin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *SysAdminHTTPClientImpl) UpdateSysAdmin(ctx context.Context, in *UpdateSysAdminReq, opts ...http.CallOption) (*UpdateSysAdminReply, error) {
 	var out UpdateSysAdminReply
 	pattern := "/admin/v1/sys_admin/update"
@@ -221,9 +221,14 @@ type SysAuthLoginReply struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`          //token
-	ExpiredAt int64  `protobuf:"varint,2,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"` //过期时间
-	RefreshAt int64  `protobuf:"varint,3,opt,name=refreshAt,proto3" json:"refreshAt,omitempty"` //刷新时间
+	Token                  string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                    //token
+	ExpiredAt              int64  `protobuf:"varint,2,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`                           //过期时间
+	RefreshAt              int64  `protobuf:"varint,3,opt,name=refreshAt,proto3" json:"refreshAt,omitempty"`                           //刷新时间
+	RefreshToken           string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`                      //刷新token
+	RefreshExpiredAt       int64  `protobuf:"varint,5,opt,name=refreshExpiredAt,proto3" json:"refreshExpiredAt,omitempty"`             //刷新token过期时间
+	TwoFactorRequired      bool   `protobuf:"varint,6,opt,name=twoFactorRequired,proto3" json:"twoFactorRequired,omitempty"`           //是否需要两步验证, 为 true 时不返回 token
+	TwoFactorSetupRequired bool   `protobuf:"varint,7,opt,name=twoFactorSetupRequired,proto3" json:"twoFactorSetupRequired,omitempty"` //是否需要先绑定两步验证
+	TwoFactorChallenge     string `protobuf:"bytes,8,opt,name=twoFactorChallenge,proto3" json:"twoFactorChallenge,omitempty"`          //两步验证挑战
 }
 
 func (x *SysAuthLoginReply) Reset() {
@@ -279,6 +284,663 @@ func (x *SysAuthLoginReply) GetRefreshAt() int64 {
 	return 0
 }
 
+func (x *SysAuthLoginReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *SysAuthLoginReply) GetRefreshExpiredAt() int64 {
+	if x != nil {
+		return x.RefreshExpiredAt
+	}
+	return 0
+}
+
+func (x *SysAuthLoginReply) GetTwoFactorRequired() bool {
+	if x != nil {
+		return x.TwoFactorRequired
+	}
+	return false
+}
+
+func (x *SysAuthLoginReply) GetTwoFactorSetupRequired() bool {
+	if x != nil {
+		return x.TwoFactorSetupRequired
+	}
+	return false
+}
+
+func (x *SysAuthLoginReply) GetTwoFactorChallenge() string {
+	if x != nil {
+		return x.TwoFactorChallenge
+	}
+	return ""
+}
+
+// 请求-两步验证登录
+type SysAuthTwoFactorLoginReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"` //两步验证挑战
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`           //验证码或恢复码
+}
+
+func (x *SysAuthTwoFactorLoginReq) Reset() {
+	*x = SysAuthTwoFactorLoginReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorLoginReq) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorLoginReq.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorLoginReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SysAuthTwoFactorLoginReq) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *SysAuthTwoFactorLoginReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 响应-两步验证登录
+type SysAuthTwoFactorLoginReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token            string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                        //token
+	ExpiredAt        int64    `protobuf:"varint,2,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`               //过期时间
+	RefreshAt        int64    `protobuf:"varint,3,opt,name=refreshAt,proto3" json:"refreshAt,omitempty"`               //刷新时间
+	RefreshToken     string   `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`          //刷新token
+	RefreshExpiredAt int64    `protobuf:"varint,5,opt,name=refreshExpiredAt,proto3" json:"refreshExpiredAt,omitempty"` //刷新token过期时间
+	RecoveryCodes    []string `protobuf:"bytes,6,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"`        //恢复码(登录时完成绑定才返回)
+}
+
+func (x *SysAuthTwoFactorLoginReply) Reset() {
+	*x = SysAuthTwoFactorLoginReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorLoginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorLoginReply) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorLoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorLoginReply.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorLoginReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SysAuthTwoFactorLoginReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SysAuthTwoFactorLoginReply) GetExpiredAt() int64 {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return 0
+}
+
+func (x *SysAuthTwoFactorLoginReply) GetRefreshAt() int64 {
+	if x != nil {
+		return x.RefreshAt
+	}
+	return 0
+}
+
+func (x *SysAuthTwoFactorLoginReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *SysAuthTwoFactorLoginReply) GetRefreshExpiredAt() int64 {
+	if x != nil {
+		return x.RefreshExpiredAt
+	}
+	return 0
+}
+
+func (x *SysAuthTwoFactorLoginReply) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// 请求-两步验证登录时绑定
+type SysAuthTwoFactorLoginSetupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"` //两步验证挑战
+}
+
+func (x *SysAuthTwoFactorLoginSetupReq) Reset() {
+	*x = SysAuthTwoFactorLoginSetupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorLoginSetupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorLoginSetupReq) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorLoginSetupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorLoginSetupReq.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorLoginSetupReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SysAuthTwoFactorLoginSetupReq) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+// 响应-两步验证登录时绑定
+type SysAuthTwoFactorLoginSetupReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret          string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`                   //密钥
+	ProvisioningUri string `protobuf:"bytes,2,opt,name=provisioningUri,proto3" json:"provisioningUri,omitempty"` //二维码内容
+}
+
+func (x *SysAuthTwoFactorLoginSetupReply) Reset() {
+	*x = SysAuthTwoFactorLoginSetupReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorLoginSetupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorLoginSetupReply) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorLoginSetupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorLoginSetupReply.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorLoginSetupReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SysAuthTwoFactorLoginSetupReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *SysAuthTwoFactorLoginSetupReply) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+// 请求-两步验证绑定
+type SysAuthTwoFactorSetupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SysAuthTwoFactorSetupReq) Reset() {
+	*x = SysAuthTwoFactorSetupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorSetupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorSetupReq) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorSetupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorSetupReq.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorSetupReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{7}
+}
+
+// 响应-两步验证绑定
+type SysAuthTwoFactorSetupReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret          string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`                   //密钥
+	ProvisioningUri string `protobuf:"bytes,2,opt,name=provisioningUri,proto3" json:"provisioningUri,omitempty"` //二维码内容
+}
+
+func (x *SysAuthTwoFactorSetupReply) Reset() {
+	*x = SysAuthTwoFactorSetupReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorSetupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorSetupReply) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorSetupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorSetupReply.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorSetupReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SysAuthTwoFactorSetupReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *SysAuthTwoFactorSetupReply) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+// 请求-两步验证开启
+type SysAuthTwoFactorEnableReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` //验证码
+}
+
+func (x *SysAuthTwoFactorEnableReq) Reset() {
+	*x = SysAuthTwoFactorEnableReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorEnableReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorEnableReq) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorEnableReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorEnableReq.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorEnableReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SysAuthTwoFactorEnableReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 响应-两步验证开启
+type SysAuthTwoFactorEnableReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"` //恢复码
+}
+
+func (x *SysAuthTwoFactorEnableReply) Reset() {
+	*x = SysAuthTwoFactorEnableReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorEnableReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorEnableReply) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorEnableReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorEnableReply.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorEnableReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SysAuthTwoFactorEnableReply) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// 请求-两步验证关闭
+type SysAuthTwoFactorDisableReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` //验证码或恢复码
+}
+
+func (x *SysAuthTwoFactorDisableReq) Reset() {
+	*x = SysAuthTwoFactorDisableReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorDisableReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorDisableReq) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorDisableReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorDisableReq.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorDisableReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SysAuthTwoFactorDisableReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 响应-两步验证关闭
+type SysAuthTwoFactorDisableReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SysAuthTwoFactorDisableReply) Reset() {
+	*x = SysAuthTwoFactorDisableReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorDisableReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorDisableReply) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorDisableReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorDisableReply.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorDisableReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{12}
+}
+
+// 请求-两步验证重新生成恢复码
+type SysAuthTwoFactorRecoveryCodesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` //验证码
+}
+
+func (x *SysAuthTwoFactorRecoveryCodesReq) Reset() {
+	*x = SysAuthTwoFactorRecoveryCodesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorRecoveryCodesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorRecoveryCodesReq) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorRecoveryCodesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorRecoveryCodesReq.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorRecoveryCodesReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SysAuthTwoFactorRecoveryCodesReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 响应-两步验证重新生成恢复码
+type SysAuthTwoFactorRecoveryCodesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"` //恢复码
+}
+
+func (x *SysAuthTwoFactorRecoveryCodesReply) Reset() {
+	*x = SysAuthTwoFactorRecoveryCodesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthTwoFactorRecoveryCodesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthTwoFactorRecoveryCodesReply) ProtoMessage() {}
+
+func (x *SysAuthTwoFactorRecoveryCodesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthTwoFactorRecoveryCodesReply.ProtoReflect.Descriptor instead.
+func (*SysAuthTwoFactorRecoveryCodesReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SysAuthTwoFactorRecoveryCodesReply) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
 // 请求-退出
 type SysAuthLogoutReq struct {
 	state         protoimpl.MessageState
@@ -289,7 +951,7 @@ type SysAuthLogoutReq struct {
 func (x *SysAuthLogoutReq) Reset() {
 	*x = SysAuthLogoutReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[3]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -302,7 +964,7 @@ func (x *SysAuthLogoutReq) String() string {
 func (*SysAuthLogoutReq) ProtoMessage() {}
 
 func (x *SysAuthLogoutReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[3]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,33 +977,126 @@ func (x *SysAuthLogoutReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthLogoutReq.ProtoReflect.Descriptor instead.
 func (*SysAuthLogoutReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{3}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{15}
+}
+
+// 响应-退出
+type SysAuthLogoutReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SysAuthLogoutReply) Reset() {
+	*x = SysAuthLogoutReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthLogoutReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthLogoutReply) ProtoMessage() {}
+
+func (x *SysAuthLogoutReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthLogoutReply.ProtoReflect.Descriptor instead.
+func (*SysAuthLogoutReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{16}
+}
+
+// 请求-刷新token
+type SysAuthRefreshTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"` //刷新token
+}
+
+func (x *SysAuthRefreshTokenReq) Reset() {
+	*x = SysAuthRefreshTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysAuthRefreshTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysAuthRefreshTokenReq) ProtoMessage() {}
+
+func (x *SysAuthRefreshTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysAuthRefreshTokenReq.ProtoReflect.Descriptor instead.
+func (*SysAuthRefreshTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SysAuthRefreshTokenReq) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
 }
 
-// 响应-退出
-type SysAuthLogoutReply struct {
+// 响应-刷新token
+type SysAuthRefreshTokenReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Token            string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                        //token
+	ExpiredAt        int64  `protobuf:"varint,2,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`               //过期时间
+	RefreshAt        int64  `protobuf:"varint,3,opt,name=refreshAt,proto3" json:"refreshAt,omitempty"`               //刷新时间
+	RefreshToken     string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`          //刷新token
+	RefreshExpiredAt int64  `protobuf:"varint,5,opt,name=refreshExpiredAt,proto3" json:"refreshExpiredAt,omitempty"` //刷新token过期时间
 }
 
-func (x *SysAuthLogoutReply) Reset() {
-	*x = SysAuthLogoutReply{}
+func (x *SysAuthRefreshTokenReply) Reset() {
+	*x = SysAuthRefreshTokenReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[4]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SysAuthLogoutReply) String() string {
+func (x *SysAuthRefreshTokenReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SysAuthLogoutReply) ProtoMessage() {}
+func (*SysAuthRefreshTokenReply) ProtoMessage() {}
 
-func (x *SysAuthLogoutReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[4]
+func (x *SysAuthRefreshTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,9 +1107,44 @@ func (x *SysAuthLogoutReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SysAuthLogoutReply.ProtoReflect.Descriptor instead.
-func (*SysAuthLogoutReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SysAuthRefreshTokenReply.ProtoReflect.Descriptor instead.
+func (*SysAuthRefreshTokenReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SysAuthRefreshTokenReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SysAuthRefreshTokenReply) GetExpiredAt() int64 {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return 0
+}
+
+func (x *SysAuthRefreshTokenReply) GetRefreshAt() int64 {
+	if x != nil {
+		return x.RefreshAt
+	}
+	return 0
+}
+
+func (x *SysAuthRefreshTokenReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *SysAuthRefreshTokenReply) GetRefreshExpiredAt() int64 {
+	if x != nil {
+		return x.RefreshExpiredAt
+	}
+	return 0
 }
 
 // 请求-检查token
@@ -369,7 +1159,7 @@ type SysAuthCheckTokenReq struct {
 func (x *SysAuthCheckTokenReq) Reset() {
 	*x = SysAuthCheckTokenReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[5]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -382,7 +1172,7 @@ func (x *SysAuthCheckTokenReq) String() string {
 func (*SysAuthCheckTokenReq) ProtoMessage() {}
 
 func (x *SysAuthCheckTokenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[5]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +1185,7 @@ func (x *SysAuthCheckTokenReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthCheckTokenReq.ProtoReflect.Descriptor instead.
 func (*SysAuthCheckTokenReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{5}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SysAuthCheckTokenReq) GetToken() string {
@@ -411,15 +1201,16 @@ type SysAuthCheckTokenReply struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdminId  string `protobuf:"bytes,1,opt,name=adminId,proto3" json:"adminId,omitempty"`   //管理员ID
-	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"` //昵称
-	TenantId string `protobuf:"bytes,3,opt,name=tenantId,proto3" json:"tenantId,omitempty"` //租户ID
+	AdminId   string `protobuf:"bytes,1,opt,name=adminId,proto3" json:"adminId,omitempty"`     //管理员ID
+	Nickname  string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`   //昵称
+	TenantId  string `protobuf:"bytes,3,opt,name=tenantId,proto3" json:"tenantId,omitempty"`   //租户ID
+	SessionId string `protobuf:"bytes,4,opt,name=sessionId,proto3" json:"sessionId,omitempty"` //登录会话编号
 }
 
 func (x *SysAuthCheckTokenReply) Reset() {
 	*x = SysAuthCheckTokenReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[6]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -432,7 +1223,7 @@ func (x *SysAuthCheckTokenReply) String() string {
 func (*SysAuthCheckTokenReply) ProtoMessage() {}
 
 func (x *SysAuthCheckTokenReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[6]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +1236,7 @@ func (x *SysAuthCheckTokenReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthCheckTokenReply.ProtoReflect.Descriptor instead.
 func (*SysAuthCheckTokenReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{6}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SysAuthCheckTokenReply) GetAdminId() string {
@@ -469,6 +1260,13 @@ func (x *SysAuthCheckTokenReply) GetTenantId() string {
 	return ""
 }
 
+func (x *SysAuthCheckTokenReply) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 // 请求-查询用户信息
 type SysAuthAdminInfoReq struct {
 	state         protoimpl.MessageState
@@ -479,7 +1277,7 @@ type SysAuthAdminInfoReq struct {
 func (x *SysAuthAdminInfoReq) Reset() {
 	*x = SysAuthAdminInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[7]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -492,7 +1290,7 @@ func (x *SysAuthAdminInfoReq) String() string {
 func (*SysAuthAdminInfoReq) ProtoMessage() {}
 
 func (x *SysAuthAdminInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[7]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +1303,7 @@ func (x *SysAuthAdminInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthAdminInfoReq.ProtoReflect.Descriptor instead.
 func (*SysAuthAdminInfoReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{7}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{21}
 }
 
 // 响应-查询用户信息
@@ -520,7 +1318,7 @@ type SysAuthAdminInfoReply struct {
 func (x *SysAuthAdminInfoReply) Reset() {
 	*x = SysAuthAdminInfoReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[8]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -533,7 +1331,7 @@ func (x *SysAuthAdminInfoReply) String() string {
 func (*SysAuthAdminInfoReply) ProtoMessage() {}
 
 func (x *SysAuthAdminInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[8]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +1344,7 @@ func (x *SysAuthAdminInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthAdminInfoReply.ProtoReflect.Descriptor instead.
 func (*SysAuthAdminInfoReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{8}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SysAuthAdminInfoReply) GetInfo() *SysAdminInfo {
@@ -570,7 +1368,7 @@ type SysAuthUpdateAdminInfoReq struct {
 func (x *SysAuthUpdateAdminInfoReq) Reset() {
 	*x = SysAuthUpdateAdminInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[9]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -583,7 +1381,7 @@ func (x *SysAuthUpdateAdminInfoReq) String() string {
 func (*SysAuthUpdateAdminInfoReq) ProtoMessage() {}
 
 func (x *SysAuthUpdateAdminInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[9]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +1394,7 @@ func (x *SysAuthUpdateAdminInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthUpdateAdminInfoReq.ProtoReflect.Descriptor instead.
 func (*SysAuthUpdateAdminInfoReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{9}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SysAuthUpdateAdminInfoReq) GetNickname() string {
@@ -630,7 +1428,7 @@ type SysAuthUpdateAdminInfoReply struct {
 func (x *SysAuthUpdateAdminInfoReply) Reset() {
 	*x = SysAuthUpdateAdminInfoReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[10]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -643,7 +1441,7 @@ func (x *SysAuthUpdateAdminInfoReply) String() string {
 func (*SysAuthUpdateAdminInfoReply) ProtoMessage() {}
 
 func (x *SysAuthUpdateAdminInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[10]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +1454,7 @@ func (x *SysAuthUpdateAdminInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthUpdateAdminInfoReply.ProtoReflect.Descriptor instead.
 func (*SysAuthUpdateAdminInfoReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{10}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{24}
 }
 
 // 请求-更新密码
@@ -672,7 +1470,7 @@ type SysAuthUpdateAdminPasswordReq struct {
 func (x *SysAuthUpdateAdminPasswordReq) Reset() {
 	*x = SysAuthUpdateAdminPasswordReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[11]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +1483,7 @@ func (x *SysAuthUpdateAdminPasswordReq) String() string {
 func (*SysAuthUpdateAdminPasswordReq) ProtoMessage() {}
 
 func (x *SysAuthUpdateAdminPasswordReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[11]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +1496,7 @@ func (x *SysAuthUpdateAdminPasswordReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthUpdateAdminPasswordReq.ProtoReflect.Descriptor instead.
 func (*SysAuthUpdateAdminPasswordReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{11}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SysAuthUpdateAdminPasswordReq) GetOldPassword() string {
@@ -725,7 +1523,7 @@ type SysAuthUpdateAdminPasswordReply struct {
 func (x *SysAuthUpdateAdminPasswordReply) Reset() {
 	*x = SysAuthUpdateAdminPasswordReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[12]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -738,7 +1536,7 @@ func (x *SysAuthUpdateAdminPasswordReply) String() string {
 func (*SysAuthUpdateAdminPasswordReply) ProtoMessage() {}
 
 func (x *SysAuthUpdateAdminPasswordReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[12]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +1549,7 @@ func (x *SysAuthUpdateAdminPasswordReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthUpdateAdminPasswordReply.ProtoReflect.Descriptor instead.
 func (*SysAuthUpdateAdminPasswordReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{12}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{26}
 }
 
 // 请求-获取菜单
@@ -764,7 +1562,7 @@ type SysAuthMenuReq struct {
 func (x *SysAuthMenuReq) Reset() {
 	*x = SysAuthMenuReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[13]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -777,7 +1575,7 @@ func (x *SysAuthMenuReq) String() string {
 func (*SysAuthMenuReq) ProtoMessage() {}
 
 func (x *SysAuthMenuReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[13]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1588,7 @@ func (x *SysAuthMenuReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthMenuReq.ProtoReflect.Descriptor instead.
 func (*SysAuthMenuReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{13}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{27}
 }
 
 // 响应-获取菜单
@@ -805,7 +1603,7 @@ type SysAuthMenuReply struct {
 func (x *SysAuthMenuReply) Reset() {
 	*x = SysAuthMenuReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[14]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -818,7 +1616,7 @@ func (x *SysAuthMenuReply) String() string {
 func (*SysAuthMenuReply) ProtoMessage() {}
 
 func (x *SysAuthMenuReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[14]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +1629,7 @@ func (x *SysAuthMenuReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthMenuReply.ProtoReflect.Descriptor instead.
 func (*SysAuthMenuReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{14}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SysAuthMenuReply) GetMenu() []*SysMenuItem {
@@ -851,7 +1649,7 @@ type SysAuthPermissionReq struct {
 func (x *SysAuthPermissionReq) Reset() {
 	*x = SysAuthPermissionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[15]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -864,7 +1662,7 @@ func (x *SysAuthPermissionReq) String() string {
 func (*SysAuthPermissionReq) ProtoMessage() {}
 
 func (x *SysAuthPermissionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[15]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +1675,7 @@ func (x *SysAuthPermissionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthPermissionReq.ProtoReflect.Descriptor instead.
 func (*SysAuthPermissionReq) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{15}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{29}
 }
 
 // 响应-获取权限
@@ -892,7 +1690,7 @@ type SysAuthPermissionReply struct {
 func (x *SysAuthPermissionReply) Reset() {
 	*x = SysAuthPermissionReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_v1_sys_auth_proto_msgTypes[16]
+		mi := &file_admin_v1_sys_auth_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -905,7 +1703,7 @@ func (x *SysAuthPermissionReply) String() string {
 func (*SysAuthPermissionReply) ProtoMessage() {}
 
 func (x *SysAuthPermissionReply) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_sys_auth_proto_msgTypes[16]
+	mi := &file_admin_v1_sys_auth_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1716,7 @@ func (x *SysAuthPermissionReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SysAuthPermissionReply.ProtoReflect.Descriptor instead.
 func (*SysAuthPermissionReply) Descriptor() ([]byte, []int) {
-	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{16}
+	return file_admin_v1_sys_auth_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SysAuthPermissionReply) GetPermission() []string {
@@ -969,25 +1767,126 @@ var file_admin_v1_sys_auth_proto_rawDesc = []byte{
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
 	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x3a, 0x1b, 0x92, 0x41, 0x18, 0x0a,
 	0x16, 0xd2, 0x01, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x65, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x41, 0x75,
-	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74, 0x22, 0x12,
-	0x0a, 0x10, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52,
-	0x65, 0x71, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x0a, 0x14, 0x53, 0x79, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6a, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xcb, 0x02, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x2c, 0x0a, 0x11, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x74, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x16, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x74,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x78, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x25, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x3a, 0x18, 0x92, 0x41, 0x15, 0x0a, 0x13, 0xd2, 0x01, 0x09, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0xe4, 0x01, 0x0a, 0x1a, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x1d, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74,
+	0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53,
+	0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x3a, 0x11,
+	0x92, 0x41, 0x0e, 0x0a, 0x0c, 0xd2, 0x01, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x22, 0x63, 0x0a, 0x1f, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x69, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x6e, 0x67, 0x55, 0x72, 0x69, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74,
+	0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x22, 0x5e, 0x0a, 0x1a, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x55,
+	0x72, 0x69, 0x22, 0x46, 0x0a, 0x19, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x3a, 0x0c, 0x92, 0x41,
+	0x09, 0x0a, 0x07, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x43, 0x0a, 0x1b, 0x53, 0x79,
+	0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22,
+	0x47, 0x0a, 0x1a, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x3a, 0x0c, 0x92, 0x41, 0x09, 0x0a,
+	0x07, 0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x79, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4d, 0x0a, 0x20, 0x53, 0x79, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x3a, 0x0c, 0x92, 0x41, 0x09, 0x0a, 0x07,
+	0xd2, 0x01, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x22, 0x53, 0x79, 0x73, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a,
+	0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x41, 0x75,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x5b, 0x0a,
+	0x16, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x14, 0x92, 0x41, 0x11, 0x0a, 0x0f, 0xd2, 0x01, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x18, 0x53,
+	0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a,
+	0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2c, 0x0a, 0x14, 0x53, 0x79, 0x73,
+	0x41, 0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
 	0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d,
 	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x22, 0x43, 0x0a, 0x15, 0x53, 0x79, 0x73,
 	0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70,
@@ -1024,7 +1923,7 @@ var file_admin_v1_sys_auth_proto_rawDesc = []byte{
 	0x74, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
 	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x32, 0xdf, 0x07, 0x0a, 0x07, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x12, 0x6b, 0x0a,
+	0x6e, 0x32, 0xa4, 0x10, 0x0a, 0x07, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x12, 0x6b, 0x0a,
 	0x0c, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x19, 0x2e,
 	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68,
 	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
@@ -1038,59 +1937,127 @@ var file_admin_v1_sys_auth_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
 	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f,
 	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61, 0x75, 0x74,
-	0x68, 0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x57, 0x0a, 0x11, 0x53,
-	0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x1a, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
-	0x12, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f,
-	0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12,
-	0x95, 0x01, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
-	0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75,
+	0x68, 0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x13,
+	0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x22, 0x20, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x91, 0x01, 0x0a, 0x15, 0x53, 0x79, 0x73, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x22, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x28, 0x22, 0x23, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79,
+	0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0xa6, 0x01, 0x0a, 0x1a, 0x53,
+	0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x1a, 0x29, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x29, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70,
+	0x3a, 0x01, 0x2a, 0x12, 0x91, 0x01, 0x0a, 0x15, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x22, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x1a, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73,
+	0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74,
+	0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22,
+	0x23, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x2f, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x73,
+	0x65, 0x74, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x95, 0x01, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x24, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x77, 0x6f, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x99, 0x01, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x26, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73,
+	0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2a, 0x22, 0x25, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x2f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xb2, 0x01, 0x0a, 0x1d,
+	0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2a, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22,
+	0x2c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x2f, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x57, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x10, 0x53, 0x79, 0x73,
+	0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x95, 0x01, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x23, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75,
 	0x74, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x24,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0xa5, 0x01, 0x0a, 0x1a, 0x53, 0x79, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a,
-	0x29, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75,
-	0x74, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2d, 0x22, 0x28, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79,
-	0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x3a, 0x01, 0x2a, 0x12,
-	0x64, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x18,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74,
-	0x68, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x6e, 0x75, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68,
-	0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x7c, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x79, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f,
-	0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x29, 0x22, 0x24, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x79, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0xa5, 0x01, 0x0a,
+	0x1a, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x28, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x3a, 0x01, 0x2a, 0x12, 0x64, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4d,
+	0x65, 0x6e, 0x75, 0x12, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x12, 0x17, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x7c, 0x0a, 0x11, 0x53, 0x79,
+	0x73, 0x41, 0x75, 0x74, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x20, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x61, 0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1105,49 +2072,77 @@ func file_admin_v1_sys_auth_proto_rawDescGZIP() []byte {
 	return file_admin_v1_sys_auth_proto_rawDescData
 }
 
-var file_admin_v1_sys_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_admin_v1_sys_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_admin_v1_sys_auth_proto_goTypes = []interface{}{
-	(*SysMenuItem)(nil),                     // 0: admin.v1.SysMenuItem
-	(*SysAuthLoginReq)(nil),                 // 1: admin.v1.SysAuthLoginReq
-	(*SysAuthLoginReply)(nil),               // 2: admin.v1.SysAuthLoginReply
-	(*SysAuthLogoutReq)(nil),                // 3: admin.v1.SysAuthLogoutReq
-	(*SysAuthLogoutReply)(nil),              // 4: admin.v1.SysAuthLogoutReply
-	(*SysAuthCheckTokenReq)(nil),            // 5: admin.v1.SysAuthCheckTokenReq
-	(*SysAuthCheckTokenReply)(nil),          // 6: admin.v1.SysAuthCheckTokenReply
-	(*SysAuthAdminInfoReq)(nil),             // 7: admin.v1.SysAuthAdminInfoReq
-	(*SysAuthAdminInfoReply)(nil),           // 8: admin.v1.SysAuthAdminInfoReply
-	(*SysAuthUpdateAdminInfoReq)(nil),       // 9: admin.v1.SysAuthUpdateAdminInfoReq
-	(*SysAuthUpdateAdminInfoReply)(nil),     // 10: admin.v1.SysAuthUpdateAdminInfoReply
-	(*SysAuthUpdateAdminPasswordReq)(nil),   // 11: admin.v1.SysAuthUpdateAdminPasswordReq
-	(*SysAuthUpdateAdminPasswordReply)(nil), // 12: admin.v1.SysAuthUpdateAdminPasswordReply
-	(*SysAuthMenuReq)(nil),                  // 13: admin.v1.SysAuthMenuReq
-	(*SysAuthMenuReply)(nil),                // 14: admin.v1.SysAuthMenuReply
-	(*SysAuthPermissionReq)(nil),            // 15: admin.v1.SysAuthPermissionReq
-	(*SysAuthPermissionReply)(nil),          // 16: admin.v1.SysAuthPermissionReply
-	(*SysAdminInfo)(nil),                    // 17: admin.v1.SysAdminInfo
+	(*SysMenuItem)(nil),                        // 0: admin.v1.SysMenuItem
+	(*SysAuthLoginReq)(nil),                    // 1: admin.v1.SysAuthLoginReq
+	(*SysAuthLoginReply)(nil),                  // 2: admin.v1.SysAuthLoginReply
+	(*SysAuthTwoFactorLoginReq)(nil),           // 3: admin.v1.SysAuthTwoFactorLoginReq
+	(*SysAuthTwoFactorLoginReply)(nil),         // 4: admin.v1.SysAuthTwoFactorLoginReply
+	(*SysAuthTwoFactorLoginSetupReq)(nil),      // 5: admin.v1.SysAuthTwoFactorLoginSetupReq
+	(*SysAuthTwoFactorLoginSetupReply)(nil),    // 6: admin.v1.SysAuthTwoFactorLoginSetupReply
+	(*SysAuthTwoFactorSetupReq)(nil),           // 7: admin.v1.SysAuthTwoFactorSetupReq
+	(*SysAuthTwoFactorSetupReply)(nil),         // 8: admin.v1.SysAuthTwoFactorSetupReply
+	(*SysAuthTwoFactorEnableReq)(nil),          // 9: admin.v1.SysAuthTwoFactorEnableReq
+	(*SysAuthTwoFactorEnableReply)(nil),        // 10: admin.v1.SysAuthTwoFactorEnableReply
+	(*SysAuthTwoFactorDisableReq)(nil),         // 11: admin.v1.SysAuthTwoFactorDisableReq
+	(*SysAuthTwoFactorDisableReply)(nil),       // 12: admin.v1.SysAuthTwoFactorDisableReply
+	(*SysAuthTwoFactorRecoveryCodesReq)(nil),   // 13: admin.v1.SysAuthTwoFactorRecoveryCodesReq
+	(*SysAuthTwoFactorRecoveryCodesReply)(nil), // 14: admin.v1.SysAuthTwoFactorRecoveryCodesReply
+	(*SysAuthLogoutReq)(nil),                   // 15: admin.v1.SysAuthLogoutReq
+	(*SysAuthLogoutReply)(nil),                 // 16: admin.v1.SysAuthLogoutReply
+	(*SysAuthRefreshTokenReq)(nil),             // 17: admin.v1.SysAuthRefreshTokenReq
+	(*SysAuthRefreshTokenReply)(nil),           // 18: admin.v1.SysAuthRefreshTokenReply
+	(*SysAuthCheckTokenReq)(nil),               // 19: admin.v1.SysAuthCheckTokenReq
+	(*SysAuthCheckTokenReply)(nil),             // 20: admin.v1.SysAuthCheckTokenReply
+	(*SysAuthAdminInfoReq)(nil),                // 21: admin.v1.SysAuthAdminInfoReq
+	(*SysAuthAdminInfoReply)(nil),              // 22: admin.v1.SysAuthAdminInfoReply
+	(*SysAuthUpdateAdminInfoReq)(nil),          // 23: admin.v1.SysAuthUpdateAdminInfoReq
+	(*SysAuthUpdateAdminInfoReply)(nil),        // 24: admin.v1.SysAuthUpdateAdminInfoReply
+	(*SysAuthUpdateAdminPasswordReq)(nil),      // 25: admin.v1.SysAuthUpdateAdminPasswordReq
+	(*SysAuthUpdateAdminPasswordReply)(nil),    // 26: admin.v1.SysAuthUpdateAdminPasswordReply
+	(*SysAuthMenuReq)(nil),                     // 27: admin.v1.SysAuthMenuReq
+	(*SysAuthMenuReply)(nil),                   // 28: admin.v1.SysAuthMenuReply
+	(*SysAuthPermissionReq)(nil),               // 29: admin.v1.SysAuthPermissionReq
+	(*SysAuthPermissionReply)(nil),             // 30: admin.v1.SysAuthPermissionReply
+	(*SysAdminInfo)(nil),                       // 31: admin.v1.SysAdminInfo
 }
 var file_admin_v1_sys_auth_proto_depIdxs = []int32{
 	0,  // 0: admin.v1.SysMenuItem.children:type_name -> admin.v1.SysMenuItem
-	17, // 1: admin.v1.SysAuthAdminInfoReply.info:type_name -> admin.v1.SysAdminInfo
+	31, // 1: admin.v1.SysAuthAdminInfoReply.info:type_name -> admin.v1.SysAdminInfo
 	0,  // 2: admin.v1.SysAuthMenuReply.menu:type_name -> admin.v1.SysMenuItem
 	1,  // 3: admin.v1.SysAuth.SysAuthLogin:input_type -> admin.v1.SysAuthLoginReq
-	3,  // 4: admin.v1.SysAuth.SysAuthLogout:input_type -> admin.v1.SysAuthLogoutReq
-	5,  // 5: admin.v1.SysAuth.SysAuthCheckToken:input_type -> admin.v1.SysAuthCheckTokenReq
-	7,  // 6: admin.v1.SysAuth.SysAuthAdminInfo:input_type -> admin.v1.SysAuthAdminInfoReq
-	9,  // 7: admin.v1.SysAuth.SysAuthUpdateAdminInfo:input_type -> admin.v1.SysAuthUpdateAdminInfoReq
-	11, // 8: admin.v1.SysAuth.SysAuthUpdateAdminPassword:input_type -> admin.v1.SysAuthUpdateAdminPasswordReq
-	13, // 9: admin.v1.SysAuth.SysAuthMenu:input_type -> admin.v1.SysAuthMenuReq
-	15, // 10: admin.v1.SysAuth.SysAuthPermission:input_type -> admin.v1.SysAuthPermissionReq
-	2,  // 11: admin.v1.SysAuth.SysAuthLogin:output_type -> admin.v1.SysAuthLoginReply
-	4,  // 12: admin.v1.SysAuth.SysAuthLogout:output_type -> admin.v1.SysAuthLogoutReply
-	6,  // 13: admin.v1.SysAuth.SysAuthCheckToken:output_type -> admin.v1.SysAuthCheckTokenReply
-	8,  // 14: admin.v1.SysAuth.SysAuthAdminInfo:output_type -> admin.v1.SysAuthAdminInfoReply
-	10, // 15: admin.v1.SysAuth.SysAuthUpdateAdminInfo:output_type -> admin.v1.SysAuthUpdateAdminInfoReply
-	12, // 16: admin.v1.SysAuth.SysAuthUpdateAdminPassword:output_type -> admin.v1.SysAuthUpdateAdminPasswordReply
-	14, // 17: admin.v1.SysAuth.SysAuthMenu:output_type -> admin.v1.SysAuthMenuReply
-	16, // 18: admin.v1.SysAuth.SysAuthPermission:output_type -> admin.v1.SysAuthPermissionReply
-	11, // [11:19] is the sub-list for method output_type
-	3,  // [3:11] is the sub-list for method input_type
+	15, // 4: admin.v1.SysAuth.SysAuthLogout:input_type -> admin.v1.SysAuthLogoutReq
+	17, // 5: admin.v1.SysAuth.SysAuthRefreshToken:input_type -> admin.v1.SysAuthRefreshTokenReq
+	3,  // 6: admin.v1.SysAuth.SysAuthTwoFactorLogin:input_type -> admin.v1.SysAuthTwoFactorLoginReq
+	5,  // 7: admin.v1.SysAuth.SysAuthTwoFactorLoginSetup:input_type -> admin.v1.SysAuthTwoFactorLoginSetupReq
+	7,  // 8: admin.v1.SysAuth.SysAuthTwoFactorSetup:input_type -> admin.v1.SysAuthTwoFactorSetupReq
+	9,  // 9: admin.v1.SysAuth.SysAuthTwoFactorEnable:input_type -> admin.v1.SysAuthTwoFactorEnableReq
+	11, // 10: admin.v1.SysAuth.SysAuthTwoFactorDisable:input_type -> admin.v1.SysAuthTwoFactorDisableReq
+	13, // 11: admin.v1.SysAuth.SysAuthTwoFactorRecoveryCodes:input_type -> admin.v1.SysAuthTwoFactorRecoveryCodesReq
+	19, // 12: admin.v1.SysAuth.SysAuthCheckToken:input_type -> admin.v1.SysAuthCheckTokenReq
+	21, // 13: admin.v1.SysAuth.SysAuthAdminInfo:input_type -> admin.v1.SysAuthAdminInfoReq
+	23, // 14: admin.v1.SysAuth.SysAuthUpdateAdminInfo:input_type -> admin.v1.SysAuthUpdateAdminInfoReq
+	25, // 15: admin.v1.SysAuth.SysAuthUpdateAdminPassword:input_type -> admin.v1.SysAuthUpdateAdminPasswordReq
+	27, // 16: admin.v1.SysAuth.SysAuthMenu:input_type -> admin.v1.SysAuthMenuReq
+	29, // 17: admin.v1.SysAuth.SysAuthPermission:input_type -> admin.v1.SysAuthPermissionReq
+	2,  // 18: admin.v1.SysAuth.SysAuthLogin:output_type -> admin.v1.SysAuthLoginReply
+	16, // 19: admin.v1.SysAuth.SysAuthLogout:output_type -> admin.v1.SysAuthLogoutReply
+	18, // 20: admin.v1.SysAuth.SysAuthRefreshToken:output_type -> admin.v1.SysAuthRefreshTokenReply
+	4,  // 21: admin.v1.SysAuth.SysAuthTwoFactorLogin:output_type -> admin.v1.SysAuthTwoFactorLoginReply
+	6,  // 22: admin.v1.SysAuth.SysAuthTwoFactorLoginSetup:output_type -> admin.v1.SysAuthTwoFactorLoginSetupReply
+	8,  // 23: admin.v1.SysAuth.SysAuthTwoFactorSetup:output_type -> admin.v1.SysAuthTwoFactorSetupReply
+	10, // 24: admin.v1.SysAuth.SysAuthTwoFactorEnable:output_type -> admin.v1.SysAuthTwoFactorEnableReply
+	12, // 25: admin.v1.SysAuth.SysAuthTwoFactorDisable:output_type -> admin.v1.SysAuthTwoFactorDisableReply
+	14, // 26: admin.v1.SysAuth.SysAuthTwoFactorRecoveryCodes:output_type -> admin.v1.SysAuthTwoFactorRecoveryCodesReply
+	20, // 27: admin.v1.SysAuth.SysAuthCheckToken:output_type -> admin.v1.SysAuthCheckTokenReply
+	22, // 28: admin.v1.SysAuth.SysAuthAdminInfo:output_type -> admin.v1.SysAuthAdminInfoReply
+	24, // 29: admin.v1.SysAuth.SysAuthUpdateAdminInfo:output_type -> admin.v1.SysAuthUpdateAdminInfoReply
+	26, // 30: admin.v1.SysAuth.SysAuthUpdateAdminPassword:output_type -> admin.v1.SysAuthUpdateAdminPasswordReply
+	28, // 31: admin.v1.SysAuth.SysAuthMenu:output_type -> admin.v1.SysAuthMenuReply
+	30, // 32: admin.v1.SysAuth.SysAuthPermission:output_type -> admin.v1.SysAuthPermissionReply
+	18, // [18:33] is the sub-list for method output_type
+	3,  // [3:18] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
 	3,  // [3:3] is the sub-list for extension extendee
 	0,  // [0:3] is the sub-list for field type_name
@@ -1197,7 +2192,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthLogoutReq); i {
+			switch v := v.(*SysAuthTwoFactorLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1209,7 +2204,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthLogoutReply); i {
+			switch v := v.(*SysAuthTwoFactorLoginReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1221,7 +2216,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthCheckTokenReq); i {
+			switch v := v.(*SysAuthTwoFactorLoginSetupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1233,7 +2228,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthCheckTokenReply); i {
+			switch v := v.(*SysAuthTwoFactorLoginSetupReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1245,7 +2240,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthAdminInfoReq); i {
+			switch v := v.(*SysAuthTwoFactorSetupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1257,7 +2252,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthAdminInfoReply); i {
+			switch v := v.(*SysAuthTwoFactorSetupReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1269,7 +2264,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthUpdateAdminInfoReq); i {
+			switch v := v.(*SysAuthTwoFactorEnableReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1281,7 +2276,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthUpdateAdminInfoReply); i {
+			switch v := v.(*SysAuthTwoFactorEnableReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1293,7 +2288,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthUpdateAdminPasswordReq); i {
+			switch v := v.(*SysAuthTwoFactorDisableReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1305,7 +2300,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthUpdateAdminPasswordReply); i {
+			switch v := v.(*SysAuthTwoFactorDisableReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1317,7 +2312,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthMenuReq); i {
+			switch v := v.(*SysAuthTwoFactorRecoveryCodesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1329,7 +2324,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthMenuReply); i {
+			switch v := v.(*SysAuthTwoFactorRecoveryCodesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1341,7 +2336,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SysAuthPermissionReq); i {
+			switch v := v.(*SysAuthLogoutReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1353,6 +2348,174 @@ func file_admin_v1_sys_auth_proto_init() {
 			}
 		}
 		file_admin_v1_sys_auth_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthLogoutReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthRefreshTokenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthRefreshTokenReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthCheckTokenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthCheckTokenReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthAdminInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthAdminInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthUpdateAdminInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthUpdateAdminInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthUpdateAdminPasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthUpdateAdminPasswordReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthMenuReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthMenuReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysAuthPermissionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_auth_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SysAuthPermissionReply); i {
 			case 0:
 				return &v.state
@@ -1371,7 +2534,7 @@ func file_admin_v1_sys_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_admin_v1_sys_auth_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
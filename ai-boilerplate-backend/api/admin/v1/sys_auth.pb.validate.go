@@ -322,20 +322,1293 @@ func (m *SysAuthLoginReply) validate(all bool) error {
 
 	// no validation rules for RefreshAt
 
+	// no validation rules for RefreshToken
+
+	// no validation rules for RefreshExpiredAt
+
+	// no validation rules for TwoFactorRequired
+
+	// no validation rules for TwoFactorSetupRequired
+
+	// no validation rules for TwoFactorChallenge
+
+	if len(errors) > 0 {
+		return SysAuthLoginReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthLoginReplyMultiError is an error wrapping multiple validation errors
+// returned by SysAuthLoginReply.ValidateAll() if the designated constraints
+// aren't met.
+type SysAuthLoginReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthLoginReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthLoginReplyMultiError) AllErrors() []error { return m }
+
+// SysAuthLoginReplyValidationError is the validation error returned by
+// SysAuthLoginReply.Validate if the designated constraints aren't met.
+type SysAuthLoginReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthLoginReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthLoginReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthLoginReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthLoginReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthLoginReplyValidationError) ErrorName() string {
+	return "SysAuthLoginReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthLoginReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthLoginReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthLoginReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthLoginReplyValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorLoginReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorLoginReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorLoginReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorLoginReqMultiError, or nil if none found.
+func (m *SysAuthTwoFactorLoginReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorLoginReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Challenge
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorLoginReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorLoginReqMultiError is an error wrapping multiple validation
+// errors returned by SysAuthTwoFactorLoginReq.ValidateAll() if the designated
+// constraints aren't met.
+type SysAuthTwoFactorLoginReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorLoginReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorLoginReqMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorLoginReqValidationError is the validation error returned by
+// SysAuthTwoFactorLoginReq.Validate if the designated constraints aren't met.
+type SysAuthTwoFactorLoginReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorLoginReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorLoginReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorLoginReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorLoginReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorLoginReqValidationError) ErrorName() string {
+	return "SysAuthTwoFactorLoginReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorLoginReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorLoginReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorLoginReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorLoginReqValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorLoginReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorLoginReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorLoginReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorLoginReplyMultiError, or nil if none found.
+func (m *SysAuthTwoFactorLoginReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorLoginReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for ExpiredAt
+
+	// no validation rules for RefreshAt
+
+	// no validation rules for RefreshToken
+
+	// no validation rules for RefreshExpiredAt
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorLoginReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorLoginReplyMultiError is an error wrapping multiple
+// validation errors returned by SysAuthTwoFactorLoginReply.ValidateAll() if
+// the designated constraints aren't met.
+type SysAuthTwoFactorLoginReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorLoginReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorLoginReplyMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorLoginReplyValidationError is the validation error returned
+// by SysAuthTwoFactorLoginReply.Validate if the designated constraints aren't met.
+type SysAuthTwoFactorLoginReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorLoginReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorLoginReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorLoginReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorLoginReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorLoginReplyValidationError) ErrorName() string {
+	return "SysAuthTwoFactorLoginReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorLoginReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorLoginReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorLoginReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorLoginReplyValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorLoginSetupReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorLoginSetupReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorLoginSetupReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorLoginSetupReqMultiError, or nil if none found.
+func (m *SysAuthTwoFactorLoginSetupReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorLoginSetupReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Challenge
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorLoginSetupReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorLoginSetupReqMultiError is an error wrapping multiple
+// validation errors returned by SysAuthTwoFactorLoginSetupReq.ValidateAll()
+// if the designated constraints aren't met.
+type SysAuthTwoFactorLoginSetupReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorLoginSetupReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorLoginSetupReqMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorLoginSetupReqValidationError is the validation error
+// returned by SysAuthTwoFactorLoginSetupReq.Validate if the designated
+// constraints aren't met.
+type SysAuthTwoFactorLoginSetupReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorLoginSetupReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorLoginSetupReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorLoginSetupReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorLoginSetupReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorLoginSetupReqValidationError) ErrorName() string {
+	return "SysAuthTwoFactorLoginSetupReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorLoginSetupReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorLoginSetupReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorLoginSetupReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorLoginSetupReqValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorLoginSetupReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorLoginSetupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorLoginSetupReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorLoginSetupReplyMultiError, or nil if none found.
+func (m *SysAuthTwoFactorLoginSetupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorLoginSetupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for ProvisioningUri
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorLoginSetupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorLoginSetupReplyMultiError is an error wrapping multiple
+// validation errors returned by SysAuthTwoFactorLoginSetupReply.ValidateAll()
+// if the designated constraints aren't met.
+type SysAuthTwoFactorLoginSetupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorLoginSetupReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorLoginSetupReplyMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorLoginSetupReplyValidationError is the validation error
+// returned by SysAuthTwoFactorLoginSetupReply.Validate if the designated
+// constraints aren't met.
+type SysAuthTwoFactorLoginSetupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorLoginSetupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorLoginSetupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorLoginSetupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorLoginSetupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorLoginSetupReplyValidationError) ErrorName() string {
+	return "SysAuthTwoFactorLoginSetupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorLoginSetupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorLoginSetupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorLoginSetupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorLoginSetupReplyValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorSetupReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorSetupReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorSetupReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorSetupReqMultiError, or nil if none found.
+func (m *SysAuthTwoFactorSetupReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorSetupReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorSetupReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorSetupReqMultiError is an error wrapping multiple validation
+// errors returned by SysAuthTwoFactorSetupReq.ValidateAll() if the designated
+// constraints aren't met.
+type SysAuthTwoFactorSetupReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorSetupReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorSetupReqMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorSetupReqValidationError is the validation error returned by
+// SysAuthTwoFactorSetupReq.Validate if the designated constraints aren't met.
+type SysAuthTwoFactorSetupReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorSetupReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorSetupReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorSetupReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorSetupReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorSetupReqValidationError) ErrorName() string {
+	return "SysAuthTwoFactorSetupReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorSetupReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorSetupReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorSetupReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorSetupReqValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorSetupReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorSetupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorSetupReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorSetupReplyMultiError, or nil if none found.
+func (m *SysAuthTwoFactorSetupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorSetupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for ProvisioningUri
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorSetupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorSetupReplyMultiError is an error wrapping multiple
+// validation errors returned by SysAuthTwoFactorSetupReply.ValidateAll() if
+// the designated constraints aren't met.
+type SysAuthTwoFactorSetupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorSetupReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorSetupReplyMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorSetupReplyValidationError is the validation error returned
+// by SysAuthTwoFactorSetupReply.Validate if the designated constraints aren't met.
+type SysAuthTwoFactorSetupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorSetupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorSetupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorSetupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorSetupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorSetupReplyValidationError) ErrorName() string {
+	return "SysAuthTwoFactorSetupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorSetupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorSetupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorSetupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorSetupReplyValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorEnableReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorEnableReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorEnableReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorEnableReqMultiError, or nil if none found.
+func (m *SysAuthTwoFactorEnableReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorEnableReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorEnableReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorEnableReqMultiError is an error wrapping multiple validation
+// errors returned by SysAuthTwoFactorEnableReq.ValidateAll() if the
+// designated constraints aren't met.
+type SysAuthTwoFactorEnableReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorEnableReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorEnableReqMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorEnableReqValidationError is the validation error returned by
+// SysAuthTwoFactorEnableReq.Validate if the designated constraints aren't met.
+type SysAuthTwoFactorEnableReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorEnableReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorEnableReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorEnableReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorEnableReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorEnableReqValidationError) ErrorName() string {
+	return "SysAuthTwoFactorEnableReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorEnableReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorEnableReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorEnableReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorEnableReqValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorEnableReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorEnableReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorEnableReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorEnableReplyMultiError, or nil if none found.
+func (m *SysAuthTwoFactorEnableReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorEnableReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorEnableReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorEnableReplyMultiError is an error wrapping multiple
+// validation errors returned by SysAuthTwoFactorEnableReply.ValidateAll() if
+// the designated constraints aren't met.
+type SysAuthTwoFactorEnableReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorEnableReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorEnableReplyMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorEnableReplyValidationError is the validation error returned
+// by SysAuthTwoFactorEnableReply.Validate if the designated constraints
+// aren't met.
+type SysAuthTwoFactorEnableReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorEnableReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorEnableReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorEnableReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorEnableReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorEnableReplyValidationError) ErrorName() string {
+	return "SysAuthTwoFactorEnableReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorEnableReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorEnableReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorEnableReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorEnableReplyValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorDisableReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorDisableReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorDisableReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorDisableReqMultiError, or nil if none found.
+func (m *SysAuthTwoFactorDisableReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorDisableReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorDisableReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorDisableReqMultiError is an error wrapping multiple
+// validation errors returned by SysAuthTwoFactorDisableReq.ValidateAll() if
+// the designated constraints aren't met.
+type SysAuthTwoFactorDisableReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorDisableReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorDisableReqMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorDisableReqValidationError is the validation error returned
+// by SysAuthTwoFactorDisableReq.Validate if the designated constraints aren't met.
+type SysAuthTwoFactorDisableReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorDisableReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorDisableReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorDisableReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorDisableReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorDisableReqValidationError) ErrorName() string {
+	return "SysAuthTwoFactorDisableReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorDisableReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorDisableReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorDisableReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorDisableReqValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorDisableReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthTwoFactorDisableReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorDisableReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorDisableReplyMultiError, or nil if none found.
+func (m *SysAuthTwoFactorDisableReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorDisableReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorDisableReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorDisableReplyMultiError is an error wrapping multiple
+// validation errors returned by SysAuthTwoFactorDisableReply.ValidateAll() if
+// the designated constraints aren't met.
+type SysAuthTwoFactorDisableReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorDisableReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorDisableReplyMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorDisableReplyValidationError is the validation error returned
+// by SysAuthTwoFactorDisableReply.Validate if the designated constraints
+// aren't met.
+type SysAuthTwoFactorDisableReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorDisableReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorDisableReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorDisableReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorDisableReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorDisableReplyValidationError) ErrorName() string {
+	return "SysAuthTwoFactorDisableReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorDisableReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorDisableReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorDisableReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorDisableReplyValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorRecoveryCodesReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SysAuthTwoFactorRecoveryCodesReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorRecoveryCodesReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorRecoveryCodesReqMultiError, or nil if none found.
+func (m *SysAuthTwoFactorRecoveryCodesReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorRecoveryCodesReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return SysAuthTwoFactorRecoveryCodesReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthTwoFactorRecoveryCodesReqMultiError is an error wrapping multiple
+// validation errors returned by
+// SysAuthTwoFactorRecoveryCodesReq.ValidateAll() if the designated
+// constraints aren't met.
+type SysAuthTwoFactorRecoveryCodesReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthTwoFactorRecoveryCodesReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthTwoFactorRecoveryCodesReqMultiError) AllErrors() []error { return m }
+
+// SysAuthTwoFactorRecoveryCodesReqValidationError is the validation error
+// returned by SysAuthTwoFactorRecoveryCodesReq.Validate if the designated
+// constraints aren't met.
+type SysAuthTwoFactorRecoveryCodesReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthTwoFactorRecoveryCodesReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthTwoFactorRecoveryCodesReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthTwoFactorRecoveryCodesReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthTwoFactorRecoveryCodesReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthTwoFactorRecoveryCodesReqValidationError) ErrorName() string {
+	return "SysAuthTwoFactorRecoveryCodesReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthTwoFactorRecoveryCodesReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthTwoFactorRecoveryCodesReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthTwoFactorRecoveryCodesReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthTwoFactorRecoveryCodesReqValidationError{}
+
+// Validate checks the field values on SysAuthTwoFactorRecoveryCodesReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SysAuthTwoFactorRecoveryCodesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthTwoFactorRecoveryCodesReply
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SysAuthTwoFactorRecoveryCodesReplyMultiError, or nil if none found.
+func (m *SysAuthTwoFactorRecoveryCodesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthTwoFactorRecoveryCodesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if len(errors) > 0 {
-		return SysAuthLoginReplyMultiError(errors)
+		return SysAuthTwoFactorRecoveryCodesReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SysAuthLoginReplyMultiError is an error wrapping multiple validation errors
-// returned by SysAuthLoginReply.ValidateAll() if the designated constraints
-// aren't met.
-type SysAuthLoginReplyMultiError []error
+// SysAuthTwoFactorRecoveryCodesReplyMultiError is an error wrapping multiple
+// validation errors returned by
+// SysAuthTwoFactorRecoveryCodesReply.ValidateAll() if the designated
+// constraints aren't met.
+type SysAuthTwoFactorRecoveryCodesReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SysAuthLoginReplyMultiError) Error() string {
+func (m SysAuthTwoFactorRecoveryCodesReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -344,11 +1617,12 @@ func (m SysAuthLoginReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SysAuthLoginReplyMultiError) AllErrors() []error { return m }
+func (m SysAuthTwoFactorRecoveryCodesReplyMultiError) AllErrors() []error { return m }
 
-// SysAuthLoginReplyValidationError is the validation error returned by
-// SysAuthLoginReply.Validate if the designated constraints aren't met.
-type SysAuthLoginReplyValidationError struct {
+// SysAuthTwoFactorRecoveryCodesReplyValidationError is the validation error
+// returned by SysAuthTwoFactorRecoveryCodesReply.Validate if the designated
+// constraints aren't met.
+type SysAuthTwoFactorRecoveryCodesReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -356,24 +1630,24 @@ type SysAuthLoginReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SysAuthLoginReplyValidationError) Field() string { return e.field }
+func (e SysAuthTwoFactorRecoveryCodesReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SysAuthLoginReplyValidationError) Reason() string { return e.reason }
+func (e SysAuthTwoFactorRecoveryCodesReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SysAuthLoginReplyValidationError) Cause() error { return e.cause }
+func (e SysAuthTwoFactorRecoveryCodesReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SysAuthLoginReplyValidationError) Key() bool { return e.key }
+func (e SysAuthTwoFactorRecoveryCodesReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SysAuthLoginReplyValidationError) ErrorName() string {
-	return "SysAuthLoginReplyValidationError"
+func (e SysAuthTwoFactorRecoveryCodesReplyValidationError) ErrorName() string {
+	return "SysAuthTwoFactorRecoveryCodesReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SysAuthLoginReplyValidationError) Error() string {
+func (e SysAuthTwoFactorRecoveryCodesReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -385,14 +1659,14 @@ func (e SysAuthLoginReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSysAuthLoginReply.%s: %s%s",
+		"invalid %sSysAuthTwoFactorRecoveryCodesReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SysAuthLoginReplyValidationError{}
+var _ error = SysAuthTwoFactorRecoveryCodesReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -400,7 +1674,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SysAuthLoginReplyValidationError{}
+} = SysAuthTwoFactorRecoveryCodesReplyValidationError{}
 
 // Validate checks the field values on SysAuthLogoutReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -604,6 +1878,222 @@ var _ interface {
 	ErrorName() string
 } = SysAuthLogoutReplyValidationError{}
 
+// Validate checks the field values on SysAuthRefreshTokenReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthRefreshTokenReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthRefreshTokenReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthRefreshTokenReqMultiError, or nil if none found.
+func (m *SysAuthRefreshTokenReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthRefreshTokenReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return SysAuthRefreshTokenReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthRefreshTokenReqMultiError is an error wrapping multiple validation
+// errors returned by SysAuthRefreshTokenReq.ValidateAll() if the designated
+// constraints aren't met.
+type SysAuthRefreshTokenReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthRefreshTokenReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthRefreshTokenReqMultiError) AllErrors() []error { return m }
+
+// SysAuthRefreshTokenReqValidationError is the validation error returned by
+// SysAuthRefreshTokenReq.Validate if the designated constraints aren't met.
+type SysAuthRefreshTokenReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthRefreshTokenReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthRefreshTokenReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthRefreshTokenReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthRefreshTokenReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthRefreshTokenReqValidationError) ErrorName() string {
+	return "SysAuthRefreshTokenReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthRefreshTokenReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthRefreshTokenReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthRefreshTokenReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthRefreshTokenReqValidationError{}
+
+// Validate checks the field values on SysAuthRefreshTokenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SysAuthRefreshTokenReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SysAuthRefreshTokenReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SysAuthRefreshTokenReplyMultiError, or nil if none found.
+func (m *SysAuthRefreshTokenReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SysAuthRefreshTokenReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for ExpiredAt
+
+	// no validation rules for RefreshAt
+
+	// no validation rules for RefreshToken
+
+	// no validation rules for RefreshExpiredAt
+
+	if len(errors) > 0 {
+		return SysAuthRefreshTokenReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SysAuthRefreshTokenReplyMultiError is an error wrapping multiple validation
+// errors returned by SysAuthRefreshTokenReply.ValidateAll() if the designated
+// constraints aren't met.
+type SysAuthRefreshTokenReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SysAuthRefreshTokenReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SysAuthRefreshTokenReplyMultiError) AllErrors() []error { return m }
+
+// SysAuthRefreshTokenReplyValidationError is the validation error returned by
+// SysAuthRefreshTokenReply.Validate if the designated constraints aren't met.
+type SysAuthRefreshTokenReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SysAuthRefreshTokenReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SysAuthRefreshTokenReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SysAuthRefreshTokenReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SysAuthRefreshTokenReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SysAuthRefreshTokenReplyValidationError) ErrorName() string {
+	return "SysAuthRefreshTokenReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SysAuthRefreshTokenReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSysAuthRefreshTokenReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SysAuthRefreshTokenReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SysAuthRefreshTokenReplyValidationError{}
+
 // Validate checks the field values on SysAuthCheckTokenReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -736,6 +2226,8 @@ func (m *SysAuthCheckTokenReply) validate(all bool) error {
 
 	// no validation rules for TenantId
 
+	// no validation rules for SessionId
+
 	if len(errors) > 0 {
 		return SysAuthCheckTokenReplyMultiError(errors)
 	}
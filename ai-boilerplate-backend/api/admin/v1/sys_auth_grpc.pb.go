@@ -26,6 +26,20 @@ type SysAuthClient interface {
 	SysAuthLogin(ctx context.Context, in *SysAuthLoginReq, opts ...grpc.CallOption) (*SysAuthLoginReply, error)
 	// Auth-退出
 	SysAuthLogout(ctx context.Context, in *SysAuthLogoutReq, opts ...grpc.CallOption) (*SysAuthLogoutReply, error)
+	// Auth-刷新token
+	SysAuthRefreshToken(ctx context.Context, in *SysAuthRefreshTokenReq, opts ...grpc.CallOption) (*SysAuthRefreshTokenReply, error)
+	// Auth-两步验证登录
+	SysAuthTwoFactorLogin(ctx context.Context, in *SysAuthTwoFactorLoginReq, opts ...grpc.CallOption) (*SysAuthTwoFactorLoginReply, error)
+	// Auth-两步验证登录时绑定
+	SysAuthTwoFactorLoginSetup(ctx context.Context, in *SysAuthTwoFactorLoginSetupReq, opts ...grpc.CallOption) (*SysAuthTwoFactorLoginSetupReply, error)
+	// Auth-两步验证绑定
+	SysAuthTwoFactorSetup(ctx context.Context, in *SysAuthTwoFactorSetupReq, opts ...grpc.CallOption) (*SysAuthTwoFactorSetupReply, error)
+	// Auth-两步验证开启
+	SysAuthTwoFactorEnable(ctx context.Context, in *SysAuthTwoFactorEnableReq, opts ...grpc.CallOption) (*SysAuthTwoFactorEnableReply, error)
+	// Auth-两步验证关闭
+	SysAuthTwoFactorDisable(ctx context.Context, in *SysAuthTwoFactorDisableReq, opts ...grpc.CallOption) (*SysAuthTwoFactorDisableReply, error)
+	// Auth-两步验证重新生成恢复码
+	SysAuthTwoFactorRecoveryCodes(ctx context.Context, in *SysAuthTwoFactorRecoveryCodesReq, opts ...grpc.CallOption) (*SysAuthTwoFactorRecoveryCodesReply, error)
 	// Auth-检查token
 	SysAuthCheckToken(ctx context.Context, in *SysAuthCheckTokenReq, opts ...grpc.CallOption) (*SysAuthCheckTokenReply, error)
 	// Auth-查询用户信息
@@ -66,6 +80,69 @@ func (c *sysAuthClient) SysAuthLogout(ctx context.Context, in *SysAuthLogoutReq,
 	return out, nil
 }
 
+func (c *sysAuthClient) SysAuthRefreshToken(ctx context.Context, in *SysAuthRefreshTokenReq, opts ...grpc.CallOption) (*SysAuthRefreshTokenReply, error) {
+	out := new(SysAuthRefreshTokenReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAuth/SysAuthRefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAuthClient) SysAuthTwoFactorLogin(ctx context.Context, in *SysAuthTwoFactorLoginReq, opts ...grpc.CallOption) (*SysAuthTwoFactorLoginReply, error) {
+	out := new(SysAuthTwoFactorLoginReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAuth/SysAuthTwoFactorLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAuthClient) SysAuthTwoFactorLoginSetup(ctx context.Context, in *SysAuthTwoFactorLoginSetupReq, opts ...grpc.CallOption) (*SysAuthTwoFactorLoginSetupReply, error) {
+	out := new(SysAuthTwoFactorLoginSetupReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAuth/SysAuthTwoFactorLoginSetup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAuthClient) SysAuthTwoFactorSetup(ctx context.Context, in *SysAuthTwoFactorSetupReq, opts ...grpc.CallOption) (*SysAuthTwoFactorSetupReply, error) {
+	out := new(SysAuthTwoFactorSetupReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAuth/SysAuthTwoFactorSetup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAuthClient) SysAuthTwoFactorEnable(ctx context.Context, in *SysAuthTwoFactorEnableReq, opts ...grpc.CallOption) (*SysAuthTwoFactorEnableReply, error) {
+	out := new(SysAuthTwoFactorEnableReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAuth/SysAuthTwoFactorEnable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAuthClient) SysAuthTwoFactorDisable(ctx context.Context, in *SysAuthTwoFactorDisableReq, opts ...grpc.CallOption) (*SysAuthTwoFactorDisableReply, error) {
+	out := new(SysAuthTwoFactorDisableReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAuth/SysAuthTwoFactorDisable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAuthClient) SysAuthTwoFactorRecoveryCodes(ctx context.Context, in *SysAuthTwoFactorRecoveryCodesReq, opts ...grpc.CallOption) (*SysAuthTwoFactorRecoveryCodesReply, error) {
+	out := new(SysAuthTwoFactorRecoveryCodesReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysAuth/SysAuthTwoFactorRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysAuthClient) SysAuthCheckToken(ctx context.Context, in *SysAuthCheckTokenReq, opts ...grpc.CallOption) (*SysAuthCheckTokenReply, error) {
 	out := new(SysAuthCheckTokenReply)
 	err := c.cc.Invoke(ctx, "/admin.v1.SysAuth/SysAuthCheckToken", in, out, opts...)
@@ -128,6 +205,20 @@ type SysAuthServer interface {
 	SysAuthLogin(context.Context, *SysAuthLoginReq) (*SysAuthLoginReply, error)
 	// Auth-退出
 	SysAuthLogout(context.Context, *SysAuthLogoutReq) (*SysAuthLogoutReply, error)
+	// Auth-刷新token
+	SysAuthRefreshToken(context.Context, *SysAuthRefreshTokenReq) (*SysAuthRefreshTokenReply, error)
+	// Auth-两步验证登录
+	SysAuthTwoFactorLogin(context.Context, *SysAuthTwoFactorLoginReq) (*SysAuthTwoFactorLoginReply, error)
+	// Auth-两步验证登录时绑定
+	SysAuthTwoFactorLoginSetup(context.Context, *SysAuthTwoFactorLoginSetupReq) (*SysAuthTwoFactorLoginSetupReply, error)
+	// Auth-两步验证绑定
+	SysAuthTwoFactorSetup(context.Context, *SysAuthTwoFactorSetupReq) (*SysAuthTwoFactorSetupReply, error)
+	// Auth-两步验证开启
+	SysAuthTwoFactorEnable(context.Context, *SysAuthTwoFactorEnableReq) (*SysAuthTwoFactorEnableReply, error)
+	// Auth-两步验证关闭
+	SysAuthTwoFactorDisable(context.Context, *SysAuthTwoFactorDisableReq) (*SysAuthTwoFactorDisableReply, error)
+	// Auth-两步验证重新生成恢复码
+	SysAuthTwoFactorRecoveryCodes(context.Context, *SysAuthTwoFactorRecoveryCodesReq) (*SysAuthTwoFactorRecoveryCodesReply, error)
 	// Auth-检查token
 	SysAuthCheckToken(context.Context, *SysAuthCheckTokenReq) (*SysAuthCheckTokenReply, error)
 	// Auth-查询用户信息
@@ -153,6 +244,27 @@ func (UnimplementedSysAuthServer) SysAuthLogin(context.Context, *SysAuthLoginReq
 func (UnimplementedSysAuthServer) SysAuthLogout(context.Context, *SysAuthLogoutReq) (*SysAuthLogoutReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SysAuthLogout not implemented")
 }
+func (UnimplementedSysAuthServer) SysAuthRefreshToken(context.Context, *SysAuthRefreshTokenReq) (*SysAuthRefreshTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SysAuthRefreshToken not implemented")
+}
+func (UnimplementedSysAuthServer) SysAuthTwoFactorLogin(context.Context, *SysAuthTwoFactorLoginReq) (*SysAuthTwoFactorLoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SysAuthTwoFactorLogin not implemented")
+}
+func (UnimplementedSysAuthServer) SysAuthTwoFactorLoginSetup(context.Context, *SysAuthTwoFactorLoginSetupReq) (*SysAuthTwoFactorLoginSetupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SysAuthTwoFactorLoginSetup not implemented")
+}
+func (UnimplementedSysAuthServer) SysAuthTwoFactorSetup(context.Context, *SysAuthTwoFactorSetupReq) (*SysAuthTwoFactorSetupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SysAuthTwoFactorSetup not implemented")
+}
+func (UnimplementedSysAuthServer) SysAuthTwoFactorEnable(context.Context, *SysAuthTwoFactorEnableReq) (*SysAuthTwoFactorEnableReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SysAuthTwoFactorEnable not implemented")
+}
+func (UnimplementedSysAuthServer) SysAuthTwoFactorDisable(context.Context, *SysAuthTwoFactorDisableReq) (*SysAuthTwoFactorDisableReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SysAuthTwoFactorDisable not implemented")
+}
+func (UnimplementedSysAuthServer) SysAuthTwoFactorRecoveryCodes(context.Context, *SysAuthTwoFactorRecoveryCodesReq) (*SysAuthTwoFactorRecoveryCodesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SysAuthTwoFactorRecoveryCodes not implemented")
+}
 func (UnimplementedSysAuthServer) SysAuthCheckToken(context.Context, *SysAuthCheckTokenReq) (*SysAuthCheckTokenReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SysAuthCheckToken not implemented")
 }
@@ -220,6 +332,132 @@ func _SysAuth_SysAuthLogout_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysAuth_SysAuthRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SysAuthRefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAuthServer).SysAuthRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAuth/SysAuthRefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAuthServer).SysAuthRefreshToken(ctx, req.(*SysAuthRefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAuth_SysAuthTwoFactorLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SysAuthTwoFactorLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAuthServer).SysAuthTwoFactorLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAuth/SysAuthTwoFactorLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAuthServer).SysAuthTwoFactorLogin(ctx, req.(*SysAuthTwoFactorLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAuth_SysAuthTwoFactorLoginSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SysAuthTwoFactorLoginSetupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAuthServer).SysAuthTwoFactorLoginSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAuth/SysAuthTwoFactorLoginSetup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAuthServer).SysAuthTwoFactorLoginSetup(ctx, req.(*SysAuthTwoFactorLoginSetupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAuth_SysAuthTwoFactorSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SysAuthTwoFactorSetupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAuthServer).SysAuthTwoFactorSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAuth/SysAuthTwoFactorSetup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAuthServer).SysAuthTwoFactorSetup(ctx, req.(*SysAuthTwoFactorSetupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAuth_SysAuthTwoFactorEnable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SysAuthTwoFactorEnableReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAuthServer).SysAuthTwoFactorEnable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAuth/SysAuthTwoFactorEnable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAuthServer).SysAuthTwoFactorEnable(ctx, req.(*SysAuthTwoFactorEnableReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAuth_SysAuthTwoFactorDisable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SysAuthTwoFactorDisableReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAuthServer).SysAuthTwoFactorDisable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAuth/SysAuthTwoFactorDisable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAuthServer).SysAuthTwoFactorDisable(ctx, req.(*SysAuthTwoFactorDisableReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAuth_SysAuthTwoFactorRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SysAuthTwoFactorRecoveryCodesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAuthServer).SysAuthTwoFactorRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysAuth/SysAuthTwoFactorRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAuthServer).SysAuthTwoFactorRecoveryCodes(ctx, req.(*SysAuthTwoFactorRecoveryCodesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SysAuth_SysAuthCheckToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SysAuthCheckTokenReq)
 	if err := dec(in); err != nil {
@@ -343,6 +581,34 @@ var SysAuth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SysAuthLogout",
 			Handler:    _SysAuth_SysAuthLogout_Handler,
 		},
+		{
+			MethodName: "SysAuthRefreshToken",
+			Handler:    _SysAuth_SysAuthRefreshToken_Handler,
+		},
+		{
+			MethodName: "SysAuthTwoFactorLogin",
+			Handler:    _SysAuth_SysAuthTwoFactorLogin_Handler,
+		},
+		{
+			MethodName: "SysAuthTwoFactorLoginSetup",
+			Handler:    _SysAuth_SysAuthTwoFactorLoginSetup_Handler,
+		},
+		{
+			MethodName: "SysAuthTwoFactorSetup",
+			Handler:    _SysAuth_SysAuthTwoFactorSetup_Handler,
+		},
+		{
+			MethodName: "SysAuthTwoFactorEnable",
+			Handler:    _SysAuth_SysAuthTwoFactorEnable_Handler,
+		},
+		{
+			MethodName: "SysAuthTwoFactorDisable",
+			Handler:    _SysAuth_SysAuthTwoFactorDisable_Handler,
+		},
+		{
+			MethodName: "SysAuthTwoFactorRecoveryCodes",
+			Handler:    _SysAuth_SysAuthTwoFactorRecoveryCodes_Handler,
+		},
 		{
 			MethodName: "SysAuthCheckToken",
 			Handler:    _SysAuth_SysAuthCheckToken_Handler,
@@ -24,6 +24,13 @@ const OperationSysAuthSysAuthLogin = "/admin.v1.SysAuth/SysAuthLogin"
 const OperationSysAuthSysAuthLogout = "/admin.v1.SysAuth/SysAuthLogout"
 const OperationSysAuthSysAuthMenu = "/admin.v1.SysAuth/SysAuthMenu"
 const OperationSysAuthSysAuthPermission = "/admin.v1.SysAuth/SysAuthPermission"
+const OperationSysAuthSysAuthRefreshToken = "/admin.v1.SysAuth/SysAuthRefreshToken"
+const OperationSysAuthSysAuthTwoFactorDisable = "/admin.v1.SysAuth/SysAuthTwoFactorDisable"
+const OperationSysAuthSysAuthTwoFactorEnable = "/admin.v1.SysAuth/SysAuthTwoFactorEnable"
+const OperationSysAuthSysAuthTwoFactorLogin = "/admin.v1.SysAuth/SysAuthTwoFactorLogin"
+const OperationSysAuthSysAuthTwoFactorLoginSetup = "/admin.v1.SysAuth/SysAuthTwoFactorLoginSetup"
+const OperationSysAuthSysAuthTwoFactorRecoveryCodes = "/admin.v1.SysAuth/SysAuthTwoFactorRecoveryCodes"
+const OperationSysAuthSysAuthTwoFactorSetup = "/admin.v1.SysAuth/SysAuthTwoFactorSetup"
 const OperationSysAuthSysAuthUpdateAdminInfo = "/admin.v1.SysAuth/SysAuthUpdateAdminInfo"
 const OperationSysAuthSysAuthUpdateAdminPassword = "/admin.v1.SysAuth/SysAuthUpdateAdminPassword"
 
@@ -33,6 +40,13 @@ type SysAuthHTTPServer interface {
 	SysAuthLogout(context.Context, *SysAuthLogoutReq) (*SysAuthLogoutReply, error)
 	SysAuthMenu(context.Context, *SysAuthMenuReq) (*SysAuthMenuReply, error)
 	SysAuthPermission(context.Context, *SysAuthPermissionReq) (*SysAuthPermissionReply, error)
+	SysAuthRefreshToken(context.Context, *SysAuthRefreshTokenReq) (*SysAuthRefreshTokenReply, error)
+	SysAuthTwoFactorDisable(context.Context, *SysAuthTwoFactorDisableReq) (*SysAuthTwoFactorDisableReply, error)
+	SysAuthTwoFactorEnable(context.Context, *SysAuthTwoFactorEnableReq) (*SysAuthTwoFactorEnableReply, error)
+	SysAuthTwoFactorLogin(context.Context, *SysAuthTwoFactorLoginReq) (*SysAuthTwoFactorLoginReply, error)
+	SysAuthTwoFactorLoginSetup(context.Context, *SysAuthTwoFactorLoginSetupReq) (*SysAuthTwoFactorLoginSetupReply, error)
+	SysAuthTwoFactorRecoveryCodes(context.Context, *SysAuthTwoFactorRecoveryCodesReq) (*SysAuthTwoFactorRecoveryCodesReply, error)
+	SysAuthTwoFactorSetup(context.Context, *SysAuthTwoFactorSetupReq) (*SysAuthTwoFactorSetupReply, error)
 	SysAuthUpdateAdminInfo(context.Context, *SysAuthUpdateAdminInfoReq) (*SysAuthUpdateAdminInfoReply, error)
 	SysAuthUpdateAdminPassword(context.Context, *SysAuthUpdateAdminPasswordReq) (*SysAuthUpdateAdminPasswordReply, error)
 }
@@ -41,6 +55,13 @@ func RegisterSysAuthHTTPServer(s *http.Server, srv SysAuthHTTPServer) {
 	r := s.Route("/")
 	r.POST("/admin/v1/sys_auth/login", _SysAuth_SysAuthLogin0_HTTP_Handler(srv))
 	r.POST("/admin/v1/sys_auth/logout", _SysAuth_SysAuthLogout0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_auth/refresh_token", _SysAuth_SysAuthRefreshToken0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_auth/two_factor/login", _SysAuth_SysAuthTwoFactorLogin0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_auth/two_factor/login_setup", _SysAuth_SysAuthTwoFactorLoginSetup0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_auth/two_factor/setup", _SysAuth_SysAuthTwoFactorSetup0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_auth/two_factor/enable", _SysAuth_SysAuthTwoFactorEnable0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_auth/two_factor/disable", _SysAuth_SysAuthTwoFactorDisable0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_auth/two_factor/recovery_codes", _SysAuth_SysAuthTwoFactorRecoveryCodes0_HTTP_Handler(srv))
 	r.GET("/admin/v1/sys_auth/admin_info", _SysAuth_SysAuthAdminInfo0_HTTP_Handler(srv))
 	r.POST("/admin/v1/sys_auth/update/admin_info", _SysAuth_SysAuthUpdateAdminInfo0_HTTP_Handler(srv))
 	r.POST("/admin/v1/sys_auth/update/admin_password", _SysAuth_SysAuthUpdateAdminPassword0_HTTP_Handler(srv))
@@ -86,6 +107,139 @@ func _SysAuth_SysAuthLogout0_HTTP_Handler(srv SysAuthHTTPServer) func(ctx http.C
 	}
 }
 
+func _SysAuth_SysAuthRefreshToken0_HTTP_Handler(srv SysAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SysAuthRefreshTokenReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAuthSysAuthRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SysAuthRefreshToken(ctx, req.(*SysAuthRefreshTokenReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SysAuthRefreshTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysAuth_SysAuthTwoFactorLogin0_HTTP_Handler(srv SysAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SysAuthTwoFactorLoginReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAuthSysAuthTwoFactorLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SysAuthTwoFactorLogin(ctx, req.(*SysAuthTwoFactorLoginReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SysAuthTwoFactorLoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysAuth_SysAuthTwoFactorLoginSetup0_HTTP_Handler(srv SysAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SysAuthTwoFactorLoginSetupReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAuthSysAuthTwoFactorLoginSetup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SysAuthTwoFactorLoginSetup(ctx, req.(*SysAuthTwoFactorLoginSetupReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SysAuthTwoFactorLoginSetupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysAuth_SysAuthTwoFactorSetup0_HTTP_Handler(srv SysAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SysAuthTwoFactorSetupReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAuthSysAuthTwoFactorSetup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SysAuthTwoFactorSetup(ctx, req.(*SysAuthTwoFactorSetupReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SysAuthTwoFactorSetupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysAuth_SysAuthTwoFactorEnable0_HTTP_Handler(srv SysAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SysAuthTwoFactorEnableReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAuthSysAuthTwoFactorEnable)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SysAuthTwoFactorEnable(ctx, req.(*SysAuthTwoFactorEnableReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SysAuthTwoFactorEnableReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysAuth_SysAuthTwoFactorDisable0_HTTP_Handler(srv SysAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SysAuthTwoFactorDisableReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAuthSysAuthTwoFactorDisable)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SysAuthTwoFactorDisable(ctx, req.(*SysAuthTwoFactorDisableReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SysAuthTwoFactorDisableReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysAuth_SysAuthTwoFactorRecoveryCodes0_HTTP_Handler(srv SysAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SysAuthTwoFactorRecoveryCodesReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysAuthSysAuthTwoFactorRecoveryCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SysAuthTwoFactorRecoveryCodes(ctx, req.(*SysAuthTwoFactorRecoveryCodesReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SysAuthTwoFactorRecoveryCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _SysAuth_SysAuthAdminInfo0_HTTP_Handler(srv SysAuthHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SysAuthAdminInfoReq
@@ -187,6 +341,13 @@ type SysAuthHTTPClient interface {
 	SysAuthLogout(ctx context.Context, req *SysAuthLogoutReq, opts ...http.CallOption) (rsp *SysAuthLogoutReply, err error)
 	SysAuthMenu(ctx context.Context, req *SysAuthMenuReq, opts ...http.CallOption) (rsp *SysAuthMenuReply, err error)
 	SysAuthPermission(ctx context.Context, req *SysAuthPermissionReq, opts ...http.CallOption) (rsp *SysAuthPermissionReply, err error)
+	SysAuthRefreshToken(ctx context.Context, req *SysAuthRefreshTokenReq, opts ...http.CallOption) (rsp *SysAuthRefreshTokenReply, err error)
+	SysAuthTwoFactorDisable(ctx context.Context, req *SysAuthTwoFactorDisableReq, opts ...http.CallOption) (rsp *SysAuthTwoFactorDisableReply, err error)
+	SysAuthTwoFactorEnable(ctx context.Context, req *SysAuthTwoFactorEnableReq, opts ...http.CallOption) (rsp *SysAuthTwoFactorEnableReply, err error)
+	SysAuthTwoFactorLogin(ctx context.Context, req *SysAuthTwoFactorLoginReq, opts ...http.CallOption) (rsp *SysAuthTwoFactorLoginReply, err error)
+	SysAuthTwoFactorLoginSetup(ctx context.Context, req *SysAuthTwoFactorLoginSetupReq, opts ...http.CallOption) (rsp *SysAuthTwoFactorLoginSetupReply, err error)
+	SysAuthTwoFactorRecoveryCodes(ctx context.Context, req *SysAuthTwoFactorRecoveryCodesReq, opts ...http.CallOption) (rsp *SysAuthTwoFactorRecoveryCodesReply, err error)
+	SysAuthTwoFactorSetup(ctx context.Context, req *SysAuthTwoFactorSetupReq, opts ...http.CallOption) (rsp *SysAuthTwoFactorSetupReply, err error)
 	SysAuthUpdateAdminInfo(ctx context.Context, req *SysAuthUpdateAdminInfoReq, opts ...http.CallOption) (rsp *SysAuthUpdateAdminInfoReply, err error)
 	SysAuthUpdateAdminPassword(ctx context.Context, req *SysAuthUpdateAdminPasswordReq, opts ...http.CallOption) (rsp *SysAuthUpdateAdminPasswordReply, err error)
 }
@@ -264,6 +425,97 @@ func (c *SysAuthHTTPClientImpl) SysAuthPermission(ctx context.Context, in *SysAu
 	return &out, err
 }
 
+func (c *SysAuthHTTPClientImpl) SysAuthRefreshToken(ctx context.Context, in *SysAuthRefreshTokenReq, opts ...http.CallOption) (*SysAuthRefreshTokenReply, error) {
+	var out SysAuthRefreshTokenReply
+	pattern := "/admin/v1/sys_auth/refresh_token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAuthSysAuthRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SysAuthHTTPClientImpl) SysAuthTwoFactorDisable(ctx context.Context, in *SysAuthTwoFactorDisableReq, opts ...http.CallOption) (*SysAuthTwoFactorDisableReply, error) {
+	var out SysAuthTwoFactorDisableReply
+	pattern := "/admin/v1/sys_auth/two_factor/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAuthSysAuthTwoFactorDisable))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SysAuthHTTPClientImpl) SysAuthTwoFactorEnable(ctx context.Context, in *SysAuthTwoFactorEnableReq, opts ...http.CallOption) (*SysAuthTwoFactorEnableReply, error) {
+	var out SysAuthTwoFactorEnableReply
+	pattern := "/admin/v1/sys_auth/two_factor/enable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAuthSysAuthTwoFactorEnable))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SysAuthHTTPClientImpl) SysAuthTwoFactorLogin(ctx context.Context, in *SysAuthTwoFactorLoginReq, opts ...http.CallOption) (*SysAuthTwoFactorLoginReply, error) {
+	var out SysAuthTwoFactorLoginReply
+	pattern := "/admin/v1/sys_auth/two_factor/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAuthSysAuthTwoFactorLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SysAuthHTTPClientImpl) SysAuthTwoFactorLoginSetup(ctx context.Context, in *SysAuthTwoFactorLoginSetupReq, opts ...http.CallOption) (*SysAuthTwoFactorLoginSetupReply, error) {
+	var out SysAuthTwoFactorLoginSetupReply
+	pattern := "/admin/v1/sys_auth/two_factor/login_setup"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAuthSysAuthTwoFactorLoginSetup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SysAuthHTTPClientImpl) SysAuthTwoFactorRecoveryCodes(ctx context.Context, in *SysAuthTwoFactorRecoveryCodesReq, opts ...http.CallOption) (*SysAuthTwoFactorRecoveryCodesReply, error) {
+	var out SysAuthTwoFactorRecoveryCodesReply
+	pattern := "/admin/v1/sys_auth/two_factor/recovery_codes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAuthSysAuthTwoFactorRecoveryCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SysAuthHTTPClientImpl) SysAuthTwoFactorSetup(ctx context.Context, in *SysAuthTwoFactorSetupReq, opts ...http.CallOption) (*SysAuthTwoFactorSetupReply, error) {
+	var out SysAuthTwoFactorSetupReply
+	pattern := "/admin/v1/sys_auth/two_factor/setup"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysAuthSysAuthTwoFactorSetup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *SysAuthHTTPClientImpl) SysAuthUpdateAdminInfo(ctx context.Context, in *SysAuthUpdateAdminInfoReq, opts ...http.CallOption) (*SysAuthUpdateAdminInfoReply, error) {
 	var out SysAuthUpdateAdminInfoReply
 	pattern := "/admin/v1/sys_auth/update/admin_info"
@@ -29,13 +29,20 @@ type SysNoticeInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`               // id
-	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`           // 类型
-	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`         // 标题
-	Content   string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`     // 内容
-	Status    int32  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`      // 状态(-1禁用,1开启)
-	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // 创建时间
-	UpdatedAt string `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` // 更新时间
+	Id             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                           // id
+	Type           string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                       // 类型
+	Title          string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                     // 标题
+	Content        string   `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                 // 内容
+	Status         int32    `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                  // 状态(-1禁用,1开启)
+	CreatedAt      string   `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`             // 创建时间
+	UpdatedAt      string   `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`             // 更新时间
+	TargetType     string   `protobuf:"bytes,8,opt,name=targetType,proto3" json:"targetType,omitempty"`           // 发布对象类型(all全部租户,tenant指定租户,role指定角色,dept指定部门)
+	TargetIds      []string `protobuf:"bytes,9,rep,name=targetIds,proto3" json:"targetIds,omitempty"`             // 发布对象编号
+	PublishStatus  int32    `protobuf:"varint,10,opt,name=publishStatus,proto3" json:"publishStatus,omitempty"`   // 发布状态(-1失败,0草稿,1待发布,2发布中,3已发布,4已过期)
+	PublishTime    string   `protobuf:"bytes,11,opt,name=publishTime,proto3" json:"publishTime,omitempty"`        // 计划发布时间
+	ExpireTime     string   `protobuf:"bytes,12,opt,name=expireTime,proto3" json:"expireTime,omitempty"`          // 过期时间
+	PublishedAt    string   `protobuf:"bytes,13,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`        // 实际发布时间
+	RecipientCount int32    `protobuf:"varint,14,opt,name=recipientCount,proto3" json:"recipientCount,omitempty"` // 接收人数
 }
 
 func (x *SysNoticeInfo) Reset() {
@@ -119,6 +126,55 @@ func (x *SysNoticeInfo) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *SysNoticeInfo) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *SysNoticeInfo) GetTargetIds() []string {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *SysNoticeInfo) GetPublishStatus() int32 {
+	if x != nil {
+		return x.PublishStatus
+	}
+	return 0
+}
+
+func (x *SysNoticeInfo) GetPublishTime() string {
+	if x != nil {
+		return x.PublishTime
+	}
+	return ""
+}
+
+func (x *SysNoticeInfo) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+func (x *SysNoticeInfo) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *SysNoticeInfo) GetRecipientCount() int32 {
+	if x != nil {
+		return x.RecipientCount
+	}
+	return 0
+}
+
 // 请求-系统-公告-创建一条数据
 type CreateSysNoticeReq struct {
 	state         protoimpl.MessageState
@@ -772,6 +828,245 @@ func (x *GetSysNoticeListReply) GetList() []*SysNoticeInfo {
 	return nil
 }
 
+// 请求-系统-公告-发布
+type PublishSysNoticeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // id
+	TargetType  string   `protobuf:"bytes,2,opt,name=targetType,proto3" json:"targetType,omitempty"`   // 发布对象类型(all全部租户,tenant指定租户,role指定角色,dept指定部门)
+	TargetIds   []string `protobuf:"bytes,3,rep,name=targetIds,proto3" json:"targetIds,omitempty"`     // 发布对象编号, 发布对象类型为 all 时忽略
+	PublishTime string   `protobuf:"bytes,4,opt,name=publishTime,proto3" json:"publishTime,omitempty"` // 计划发布时间, 为空时立即发布
+	ExpireTime  string   `protobuf:"bytes,5,opt,name=expireTime,proto3" json:"expireTime,omitempty"`   // 过期时间, 为空时不过期
+}
+
+func (x *PublishSysNoticeReq) Reset() {
+	*x = PublishSysNoticeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_notice_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishSysNoticeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSysNoticeReq) ProtoMessage() {}
+
+func (x *PublishSysNoticeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_notice_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSysNoticeReq.ProtoReflect.Descriptor instead.
+func (*PublishSysNoticeReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_notice_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PublishSysNoticeReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublishSysNoticeReq) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *PublishSysNoticeReq) GetTargetIds() []string {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *PublishSysNoticeReq) GetPublishTime() string {
+	if x != nil {
+		return x.PublishTime
+	}
+	return ""
+}
+
+func (x *PublishSysNoticeReq) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+// 响应-系统-公告-发布
+type PublishSysNoticeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PublishSysNoticeReply) Reset() {
+	*x = PublishSysNoticeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_notice_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishSysNoticeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSysNoticeReply) ProtoMessage() {}
+
+func (x *PublishSysNoticeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_notice_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSysNoticeReply.ProtoReflect.Descriptor instead.
+func (*PublishSysNoticeReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_notice_proto_rawDescGZIP(), []int{14}
+}
+
+// 请求-系统-公告-阅读统计
+type GetSysNoticeReadStatsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // id
+}
+
+func (x *GetSysNoticeReadStatsReq) Reset() {
+	*x = GetSysNoticeReadStatsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_notice_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSysNoticeReadStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSysNoticeReadStatsReq) ProtoMessage() {}
+
+func (x *GetSysNoticeReadStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_notice_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSysNoticeReadStatsReq.ProtoReflect.Descriptor instead.
+func (*GetSysNoticeReadStatsReq) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_notice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSysNoticeReadStatsReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 响应-系统-公告-阅读统计
+type GetSysNoticeReadStatsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecipientCount int64   `protobuf:"varint,1,opt,name=recipientCount,proto3" json:"recipientCount,omitempty"` // 接收人数
+	ReadCount      int64   `protobuf:"varint,2,opt,name=readCount,proto3" json:"readCount,omitempty"`           // 已读人数
+	UnreadCount    int64   `protobuf:"varint,3,opt,name=unreadCount,proto3" json:"unreadCount,omitempty"`       // 未读人数
+	ReadRate       float64 `protobuf:"fixed64,4,opt,name=readRate,proto3" json:"readRate,omitempty"`            // 阅读率(0-1)
+}
+
+func (x *GetSysNoticeReadStatsReply) Reset() {
+	*x = GetSysNoticeReadStatsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_v1_sys_notice_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSysNoticeReadStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSysNoticeReadStatsReply) ProtoMessage() {}
+
+func (x *GetSysNoticeReadStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_sys_notice_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSysNoticeReadStatsReply.ProtoReflect.Descriptor instead.
+func (*GetSysNoticeReadStatsReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_sys_notice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSysNoticeReadStatsReply) GetRecipientCount() int64 {
+	if x != nil {
+		return x.RecipientCount
+	}
+	return 0
+}
+
+func (x *GetSysNoticeReadStatsReply) GetReadCount() int64 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+func (x *GetSysNoticeReadStatsReply) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetSysNoticeReadStatsReply) GetReadRate() float64 {
+	if x != nil {
+		return x.ReadRate
+	}
+	return 0
+}
+
 var File_admin_v1_sys_notice_proto protoreflect.FileDescriptor
 
 var file_admin_v1_sys_notice_proto_rawDesc = []byte{
@@ -784,7 +1079,7 @@ var file_admin_v1_sys_notice_proto_rawDesc = []byte{
 	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
 	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xb7, 0x01, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x49, 0x6e,
+	0x22, 0xa7, 0x03, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x49, 0x6e,
 	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
@@ -795,7 +1090,22 @@ var file_admin_v1_sys_notice_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
 	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x43,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65,
 	0x71, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
@@ -863,7 +1173,39 @@ var file_admin_v1_sys_notice_proto_rawDesc = []byte{
 	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b,
 	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
 	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x32, 0xf7, 0x05, 0x0a, 0x09,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xfd, 0x01, 0x0a, 0x13,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x3e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x48, 0x1b, 0x72, 0x19, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x52,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x64,
+	0x65, 0x70, 0x74, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2f, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x11, 0xba, 0x48, 0x0e, 0x92, 0x01, 0x0b, 0x10, 0xe8, 0x07, 0x22, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x3a, 0x17, 0x92, 0x41, 0x14, 0x0a, 0x12, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01,
+	0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x42, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41,
+	0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x52, 0x61, 0x74, 0x65, 0x32, 0x81, 0x08, 0x0a, 0x09,
 	0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x0f, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x61,
 	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79,
@@ -911,11 +1253,28 @@ var file_admin_v1_sys_notice_proto_rawDesc = []byte{
 	0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
 	0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x64, 0x6d,
 	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65,
-	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d,
-	0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b,
-	0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
-	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x7b, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x79, 0x73, 0x4e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x79, 0x73, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x22, 0x1c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73,
+	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x3a,
+	0x01, 0x2a, 0x12, 0x8a, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x4e, 0x6f, 0x74,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x79, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x5f, 0x6e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42,
+	0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a,
+	0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -930,7 +1289,7 @@ func file_admin_v1_sys_notice_proto_rawDescGZIP() []byte {
 	return file_admin_v1_sys_notice_proto_rawDescData
 }
 
-var file_admin_v1_sys_notice_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_admin_v1_sys_notice_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_admin_v1_sys_notice_proto_goTypes = []interface{}{
 	(*SysNoticeInfo)(nil),              // 0: admin.v1.SysNoticeInfo
 	(*CreateSysNoticeReq)(nil),         // 1: admin.v1.CreateSysNoticeReq
@@ -945,6 +1304,10 @@ var file_admin_v1_sys_notice_proto_goTypes = []interface{}{
 	(*GetSysNoticeInfoReply)(nil),      // 10: admin.v1.GetSysNoticeInfoReply
 	(*GetSysNoticeListReq)(nil),        // 11: admin.v1.GetSysNoticeListReq
 	(*GetSysNoticeListReply)(nil),      // 12: admin.v1.GetSysNoticeListReply
+	(*PublishSysNoticeReq)(nil),        // 13: admin.v1.PublishSysNoticeReq
+	(*PublishSysNoticeReply)(nil),      // 14: admin.v1.PublishSysNoticeReply
+	(*GetSysNoticeReadStatsReq)(nil),   // 15: admin.v1.GetSysNoticeReadStatsReq
+	(*GetSysNoticeReadStatsReply)(nil), // 16: admin.v1.GetSysNoticeReadStatsReply
 }
 var file_admin_v1_sys_notice_proto_depIdxs = []int32{
 	0,  // 0: admin.v1.GetSysNoticeInfoReply.info:type_name -> admin.v1.SysNoticeInfo
@@ -955,14 +1318,18 @@ var file_admin_v1_sys_notice_proto_depIdxs = []int32{
 	7,  // 5: admin.v1.SysNotice.DeleteSysNotice:input_type -> admin.v1.DeleteSysNoticeReq
 	9,  // 6: admin.v1.SysNotice.GetSysNoticeInfo:input_type -> admin.v1.GetSysNoticeInfoReq
 	11, // 7: admin.v1.SysNotice.GetSysNoticeList:input_type -> admin.v1.GetSysNoticeListReq
-	2,  // 8: admin.v1.SysNotice.CreateSysNotice:output_type -> admin.v1.CreateSysNoticeReply
-	4,  // 9: admin.v1.SysNotice.UpdateSysNotice:output_type -> admin.v1.UpdateSysNoticeReply
-	6,  // 10: admin.v1.SysNotice.UpdateSysNoticeStatus:output_type -> admin.v1.UpdateSysNoticeStatusReply
-	8,  // 11: admin.v1.SysNotice.DeleteSysNotice:output_type -> admin.v1.DeleteSysNoticeReply
-	10, // 12: admin.v1.SysNotice.GetSysNoticeInfo:output_type -> admin.v1.GetSysNoticeInfoReply
-	12, // 13: admin.v1.SysNotice.GetSysNoticeList:output_type -> admin.v1.GetSysNoticeListReply
-	8,  // [8:14] is the sub-list for method output_type
-	2,  // [2:8] is the sub-list for method input_type
+	13, // 8: admin.v1.SysNotice.PublishSysNotice:input_type -> admin.v1.PublishSysNoticeReq
+	15, // 9: admin.v1.SysNotice.GetSysNoticeReadStats:input_type -> admin.v1.GetSysNoticeReadStatsReq
+	2,  // 10: admin.v1.SysNotice.CreateSysNotice:output_type -> admin.v1.CreateSysNoticeReply
+	4,  // 11: admin.v1.SysNotice.UpdateSysNotice:output_type -> admin.v1.UpdateSysNoticeReply
+	6,  // 12: admin.v1.SysNotice.UpdateSysNoticeStatus:output_type -> admin.v1.UpdateSysNoticeStatusReply
+	8,  // 13: admin.v1.SysNotice.DeleteSysNotice:output_type -> admin.v1.DeleteSysNoticeReply
+	10, // 14: admin.v1.SysNotice.GetSysNoticeInfo:output_type -> admin.v1.GetSysNoticeInfoReply
+	12, // 15: admin.v1.SysNotice.GetSysNoticeList:output_type -> admin.v1.GetSysNoticeListReply
+	14, // 16: admin.v1.SysNotice.PublishSysNotice:output_type -> admin.v1.PublishSysNoticeReply
+	16, // 17: admin.v1.SysNotice.GetSysNoticeReadStats:output_type -> admin.v1.GetSysNoticeReadStatsReply
+	10, // [10:18] is the sub-list for method output_type
+	2,  // [2:10] is the sub-list for method input_type
 	2,  // [2:2] is the sub-list for extension type_name
 	2,  // [2:2] is the sub-list for extension extendee
 	0,  // [0:2] is the sub-list for field type_name
@@ -1130,6 +1497,54 @@ func file_admin_v1_sys_notice_proto_init() {
 				return nil
 			}
 		}
+		file_admin_v1_sys_notice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishSysNoticeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_notice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishSysNoticeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_notice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSysNoticeReadStatsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_v1_sys_notice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSysNoticeReadStatsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1137,7 +1552,7 @@ func file_admin_v1_sys_notice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_admin_v1_sys_notice_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -71,6 +71,18 @@ func (m *SysNoticeInfo) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for TargetType
+
+	// no validation rules for PublishStatus
+
+	// no validation rules for PublishTime
+
+	// no validation rules for ExpireTime
+
+	// no validation rules for PublishedAt
+
+	// no validation rules for RecipientCount
+
 	if len(errors) > 0 {
 		return SysNoticeInfoMultiError(errors)
 	}
@@ -1475,3 +1487,429 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetSysNoticeListReplyValidationError{}
+
+// Validate checks the field values on PublishSysNoticeReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishSysNoticeReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishSysNoticeReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishSysNoticeReqMultiError, or nil if none found.
+func (m *PublishSysNoticeReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishSysNoticeReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TargetType
+
+	// no validation rules for PublishTime
+
+	// no validation rules for ExpireTime
+
+	if len(errors) > 0 {
+		return PublishSysNoticeReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishSysNoticeReqMultiError is an error wrapping multiple validation
+// errors returned by PublishSysNoticeReq.ValidateAll() if the designated
+// constraints aren't met.
+type PublishSysNoticeReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishSysNoticeReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishSysNoticeReqMultiError) AllErrors() []error { return m }
+
+// PublishSysNoticeReqValidationError is the validation error returned by
+// PublishSysNoticeReq.Validate if the designated constraints aren't met.
+type PublishSysNoticeReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishSysNoticeReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishSysNoticeReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishSysNoticeReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishSysNoticeReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishSysNoticeReqValidationError) ErrorName() string {
+	return "PublishSysNoticeReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishSysNoticeReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishSysNoticeReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishSysNoticeReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishSysNoticeReqValidationError{}
+
+// Validate checks the field values on PublishSysNoticeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishSysNoticeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishSysNoticeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishSysNoticeReplyMultiError, or nil if none found.
+func (m *PublishSysNoticeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishSysNoticeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PublishSysNoticeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishSysNoticeReplyMultiError is an error wrapping multiple validation
+// errors returned by PublishSysNoticeReply.ValidateAll() if the designated
+// constraints aren't met.
+type PublishSysNoticeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishSysNoticeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishSysNoticeReplyMultiError) AllErrors() []error { return m }
+
+// PublishSysNoticeReplyValidationError is the validation error returned by
+// PublishSysNoticeReply.Validate if the designated constraints aren't met.
+type PublishSysNoticeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishSysNoticeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishSysNoticeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishSysNoticeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishSysNoticeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishSysNoticeReplyValidationError) ErrorName() string {
+	return "PublishSysNoticeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishSysNoticeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishSysNoticeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishSysNoticeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishSysNoticeReplyValidationError{}
+
+// Validate checks the field values on GetSysNoticeReadStatsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSysNoticeReadStatsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSysNoticeReadStatsReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSysNoticeReadStatsReqMultiError, or nil if none found.
+func (m *GetSysNoticeReadStatsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSysNoticeReadStatsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetSysNoticeReadStatsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSysNoticeReadStatsReqMultiError is an error wrapping multiple validation
+// errors returned by GetSysNoticeReadStatsReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetSysNoticeReadStatsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSysNoticeReadStatsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSysNoticeReadStatsReqMultiError) AllErrors() []error { return m }
+
+// GetSysNoticeReadStatsReqValidationError is the validation error returned by
+// GetSysNoticeReadStatsReq.Validate if the designated constraints aren't met.
+type GetSysNoticeReadStatsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSysNoticeReadStatsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSysNoticeReadStatsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSysNoticeReadStatsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSysNoticeReadStatsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSysNoticeReadStatsReqValidationError) ErrorName() string {
+	return "GetSysNoticeReadStatsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSysNoticeReadStatsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSysNoticeReadStatsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSysNoticeReadStatsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSysNoticeReadStatsReqValidationError{}
+
+// Validate checks the field values on GetSysNoticeReadStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSysNoticeReadStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSysNoticeReadStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSysNoticeReadStatsReplyMultiError, or nil if none found.
+func (m *GetSysNoticeReadStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSysNoticeReadStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecipientCount
+
+	// no validation rules for ReadCount
+
+	// no validation rules for UnreadCount
+
+	// no validation rules for ReadRate
+
+	if len(errors) > 0 {
+		return GetSysNoticeReadStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSysNoticeReadStatsReplyMultiError is an error wrapping multiple
+// validation errors returned by GetSysNoticeReadStatsReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetSysNoticeReadStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSysNoticeReadStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSysNoticeReadStatsReplyMultiError) AllErrors() []error { return m }
+
+// GetSysNoticeReadStatsReplyValidationError is the validation error returned
+// by GetSysNoticeReadStatsReply.Validate if the designated constraints aren't met.
+type GetSysNoticeReadStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSysNoticeReadStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSysNoticeReadStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSysNoticeReadStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSysNoticeReadStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSysNoticeReadStatsReplyValidationError) ErrorName() string {
+	return "GetSysNoticeReadStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSysNoticeReadStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSysNoticeReadStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSysNoticeReadStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSysNoticeReadStatsReplyValidationError{}
@@ -34,6 +34,10 @@ type SysNoticeClient interface {
 	GetSysNoticeInfo(ctx context.Context, in *GetSysNoticeInfoReq, opts ...grpc.CallOption) (*GetSysNoticeInfoReply, error)
 	// 系统-公告-列表数据查询
 	GetSysNoticeList(ctx context.Context, in *GetSysNoticeListReq, opts ...grpc.CallOption) (*GetSysNoticeListReply, error)
+	// 系统-公告-发布(指定发布对象, 支持定时发布与过期时间)
+	PublishSysNotice(ctx context.Context, in *PublishSysNoticeReq, opts ...grpc.CallOption) (*PublishSysNoticeReply, error)
+	// 系统-公告-阅读统计
+	GetSysNoticeReadStats(ctx context.Context, in *GetSysNoticeReadStatsReq, opts ...grpc.CallOption) (*GetSysNoticeReadStatsReply, error)
 }
 
 type sysNoticeClient struct {
@@ -98,6 +102,24 @@ func (c *sysNoticeClient) GetSysNoticeList(ctx context.Context, in *GetSysNotice
 	return out, nil
 }
 
+func (c *sysNoticeClient) PublishSysNotice(ctx context.Context, in *PublishSysNoticeReq, opts ...grpc.CallOption) (*PublishSysNoticeReply, error) {
+	out := new(PublishSysNoticeReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysNotice/PublishSysNotice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysNoticeClient) GetSysNoticeReadStats(ctx context.Context, in *GetSysNoticeReadStatsReq, opts ...grpc.CallOption) (*GetSysNoticeReadStatsReply, error) {
+	out := new(GetSysNoticeReadStatsReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.SysNotice/GetSysNoticeReadStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SysNoticeServer is the server API for SysNotice service.
 // All implementations must embed UnimplementedSysNoticeServer
 // for forward compatibility
@@ -114,6 +136,10 @@ type SysNoticeServer interface {
 	GetSysNoticeInfo(context.Context, *GetSysNoticeInfoReq) (*GetSysNoticeInfoReply, error)
 	// 系统-公告-列表数据查询
 	GetSysNoticeList(context.Context, *GetSysNoticeListReq) (*GetSysNoticeListReply, error)
+	// 系统-公告-发布(指定发布对象, 支持定时发布与过期时间)
+	PublishSysNotice(context.Context, *PublishSysNoticeReq) (*PublishSysNoticeReply, error)
+	// 系统-公告-阅读统计
+	GetSysNoticeReadStats(context.Context, *GetSysNoticeReadStatsReq) (*GetSysNoticeReadStatsReply, error)
 	mustEmbedUnimplementedSysNoticeServer()
 }
 
@@ -139,6 +165,12 @@ func (UnimplementedSysNoticeServer) GetSysNoticeInfo(context.Context, *GetSysNot
 func (UnimplementedSysNoticeServer) GetSysNoticeList(context.Context, *GetSysNoticeListReq) (*GetSysNoticeListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSysNoticeList not implemented")
 }
+func (UnimplementedSysNoticeServer) PublishSysNotice(context.Context, *PublishSysNoticeReq) (*PublishSysNoticeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishSysNotice not implemented")
+}
+func (UnimplementedSysNoticeServer) GetSysNoticeReadStats(context.Context, *GetSysNoticeReadStatsReq) (*GetSysNoticeReadStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSysNoticeReadStats not implemented")
+}
 func (UnimplementedSysNoticeServer) mustEmbedUnimplementedSysNoticeServer() {}
 
 // UnsafeSysNoticeServer may be embedded to opt out of forward compatibility for this service.
@@ -260,6 +292,42 @@ func _SysNotice_GetSysNoticeList_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysNotice_PublishSysNotice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishSysNoticeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysNoticeServer).PublishSysNotice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysNotice/PublishSysNotice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysNoticeServer).PublishSysNotice(ctx, req.(*PublishSysNoticeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysNotice_GetSysNoticeReadStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSysNoticeReadStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysNoticeServer).GetSysNoticeReadStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.SysNotice/GetSysNoticeReadStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysNoticeServer).GetSysNoticeReadStats(ctx, req.(*GetSysNoticeReadStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SysNotice_ServiceDesc is the grpc.ServiceDesc for SysNotice service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -291,6 +359,14 @@ var SysNotice_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSysNoticeList",
 			Handler:    _SysNotice_GetSysNoticeList_Handler,
 		},
+		{
+			MethodName: "PublishSysNotice",
+			Handler:    _SysNotice_PublishSysNotice_Handler,
+		},
+		{
+			MethodName: "GetSysNoticeReadStats",
+			Handler:    _SysNotice_GetSysNoticeReadStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/sys_notice.proto",
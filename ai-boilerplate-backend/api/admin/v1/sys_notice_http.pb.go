@@ -23,6 +23,8 @@ const OperationSysNoticeCreateSysNotice = "/admin.v1.SysNotice/CreateSysNotice"
 const OperationSysNoticeDeleteSysNotice = "/admin.v1.SysNotice/DeleteSysNotice"
 const OperationSysNoticeGetSysNoticeInfo = "/admin.v1.SysNotice/GetSysNoticeInfo"
 const OperationSysNoticeGetSysNoticeList = "/admin.v1.SysNotice/GetSysNoticeList"
+const OperationSysNoticeGetSysNoticeReadStats = "/admin.v1.SysNotice/GetSysNoticeReadStats"
+const OperationSysNoticePublishSysNotice = "/admin.v1.SysNotice/PublishSysNotice"
 const OperationSysNoticeUpdateSysNotice = "/admin.v1.SysNotice/UpdateSysNotice"
 const OperationSysNoticeUpdateSysNoticeStatus = "/admin.v1.SysNotice/UpdateSysNoticeStatus"
 
@@ -31,6 +33,8 @@ type SysNoticeHTTPServer interface {
 	DeleteSysNotice(context.Context, *DeleteSysNoticeReq) (*DeleteSysNoticeReply, error)
 	GetSysNoticeInfo(context.Context, *GetSysNoticeInfoReq) (*GetSysNoticeInfoReply, error)
 	GetSysNoticeList(context.Context, *GetSysNoticeListReq) (*GetSysNoticeListReply, error)
+	GetSysNoticeReadStats(context.Context, *GetSysNoticeReadStatsReq) (*GetSysNoticeReadStatsReply, error)
+	PublishSysNotice(context.Context, *PublishSysNoticeReq) (*PublishSysNoticeReply, error)
 	UpdateSysNotice(context.Context, *UpdateSysNoticeReq) (*UpdateSysNoticeReply, error)
 	UpdateSysNoticeStatus(context.Context, *UpdateSysNoticeStatusReq) (*UpdateSysNoticeStatusReply, error)
 }
@@ -43,6 +47,8 @@ func RegisterSysNoticeHTTPServer(s *http.Server, srv SysNoticeHTTPServer) {
 	r.POST("/admin/v1/sys_notice/delete", _SysNotice_DeleteSysNotice0_HTTP_Handler(srv))
 	r.GET("/admin/v1/sys_notice/info", _SysNotice_GetSysNoticeInfo0_HTTP_Handler(srv))
 	r.GET("/admin/v1/sys_notice/list", _SysNotice_GetSysNoticeList0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sys_notice/publish", _SysNotice_PublishSysNotice0_HTTP_Handler(srv))
+	r.GET("/admin/v1/sys_notice/read_stats", _SysNotice_GetSysNoticeReadStats0_HTTP_Handler(srv))
 }
 
 func _SysNotice_CreateSysNotice0_HTTP_Handler(srv SysNoticeHTTPServer) func(ctx http.Context) error {
@@ -159,11 +165,51 @@ func _SysNotice_GetSysNoticeList0_HTTP_Handler(srv SysNoticeHTTPServer) func(ctx
 	}
 }
 
+func _SysNotice_PublishSysNotice0_HTTP_Handler(srv SysNoticeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PublishSysNoticeReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysNoticePublishSysNotice)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishSysNotice(ctx, req.(*PublishSysNoticeReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PublishSysNoticeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysNotice_GetSysNoticeReadStats0_HTTP_Handler(srv SysNoticeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSysNoticeReadStatsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysNoticeGetSysNoticeReadStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSysNoticeReadStats(ctx, req.(*GetSysNoticeReadStatsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSysNoticeReadStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type SysNoticeHTTPClient interface {
 	CreateSysNotice(ctx context.Context, req *CreateSysNoticeReq, opts ...http.CallOption) (rsp *CreateSysNoticeReply, err error)
 	DeleteSysNotice(ctx context.Context, req *DeleteSysNoticeReq, opts ...http.CallOption) (rsp *DeleteSysNoticeReply, err error)
 	GetSysNoticeInfo(ctx context.Context, req *GetSysNoticeInfoReq, opts ...http.CallOption) (rsp *GetSysNoticeInfoReply, err error)
 	GetSysNoticeList(ctx context.Context, req *GetSysNoticeListReq, opts ...http.CallOption) (rsp *GetSysNoticeListReply, err error)
+	GetSysNoticeReadStats(ctx context.Context, req *GetSysNoticeReadStatsReq, opts ...http.CallOption) (rsp *GetSysNoticeReadStatsReply, err error)
+	PublishSysNotice(ctx context.Context, req *PublishSysNoticeReq, opts ...http.CallOption) (rsp *PublishSysNoticeReply, err error)
 	UpdateSysNotice(ctx context.Context, req *UpdateSysNoticeReq, opts ...http.CallOption) (rsp *UpdateSysNoticeReply, err error)
 	UpdateSysNoticeStatus(ctx context.Context, req *UpdateSysNoticeStatusReq, opts ...http.CallOption) (rsp *UpdateSysNoticeStatusReply, err error)
 }
@@ -228,6 +274,32 @@ func (c *SysNoticeHTTPClientImpl) GetSysNoticeList(ctx context.Context, in *GetS
 	return &out, err
 }
 
+func (c *SysNoticeHTTPClientImpl) GetSysNoticeReadStats(ctx context.Context, in *GetSysNoticeReadStatsReq, opts ...http.CallOption) (*GetSysNoticeReadStatsReply, error) {
+	var out GetSysNoticeReadStatsReply
+	pattern := "/admin/v1/sys_notice/read_stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSysNoticeGetSysNoticeReadStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SysNoticeHTTPClientImpl) PublishSysNotice(ctx context.Context, in *PublishSysNoticeReq, opts ...http.CallOption) (*PublishSysNoticeReply, error) {
+	var out PublishSysNoticeReply
+	pattern := "/admin/v1/sys_notice/publish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysNoticePublishSysNotice))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *SysNoticeHTTPClientImpl) UpdateSysNotice(ctx context.Context, in *UpdateSysNoticeReq, opts ...http.CallOption) (*UpdateSysNoticeReply, error) {
 	var out UpdateSysNoticeReply
 	pattern := "/admin/v1/sys_notice/update"
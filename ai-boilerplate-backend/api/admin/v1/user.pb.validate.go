@@ -1497,3 +1497,769 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetUserListReplyValidationError{}
+
+// Validate checks the field values on UserSessionInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserSessionInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserSessionInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserSessionInfoMultiError, or nil if none found.
+func (m *UserSessionInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserSessionInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Device
+
+	// no validation rules for Ip
+
+	// no validation rules for UserAgent
+
+	// no validation rules for LoginAt
+
+	// no validation rules for LastSeenAt
+
+	// no validation rules for ExpiredAt
+
+	if len(errors) > 0 {
+		return UserSessionInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserSessionInfoMultiError is an error wrapping multiple validation errors
+// returned by UserSessionInfo.ValidateAll() if the designated constraints
+// aren't met.
+type UserSessionInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserSessionInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserSessionInfoMultiError) AllErrors() []error { return m }
+
+// UserSessionInfoValidationError is the validation error returned by
+// UserSessionInfo.Validate if the designated constraints aren't met.
+type UserSessionInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserSessionInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserSessionInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserSessionInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserSessionInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserSessionInfoValidationError) ErrorName() string { return "UserSessionInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserSessionInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserSessionInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserSessionInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserSessionInfoValidationError{}
+
+// Validate checks the field values on GetUserSessionListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserSessionListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserSessionListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserSessionListReqMultiError, or nil if none found.
+func (m *GetUserSessionListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserSessionListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetUserSessionListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserSessionListReqMultiError is an error wrapping multiple validation
+// errors returned by GetUserSessionListReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserSessionListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserSessionListReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserSessionListReqMultiError) AllErrors() []error { return m }
+
+// GetUserSessionListReqValidationError is the validation error returned by
+// GetUserSessionListReq.Validate if the designated constraints aren't met.
+type GetUserSessionListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserSessionListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserSessionListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserSessionListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserSessionListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserSessionListReqValidationError) ErrorName() string {
+	return "GetUserSessionListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserSessionListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserSessionListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserSessionListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserSessionListReqValidationError{}
+
+// Validate checks the field values on GetUserSessionListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserSessionListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserSessionListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserSessionListReplyMultiError, or nil if none found.
+func (m *GetUserSessionListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserSessionListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserSessionListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserSessionListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserSessionListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserSessionListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserSessionListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUserSessionListReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserSessionListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserSessionListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserSessionListReplyMultiError) AllErrors() []error { return m }
+
+// GetUserSessionListReplyValidationError is the validation error returned by
+// GetUserSessionListReply.Validate if the designated constraints aren't met.
+type GetUserSessionListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserSessionListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserSessionListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserSessionListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserSessionListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserSessionListReplyValidationError) ErrorName() string {
+	return "GetUserSessionListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserSessionListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserSessionListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserSessionListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserSessionListReplyValidationError{}
+
+// Validate checks the field values on DeleteUserSessionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUserSessionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUserSessionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUserSessionReqMultiError, or nil if none found.
+func (m *DeleteUserSessionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUserSessionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SessionId
+
+	if len(errors) > 0 {
+		return DeleteUserSessionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUserSessionReqMultiError is an error wrapping multiple validation
+// errors returned by DeleteUserSessionReq.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUserSessionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUserSessionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUserSessionReqMultiError) AllErrors() []error { return m }
+
+// DeleteUserSessionReqValidationError is the validation error returned by
+// DeleteUserSessionReq.Validate if the designated constraints aren't met.
+type DeleteUserSessionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUserSessionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUserSessionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUserSessionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUserSessionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUserSessionReqValidationError) ErrorName() string {
+	return "DeleteUserSessionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUserSessionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUserSessionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUserSessionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUserSessionReqValidationError{}
+
+// Validate checks the field values on DeleteUserSessionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUserSessionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUserSessionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUserSessionReplyMultiError, or nil if none found.
+func (m *DeleteUserSessionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUserSessionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteUserSessionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUserSessionReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteUserSessionReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUserSessionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUserSessionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUserSessionReplyMultiError) AllErrors() []error { return m }
+
+// DeleteUserSessionReplyValidationError is the validation error returned by
+// DeleteUserSessionReply.Validate if the designated constraints aren't met.
+type DeleteUserSessionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUserSessionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUserSessionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUserSessionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUserSessionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUserSessionReplyValidationError) ErrorName() string {
+	return "DeleteUserSessionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUserSessionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUserSessionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUserSessionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUserSessionReplyValidationError{}
+
+// Validate checks the field values on UnlockUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnlockUserReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnlockUserReqMultiError, or
+// nil if none found.
+func (m *UnlockUserReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockUserReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Ip
+
+	if len(errors) > 0 {
+		return UnlockUserReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockUserReqMultiError is an error wrapping multiple validation errors
+// returned by UnlockUserReq.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockUserReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockUserReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockUserReqMultiError) AllErrors() []error { return m }
+
+// UnlockUserReqValidationError is the validation error returned by
+// UnlockUserReq.Validate if the designated constraints aren't met.
+type UnlockUserReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockUserReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockUserReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockUserReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockUserReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockUserReqValidationError) ErrorName() string { return "UnlockUserReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnlockUserReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockUserReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockUserReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockUserReqValidationError{}
+
+// Validate checks the field values on UnlockUserReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnlockUserReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockUserReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockUserReplyMultiError, or nil if none found.
+func (m *UnlockUserReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockUserReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnlockUserReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockUserReplyMultiError is an error wrapping multiple validation errors
+// returned by UnlockUserReply.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockUserReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockUserReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockUserReplyMultiError) AllErrors() []error { return m }
+
+// UnlockUserReplyValidationError is the validation error returned by
+// UnlockUserReply.Validate if the designated constraints aren't met.
+type UnlockUserReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockUserReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockUserReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockUserReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockUserReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockUserReplyValidationError) ErrorName() string { return "UnlockUserReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnlockUserReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockUserReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockUserReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockUserReplyValidationError{}
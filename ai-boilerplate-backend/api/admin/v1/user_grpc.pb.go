@@ -34,6 +34,12 @@ type UserClient interface {
 	GetUserInfo(ctx context.Context, in *GetUserInfoReq, opts ...grpc.CallOption) (*GetUserInfoReply, error)
 	// 用户表-列表数据查询
 	GetUserList(ctx context.Context, in *GetUserListReq, opts ...grpc.CallOption) (*GetUserListReply, error)
+	// 用户表-登录会话列表
+	GetUserSessionList(ctx context.Context, in *GetUserSessionListReq, opts ...grpc.CallOption) (*GetUserSessionListReply, error)
+	// 用户表-强制下线登录会话
+	DeleteUserSession(ctx context.Context, in *DeleteUserSessionReq, opts ...grpc.CallOption) (*DeleteUserSessionReply, error)
+	// 用户表-解除登录锁定
+	UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*UnlockUserReply, error)
 }
 
 type userClient struct {
@@ -98,6 +104,33 @@ func (c *userClient) GetUserList(ctx context.Context, in *GetUserListReq, opts .
 	return out, nil
 }
 
+func (c *userClient) GetUserSessionList(ctx context.Context, in *GetUserSessionListReq, opts ...grpc.CallOption) (*GetUserSessionListReply, error) {
+	out := new(GetUserSessionListReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.User/GetUserSessionList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteUserSession(ctx context.Context, in *DeleteUserSessionReq, opts ...grpc.CallOption) (*DeleteUserSessionReply, error) {
+	out := new(DeleteUserSessionReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.User/DeleteUserSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*UnlockUserReply, error) {
+	out := new(UnlockUserReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.User/UnlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility
@@ -114,6 +147,12 @@ type UserServer interface {
 	GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoReply, error)
 	// 用户表-列表数据查询
 	GetUserList(context.Context, *GetUserListReq) (*GetUserListReply, error)
+	// 用户表-登录会话列表
+	GetUserSessionList(context.Context, *GetUserSessionListReq) (*GetUserSessionListReply, error)
+	// 用户表-强制下线登录会话
+	DeleteUserSession(context.Context, *DeleteUserSessionReq) (*DeleteUserSessionReply, error)
+	// 用户表-解除登录锁定
+	UnlockUser(context.Context, *UnlockUserReq) (*UnlockUserReply, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -139,6 +178,15 @@ func (UnimplementedUserServer) GetUserInfo(context.Context, *GetUserInfoReq) (*G
 func (UnimplementedUserServer) GetUserList(context.Context, *GetUserListReq) (*GetUserListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserList not implemented")
 }
+func (UnimplementedUserServer) GetUserSessionList(context.Context, *GetUserSessionListReq) (*GetUserSessionListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSessionList not implemented")
+}
+func (UnimplementedUserServer) DeleteUserSession(context.Context, *DeleteUserSessionReq) (*DeleteUserSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserSession not implemented")
+}
+func (UnimplementedUserServer) UnlockUser(context.Context, *UnlockUserReq) (*UnlockUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -260,6 +308,60 @@ func _User_GetUserList_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_GetUserSessionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSessionListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserSessionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.User/GetUserSessionList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserSessionList(ctx, req.(*GetUserSessionListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.User/DeleteUserSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteUserSession(ctx, req.(*DeleteUserSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.User/UnlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnlockUser(ctx, req.(*UnlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -291,6 +393,18 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserList",
 			Handler:    _User_GetUserList_Handler,
 		},
+		{
+			MethodName: "GetUserSessionList",
+			Handler:    _User_GetUserSessionList_Handler,
+		},
+		{
+			MethodName: "DeleteUserSession",
+			Handler:    _User_DeleteUserSession_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _User_UnlockUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/user.proto",
@@ -21,16 +21,22 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationUserCreateUser = "/admin.v1.User/CreateUser"
 const OperationUserDeleteUser = "/admin.v1.User/DeleteUser"
+const OperationUserDeleteUserSession = "/admin.v1.User/DeleteUserSession"
 const OperationUserGetUserInfo = "/admin.v1.User/GetUserInfo"
 const OperationUserGetUserList = "/admin.v1.User/GetUserList"
+const OperationUserGetUserSessionList = "/admin.v1.User/GetUserSessionList"
+const OperationUserUnlockUser = "/admin.v1.User/UnlockUser"
 const OperationUserUpdateUser = "/admin.v1.User/UpdateUser"
 const OperationUserUpdateUserStatus = "/admin.v1.User/UpdateUserStatus"
 
 type UserHTTPServer interface {
 	CreateUser(context.Context, *CreateUserReq) (*CreateUserReply, error)
 	DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserReply, error)
+	DeleteUserSession(context.Context, *DeleteUserSessionReq) (*DeleteUserSessionReply, error)
 	GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoReply, error)
 	GetUserList(context.Context, *GetUserListReq) (*GetUserListReply, error)
+	GetUserSessionList(context.Context, *GetUserSessionListReq) (*GetUserSessionListReply, error)
+	UnlockUser(context.Context, *UnlockUserReq) (*UnlockUserReply, error)
 	UpdateUser(context.Context, *UpdateUserReq) (*UpdateUserReply, error)
 	UpdateUserStatus(context.Context, *UpdateUserStatusReq) (*UpdateUserStatusReply, error)
 }
@@ -41,8 +47,11 @@ func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r.POST("/admin/v1/user/update", _User_UpdateUser0_HTTP_Handler(srv))
 	r.POST("/admin/v1/user/update/status", _User_UpdateUserStatus0_HTTP_Handler(srv))
 	r.POST("/admin/v1/user/delete", _User_DeleteUser0_HTTP_Handler(srv))
-	r.GET("/admin/v1/user/info", _User_GetUserInfo1_HTTP_Handler(srv))
+	r.GET("/admin/v1/user/info", _User_GetUserInfo0_HTTP_Handler(srv))
 	r.GET("/admin/v1/user/list", _User_GetUserList0_HTTP_Handler(srv))
+	r.GET("/admin/v1/user/session/list", _User_GetUserSessionList0_HTTP_Handler(srv))
+	r.POST("/admin/v1/user/session/delete", _User_DeleteUserSession0_HTTP_Handler(srv))
+	r.POST("/admin/v1/user/unlock", _User_UnlockUser0_HTTP_Handler(srv))
 }
 
 func _User_CreateUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
@@ -121,7 +130,7 @@ func _User_DeleteUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) e
 	}
 }
 
-func _User_GetUserInfo1_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+func _User_GetUserInfo0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetUserInfoReq
 		if err := ctx.BindQuery(&in); err != nil {
@@ -159,11 +168,71 @@ func _User_GetUserList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _User_GetUserSessionList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserSessionListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserGetUserSessionList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUserSessionList(ctx, req.(*GetUserSessionListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUserSessionListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_DeleteUserSession0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteUserSessionReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserDeleteUserSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteUserSession(ctx, req.(*DeleteUserSessionReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteUserSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_UnlockUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlockUserReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUnlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockUser(ctx, req.(*UnlockUserReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlockUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserHTTPClient interface {
 	CreateUser(ctx context.Context, req *CreateUserReq, opts ...http.CallOption) (rsp *CreateUserReply, err error)
 	DeleteUser(ctx context.Context, req *DeleteUserReq, opts ...http.CallOption) (rsp *DeleteUserReply, err error)
+	DeleteUserSession(ctx context.Context, req *DeleteUserSessionReq, opts ...http.CallOption) (rsp *DeleteUserSessionReply, err error)
 	GetUserInfo(ctx context.Context, req *GetUserInfoReq, opts ...http.CallOption) (rsp *GetUserInfoReply, err error)
 	GetUserList(ctx context.Context, req *GetUserListReq, opts ...http.CallOption) (rsp *GetUserListReply, err error)
+	GetUserSessionList(ctx context.Context, req *GetUserSessionListReq, opts ...http.CallOption) (rsp *GetUserSessionListReply, err error)
+	UnlockUser(ctx context.Context, req *UnlockUserReq, opts ...http.CallOption) (rsp *UnlockUserReply, err error)
 	UpdateUser(ctx context.Context, req *UpdateUserReq, opts ...http.CallOption) (rsp *UpdateUserReply, err error)
 	UpdateUserStatus(ctx context.Context, req *UpdateUserStatusReq, opts ...http.CallOption) (rsp *UpdateUserStatusReply, err error)
 }
@@ -202,6 +271,19 @@ func (c *UserHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUserReq,
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) DeleteUserSession(ctx context.Context, in *DeleteUserSessionReq, opts ...http.CallOption) (*DeleteUserSessionReply, error) {
+	var out DeleteUserSessionReply
+	pattern := "/admin/v1/user/session/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserDeleteUserSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) GetUserInfo(ctx context.Context, in *GetUserInfoReq, opts ...http.CallOption) (*GetUserInfoReply, error) {
 	var out GetUserInfoReply
 	pattern := "/admin/v1/user/info"
@@ -228,6 +310,32 @@ func (c *UserHTTPClientImpl) GetUserList(ctx context.Context, in *GetUserListReq
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) GetUserSessionList(ctx context.Context, in *GetUserSessionListReq, opts ...http.CallOption) (*GetUserSessionListReply, error) {
+	var out GetUserSessionListReply
+	pattern := "/admin/v1/user/session/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserGetUserSessionList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...http.CallOption) (*UnlockUserReply, error) {
+	var out UnlockUserReply
+	pattern := "/admin/v1/user/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserUnlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserReq, opts ...http.CallOption) (*UpdateUserReply, error) {
 	var out UpdateUserReply
 	pattern := "/admin/v1/user/update"
@@ -64,6 +64,10 @@ const (
 	ErrorReason_SmsCodeInvalid ErrorReason = 27
 	// 未授权
 	ErrorReason_Unauthorized ErrorReason = 28
+	// 账号登录已锁定
+	ErrorReason_AccountLocked ErrorReason = 29
+	// 账号已禁用
+	ErrorReason_AccountDisabled ErrorReason = 30
 )
 
 // Enum value maps for ErrorReason.
@@ -98,6 +102,8 @@ var (
 		26: "SmsFrequencyLimit",
 		27: "SmsCodeInvalid",
 		28: "Unauthorized",
+		29: "AccountLocked",
+		30: "AccountDisabled",
 	}
 	ErrorReason_value = map[string]int32{
 		"RequestCanceledErr":      0,
@@ -129,6 +135,8 @@ var (
 		"SmsFrequencyLimit":       26,
 		"SmsCodeInvalid":          27,
 		"Unauthorized":            28,
+		"AccountLocked":           29,
+		"AccountDisabled":         30,
 	}
 )
 
@@ -165,7 +173,7 @@ var file_app_v1_error_reason_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72,
 	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x61, 0x70, 0x70,
 	0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x9c, 0x17, 0x0a, 0x0b, 0x45, 0x72, 0x72,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x8f, 0x19, 0x0a, 0x0b, 0x45, 0x72, 0x72,
 	0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x45, 0x72, 0x72, 0x10, 0x00,
 	0x1a, 0x47, 0xa8, 0x45, 0x99, 0x03, 0xea, 0x83, 0x01, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
@@ -347,15 +355,30 @@ var file_app_v1_error_reason_proto_rawDesc = []byte{
 	0x1a, 0x3a, 0xa8, 0x45, 0x91, 0x03, 0xea, 0x83, 0x01, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68,
 	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0xaa, 0xc2, 0x01, 0x05, 0x7a, 0x68, 0x5f, 0x43, 0x4e, 0xea,
 	0x80, 0x02, 0x19, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
-	0x64, 0x12, 0x09, 0xe6, 0x9c, 0xaa, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83, 0x1a, 0x39, 0xa0, 0x45,
-	0xf4, 0x03, 0xe2, 0x83, 0x01, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0xa2, 0xc2, 0x01,
-	0x05, 0x7a, 0x68, 0x5f, 0x43, 0x4e, 0xe2, 0x80, 0x02, 0x1d, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0c, 0xe6, 0x9c, 0xaa, 0xe7, 0x9f,
-	0xa5, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61,
-	0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31,
-	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x12, 0x09, 0xe6, 0x9c, 0xaa, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83, 0x12, 0x98, 0x01, 0x0a,
+	0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x10, 0x1d,
+	0x1a, 0x84, 0x01, 0xa8, 0x45, 0xad, 0x03, 0xea, 0x83, 0x01, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0xea, 0x80, 0x02, 0x6b, 0x0a, 0x3a, 0x54, 0x6f,
+	0x6f, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x2c, 0x20, 0x70, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x20, 0x72, 0x65, 0x74, 0x72, 0x79, 0x20, 0x69, 0x6e, 0x20, 0x25, 0x64,
+	0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2d, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95,
+	0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0, 0xe8, 0xbf, 0x87, 0xe5,
+	0xa4, 0x9a, 0x2c, 0x20, 0xe8, 0xaf, 0xb7, 0x20, 0x25, 0x64, 0x20, 0xe7, 0xa7, 0x92, 0xe5, 0x90,
+	0x8e, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0x12, 0x56, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x1e, 0x1a, 0x41, 0xa8, 0x45,
+	0x93, 0x03, 0xea, 0x83, 0x01, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0xea, 0x80, 0x02, 0x26, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x0f,
+	0xe8, 0xb4, 0xa6, 0xe5, 0x8f, 0xb7, 0xe5, 0xb7, 0xb2, 0xe7, 0xa6, 0x81, 0xe7, 0x94, 0xa8, 0x1a,
+	0x39, 0xa0, 0x45, 0xf4, 0x03, 0xe2, 0x83, 0x01, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0xa2, 0xc2, 0x01, 0x05, 0x7a, 0x68, 0x5f, 0x43, 0x4e, 0xe2, 0x80, 0x02, 0x1d, 0x0a, 0x0d, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0c, 0xe6, 0x9c,
+	0xaa, 0xe7, 0x9f, 0xa5, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x7a, 0x66, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x61, 0x69, 0x2d, 0x62, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
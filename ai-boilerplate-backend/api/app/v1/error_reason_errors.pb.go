@@ -948,3 +948,67 @@ func ErrorReasonUnauthorized(opts ...Option) *errors.Error {
 	}
 	return e.Error()
 }
+
+// 账号登录已锁定
+func IsAccountLocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_AccountLocked.String() && e.Code == 429
+}
+
+// 账号登录已锁定
+func ErrorAccountLocked(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_AccountLocked.String(), fmt.Sprintf(format, args...))
+}
+
+// 账号登录已锁定
+func ErrorReasonAccountLocked(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    429,
+		reason:  ErrorReason_AccountLocked.String(),
+		message: "AccountLocked",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Too many failed login attempts, please retry in %d seconds",
+			"zh_CN": "登录失败次数过多, 请 %d 秒后重试",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
+
+// 账号已禁用
+func IsAccountDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_AccountDisabled.String() && e.Code == 403
+}
+
+// 账号已禁用
+func ErrorAccountDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_AccountDisabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 账号已禁用
+func ErrorReasonAccountDisabled(opts ...Option) *errors.Error {
+	e := &ErrorReasonErrors{
+		code:    403,
+		reason:  ErrorReason_AccountDisabled.String(),
+		message: "AccountDisabled",
+		lang:    "zh_CN",
+		i18n: map[string]string{
+			"en_US": "Account is disabled",
+			"zh_CN": "账号已禁用",
+		},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e.Error()
+}
@@ -24,7 +24,10 @@ const OperationUserChangePassword = "/app.v1.User/ChangePassword"
 const OperationUserDeleteAccount = "/app.v1.User/DeleteAccount"
 const OperationUserGetUserInfo = "/app.v1.User/GetUserInfo"
 const OperationUserLogin = "/app.v1.User/Login"
+const OperationUserLogout = "/app.v1.User/Logout"
+const OperationUserRefreshToken = "/app.v1.User/RefreshToken"
 const OperationUserSendVerifyCode = "/app.v1.User/SendVerifyCode"
+const OperationUserSmsLogin = "/app.v1.User/SmsLogin"
 const OperationUserUpdateUserInfo = "/app.v1.User/UpdateUserInfo"
 
 type UserHTTPServer interface {
@@ -33,14 +36,20 @@ type UserHTTPServer interface {
 	DeleteAccount(context.Context, *DeleteAccountReq) (*DeleteAccountReply, error)
 	GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoReply, error)
 	Login(context.Context, *LoginReq) (*LoginReply, error)
+	Logout(context.Context, *LogoutReq) (*LogoutReply, error)
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenReply, error)
 	SendVerifyCode(context.Context, *SendVerifyCodeReq) (*SendVerifyCodeReply, error)
+	SmsLogin(context.Context, *SmsLoginReq) (*SmsLoginReply, error)
 	UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoReply, error)
 }
 
 func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r := s.Route("/")
 	r.POST("/app/v1/user/login", _User_Login0_HTTP_Handler(srv))
-	r.GET("/app/v1/user/profile", _User_GetUserInfo0_HTTP_Handler(srv))
+	r.POST("/app/v1/user/sms_login", _User_SmsLogin0_HTTP_Handler(srv))
+	r.POST("/app/v1/user/refresh_token", _User_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/app/v1/user/logout", _User_Logout0_HTTP_Handler(srv))
+	r.GET("/app/v1/user/profile", _User_GetUserInfo1_HTTP_Handler(srv))
 	r.POST("/app/v1/user/profile/update", _User_UpdateUserInfo0_HTTP_Handler(srv))
 	r.POST("/app/v1/user/password/change", _User_ChangePassword0_HTTP_Handler(srv))
 	r.POST("/app/v1/user/code/send", _User_SendVerifyCode0_HTTP_Handler(srv))
@@ -67,7 +76,64 @@ func _User_Login0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error
 	}
 }
 
-func _User_GetUserInfo0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+func _User_SmsLogin0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SmsLoginReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSmsLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SmsLogin(ctx, req.(*SmsLoginReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SmsLoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RefreshToken0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Logout0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_GetUserInfo1_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetUserInfoReq
 		if err := ctx.BindQuery(&in); err != nil {
@@ -187,7 +253,10 @@ type UserHTTPClient interface {
 	DeleteAccount(ctx context.Context, req *DeleteAccountReq, opts ...http.CallOption) (rsp *DeleteAccountReply, err error)
 	GetUserInfo(ctx context.Context, req *GetUserInfoReq, opts ...http.CallOption) (rsp *GetUserInfoReply, err error)
 	Login(ctx context.Context, req *LoginReq, opts ...http.CallOption) (rsp *LoginReply, err error)
+	Logout(ctx context.Context, req *LogoutReq, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenReq, opts ...http.CallOption) (rsp *RefreshTokenReply, err error)
 	SendVerifyCode(ctx context.Context, req *SendVerifyCodeReq, opts ...http.CallOption) (rsp *SendVerifyCodeReply, err error)
+	SmsLogin(ctx context.Context, req *SmsLoginReq, opts ...http.CallOption) (rsp *SmsLoginReply, err error)
 	UpdateUserInfo(ctx context.Context, req *UpdateUserInfoReq, opts ...http.CallOption) (rsp *UpdateUserInfoReply, err error)
 }
 
@@ -264,6 +333,32 @@ func (c *UserHTTPClientImpl) Login(ctx context.Context, in *LoginReq, opts ...ht
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) Logout(ctx context.Context, in *LogoutReq, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/app/v1/user/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...http.CallOption) (*RefreshTokenReply, error) {
+	var out RefreshTokenReply
+	pattern := "/app/v1/user/refresh_token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) SendVerifyCode(ctx context.Context, in *SendVerifyCodeReq, opts ...http.CallOption) (*SendVerifyCodeReply, error) {
 	var out SendVerifyCodeReply
 	pattern := "/app/v1/user/code/send"
@@ -277,6 +372,19 @@ func (c *UserHTTPClientImpl) SendVerifyCode(ctx context.Context, in *SendVerifyC
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) SmsLogin(ctx context.Context, in *SmsLoginReq, opts ...http.CallOption) (*SmsLoginReply, error) {
+	var out SmsLoginReply
+	pattern := "/app/v1/user/sms_login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserSmsLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) UpdateUserInfo(ctx context.Context, in *UpdateUserInfoReq, opts ...http.CallOption) (*UpdateUserInfoReply, error) {
 	var out UpdateUserInfoReply
 	pattern := "/app/v1/user/profile/update"
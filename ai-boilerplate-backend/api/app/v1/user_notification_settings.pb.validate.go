@@ -75,6 +75,12 @@ func (m *NotificationSettingsInfo) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for MessageNotification
+
+	// no validation rules for DndEnabled
+
+	// no validation rules for DndTimeZone
+
 	if len(errors) > 0 {
 		return NotificationSettingsInfoMultiError(errors)
 	}
@@ -421,6 +427,12 @@ func (m *UpdateNotificationSettingsReq) validate(all bool) error {
 
 	// no validation rules for DndEndTime
 
+	// no validation rules for MessageNotification
+
+	// no validation rules for DndEnabled
+
+	// no validation rules for DndTimeZone
+
 	if len(errors) > 0 {
 		return UpdateNotificationSettingsReqMultiError(errors)
 	}
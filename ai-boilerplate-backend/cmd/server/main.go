@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"os"
+	_ "time/tzdata" // 内置时区数据, 镜像缺少时区数据时仍可按用户时区计算勿扰时段
 
 	"github.com/fzf-labs/kratos-contrib/bootstrap"
 	"github.com/fzf-labs/kratos-contrib/pkg/mq"
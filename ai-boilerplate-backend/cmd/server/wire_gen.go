@@ -9,6 +9,7 @@ package main
 import (
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/rpc"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/server"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/service"
 	"github.com/fzf-labs/kratos-contrib/api/conf/v1"
@@ -36,7 +37,12 @@ func wireApp(bootstrap *v1.Bootstrap, logger log.Logger) (*kratos.App, func(), e
 	deviceRepo := ai_boilerplate_repo.NewDeviceRepo(repo)
 	dataDeviceRepo := data.NewDeviceRepo(logger, dataData, deviceRepo)
 	deviceHeartbeatRepo := data.NewDeviceHeartbeatRepo(logger, dataData)
-	adminV1DeviceService := service.NewAdminV1DeviceService(logger, dataDeviceRepo, deviceHeartbeatRepo)
+	devicePushLogRepo := ai_boilerplate_repo.NewDevicePushLogRepo(repo)
+	httputilClient := data.NewHTTPClient(bootstrap)
+	baiduPushHTTPRPC := rpc.NewBaiduPushHTTPRPC(bootstrap, logger, httputilClient)
+	devicePushSender := data.NewDevicePushSender(logger, dataData, baiduPushHTTPRPC)
+	devicePushRepo := data.NewDevicePushRepo(logger, dataData, deviceRepo, devicePushLogRepo, devicePushSender)
+	adminV1DeviceService := service.NewAdminV1DeviceService(logger, dataDeviceRepo, deviceHeartbeatRepo, devicePushRepo)
 	grpcServer := server.NewGRPCServer(bootstrap, logger, adminV1DeviceService)
 	sysAdminRepo := ai_boilerplate_repo.NewSysAdminRepo(repo)
 	dataSysAdminRepo := data.NewSysAdminRepo(logger, dataData, sysAdminRepo)
@@ -48,22 +54,25 @@ func wireApp(bootstrap *v1.Bootstrap, logger log.Logger) (*kratos.App, func(), e
 	dataSysDeptRepo := data.NewSysDeptRepo(logger, dataData, sysDeptRepo)
 	sysPostRepo := ai_boilerplate_repo.NewSysPostRepo(repo)
 	dataSysPostRepo := data.NewSysPostRepo(logger, dataData, sysPostRepo)
-	adminV1SysAuthService := service.NewAdminV1SysAuthService(logger, dataSysAdminRepo, dataSysMenuRepo, dataSysRoleRepo, dataSysDeptRepo, dataSysPostRepo)
-	commonRepo := data.NewCommonRepo(logger, bootstrap, dataData)
+	sysAPIRepo := ai_boilerplate_repo.NewSysAPIRepo(repo)
+	dataSysAPIRepo := data.NewSysAPIRepo(logger, dataData, sysAPIRepo)
+	sysOperateLogRepo := ai_boilerplate_repo.NewSysOperateLogRepo(repo)
+	loginLimitRepo := data.NewLoginLimitRepo(logger, dataData, sysOperateLogRepo)
 	sysTenantRepo := ai_boilerplate_repo.NewSysTenantRepo(repo)
+	twoFactorRepo := data.NewTwoFactorRepo(logger, dataData, sysAdminRepo, sysTenantRepo)
+	adminV1SysAuthService := service.NewAdminV1SysAuthService(logger, dataSysAdminRepo, dataSysMenuRepo, dataSysRoleRepo, dataSysDeptRepo, dataSysPostRepo, dataSysAPIRepo, loginLimitRepo, twoFactorRepo)
+	commonRepo := data.NewCommonRepo(logger, bootstrap, dataData)
 	dataSysTenantRepo := data.NewSysTenantRepo(logger, dataData, sysTenantRepo)
 	adminV1SysTenantService := service.NewAdminV1SysTenantService(logger, commonRepo, dataSysTenantRepo, dataSysAdminRepo)
-	adminV1SysAdminService := service.NewAdminV1SysAdminService(logger, dataSysAdminRepo, dataSysRoleRepo, dataSysDeptRepo, dataSysPostRepo)
-	adminV1SysMenuService := service.NewAdminV1SysMenuService(logger, dataSysMenuRepo)
+	dataScopeRepo := data.NewDataScopeRepo(logger, dataData, sysAdminRepo, sysRoleRepo, sysDeptRepo)
+	adminV1SysAdminService := service.NewAdminV1SysAdminService(logger, dataSysAdminRepo, dataSysRoleRepo, dataSysDeptRepo, dataSysPostRepo, dataScopeRepo, loginLimitRepo, twoFactorRepo)
+	adminV1SysMenuService := service.NewAdminV1SysMenuService(logger, dataSysMenuRepo, dataSysRoleRepo)
 	adminV1SysRoleService := service.NewAdminV1SysRoleService(logger, dataSysRoleRepo)
-	adminV1SysDeptService := service.NewAdminV1SysDeptService(logger, dataSysDeptRepo, dataSysAdminRepo)
+	adminV1SysDeptService := service.NewAdminV1SysDeptService(logger, dataSysDeptRepo, dataSysAdminRepo, dataScopeRepo)
 	adminV1SysPostService := service.NewAdminV1SysPostService(logger, dataSysPostRepo)
-	sysAPIRepo := ai_boilerplate_repo.NewSysAPIRepo(repo)
-	dataSysAPIRepo := data.NewSysAPIRepo(logger, dataData, sysAPIRepo)
 	adminV1SysAPIService := service.NewAdminV1SysAPIService(logger, dataSysAPIRepo)
-	sysOperateLogRepo := ai_boilerplate_repo.NewSysOperateLogRepo(repo)
 	dataSysOperateLogRepo := data.NewSysOperateLogRepo(logger, dataData, sysOperateLogRepo)
-	adminV1SysOperateLogService := service.NewAdminV1SysOperateLogService(logger, dataSysOperateLogRepo, dataSysAdminRepo)
+	adminV1SysOperateLogService := service.NewAdminV1SysOperateLogService(logger, dataSysOperateLogRepo, dataSysAdminRepo, dataScopeRepo)
 	dictTypeRepo := ai_boilerplate_repo.NewDictTypeRepo(repo)
 	dataDictTypeRepo := data.NewDictTypeRepo(logger, dataData, dictTypeRepo)
 	adminV1DictTypeService := service.NewAdminV1DictTypeService(logger, dataDictTypeRepo)
@@ -74,26 +83,28 @@ func wireApp(bootstrap *v1.Bootstrap, logger log.Logger) (*kratos.App, func(), e
 	dataSysNotifyMessageRepo := data.NewSysNotifyMessageRepo(logger, dataData, sysNotifyMessageRepo)
 	adminV1SysNotifyMessageService := service.NewAdminV1SysNotifyMessageService(logger, dataSysNotifyMessageRepo, dataSysAdminRepo)
 	sysNoticeRepo := ai_boilerplate_repo.NewSysNoticeRepo(repo)
-	dataSysNoticeRepo := data.NewSysNoticeRepo(logger, dataData, sysNoticeRepo)
-	adminV1SysNoticeService := service.NewAdminV1SysNoticeService(logger, dataSysNoticeRepo)
+	dataSysNoticeRepo := data.NewSysNoticeRepo(logger, dataData, sysNoticeRepo, dataSysNotifyMessageRepo)
+	adminV1SysNoticeService := service.NewAdminV1SysNoticeService(logger, dataSysNoticeRepo, dataSysTenantRepo, dataSysRoleRepo, dataSysDeptRepo)
 	smsChannelRepo := ai_boilerplate_repo.NewSmsChannelRepo(repo)
 	dataSmsChannelRepo := data.NewSmsChannelRepo(logger, dataData, smsChannelRepo)
-	adminV1SmsChannelService := service.NewAdminV1SmsChannelService(logger, dataSmsChannelRepo)
 	smsTemplateRepo := ai_boilerplate_repo.NewSmsTemplateRepo(repo)
 	dataSmsTemplateRepo := data.NewSmsTemplateRepo(logger, dataData, smsTemplateRepo)
-	adminV1SmsTemplateService := service.NewAdminV1SmsTemplateService(logger, dataSmsTemplateRepo, dataSmsChannelRepo)
 	smsLogRepo := ai_boilerplate_repo.NewSmsLogRepo(repo)
+	smsSendRepo := data.NewSmsSendRepo(logger, dataData, smsChannelRepo, smsTemplateRepo, smsLogRepo)
+	adminV1SmsChannelService := service.NewAdminV1SmsChannelService(logger, dataSmsChannelRepo, smsSendRepo)
+	adminV1SmsTemplateService := service.NewAdminV1SmsTemplateService(logger, dataSmsTemplateRepo, dataSmsChannelRepo, smsSendRepo)
 	dataSmsLogRepo := data.NewSmsLogRepo(logger, dataData, smsLogRepo)
-	adminV1SmsLogService := service.NewAdminV1SmsLogService(logger, dataSmsLogRepo)
+	adminV1SmsLogService := service.NewAdminV1SmsLogService(logger, dataSmsLogRepo, dataSmsChannelRepo, smsSendRepo)
 	mailAccountRepo := ai_boilerplate_repo.NewMailAccountRepo(repo)
 	dataMailAccountRepo := data.NewMailAccountRepo(logger, dataData, mailAccountRepo)
-	adminV1MailAccountService := service.NewAdminV1MailAccountService(logger, dataMailAccountRepo)
 	mailTemplateRepo := ai_boilerplate_repo.NewMailTemplateRepo(repo)
-	dataMailTemplateRepo := data.NewMailTemplateRepo(logger, dataData, mailTemplateRepo)
-	adminV1MailTemplateService := service.NewAdminV1MailTemplateService(logger, dataMailTemplateRepo)
 	mailLogRepo := ai_boilerplate_repo.NewMailLogRepo(repo)
+	mailSendRepo := data.NewMailSendRepo(logger, dataData, mailAccountRepo, mailTemplateRepo, mailLogRepo)
+	adminV1MailAccountService := service.NewAdminV1MailAccountService(logger, dataMailAccountRepo, mailSendRepo)
+	dataMailTemplateRepo := data.NewMailTemplateRepo(logger, dataData, mailTemplateRepo)
+	adminV1MailTemplateService := service.NewAdminV1MailTemplateService(logger, dataMailTemplateRepo, mailSendRepo)
 	dataMailLogRepo := data.NewMailLogRepo(logger, dataData, mailLogRepo)
-	adminV1MailLogService := service.NewAdminV1MailLogService(logger, dataMailLogRepo)
+	adminV1MailLogService := service.NewAdminV1MailLogService(logger, dataMailLogRepo, mailSendRepo)
 	configDatumRepo := ai_boilerplate_repo.NewConfigDatumRepo(repo)
 	dataConfigDatumRepo := data.NewConfigDatumRepo(logger, dataData, configDatumRepo)
 	adminV1ConfigDatumService := service.NewAdminV1ConfigDatumService(logger, dataConfigDatumRepo)
@@ -134,8 +145,15 @@ func wireApp(bootstrap *v1.Bootstrap, logger log.Logger) (*kratos.App, func(), e
 	dataUserRepo := data.NewUserRepo(logger, dataData, userRepo)
 	userMembershipRepo := ai_boilerplate_repo.NewUserMembershipRepo(repo)
 	dataUserMembershipRepo := data.NewUserMembershipRepo(logger, dataData, userMembershipRepo)
-	adminV1UserService := service.NewAdminV1UserService(logger, dataUserRepo, dataUserMembershipRepo)
+	adminV1UserService := service.NewAdminV1UserService(logger, dataUserRepo, dataUserMembershipRepo, loginLimitRepo)
 	adminV1UserMembershipService := service.NewAdminV1UserMembershipService(logger, dataUserMembershipRepo)
+	userNotificationSettingRepo := ai_boilerplate_repo.NewUserNotificationSettingRepo(repo)
+	dataUserNotificationSettingRepo := data.NewUserNotificationSettingRepo(logger, dataData, userNotificationSettingRepo)
+	userNotifyLogRepo := ai_boilerplate_repo.NewUserNotifyLogRepo(repo)
+	dataUserNotifyLogRepo := data.NewUserNotifyLogRepo(logger, dataData, userNotifyLogRepo)
+	userBindDeviceRepo := ai_boilerplate_repo.NewUserBindDeviceRepo(repo)
+	notifyRepo := data.NewNotifyRepo(logger, dataData, userRepo, dataUserNotificationSettingRepo, userNotifyLogRepo, sysNotifyMessageRepo, smsSendRepo, mailSendRepo, dataWxGzhAccountRepo, wxGzhUserRepo, userBindDeviceRepo, devicePushRepo)
+	adminV1UserNotifyLogService := service.NewAdminV1UserNotifyLogService(logger, dataUserNotifyLogRepo, notifyRepo)
 	membershipRepo := ai_boilerplate_repo.NewMembershipRepo(repo)
 	dataMembershipRepo := data.NewMembershipRepo(logger, dataData, membershipRepo)
 	adminV1MembershipService := service.NewAdminV1MembershipService(logger, dataMembershipRepo)
@@ -168,29 +186,47 @@ func wireApp(bootstrap *v1.Bootstrap, logger log.Logger) (*kratos.App, func(), e
 	adminV1AiProviderPlatformService := service.NewAdminV1AiProviderPlatformService(logger, dataAiProviderPlatformRepo)
 	aiPromptRepo := ai_boilerplate_repo.NewAiPromptRepo(repo)
 	dataAiPromptRepo := data.NewAiPromptRepo(logger, dataData, aiPromptRepo)
-	adminV1AiPromptService := service.NewAdminV1AiPromptService(logger, dataAiPromptRepo)
+	adminV1AiPromptService := service.NewAdminV1AiPromptService(logger, dataAiPromptRepo, dataScopeRepo)
 	aiChatConversationRepo := ai_boilerplate_repo.NewAiChatConversationRepo(repo)
 	dataAiChatConversationRepo := data.NewAiChatConversationRepo(logger, dataData, aiChatConversationRepo)
-	adminV1AiChatConversationService := service.NewAdminV1AiChatConversationService(logger, dataAiChatConversationRepo)
+	adminV1AiChatConversationService := service.NewAdminV1AiChatConversationService(logger, dataAiChatConversationRepo, dataScopeRepo)
 	aiChatMessageRepo := ai_boilerplate_repo.NewAiChatMessageRepo(repo)
 	dataAiChatMessageRepo := data.NewAiChatMessageRepo(logger, dataData, aiChatMessageRepo)
-	adminV1AiChatMessageService := service.NewAdminV1AiChatMessageService(logger, dataAiChatMessageRepo)
+	adminV1AiChatMessageService := service.NewAdminV1AiChatMessageService(logger, dataAiChatMessageRepo, dataScopeRepo)
 	aiImageRecordRepo := ai_boilerplate_repo.NewAiImageRecordRepo(repo)
 	dataAiImageRecordRepo := data.NewAiImageRecordRepo(logger, dataData, aiImageRecordRepo)
-	adminV1AiImageRecordService := service.NewAdminV1AiImageRecordService(logger, dataAiImageRecordRepo)
+	adminV1AiImageRecordService := service.NewAdminV1AiImageRecordService(logger, dataAiImageRecordRepo, dataScopeRepo)
 	aiAudioRecordRepo := ai_boilerplate_repo.NewAiAudioRecordRepo(repo)
 	dataAiAudioRecordRepo := data.NewAiAudioRecordRepo(logger, dataData, aiAudioRecordRepo)
-	adminV1AiAudioRecordService := service.NewAdminV1AiAudioRecordService(logger, dataAiAudioRecordRepo)
+	adminV1AiAudioRecordService := service.NewAdminV1AiAudioRecordService(logger, dataAiAudioRecordRepo, dataScopeRepo)
 	aiVideoRecordRepo := ai_boilerplate_repo.NewAiVideoRecordRepo(repo)
 	dataAiVideoRecordRepo := data.NewAiVideoRecordRepo(logger, dataData, aiVideoRecordRepo)
-	adminV1AiVideoRecordService := service.NewAdminV1AiVideoRecordService(logger, dataAiVideoRecordRepo)
+	adminV1AiVideoRecordService := service.NewAdminV1AiVideoRecordService(logger, dataAiVideoRecordRepo, dataScopeRepo)
 	aiWriteRecordRepo := ai_boilerplate_repo.NewAiWriteRecordRepo(repo)
 	dataAiWriteRecordRepo := data.NewAiWriteRecordRepo(logger, dataData, aiWriteRecordRepo)
-	adminV1AiWriteRecordService := service.NewAdminV1AiWriteRecordService(logger, dataAiWriteRecordRepo)
+	adminV1AiWriteRecordService := service.NewAdminV1AiWriteRecordService(logger, dataAiWriteRecordRepo, dataScopeRepo)
 	adminV1AiIndexPromptService := service.NewAdminV1AiIndexPromptService(logger, dataAiPromptRepo)
-	adminV1AiIndexChatService := service.NewAdminV1AiIndexChatService(logger, dataAiChatConversationRepo, dataAiChatMessageRepo)
+	aiTokenUsageRepo := ai_boilerplate_repo.NewAiTokenUsageRepo(repo)
+	aiTokenQuotaRepo := ai_boilerplate_repo.NewAiTokenQuotaRepo(repo)
+	dataAiTokenUsageRepo := data.NewAiTokenUsageRepo(logger, dataData, aiTokenUsageRepo, aiTokenQuotaRepo)
+	adminV1AiIndexChatService := service.NewAdminV1AiIndexChatService(logger, dataAiChatConversationRepo, dataAiChatMessageRepo, dataAiProviderModelRepo, dataAiProviderPlatformRepo, dataAiTokenUsageRepo)
+	aiAPIKeyRepo := ai_boilerplate_repo.NewAiAPIKeyRepo(repo)
+	dataAiAPIKeyRepo := data.NewAiAPIKeyRepo(logger, dataData, aiAPIKeyRepo)
+	adminV1AiAPIKeyService := service.NewAdminV1AiAPIKeyService(logger, dataAiAPIKeyRepo, dataScopeRepo)
+	aiAPICallLogRepo := ai_boilerplate_repo.NewAiAPICallLogRepo(repo)
+	dataAiAPICallLogRepo := data.NewAiAPICallLogRepo(logger, dataData, aiAPICallLogRepo)
+	adminV1AiAPICallLogService := service.NewAdminV1AiAPICallLogService(logger, dataAiAPICallLogRepo)
+	adminV1AiIndexImageService := service.NewAdminV1AiIndexImageService(logger, dataAiImageRecordRepo, dataAiProviderModelRepo, dataAiProviderPlatformRepo, dataAiTokenUsageRepo, dataFileConfigRepo, dataFileDatumRepo, dataSysNotifyMessageRepo)
+	adminV1AiIndexVideoService := service.NewAdminV1AiIndexVideoService(logger, dataAiVideoRecordRepo, dataAiProviderModelRepo, dataAiProviderPlatformRepo, dataAiTokenUsageRepo, dataFileConfigRepo, dataFileDatumRepo, dataSysNotifyMessageRepo)
+	adminV1AiIndexAudioService := service.NewAdminV1AiIndexAudioService(logger, dataAiAudioRecordRepo, dataAiProviderModelRepo, dataAiProviderPlatformRepo, dataSysNotifyMessageRepo)
+	adminV1AiIndexWriteService := service.NewAdminV1AiIndexWriteService(logger, dataAiWriteRecordRepo, dataAiProviderModelRepo, dataAiProviderPlatformRepo, dataAiTokenUsageRepo, dataDictDatumRepo)
+	adminV1AiTokenUsageService := service.NewAdminV1AiTokenUsageService(logger, dataAiTokenUsageRepo, dataAiProviderModelRepo, dataSysAdminRepo, dataSysTenantRepo, dataScopeRepo)
+	dataAiTokenQuotaRepo := data.NewAiTokenQuotaRepo(logger, dataData, aiTokenQuotaRepo)
+	adminV1AiTokenQuotaService := service.NewAdminV1AiTokenQuotaService(logger, dataAiTokenQuotaRepo, dataAiTokenUsageRepo, dataSysTenantRepo)
+	openAIV1ChatService := service.NewOpenAIV1ChatService(logger, dataAiAPIKeyRepo, dataAiAPICallLogRepo, dataAiProviderModelRepo, dataAiProviderPlatformRepo, dataAiTokenUsageRepo)
 	appV1HomeService := service.NewAppV1HomeService(logger)
-	appV1UserService := service.NewAppV1UserService(logger, dataUserRepo)
+	smsCodeRepo := data.NewSmsCodeRepo(logger, dataData)
+	appV1UserService := service.NewAppV1UserService(logger, dataUserRepo, loginLimitRepo, smsCodeRepo, smsSendRepo)
 	helpFeedbackRepo := ai_boilerplate_repo.NewHelpFeedbackRepo(repo)
 	dataHelpFeedbackRepo := data.NewHelpFeedbackRepo(logger, dataData, helpFeedbackRepo)
 	appV1HelpFeedbackService := service.NewAppV1HelpFeedbackService(logger, dataHelpFeedbackRepo)
@@ -200,8 +236,9 @@ func wireApp(bootstrap *v1.Bootstrap, logger log.Logger) (*kratos.App, func(), e
 	helpCategoryRepo := ai_boilerplate_repo.NewHelpCategoryRepo(repo)
 	dataHelpCategoryRepo := data.NewHelpCategoryRepo(logger, dataData, helpCategoryRepo)
 	appV1HelpCategoryService := service.NewAppV1HelpCategoryService(logger, dataHelpCategoryRepo)
-	httpServer := server.NewHTTPServer(bootstrap, logger, adminV1SysAuthService, adminV1SysTenantService, adminV1SysAdminService, adminV1SysMenuService, adminV1SysRoleService, adminV1SysDeptService, adminV1SysPostService, adminV1SysAPIService, adminV1SysOperateLogService, adminV1DictTypeService, adminV1DictDatumService, adminV1SysNotifyMessageService, adminV1SysNoticeService, adminV1SmsChannelService, adminV1SmsTemplateService, adminV1SmsLogService, adminV1MailAccountService, adminV1MailTemplateService, adminV1MailLogService, adminV1ConfigDatumService, adminV1FileConfigService, adminV1FileDatumService, adminV1WxGzhAccountService, adminV1WxGzhAutoReplyService, adminV1WxGzhMaterialService, adminV1WxGzhMenuService, adminV1WxGzhMessageService, adminV1WxGzhTagService, adminV1WxGzhUserService, adminV1WxXcxUserService, adminV1DeviceService, adminV1SensitiveWordService, adminV1UserService, adminV1UserMembershipService, adminV1MembershipService, adminV1MembershipBenefitService, adminV1SelfAppService, adminV1SelfAppReleaseService, adminV1MallActivationCodeService, adminV1MallOrderService, adminV1MallPaymentRecordService, adminV1MallProductService, adminV1AiProviderModelService, adminV1AiProviderPlatformService, adminV1AiPromptService, adminV1AiChatConversationService, adminV1AiChatMessageService, adminV1AiImageRecordService, adminV1AiAudioRecordService, adminV1AiVideoRecordService, adminV1AiWriteRecordService, adminV1AiIndexPromptService, adminV1AiIndexChatService, appV1HomeService, appV1UserService, appV1HelpFeedbackService, appV1HelpFaqService, appV1HelpCategoryService)
-	mqServer := server.NewMQServer(bootstrap, logger)
+	appV1UserNotificationSettingService := service.NewAppV1UserNotificationSettingService(logger, dataUserNotificationSettingRepo)
+	httpServer := server.NewHTTPServer(bootstrap, logger, adminV1SysAuthService, adminV1SysTenantService, adminV1SysAdminService, adminV1SysMenuService, adminV1SysRoleService, adminV1SysDeptService, adminV1SysPostService, adminV1SysAPIService, adminV1SysOperateLogService, adminV1DictTypeService, adminV1DictDatumService, adminV1SysNotifyMessageService, adminV1SysNoticeService, adminV1SmsChannelService, adminV1SmsTemplateService, adminV1SmsLogService, adminV1MailAccountService, adminV1MailTemplateService, adminV1MailLogService, adminV1ConfigDatumService, adminV1FileConfigService, adminV1FileDatumService, adminV1WxGzhAccountService, adminV1WxGzhAutoReplyService, adminV1WxGzhMaterialService, adminV1WxGzhMenuService, adminV1WxGzhMessageService, adminV1WxGzhTagService, adminV1WxGzhUserService, adminV1WxXcxUserService, adminV1DeviceService, adminV1SensitiveWordService, adminV1UserService, adminV1UserMembershipService, adminV1UserNotifyLogService, adminV1MembershipService, adminV1MembershipBenefitService, adminV1SelfAppService, adminV1SelfAppReleaseService, adminV1MallActivationCodeService, adminV1MallOrderService, adminV1MallPaymentRecordService, adminV1MallProductService, adminV1AiProviderModelService, adminV1AiProviderPlatformService, adminV1AiPromptService, adminV1AiChatConversationService, adminV1AiChatMessageService, adminV1AiImageRecordService, adminV1AiAudioRecordService, adminV1AiVideoRecordService, adminV1AiWriteRecordService, adminV1AiIndexPromptService, adminV1AiIndexChatService, adminV1AiIndexImageService, adminV1AiIndexVideoService, adminV1AiIndexAudioService, adminV1AiIndexWriteService, adminV1AiAPIKeyService, adminV1AiAPICallLogService, adminV1AiTokenUsageService, adminV1AiTokenQuotaService, openAIV1ChatService, appV1HomeService, appV1UserService, appV1HelpFeedbackService, appV1HelpFaqService, appV1HelpCategoryService, appV1UserNotificationSettingService)
+	mqServer := server.NewMQServer(bootstrap, logger, adminV1AiIndexImageService, adminV1AiIndexVideoService, adminV1AiIndexAudioService, adminV1MailTemplateService, adminV1UserNotifyLogService, adminV1SysNoticeService, adminV1DeviceService)
 	app := newApp(logger, grpcServer, httpServer, mqServer)
 	return app, func() {
 		cleanup()
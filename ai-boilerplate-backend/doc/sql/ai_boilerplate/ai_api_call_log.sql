@@ -0,0 +1,34 @@
+CREATE TABLE public.ai_api_call_log (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    tenant_id character varying(64) NOT NULL,
+    api_key_id character varying(64) NOT NULL,
+    model character varying(64) NOT NULL,
+    model_id character varying(64),
+    stream boolean DEFAULT false NOT NULL,
+    success boolean DEFAULT false NOT NULL,
+    error_message character varying(1024),
+    prompt_tokens integer DEFAULT 0 NOT NULL,
+    completion_tokens integer DEFAULT 0 NOT NULL,
+    total_tokens integer DEFAULT 0 NOT NULL,
+    duration integer DEFAULT 0 NOT NULL,
+    ip character varying(64),
+    created_at timestamp with time zone NOT NULL
+);
+COMMENT ON TABLE public.ai_api_call_log IS 'AI 接口调用日志表';
+COMMENT ON COLUMN public.ai_api_call_log.id IS '编号';
+COMMENT ON COLUMN public.ai_api_call_log.tenant_id IS '租户编号';
+COMMENT ON COLUMN public.ai_api_call_log.api_key_id IS '密钥编号';
+COMMENT ON COLUMN public.ai_api_call_log.model IS '请求模型';
+COMMENT ON COLUMN public.ai_api_call_log.model_id IS '模型编号';
+COMMENT ON COLUMN public.ai_api_call_log.stream IS '是否流式';
+COMMENT ON COLUMN public.ai_api_call_log.success IS '是否成功';
+COMMENT ON COLUMN public.ai_api_call_log.error_message IS '错误信息';
+COMMENT ON COLUMN public.ai_api_call_log.prompt_tokens IS '输入 Token 数';
+COMMENT ON COLUMN public.ai_api_call_log.completion_tokens IS '输出 Token 数';
+COMMENT ON COLUMN public.ai_api_call_log.total_tokens IS '总 Token 数';
+COMMENT ON COLUMN public.ai_api_call_log.duration IS '耗时(毫秒)';
+COMMENT ON COLUMN public.ai_api_call_log.ip IS '请求 IP';
+COMMENT ON COLUMN public.ai_api_call_log.created_at IS '创建时间';
+ALTER TABLE ONLY public.ai_api_call_log ADD CONSTRAINT ai_api_call_log_pkey PRIMARY KEY (id);
+CREATE INDEX ai_api_call_log_api_key_id_idx ON public.ai_api_call_log USING btree (api_key_id);
+CREATE INDEX ai_api_call_log_tenant_id_idx ON public.ai_api_call_log USING btree (tenant_id);
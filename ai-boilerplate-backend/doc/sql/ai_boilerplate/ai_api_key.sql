@@ -0,0 +1,30 @@
+CREATE TABLE public.ai_api_key (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    tenant_id character varying(64) NOT NULL,
+    admin_id character varying(64) NOT NULL,
+    name character varying(64) NOT NULL,
+    key_prefix character varying(32) NOT NULL,
+    key_hash character varying(64) NOT NULL,
+    status integer DEFAULT 1 NOT NULL,
+    expired_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone
+);
+COMMENT ON TABLE public.ai_api_key IS 'AI 接口密钥表';
+COMMENT ON COLUMN public.ai_api_key.id IS '编号';
+COMMENT ON COLUMN public.ai_api_key.tenant_id IS '租户编号';
+COMMENT ON COLUMN public.ai_api_key.admin_id IS '创建人编号';
+COMMENT ON COLUMN public.ai_api_key.name IS '名称';
+COMMENT ON COLUMN public.ai_api_key.key_prefix IS '密钥前缀';
+COMMENT ON COLUMN public.ai_api_key.key_hash IS '密钥摘要';
+COMMENT ON COLUMN public.ai_api_key.status IS '状态';
+COMMENT ON COLUMN public.ai_api_key.expired_at IS '过期时间';
+COMMENT ON COLUMN public.ai_api_key.last_used_at IS '最后使用时间';
+COMMENT ON COLUMN public.ai_api_key.created_at IS '创建时间';
+COMMENT ON COLUMN public.ai_api_key.updated_at IS '更新时间';
+COMMENT ON COLUMN public.ai_api_key.deleted_at IS '删除时间';
+ALTER TABLE ONLY public.ai_api_key ADD CONSTRAINT ai_api_key_pkey PRIMARY KEY (id);
+CREATE UNIQUE INDEX ai_api_key_key_hash_idx ON public.ai_api_key USING btree (key_hash);
+CREATE INDEX ai_api_key_tenant_id_idx ON public.ai_api_key USING btree (tenant_id);
@@ -11,6 +11,7 @@ CREATE TABLE public.ai_chat_message (
     content text NOT NULL,
     use_context boolean DEFAULT false NOT NULL,
     segment_ids character varying(2048),
+    partial boolean DEFAULT false NOT NULL,
     created_at timestamp with time zone NOT NULL,
     updated_at timestamp with time zone NOT NULL,
     deleted_at timestamp with time zone
@@ -28,6 +29,7 @@ COMMENT ON COLUMN public.ai_chat_message.model_id IS '模型编号';
 COMMENT ON COLUMN public.ai_chat_message.content IS '消息内容';
 COMMENT ON COLUMN public.ai_chat_message.use_context IS '是否携带上下文';
 COMMENT ON COLUMN public.ai_chat_message.segment_ids IS '段落编号数组';
+COMMENT ON COLUMN public.ai_chat_message.partial IS '是否为中断的部分回复';
 COMMENT ON COLUMN public.ai_chat_message.created_at IS '创建时间';
 COMMENT ON COLUMN public.ai_chat_message.updated_at IS '更新时间';
 COMMENT ON COLUMN public.ai_chat_message.deleted_at IS '删除时间';
@@ -0,0 +1,21 @@
+CREATE TABLE public.ai_token_quota (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    tenant_id character varying(64) NOT NULL,
+    daily_limit bigint DEFAULT 0 NOT NULL,
+    monthly_limit bigint DEFAULT 0 NOT NULL,
+    status integer DEFAULT 1 NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone
+);
+COMMENT ON TABLE public.ai_token_quota IS 'AI Token 配额表';
+COMMENT ON COLUMN public.ai_token_quota.id IS '编号';
+COMMENT ON COLUMN public.ai_token_quota.tenant_id IS '租户编号';
+COMMENT ON COLUMN public.ai_token_quota.daily_limit IS '每日 Token 上限(0:不限制)';
+COMMENT ON COLUMN public.ai_token_quota.monthly_limit IS '每月 Token 上限(0:不限制)';
+COMMENT ON COLUMN public.ai_token_quota.status IS '状态(-1:禁用 1:启用)';
+COMMENT ON COLUMN public.ai_token_quota.created_at IS '创建时间';
+COMMENT ON COLUMN public.ai_token_quota.updated_at IS '更新时间';
+COMMENT ON COLUMN public.ai_token_quota.deleted_at IS '删除时间';
+ALTER TABLE ONLY public.ai_token_quota ADD CONSTRAINT ai_token_quota_pkey PRIMARY KEY (id);
+CREATE UNIQUE INDEX ai_token_quota_tenant_id_idx ON public.ai_token_quota USING btree (tenant_id);
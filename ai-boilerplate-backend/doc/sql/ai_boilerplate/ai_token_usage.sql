@@ -0,0 +1,29 @@
+CREATE TABLE public.ai_token_usage (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    tenant_id character varying(64) NOT NULL,
+    admin_id character varying(64),
+    model_id character varying(64),
+    model character varying(64),
+    scene character varying(32) NOT NULL,
+    biz_id character varying(64),
+    prompt_tokens integer DEFAULT 0 NOT NULL,
+    completion_tokens integer DEFAULT 0 NOT NULL,
+    total_tokens integer DEFAULT 0 NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+COMMENT ON TABLE public.ai_token_usage IS 'AI Token 用量表';
+COMMENT ON COLUMN public.ai_token_usage.id IS '编号';
+COMMENT ON COLUMN public.ai_token_usage.tenant_id IS '租户编号';
+COMMENT ON COLUMN public.ai_token_usage.admin_id IS '用户编号';
+COMMENT ON COLUMN public.ai_token_usage.model_id IS '模型编号';
+COMMENT ON COLUMN public.ai_token_usage.model IS '模型标识';
+COMMENT ON COLUMN public.ai_token_usage.scene IS '使用场景(chat:聊天 write:写作 image:绘画 video:视频 api:接口)';
+COMMENT ON COLUMN public.ai_token_usage.biz_id IS '业务编号';
+COMMENT ON COLUMN public.ai_token_usage.prompt_tokens IS '输入 Token 数';
+COMMENT ON COLUMN public.ai_token_usage.completion_tokens IS '输出 Token 数';
+COMMENT ON COLUMN public.ai_token_usage.total_tokens IS '总 Token 数';
+COMMENT ON COLUMN public.ai_token_usage.created_at IS '创建时间';
+ALTER TABLE ONLY public.ai_token_usage ADD CONSTRAINT ai_token_usage_pkey PRIMARY KEY (id);
+CREATE INDEX ai_token_usage_admin_id_idx ON public.ai_token_usage USING btree (admin_id);
+CREATE INDEX ai_token_usage_model_id_idx ON public.ai_token_usage USING btree (model_id);
+CREATE INDEX ai_token_usage_tenant_id_idx ON public.ai_token_usage USING btree (tenant_id);
@@ -0,0 +1,34 @@
+CREATE TABLE public.device_push_log (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    push_type character varying(32) NOT NULL,
+    msg_type integer NOT NULL,
+    sns jsonb,
+    channel_ids jsonb,
+    tag character varying(128),
+    command character varying(64),
+    msg text NOT NULL,
+    status integer NOT NULL,
+    msg_id character varying(64),
+    attempts integer DEFAULT 0 NOT NULL,
+    error_message character varying(1024),
+    send_time timestamp with time zone,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+COMMENT ON TABLE public.device_push_log IS '设备推送日志表';
+COMMENT ON COLUMN public.device_push_log.id IS '编号';
+COMMENT ON COLUMN public.device_push_log.push_type IS '推送方式(single:单设备 batch:批量设备 tag:标签)';
+COMMENT ON COLUMN public.device_push_log.msg_type IS '消息类型(0:透传消息 1:通知)';
+COMMENT ON COLUMN public.device_push_log.sns IS '设备SN';
+COMMENT ON COLUMN public.device_push_log.channel_ids IS '推送通道ID';
+COMMENT ON COLUMN public.device_push_log.tag IS '标签';
+COMMENT ON COLUMN public.device_push_log.command IS '远程指令(locate:定位 screenshot:截图)';
+COMMENT ON COLUMN public.device_push_log.msg IS '推送内容';
+COMMENT ON COLUMN public.device_push_log.status IS '推送状态(-1:失败 0:待推送 2:成功)';
+COMMENT ON COLUMN public.device_push_log.msg_id IS '百度推送消息编号';
+COMMENT ON COLUMN public.device_push_log.attempts IS '已推送次数';
+COMMENT ON COLUMN public.device_push_log.error_message IS '错误信息';
+COMMENT ON COLUMN public.device_push_log.send_time IS '推送时间';
+COMMENT ON COLUMN public.device_push_log.created_at IS '创建时间';
+COMMENT ON COLUMN public.device_push_log.updated_at IS '更新时间';
+ALTER TABLE ONLY public.device_push_log ADD CONSTRAINT device_push_log_pkey PRIMARY KEY (id);
@@ -13,6 +13,11 @@ CREATE TABLE public.mail_log (
     send_time timestamp with time zone NOT NULL,
     send_message_id character varying(255),
     send_exception character varying(4096),
+    open_count integer DEFAULT 0 NOT NULL,
+    open_time timestamp with time zone,
+    click_count integer DEFAULT 0 NOT NULL,
+    bounce_time timestamp with time zone,
+    bounce_reason character varying(1024),
     created_at timestamp with time zone NOT NULL,
     updated_at timestamp with time zone NOT NULL,
     deleted_at timestamp with time zone
@@ -32,7 +37,14 @@ COMMENT ON COLUMN public.mail_log.send_status IS '发送状态';
 COMMENT ON COLUMN public.mail_log.send_time IS '发送时间';
 COMMENT ON COLUMN public.mail_log.send_message_id IS '发送返回的消息 ID';
 COMMENT ON COLUMN public.mail_log.send_exception IS '发送异常';
+COMMENT ON COLUMN public.mail_log.open_count IS '打开次数';
+COMMENT ON COLUMN public.mail_log.open_time IS '首次打开时间';
+COMMENT ON COLUMN public.mail_log.click_count IS '点击次数';
+COMMENT ON COLUMN public.mail_log.bounce_time IS '退信时间';
+COMMENT ON COLUMN public.mail_log.bounce_reason IS '退信原因';
 COMMENT ON COLUMN public.mail_log.created_at IS '创建时间';
 COMMENT ON COLUMN public.mail_log.updated_at IS '更新时间';
 COMMENT ON COLUMN public.mail_log.deleted_at IS '删除时间';
 ALTER TABLE ONLY public.mail_log ADD CONSTRAINT mail_log_pkey PRIMARY KEY (id);
+CREATE INDEX mail_log_send_message_id_idx ON public.mail_log USING btree (send_message_id);
+CREATE INDEX mail_log_to_mail_idx ON public.mail_log USING btree (to_mail);
@@ -9,6 +9,8 @@ CREATE TABLE public.mail_template (
     params jsonb,
     remark character varying(255),
     status integer NOT NULL,
+    track_open boolean DEFAULT false NOT NULL,
+    track_click boolean DEFAULT false NOT NULL,
     created_at timestamp with time zone NOT NULL,
     updated_at timestamp with time zone NOT NULL,
     deleted_at timestamp with time zone
@@ -24,6 +26,8 @@ COMMENT ON COLUMN public.mail_template.content IS '模板内容';
 COMMENT ON COLUMN public.mail_template.params IS '参数数组';
 COMMENT ON COLUMN public.mail_template.remark IS '备注';
 COMMENT ON COLUMN public.mail_template.status IS '状态(-1禁用,1开启)';
+COMMENT ON COLUMN public.mail_template.track_open IS '是否开启打开追踪';
+COMMENT ON COLUMN public.mail_template.track_click IS '是否开启点击追踪';
 COMMENT ON COLUMN public.mail_template.created_at IS '创建时间';
 COMMENT ON COLUMN public.mail_template.updated_at IS '更新时间';
 COMMENT ON COLUMN public.mail_template.deleted_at IS '删除时间';
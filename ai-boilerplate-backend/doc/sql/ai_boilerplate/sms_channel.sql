@@ -7,6 +7,7 @@ CREATE TABLE public.sms_channel (
     api_secret character varying(128),
     callback_url character varying(255),
     status smallint DEFAULT 1 NOT NULL,
+    config jsonb,
     created_at timestamp with time zone NOT NULL,
     updated_at timestamp with time zone NOT NULL,
     deleted_at timestamp with time zone
@@ -20,6 +21,7 @@ COMMENT ON COLUMN public.sms_channel.api_key IS '短信 API 的账号';
 COMMENT ON COLUMN public.sms_channel.api_secret IS '短信 API 的秘钥';
 COMMENT ON COLUMN public.sms_channel.callback_url IS '短信发送回调 URL';
 COMMENT ON COLUMN public.sms_channel.status IS '状态(-1禁用,1开启)';
+COMMENT ON COLUMN public.sms_channel.config IS '渠道配置';
 COMMENT ON COLUMN public.sms_channel.created_at IS '创建时间';
 COMMENT ON COLUMN public.sms_channel.updated_at IS '更新时间';
 COMMENT ON COLUMN public.sms_channel.deleted_at IS '删除时间';
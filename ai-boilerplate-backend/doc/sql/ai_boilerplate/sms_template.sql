@@ -9,6 +9,8 @@ CREATE TABLE public.sms_template (
     remark character varying(255),
     api_template_id character varying(64) NOT NULL,
     status smallint DEFAULT 1 NOT NULL,
+    priority integer DEFAULT 0 NOT NULL,
+    weight integer DEFAULT 1 NOT NULL,
     created_at timestamp with time zone NOT NULL,
     updated_at timestamp with time zone NOT NULL,
     deleted_at timestamp with time zone
@@ -24,6 +26,8 @@ COMMENT ON COLUMN public.sms_template.template_params IS '模板参数';
 COMMENT ON COLUMN public.sms_template.remark IS '备注';
 COMMENT ON COLUMN public.sms_template.api_template_id IS '短信供应商的模板编号';
 COMMENT ON COLUMN public.sms_template.status IS '状态(-1禁用,1开启)';
+COMMENT ON COLUMN public.sms_template.priority IS '渠道优先级(同模板编码下数值越小越优先)';
+COMMENT ON COLUMN public.sms_template.weight IS '渠道权重(同优先级下按权重随机)';
 COMMENT ON COLUMN public.sms_template.created_at IS '创建时间';
 COMMENT ON COLUMN public.sms_template.updated_at IS '更新时间';
 COMMENT ON COLUMN public.sms_template.deleted_at IS '删除时间';
@@ -12,6 +12,9 @@ CREATE TABLE public.sys_admin (
     dept_id character varying,
     post_id character varying,
     status smallint DEFAULT 1 NOT NULL,
+    two_factor_enabled boolean DEFAULT false NOT NULL,
+    two_factor_secret character varying(64),
+    two_factor_recovery_codes jsonb,
     created_at timestamp with time zone NOT NULL,
     updated_at timestamp with time zone NOT NULL,
     deleted_at timestamp with time zone
@@ -30,6 +33,9 @@ COMMENT ON COLUMN public.sys_admin.role_id IS '角色Id';
 COMMENT ON COLUMN public.sys_admin.dept_id IS '部门';
 COMMENT ON COLUMN public.sys_admin.post_id IS '岗位';
 COMMENT ON COLUMN public.sys_admin.status IS '状态(-1禁用,1开启)';
+COMMENT ON COLUMN public.sys_admin.two_factor_enabled IS '是否开启两步验证';
+COMMENT ON COLUMN public.sys_admin.two_factor_secret IS '两步验证密钥';
+COMMENT ON COLUMN public.sys_admin.two_factor_recovery_codes IS '两步验证恢复码摘要';
 COMMENT ON COLUMN public.sys_admin.created_at IS '创建时间';
 COMMENT ON COLUMN public.sys_admin.updated_at IS '更新时间';
 COMMENT ON COLUMN public.sys_admin.deleted_at IS '删除时间';
@@ -5,6 +5,13 @@ CREATE TABLE public.sys_notice (
     title character varying(200) NOT NULL,
     content character varying(200) NOT NULL,
     status smallint DEFAULT 1 NOT NULL,
+    target_type character varying(32) DEFAULT 'all'::character varying NOT NULL,
+    target_ids jsonb,
+    publish_status smallint DEFAULT 0 NOT NULL,
+    publish_time timestamp with time zone,
+    expire_time timestamp with time zone,
+    published_at timestamp with time zone,
+    recipient_count integer DEFAULT 0 NOT NULL,
     created_at timestamp with time zone NOT NULL,
     updated_at timestamp with time zone NOT NULL,
     deleted_at timestamp with time zone
@@ -16,6 +23,13 @@ COMMENT ON COLUMN public.sys_notice.type IS '类型';
 COMMENT ON COLUMN public.sys_notice.title IS '标题';
 COMMENT ON COLUMN public.sys_notice.content IS '内容';
 COMMENT ON COLUMN public.sys_notice.status IS '状态(-1禁用,1开启)';
+COMMENT ON COLUMN public.sys_notice.target_type IS '发布对象类型(all全部租户,tenant指定租户,role指定角色,dept指定部门)';
+COMMENT ON COLUMN public.sys_notice.target_ids IS '发布对象编号';
+COMMENT ON COLUMN public.sys_notice.publish_status IS '发布状态(-1失败,0草稿,1待发布,2发布中,3已发布,4已过期)';
+COMMENT ON COLUMN public.sys_notice.publish_time IS '计划发布时间';
+COMMENT ON COLUMN public.sys_notice.expire_time IS '过期时间';
+COMMENT ON COLUMN public.sys_notice.published_at IS '实际发布时间';
+COMMENT ON COLUMN public.sys_notice.recipient_count IS '接收人数';
 COMMENT ON COLUMN public.sys_notice.created_at IS '创建时间';
 COMMENT ON COLUMN public.sys_notice.updated_at IS '更新时间';
 COMMENT ON COLUMN public.sys_notice.deleted_at IS '删除时间';
@@ -8,7 +8,8 @@ CREATE TABLE public.sys_notify_message (
     receiver character varying(64) NOT NULL,
     send_time character varying(64) NOT NULL,
     read_time character varying(64) DEFAULT ''::character varying,
-    extend jsonb
+    extend jsonb,
+    notice_id character varying(64) DEFAULT ''::character varying
 );
 COMMENT ON TABLE public.sys_notify_message IS '系统-通知消息';
 COMMENT ON COLUMN public.sys_notify_message.id IS 'id';
@@ -21,5 +22,7 @@ COMMENT ON COLUMN public.sys_notify_message.receiver IS '接收人';
 COMMENT ON COLUMN public.sys_notify_message.send_time IS '发送时间';
 COMMENT ON COLUMN public.sys_notify_message.read_time IS '阅读时间';
 COMMENT ON COLUMN public.sys_notify_message.extend IS '扩展';
+COMMENT ON COLUMN public.sys_notify_message.notice_id IS '公告id';
 ALTER TABLE ONLY public.sys_notify_message ADD CONSTRAINT sys_notify_message_pkey PRIMARY KEY (id);
 CREATE INDEX sys_notify_message_receiver_read_time_idx ON public.sys_notify_message USING btree (receiver, read_time);
+CREATE INDEX sys_notify_message_notice_id_idx ON public.sys_notify_message USING btree (notice_id);
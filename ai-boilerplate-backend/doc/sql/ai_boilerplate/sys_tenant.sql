@@ -5,6 +5,7 @@ CREATE TABLE public.sys_tenant (
     admin_id character varying(50),
     expire_time timestamp with time zone,
     "menuIds" jsonb,
+    two_factor_role_ids jsonb,
     status smallint DEFAULT 1 NOT NULL,
     created_at timestamp with time zone NOT NULL,
     updated_at timestamp with time zone NOT NULL,
@@ -17,6 +18,7 @@ COMMENT ON COLUMN public.sys_tenant.remark IS '备注';
 COMMENT ON COLUMN public.sys_tenant.admin_id IS '租户管理员Id';
 COMMENT ON COLUMN public.sys_tenant.expire_time IS '过期时间';
 COMMENT ON COLUMN public.sys_tenant."menuIds" IS '菜单';
+COMMENT ON COLUMN public.sys_tenant.two_factor_role_ids IS '强制两步验证的角色';
 COMMENT ON COLUMN public.sys_tenant.status IS '状态(-1禁用,1开启)';
 COMMENT ON COLUMN public.sys_tenant.created_at IS '创建时间';
 COMMENT ON COLUMN public.sys_tenant.updated_at IS '更新时间';
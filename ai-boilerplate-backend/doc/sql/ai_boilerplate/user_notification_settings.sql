@@ -8,6 +8,7 @@ CREATE TABLE public.user_notification_settings (
     dnd_enabled boolean DEFAULT false NOT NULL,
     dnd_start_time character varying(5),
     dnd_end_time character varying(5),
+    dnd_time_zone character varying(64),
     created_at timestamp with time zone NOT NULL,
     updated_at timestamp with time zone NOT NULL,
     deleted_at timestamp with time zone
@@ -22,6 +23,7 @@ COMMENT ON COLUMN public.user_notification_settings.message_notification IS '消
 COMMENT ON COLUMN public.user_notification_settings.dnd_enabled IS '勿扰模式启用';
 COMMENT ON COLUMN public.user_notification_settings.dnd_start_time IS '勿扰开始时间';
 COMMENT ON COLUMN public.user_notification_settings.dnd_end_time IS '勿扰结束时间';
+COMMENT ON COLUMN public.user_notification_settings.dnd_time_zone IS '勿扰时段的时区(IANA 名称, 为空时使用服务器时区)';
 COMMENT ON COLUMN public.user_notification_settings.created_at IS '创建时间';
 COMMENT ON COLUMN public.user_notification_settings.updated_at IS '更新时间';
 COMMENT ON COLUMN public.user_notification_settings.deleted_at IS '删除时间';
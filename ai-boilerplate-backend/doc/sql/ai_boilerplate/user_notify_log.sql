@@ -0,0 +1,36 @@
+CREATE TABLE public.user_notify_log (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    notify_id character varying(64) NOT NULL,
+    user_id character varying(64) NOT NULL,
+    category character varying(32) NOT NULL,
+    channel character varying(32) NOT NULL,
+    title character varying(255) NOT NULL,
+    content text,
+    payload jsonb,
+    status integer NOT NULL,
+    biz_id character varying(64),
+    error_message character varying(1024),
+    plan_time timestamp with time zone NOT NULL,
+    send_time timestamp with time zone,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+COMMENT ON TABLE public.user_notify_log IS '用户通知投递日志表';
+COMMENT ON COLUMN public.user_notify_log.id IS '编号';
+COMMENT ON COLUMN public.user_notify_log.notify_id IS '通知编号(同一次通知的各渠道相同)';
+COMMENT ON COLUMN public.user_notify_log.user_id IS '用户编号';
+COMMENT ON COLUMN public.user_notify_log.category IS '通知类别(system:系统 activity:活动 order:订单 message:消息)';
+COMMENT ON COLUMN public.user_notify_log.channel IS '投递渠道(inApp:站内信 sms:短信 mail:邮件 wxGzh:公众号 push:推送)';
+COMMENT ON COLUMN public.user_notify_log.title IS '标题';
+COMMENT ON COLUMN public.user_notify_log.content IS '内容';
+COMMENT ON COLUMN public.user_notify_log.payload IS '通知内容与各渠道参数';
+COMMENT ON COLUMN public.user_notify_log.status IS '投递状态';
+COMMENT ON COLUMN public.user_notify_log.biz_id IS '渠道记录编号(站内信/短信日志/邮件日志编号等)';
+COMMENT ON COLUMN public.user_notify_log.error_message IS '错误信息';
+COMMENT ON COLUMN public.user_notify_log.plan_time IS '计划投递时间';
+COMMENT ON COLUMN public.user_notify_log.send_time IS '投递时间';
+COMMENT ON COLUMN public.user_notify_log.created_at IS '创建时间';
+COMMENT ON COLUMN public.user_notify_log.updated_at IS '更新时间';
+ALTER TABLE ONLY public.user_notify_log ADD CONSTRAINT user_notify_log_pkey PRIMARY KEY (id);
+CREATE INDEX user_notify_log_notify_id_idx ON public.user_notify_log USING btree (notify_id);
+CREATE INDEX user_notify_log_user_id_idx ON public.user_notify_log USING btree (user_id);
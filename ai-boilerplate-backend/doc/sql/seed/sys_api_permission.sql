@@ -0,0 +1,1106 @@
+-- 管理后台接口权限初始化数据
+-- 由 HTTP 注册的 protobuf 路由与自定义路由(处理函数中 http.SetOperation 设置 operation)生成, 不包含只在进程内调用的接口
+-- 每个服务生成一个按钮分组, 每个接口生成一个按钮权限与 sys_api 映射(按 operation 匹配)
+-- 未在 sys_api 配置的 /admin. 接口默认拒绝, 登录即可访问的接口见 internal/middleware/auth/admin.go AdminAPIPermissionWhiteList
+-- 编号由 md5 生成, 可重复执行
+--
+-- 迁移步骤(开启默认拒绝前执行, 否则除白名单外的接口全部返回无权限):
+-- 1. 执行本脚本写入按钮权限与 sys_api 映射
+-- 2. 配置 business.tenant.superRoleId 为平台租户的超级管理员角色编号, 该角色跳过接口权限校验并拥有全部按钮权限,
+--    再通过 UpdateSysRole 为其他角色分配按钮; 或执行文件末尾的语句直接把全部按钮分配给已有角色
+-- 3. 删除 Redis 中的接口权限映射缓存(sys_api_permission)与角色权限缓存(sys_role_permission), 或等待其过期
+
+-- AI 接口调用日志表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiAPICallLog')::uuid, '', 'AI 接口调用日志表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAPICallLog/GetAiAPICallLogInfo')::uuid, md5('sys_menu:admin.v1.AiAPICallLog')::uuid::text, 'AI 接口调用日志表-单条数据查询', 'button', '', 'ai_api_call_log:get_ai_api_call_log_info', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAPICallLog/GetAiAPICallLogList')::uuid, md5('sys_menu:admin.v1.AiAPICallLog')::uuid::text, 'AI 接口调用日志表-列表数据查询', 'button', '', 'ai_api_call_log:get_ai_api_call_log_list', NULL, NULL, NULL, 2, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiAPICallLog/GetAiAPICallLogInfo')::uuid, md5('sys_menu:/admin.v1.AiAPICallLog/GetAiAPICallLogInfo')::uuid, 'GET', '/admin.v1.AiAPICallLog/GetAiAPICallLogInfo', 'AI 接口调用日志表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiAPICallLog/GetAiAPICallLogList')::uuid, md5('sys_menu:/admin.v1.AiAPICallLog/GetAiAPICallLogList')::uuid, 'GET', '/admin.v1.AiAPICallLog/GetAiAPICallLogList', 'AI 接口调用日志表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 接口密钥表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiAPIKey')::uuid, '', 'AI 接口密钥表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAPIKey/CreateAiAPIKey')::uuid, md5('sys_menu:admin.v1.AiAPIKey')::uuid::text, 'AI 接口密钥表-创建一条数据', 'button', '', 'ai_api_key:create_ai_api_key', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAPIKey/UpdateAiAPIKey')::uuid, md5('sys_menu:admin.v1.AiAPIKey')::uuid::text, 'AI 接口密钥表-更新一条数据', 'button', '', 'ai_api_key:update_ai_api_key', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAPIKey/UpdateAiAPIKeyStatus')::uuid, md5('sys_menu:admin.v1.AiAPIKey')::uuid::text, 'AI 接口密钥表-更新状态', 'button', '', 'ai_api_key:update_ai_api_key_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAPIKey/DeleteAiAPIKey')::uuid, md5('sys_menu:admin.v1.AiAPIKey')::uuid::text, 'AI 接口密钥表-删除一条数据', 'button', '', 'ai_api_key:delete_ai_api_key', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAPIKey/GetAiAPIKeyInfo')::uuid, md5('sys_menu:admin.v1.AiAPIKey')::uuid::text, 'AI 接口密钥表-单条数据查询', 'button', '', 'ai_api_key:get_ai_api_key_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAPIKey/GetAiAPIKeyList')::uuid, md5('sys_menu:admin.v1.AiAPIKey')::uuid::text, 'AI 接口密钥表-列表数据查询', 'button', '', 'ai_api_key:get_ai_api_key_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiAPIKey/CreateAiAPIKey')::uuid, md5('sys_menu:/admin.v1.AiAPIKey/CreateAiAPIKey')::uuid, 'POST', '/admin.v1.AiAPIKey/CreateAiAPIKey', 'AI 接口密钥表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiAPIKey/UpdateAiAPIKey')::uuid, md5('sys_menu:/admin.v1.AiAPIKey/UpdateAiAPIKey')::uuid, 'POST', '/admin.v1.AiAPIKey/UpdateAiAPIKey', 'AI 接口密钥表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiAPIKey/UpdateAiAPIKeyStatus')::uuid, md5('sys_menu:/admin.v1.AiAPIKey/UpdateAiAPIKeyStatus')::uuid, 'POST', '/admin.v1.AiAPIKey/UpdateAiAPIKeyStatus', 'AI 接口密钥表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.AiAPIKey/DeleteAiAPIKey')::uuid, md5('sys_menu:/admin.v1.AiAPIKey/DeleteAiAPIKey')::uuid, 'POST', '/admin.v1.AiAPIKey/DeleteAiAPIKey', 'AI 接口密钥表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiAPIKey/GetAiAPIKeyInfo')::uuid, md5('sys_menu:/admin.v1.AiAPIKey/GetAiAPIKeyInfo')::uuid, 'GET', '/admin.v1.AiAPIKey/GetAiAPIKeyInfo', 'AI 接口密钥表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiAPIKey/GetAiAPIKeyList')::uuid, md5('sys_menu:/admin.v1.AiAPIKey/GetAiAPIKeyList')::uuid, 'GET', '/admin.v1.AiAPIKey/GetAiAPIKeyList', 'AI 接口密钥表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 音乐表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiAudioRecord')::uuid, '', 'AI 音乐表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAudioRecord/DeleteAiAudioRecord')::uuid, md5('sys_menu:admin.v1.AiAudioRecord')::uuid::text, 'AI 音乐表-删除一条数据', 'button', '', 'ai_audio_record:delete_ai_audio_record', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAudioRecord/GetAiAudioRecordInfo')::uuid, md5('sys_menu:admin.v1.AiAudioRecord')::uuid::text, 'AI 音乐表-单条数据查询', 'button', '', 'ai_audio_record:get_ai_audio_record_info', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiAudioRecord/GetAiAudioRecordList')::uuid, md5('sys_menu:admin.v1.AiAudioRecord')::uuid::text, 'AI 音乐表-列表数据查询', 'button', '', 'ai_audio_record:get_ai_audio_record_list', NULL, NULL, NULL, 3, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiAudioRecord/DeleteAiAudioRecord')::uuid, md5('sys_menu:/admin.v1.AiAudioRecord/DeleteAiAudioRecord')::uuid, 'POST', '/admin.v1.AiAudioRecord/DeleteAiAudioRecord', 'AI 音乐表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiAudioRecord/GetAiAudioRecordInfo')::uuid, md5('sys_menu:/admin.v1.AiAudioRecord/GetAiAudioRecordInfo')::uuid, 'GET', '/admin.v1.AiAudioRecord/GetAiAudioRecordInfo', 'AI 音乐表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiAudioRecord/GetAiAudioRecordList')::uuid, md5('sys_menu:/admin.v1.AiAudioRecord/GetAiAudioRecordList')::uuid, 'GET', '/admin.v1.AiAudioRecord/GetAiAudioRecordList', 'AI 音乐表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 聊天对话表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiChatConversation')::uuid, '', 'AI 聊天对话表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiChatConversation/DeleteAiChatConversation')::uuid, md5('sys_menu:admin.v1.AiChatConversation')::uuid::text, 'AI 聊天对话表-删除一条数据', 'button', '', 'ai_chat_conversation:delete_ai_chat_conversation', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiChatConversation/GetAiChatConversationInfo')::uuid, md5('sys_menu:admin.v1.AiChatConversation')::uuid::text, 'AI 聊天对话表-单条数据查询', 'button', '', 'ai_chat_conversation:get_ai_chat_conversation_info', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiChatConversation/GetAiChatConversationList')::uuid, md5('sys_menu:admin.v1.AiChatConversation')::uuid::text, 'AI 聊天对话表-列表数据查询', 'button', '', 'ai_chat_conversation:get_ai_chat_conversation_list', NULL, NULL, NULL, 3, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiChatConversation/DeleteAiChatConversation')::uuid, md5('sys_menu:/admin.v1.AiChatConversation/DeleteAiChatConversation')::uuid, 'POST', '/admin.v1.AiChatConversation/DeleteAiChatConversation', 'AI 聊天对话表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiChatConversation/GetAiChatConversationInfo')::uuid, md5('sys_menu:/admin.v1.AiChatConversation/GetAiChatConversationInfo')::uuid, 'GET', '/admin.v1.AiChatConversation/GetAiChatConversationInfo', 'AI 聊天对话表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiChatConversation/GetAiChatConversationList')::uuid, md5('sys_menu:/admin.v1.AiChatConversation/GetAiChatConversationList')::uuid, 'GET', '/admin.v1.AiChatConversation/GetAiChatConversationList', 'AI 聊天对话表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 聊天消息表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiChatMessage')::uuid, '', 'AI 聊天消息表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiChatMessage/GetAiChatMessageList')::uuid, md5('sys_menu:admin.v1.AiChatMessage')::uuid::text, 'AI 聊天消息表-列表数据查询', 'button', '', 'ai_chat_message:get_ai_chat_message_list', NULL, NULL, NULL, 1, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiChatMessage/GetAiChatMessageList')::uuid, md5('sys_menu:/admin.v1.AiChatMessage/GetAiChatMessageList')::uuid, 'GET', '/admin.v1.AiChatMessage/GetAiChatMessageList', 'AI 聊天消息表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 绘画表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiImageRecord')::uuid, '', 'AI 绘画表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiImageRecord/CreateAiImageRecord')::uuid, md5('sys_menu:admin.v1.AiImageRecord')::uuid::text, 'AI 绘画表-创建一条数据', 'button', '', 'ai_image_record:create_ai_image_record', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiImageRecord/UpdateAiImageRecord')::uuid, md5('sys_menu:admin.v1.AiImageRecord')::uuid::text, 'AI 绘画表-更新一条数据', 'button', '', 'ai_image_record:update_ai_image_record', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiImageRecord/UpdateAiImageRecordStatus')::uuid, md5('sys_menu:admin.v1.AiImageRecord')::uuid::text, 'AI 绘画表-更新状态', 'button', '', 'ai_image_record:update_ai_image_record_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiImageRecord/DeleteAiImageRecord')::uuid, md5('sys_menu:admin.v1.AiImageRecord')::uuid::text, 'AI 绘画表-删除一条数据', 'button', '', 'ai_image_record:delete_ai_image_record', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiImageRecord/GetAiImageRecordInfo')::uuid, md5('sys_menu:admin.v1.AiImageRecord')::uuid::text, 'AI 绘画表-单条数据查询', 'button', '', 'ai_image_record:get_ai_image_record_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiImageRecord/GetAiImageRecordList')::uuid, md5('sys_menu:admin.v1.AiImageRecord')::uuid::text, 'AI 绘画表-列表数据查询', 'button', '', 'ai_image_record:get_ai_image_record_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiImageRecord/CreateAiImageRecord')::uuid, md5('sys_menu:/admin.v1.AiImageRecord/CreateAiImageRecord')::uuid, 'POST', '/admin.v1.AiImageRecord/CreateAiImageRecord', 'AI 绘画表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiImageRecord/UpdateAiImageRecord')::uuid, md5('sys_menu:/admin.v1.AiImageRecord/UpdateAiImageRecord')::uuid, 'POST', '/admin.v1.AiImageRecord/UpdateAiImageRecord', 'AI 绘画表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiImageRecord/UpdateAiImageRecordStatus')::uuid, md5('sys_menu:/admin.v1.AiImageRecord/UpdateAiImageRecordStatus')::uuid, 'POST', '/admin.v1.AiImageRecord/UpdateAiImageRecordStatus', 'AI 绘画表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.AiImageRecord/DeleteAiImageRecord')::uuid, md5('sys_menu:/admin.v1.AiImageRecord/DeleteAiImageRecord')::uuid, 'POST', '/admin.v1.AiImageRecord/DeleteAiImageRecord', 'AI 绘画表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiImageRecord/GetAiImageRecordInfo')::uuid, md5('sys_menu:/admin.v1.AiImageRecord/GetAiImageRecordInfo')::uuid, 'GET', '/admin.v1.AiImageRecord/GetAiImageRecordInfo', 'AI 绘画表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiImageRecord/GetAiImageRecordList')::uuid, md5('sys_menu:/admin.v1.AiImageRecord/GetAiImageRecordList')::uuid, 'GET', '/admin.v1.AiImageRecord/GetAiImageRecordList', 'AI 绘画表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 聊天
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiIndexAudio')::uuid, '', 'AI 聊天', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexAudio/CreateAiIndexAudioRecord')::uuid, md5('sys_menu:admin.v1.AiIndexAudio')::uuid::text, 'AI 音乐表-创建一条数据', 'button', '', 'ai_index_audio:create_ai_index_audio_record', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexAudio/DeleteAiIndexAudioRecord')::uuid, md5('sys_menu:admin.v1.AiIndexAudio')::uuid::text, 'AI 音乐表-删除一条数据', 'button', '', 'ai_index_audio:delete_ai_index_audio_record', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexAudio/GetAiIndexAudioRecordInfo')::uuid, md5('sys_menu:admin.v1.AiIndexAudio')::uuid::text, 'AI 音乐表-单条数据查询', 'button', '', 'ai_index_audio:get_ai_index_audio_record_info', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexAudio/GetAiIndexAudioRecordList')::uuid, md5('sys_menu:admin.v1.AiIndexAudio')::uuid::text, 'AI 音乐表-列表数据查询', 'button', '', 'ai_index_audio:get_ai_index_audio_record_list', NULL, NULL, NULL, 4, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiIndexAudio/CreateAiIndexAudioRecord')::uuid, md5('sys_menu:/admin.v1.AiIndexAudio/CreateAiIndexAudioRecord')::uuid, 'POST', '/admin.v1.AiIndexAudio/CreateAiIndexAudioRecord', 'AI 音乐表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexAudio/DeleteAiIndexAudioRecord')::uuid, md5('sys_menu:/admin.v1.AiIndexAudio/DeleteAiIndexAudioRecord')::uuid, 'POST', '/admin.v1.AiIndexAudio/DeleteAiIndexAudioRecord', 'AI 音乐表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexAudio/GetAiIndexAudioRecordInfo')::uuid, md5('sys_menu:/admin.v1.AiIndexAudio/GetAiIndexAudioRecordInfo')::uuid, 'GET', '/admin.v1.AiIndexAudio/GetAiIndexAudioRecordInfo', 'AI 音乐表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexAudio/GetAiIndexAudioRecordList')::uuid, md5('sys_menu:/admin.v1.AiIndexAudio/GetAiIndexAudioRecordList')::uuid, 'GET', '/admin.v1.AiIndexAudio/GetAiIndexAudioRecordList', 'AI 音乐表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 聊天
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiIndexChat')::uuid, '', 'AI 聊天', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexChat/CreateAiIndexChatConversation')::uuid, md5('sys_menu:admin.v1.AiIndexChat')::uuid::text, 'AI 聊天对话表-创建一条数据', 'button', '', 'ai_index_chat:create_ai_index_chat_conversation', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexChat/UpdateAiIndexChatConversation')::uuid, md5('sys_menu:admin.v1.AiIndexChat')::uuid::text, 'AI 聊天对话表-更新一条数据', 'button', '', 'ai_index_chat:update_ai_index_chat_conversation', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexChat/PinAiIndexChatConversation')::uuid, md5('sys_menu:admin.v1.AiIndexChat')::uuid::text, 'AI 聊天对话表-置顶和取消置顶', 'button', '', 'ai_index_chat:pin_ai_index_chat_conversation', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexChat/DeleteAiIndexChatConversation')::uuid, md5('sys_menu:admin.v1.AiIndexChat')::uuid::text, 'AI 聊天对话表-删除一条数据', 'button', '', 'ai_index_chat:delete_ai_index_chat_conversation', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexChat/GetAiIndexChatConversationItem')::uuid, md5('sys_menu:admin.v1.AiIndexChat')::uuid::text, 'AI 聊天对话表-单条数据查询', 'button', '', 'ai_index_chat:get_ai_index_chat_conversation_item', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexChat/GetAiIndexChatConversationList')::uuid, md5('sys_menu:admin.v1.AiIndexChat')::uuid::text, 'AI 聊天对话表-列表数据查询', 'button', '', 'ai_index_chat:get_ai_index_chat_conversation_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexChat/GetAiIndexChatMessageList')::uuid, md5('sys_menu:admin.v1.AiIndexChat')::uuid::text, 'AI 聊天消息表-列表数据查询', 'button', '', 'ai_index_chat:get_ai_index_chat_message_list', NULL, NULL, NULL, 7, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexChat/AiIndexChatCompletions')::uuid, md5('sys_menu:admin.v1.AiIndexChat')::uuid::text, 'AI 聊天-聊天 ChatCompletions格式 (SSE 流式返回)', 'button', '', 'ai_index_chat:ai_index_chat_completions', NULL, NULL, NULL, 8, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiIndexChat/CreateAiIndexChatConversation')::uuid, md5('sys_menu:/admin.v1.AiIndexChat/CreateAiIndexChatConversation')::uuid, 'POST', '/admin.v1.AiIndexChat/CreateAiIndexChatConversation', 'AI 聊天对话表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexChat/UpdateAiIndexChatConversation')::uuid, md5('sys_menu:/admin.v1.AiIndexChat/UpdateAiIndexChatConversation')::uuid, 'POST', '/admin.v1.AiIndexChat/UpdateAiIndexChatConversation', 'AI 聊天对话表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexChat/PinAiIndexChatConversation')::uuid, md5('sys_menu:/admin.v1.AiIndexChat/PinAiIndexChatConversation')::uuid, 'POST', '/admin.v1.AiIndexChat/PinAiIndexChatConversation', 'AI 聊天对话表-置顶和取消置顶', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexChat/DeleteAiIndexChatConversation')::uuid, md5('sys_menu:/admin.v1.AiIndexChat/DeleteAiIndexChatConversation')::uuid, 'POST', '/admin.v1.AiIndexChat/DeleteAiIndexChatConversation', 'AI 聊天对话表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexChat/GetAiIndexChatConversationItem')::uuid, md5('sys_menu:/admin.v1.AiIndexChat/GetAiIndexChatConversationItem')::uuid, 'GET', '/admin.v1.AiIndexChat/GetAiIndexChatConversationItem', 'AI 聊天对话表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexChat/GetAiIndexChatConversationList')::uuid, md5('sys_menu:/admin.v1.AiIndexChat/GetAiIndexChatConversationList')::uuid, 'GET', '/admin.v1.AiIndexChat/GetAiIndexChatConversationList', 'AI 聊天对话表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexChat/GetAiIndexChatMessageList')::uuid, md5('sys_menu:/admin.v1.AiIndexChat/GetAiIndexChatMessageList')::uuid, 'GET', '/admin.v1.AiIndexChat/GetAiIndexChatMessageList', 'AI 聊天消息表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexChat/AiIndexChatCompletions')::uuid, md5('sys_menu:/admin.v1.AiIndexChat/AiIndexChatCompletions')::uuid, 'POST', '/admin.v1.AiIndexChat/AiIndexChatCompletions', 'AI 聊天-聊天 ChatCompletions格式 (SSE 流式返回)', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 图片
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiIndexImage')::uuid, '', 'AI 图片', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexImage/CreateAiIndexImageRecord')::uuid, md5('sys_menu:admin.v1.AiIndexImage')::uuid::text, 'AI 绘画表-创建一条数据', 'button', '', 'ai_index_image:create_ai_index_image_record', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexImage/DeleteAiIndexImageRecord')::uuid, md5('sys_menu:admin.v1.AiIndexImage')::uuid::text, 'AI 绘画表-删除一条数据', 'button', '', 'ai_index_image:delete_ai_index_image_record', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexImage/GetAiIndexImageRecordList')::uuid, md5('sys_menu:admin.v1.AiIndexImage')::uuid::text, 'AI 绘画表-列表数据查询', 'button', '', 'ai_index_image:get_ai_index_image_record_list', NULL, NULL, NULL, 3, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiIndexImage/CreateAiIndexImageRecord')::uuid, md5('sys_menu:/admin.v1.AiIndexImage/CreateAiIndexImageRecord')::uuid, 'POST', '/admin.v1.AiIndexImage/CreateAiIndexImageRecord', 'AI 绘画表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexImage/DeleteAiIndexImageRecord')::uuid, md5('sys_menu:/admin.v1.AiIndexImage/DeleteAiIndexImageRecord')::uuid, 'POST', '/admin.v1.AiIndexImage/DeleteAiIndexImageRecord', 'AI 绘画表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexImage/GetAiIndexImageRecordList')::uuid, md5('sys_menu:/admin.v1.AiIndexImage/GetAiIndexImageRecordList')::uuid, 'GET', '/admin.v1.AiIndexImage/GetAiIndexImageRecordList', 'AI 绘画表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 提示词
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiIndexPrompt')::uuid, '', 'AI 提示词', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexPrompt/CreateAiIndexPrompt')::uuid, md5('sys_menu:admin.v1.AiIndexPrompt')::uuid::text, 'AI 提示词-创建一条数据', 'button', '', 'ai_index_prompt:create_ai_index_prompt', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexPrompt/UpdateAiIndexPrompt')::uuid, md5('sys_menu:admin.v1.AiIndexPrompt')::uuid::text, 'AI 提示词-更新一条数据', 'button', '', 'ai_index_prompt:update_ai_index_prompt', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexPrompt/DeleteAiIndexPrompt')::uuid, md5('sys_menu:admin.v1.AiIndexPrompt')::uuid::text, 'AI 提示词-删除一条数据', 'button', '', 'ai_index_prompt:delete_ai_index_prompt', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexPrompt/GetAiIndexPromptInfo')::uuid, md5('sys_menu:admin.v1.AiIndexPrompt')::uuid::text, 'AI 提示词-单条数据查询', 'button', '', 'ai_index_prompt:get_ai_index_prompt_info', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexPrompt/GetAiIndexPromptList')::uuid, md5('sys_menu:admin.v1.AiIndexPrompt')::uuid::text, 'AI 提示词-列表数据查询', 'button', '', 'ai_index_prompt:get_ai_index_prompt_list', NULL, NULL, NULL, 5, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiIndexPrompt/CreateAiIndexPrompt')::uuid, md5('sys_menu:/admin.v1.AiIndexPrompt/CreateAiIndexPrompt')::uuid, 'POST', '/admin.v1.AiIndexPrompt/CreateAiIndexPrompt', 'AI 提示词-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexPrompt/UpdateAiIndexPrompt')::uuid, md5('sys_menu:/admin.v1.AiIndexPrompt/UpdateAiIndexPrompt')::uuid, 'POST', '/admin.v1.AiIndexPrompt/UpdateAiIndexPrompt', 'AI 提示词-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexPrompt/DeleteAiIndexPrompt')::uuid, md5('sys_menu:/admin.v1.AiIndexPrompt/DeleteAiIndexPrompt')::uuid, 'POST', '/admin.v1.AiIndexPrompt/DeleteAiIndexPrompt', 'AI 提示词-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexPrompt/GetAiIndexPromptInfo')::uuid, md5('sys_menu:/admin.v1.AiIndexPrompt/GetAiIndexPromptInfo')::uuid, 'GET', '/admin.v1.AiIndexPrompt/GetAiIndexPromptInfo', 'AI 提示词-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexPrompt/GetAiIndexPromptList')::uuid, md5('sys_menu:/admin.v1.AiIndexPrompt/GetAiIndexPromptList')::uuid, 'GET', '/admin.v1.AiIndexPrompt/GetAiIndexPromptList', 'AI 提示词-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 视频
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiIndexVideo')::uuid, '', 'AI 视频', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexVideo/CreateAiIndexVideoRecord')::uuid, md5('sys_menu:admin.v1.AiIndexVideo')::uuid::text, 'AI 视频表-创建一条数据', 'button', '', 'ai_index_video:create_ai_index_video_record', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexVideo/UpdateAiIndexVideoRecord')::uuid, md5('sys_menu:admin.v1.AiIndexVideo')::uuid::text, 'AI 视频表-更新一条数据', 'button', '', 'ai_index_video:update_ai_index_video_record', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexVideo/UpdateAiIndexVideoRecordStatus')::uuid, md5('sys_menu:admin.v1.AiIndexVideo')::uuid::text, 'AI 视频表-更新状态', 'button', '', 'ai_index_video:update_ai_index_video_record_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexVideo/DeleteAiIndexVideoRecord')::uuid, md5('sys_menu:admin.v1.AiIndexVideo')::uuid::text, 'AI 视频表-删除一条数据', 'button', '', 'ai_index_video:delete_ai_index_video_record', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexVideo/GetAiIndexVideoRecordInfo')::uuid, md5('sys_menu:admin.v1.AiIndexVideo')::uuid::text, 'AI 视频表-单条数据查询', 'button', '', 'ai_index_video:get_ai_index_video_record_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexVideo/GetAiIndexVideoRecordList')::uuid, md5('sys_menu:admin.v1.AiIndexVideo')::uuid::text, 'AI 视频表-列表数据查询', 'button', '', 'ai_index_video:get_ai_index_video_record_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiIndexVideo/CreateAiIndexVideoRecord')::uuid, md5('sys_menu:/admin.v1.AiIndexVideo/CreateAiIndexVideoRecord')::uuid, 'POST', '/admin.v1.AiIndexVideo/CreateAiIndexVideoRecord', 'AI 视频表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexVideo/UpdateAiIndexVideoRecord')::uuid, md5('sys_menu:/admin.v1.AiIndexVideo/UpdateAiIndexVideoRecord')::uuid, 'POST', '/admin.v1.AiIndexVideo/UpdateAiIndexVideoRecord', 'AI 视频表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexVideo/UpdateAiIndexVideoRecordStatus')::uuid, md5('sys_menu:/admin.v1.AiIndexVideo/UpdateAiIndexVideoRecordStatus')::uuid, 'POST', '/admin.v1.AiIndexVideo/UpdateAiIndexVideoRecordStatus', 'AI 视频表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexVideo/DeleteAiIndexVideoRecord')::uuid, md5('sys_menu:/admin.v1.AiIndexVideo/DeleteAiIndexVideoRecord')::uuid, 'POST', '/admin.v1.AiIndexVideo/DeleteAiIndexVideoRecord', 'AI 视频表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexVideo/GetAiIndexVideoRecordInfo')::uuid, md5('sys_menu:/admin.v1.AiIndexVideo/GetAiIndexVideoRecordInfo')::uuid, 'GET', '/admin.v1.AiIndexVideo/GetAiIndexVideoRecordInfo', 'AI 视频表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexVideo/GetAiIndexVideoRecordList')::uuid, md5('sys_menu:/admin.v1.AiIndexVideo/GetAiIndexVideoRecordList')::uuid, 'GET', '/admin.v1.AiIndexVideo/GetAiIndexVideoRecordList', 'AI 视频表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 写作
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiIndexWrite')::uuid, '', 'AI 写作', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexWrite/DeleteAiIndexWriteRecord')::uuid, md5('sys_menu:admin.v1.AiIndexWrite')::uuid::text, 'AI 写作表-删除一条数据', 'button', '', 'ai_index_write:delete_ai_index_write_record', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexWrite/GetAiIndexWriteRecordInfo')::uuid, md5('sys_menu:admin.v1.AiIndexWrite')::uuid::text, 'AI 写作表-单条数据查询', 'button', '', 'ai_index_write:get_ai_index_write_record_info', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexWrite/GetAiIndexWriteRecordList')::uuid, md5('sys_menu:admin.v1.AiIndexWrite')::uuid::text, 'AI 写作表-列表数据查询', 'button', '', 'ai_index_write:get_ai_index_write_record_list', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexWrite/AiIndexWriteGenerate')::uuid, md5('sys_menu:admin.v1.AiIndexWrite')::uuid::text, 'AI 写作-生成 (SSE 流式返回)', 'button', '', 'ai_index_write:ai_index_write_generate', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiIndexWrite/AiIndexWriteRegenerate')::uuid, md5('sys_menu:admin.v1.AiIndexWrite')::uuid::text, 'AI 写作-重新生成 (SSE 流式返回)', 'button', '', 'ai_index_write:ai_index_write_regenerate', NULL, NULL, NULL, 5, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiIndexWrite/DeleteAiIndexWriteRecord')::uuid, md5('sys_menu:/admin.v1.AiIndexWrite/DeleteAiIndexWriteRecord')::uuid, 'POST', '/admin.v1.AiIndexWrite/DeleteAiIndexWriteRecord', 'AI 写作表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexWrite/GetAiIndexWriteRecordInfo')::uuid, md5('sys_menu:/admin.v1.AiIndexWrite/GetAiIndexWriteRecordInfo')::uuid, 'GET', '/admin.v1.AiIndexWrite/GetAiIndexWriteRecordInfo', 'AI 写作表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexWrite/GetAiIndexWriteRecordList')::uuid, md5('sys_menu:/admin.v1.AiIndexWrite/GetAiIndexWriteRecordList')::uuid, 'GET', '/admin.v1.AiIndexWrite/GetAiIndexWriteRecordList', 'AI 写作表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexWrite/AiIndexWriteGenerate')::uuid, md5('sys_menu:/admin.v1.AiIndexWrite/AiIndexWriteGenerate')::uuid, 'POST', '/admin.v1.AiIndexWrite/AiIndexWriteGenerate', 'AI 写作-生成 (SSE 流式返回)', now(), now()),
+(md5('sys_api:/admin.v1.AiIndexWrite/AiIndexWriteRegenerate')::uuid, md5('sys_menu:/admin.v1.AiIndexWrite/AiIndexWriteRegenerate')::uuid, 'POST', '/admin.v1.AiIndexWrite/AiIndexWriteRegenerate', 'AI 写作-重新生成 (SSE 流式返回)', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 提示词
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiPrompt')::uuid, '', 'AI 提示词', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiPrompt/GetAiPromptInfo')::uuid, md5('sys_menu:admin.v1.AiPrompt')::uuid::text, 'AI 提示词-单条数据查询', 'button', '', 'ai_prompt:get_ai_prompt_info', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiPrompt/GetAiPromptList')::uuid, md5('sys_menu:admin.v1.AiPrompt')::uuid::text, 'AI 提示词-列表数据查询', 'button', '', 'ai_prompt:get_ai_prompt_list', NULL, NULL, NULL, 2, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiPrompt/GetAiPromptInfo')::uuid, md5('sys_menu:/admin.v1.AiPrompt/GetAiPromptInfo')::uuid, 'GET', '/admin.v1.AiPrompt/GetAiPromptInfo', 'AI 提示词-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiPrompt/GetAiPromptList')::uuid, md5('sys_menu:/admin.v1.AiPrompt/GetAiPromptList')::uuid, 'GET', '/admin.v1.AiPrompt/GetAiPromptList', 'AI 提示词-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 配置模型表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiProviderModel')::uuid, '', 'AI 配置模型表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderModel/CreateAiProviderModel')::uuid, md5('sys_menu:admin.v1.AiProviderModel')::uuid::text, 'AI 配置模型表-创建一条数据', 'button', '', 'ai_provider_model:create_ai_provider_model', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderModel/UpdateAiProviderModel')::uuid, md5('sys_menu:admin.v1.AiProviderModel')::uuid::text, 'AI 配置模型表-更新一条数据', 'button', '', 'ai_provider_model:update_ai_provider_model', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderModel/UpdateAiProviderModelStatus')::uuid, md5('sys_menu:admin.v1.AiProviderModel')::uuid::text, 'AI 配置模型表-更新状态', 'button', '', 'ai_provider_model:update_ai_provider_model_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderModel/DeleteAiProviderModel')::uuid, md5('sys_menu:admin.v1.AiProviderModel')::uuid::text, 'AI 配置模型表-删除一条数据', 'button', '', 'ai_provider_model:delete_ai_provider_model', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderModel/GetAiProviderModelInfo')::uuid, md5('sys_menu:admin.v1.AiProviderModel')::uuid::text, 'AI 配置模型表-单条数据查询', 'button', '', 'ai_provider_model:get_ai_provider_model_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderModel/GetAiProviderModelList')::uuid, md5('sys_menu:admin.v1.AiProviderModel')::uuid::text, 'AI 配置模型表-列表数据查询', 'button', '', 'ai_provider_model:get_ai_provider_model_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderModel/GetAiProviderModelLabelSelector')::uuid, md5('sys_menu:admin.v1.AiProviderModel')::uuid::text, 'AI 配置模型表-标签选择器', 'button', '', 'ai_provider_model:get_ai_provider_model_label_selector', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiProviderModel/CreateAiProviderModel')::uuid, md5('sys_menu:/admin.v1.AiProviderModel/CreateAiProviderModel')::uuid, 'POST', '/admin.v1.AiProviderModel/CreateAiProviderModel', 'AI 配置模型表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderModel/UpdateAiProviderModel')::uuid, md5('sys_menu:/admin.v1.AiProviderModel/UpdateAiProviderModel')::uuid, 'POST', '/admin.v1.AiProviderModel/UpdateAiProviderModel', 'AI 配置模型表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderModel/UpdateAiProviderModelStatus')::uuid, md5('sys_menu:/admin.v1.AiProviderModel/UpdateAiProviderModelStatus')::uuid, 'POST', '/admin.v1.AiProviderModel/UpdateAiProviderModelStatus', 'AI 配置模型表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderModel/DeleteAiProviderModel')::uuid, md5('sys_menu:/admin.v1.AiProviderModel/DeleteAiProviderModel')::uuid, 'POST', '/admin.v1.AiProviderModel/DeleteAiProviderModel', 'AI 配置模型表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderModel/GetAiProviderModelInfo')::uuid, md5('sys_menu:/admin.v1.AiProviderModel/GetAiProviderModelInfo')::uuid, 'GET', '/admin.v1.AiProviderModel/GetAiProviderModelInfo', 'AI 配置模型表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderModel/GetAiProviderModelList')::uuid, md5('sys_menu:/admin.v1.AiProviderModel/GetAiProviderModelList')::uuid, 'GET', '/admin.v1.AiProviderModel/GetAiProviderModelList', 'AI 配置模型表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderModel/GetAiProviderModelLabelSelector')::uuid, md5('sys_menu:/admin.v1.AiProviderModel/GetAiProviderModelLabelSelector')::uuid, 'GET', '/admin.v1.AiProviderModel/GetAiProviderModelLabelSelector', 'AI 配置模型表-标签选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 配置平台表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiProviderPlatform')::uuid, '', 'AI 配置平台表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderPlatform/CreateAiProviderPlatform')::uuid, md5('sys_menu:admin.v1.AiProviderPlatform')::uuid::text, 'AI 配置平台表-创建一条数据', 'button', '', 'ai_provider_platform:create_ai_provider_platform', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderPlatform/UpdateAiProviderPlatform')::uuid, md5('sys_menu:admin.v1.AiProviderPlatform')::uuid::text, 'AI 配置平台表-更新一条数据', 'button', '', 'ai_provider_platform:update_ai_provider_platform', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderPlatform/UpdateAiProviderPlatformStatus')::uuid, md5('sys_menu:admin.v1.AiProviderPlatform')::uuid::text, 'AI 配置平台表-更新状态', 'button', '', 'ai_provider_platform:update_ai_provider_platform_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderPlatform/DeleteAiProviderPlatform')::uuid, md5('sys_menu:admin.v1.AiProviderPlatform')::uuid::text, 'AI 配置平台表-删除一条数据', 'button', '', 'ai_provider_platform:delete_ai_provider_platform', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderPlatform/GetAiProviderPlatformInfo')::uuid, md5('sys_menu:admin.v1.AiProviderPlatform')::uuid::text, 'AI 配置平台表-单条数据查询', 'button', '', 'ai_provider_platform:get_ai_provider_platform_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderPlatform/GetAiProviderPlatformList')::uuid, md5('sys_menu:admin.v1.AiProviderPlatform')::uuid::text, 'AI 配置平台表-列表数据查询', 'button', '', 'ai_provider_platform:get_ai_provider_platform_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiProviderPlatform/GetAiProviderPlatformSelector')::uuid, md5('sys_menu:admin.v1.AiProviderPlatform')::uuid::text, 'AI 配置平台表-获取平台选择器', 'button', '', 'ai_provider_platform:get_ai_provider_platform_selector', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiProviderPlatform/CreateAiProviderPlatform')::uuid, md5('sys_menu:/admin.v1.AiProviderPlatform/CreateAiProviderPlatform')::uuid, 'POST', '/admin.v1.AiProviderPlatform/CreateAiProviderPlatform', 'AI 配置平台表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderPlatform/UpdateAiProviderPlatform')::uuid, md5('sys_menu:/admin.v1.AiProviderPlatform/UpdateAiProviderPlatform')::uuid, 'POST', '/admin.v1.AiProviderPlatform/UpdateAiProviderPlatform', 'AI 配置平台表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderPlatform/UpdateAiProviderPlatformStatus')::uuid, md5('sys_menu:/admin.v1.AiProviderPlatform/UpdateAiProviderPlatformStatus')::uuid, 'POST', '/admin.v1.AiProviderPlatform/UpdateAiProviderPlatformStatus', 'AI 配置平台表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderPlatform/DeleteAiProviderPlatform')::uuid, md5('sys_menu:/admin.v1.AiProviderPlatform/DeleteAiProviderPlatform')::uuid, 'POST', '/admin.v1.AiProviderPlatform/DeleteAiProviderPlatform', 'AI 配置平台表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderPlatform/GetAiProviderPlatformInfo')::uuid, md5('sys_menu:/admin.v1.AiProviderPlatform/GetAiProviderPlatformInfo')::uuid, 'GET', '/admin.v1.AiProviderPlatform/GetAiProviderPlatformInfo', 'AI 配置平台表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderPlatform/GetAiProviderPlatformList')::uuid, md5('sys_menu:/admin.v1.AiProviderPlatform/GetAiProviderPlatformList')::uuid, 'GET', '/admin.v1.AiProviderPlatform/GetAiProviderPlatformList', 'AI 配置平台表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiProviderPlatform/GetAiProviderPlatformSelector')::uuid, md5('sys_menu:/admin.v1.AiProviderPlatform/GetAiProviderPlatformSelector')::uuid, 'GET', '/admin.v1.AiProviderPlatform/GetAiProviderPlatformSelector', 'AI 配置平台表-获取平台选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI Token 配额表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiTokenQuota')::uuid, '', 'AI Token 配额表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiTokenQuota/GetAiTokenQuotaInfo')::uuid, md5('sys_menu:admin.v1.AiTokenQuota')::uuid::text, 'AI Token 配额表-单条数据查询', 'button', '', 'ai_token_quota:get_ai_token_quota_info', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiTokenQuota/UpdateAiTokenQuota')::uuid, md5('sys_menu:admin.v1.AiTokenQuota')::uuid::text, 'AI Token 配额表-设置配额', 'button', '', 'ai_token_quota:update_ai_token_quota', NULL, NULL, NULL, 2, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiTokenQuota/GetAiTokenQuotaInfo')::uuid, md5('sys_menu:/admin.v1.AiTokenQuota/GetAiTokenQuotaInfo')::uuid, 'GET', '/admin.v1.AiTokenQuota/GetAiTokenQuotaInfo', 'AI Token 配额表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiTokenQuota/UpdateAiTokenQuota')::uuid, md5('sys_menu:/admin.v1.AiTokenQuota/UpdateAiTokenQuota')::uuid, 'POST', '/admin.v1.AiTokenQuota/UpdateAiTokenQuota', 'AI Token 配额表-设置配额', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI Token 用量表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiTokenUsage')::uuid, '', 'AI Token 用量表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiTokenUsage/GetAiTokenUsageList')::uuid, md5('sys_menu:admin.v1.AiTokenUsage')::uuid::text, 'AI Token 用量表-列表数据查询', 'button', '', 'ai_token_usage:get_ai_token_usage_list', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiTokenUsage/GetAiTokenUsageReport')::uuid, md5('sys_menu:admin.v1.AiTokenUsage')::uuid::text, 'AI Token 用量表-用量统计报表', 'button', '', 'ai_token_usage:get_ai_token_usage_report', NULL, NULL, NULL, 2, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiTokenUsage/GetAiTokenUsageList')::uuid, md5('sys_menu:/admin.v1.AiTokenUsage/GetAiTokenUsageList')::uuid, 'GET', '/admin.v1.AiTokenUsage/GetAiTokenUsageList', 'AI Token 用量表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiTokenUsage/GetAiTokenUsageReport')::uuid, md5('sys_menu:/admin.v1.AiTokenUsage/GetAiTokenUsageReport')::uuid, 'GET', '/admin.v1.AiTokenUsage/GetAiTokenUsageReport', 'AI Token 用量表-用量统计报表', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 视频表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiVideoRecord')::uuid, '', 'AI 视频表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiVideoRecord/CreateAiVideoRecord')::uuid, md5('sys_menu:admin.v1.AiVideoRecord')::uuid::text, 'AI 视频表-创建一条数据', 'button', '', 'ai_video_record:create_ai_video_record', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiVideoRecord/UpdateAiVideoRecord')::uuid, md5('sys_menu:admin.v1.AiVideoRecord')::uuid::text, 'AI 视频表-更新一条数据', 'button', '', 'ai_video_record:update_ai_video_record', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiVideoRecord/UpdateAiVideoRecordStatus')::uuid, md5('sys_menu:admin.v1.AiVideoRecord')::uuid::text, 'AI 视频表-更新状态', 'button', '', 'ai_video_record:update_ai_video_record_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiVideoRecord/DeleteAiVideoRecord')::uuid, md5('sys_menu:admin.v1.AiVideoRecord')::uuid::text, 'AI 视频表-删除一条数据', 'button', '', 'ai_video_record:delete_ai_video_record', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiVideoRecord/GetAiVideoRecordInfo')::uuid, md5('sys_menu:admin.v1.AiVideoRecord')::uuid::text, 'AI 视频表-单条数据查询', 'button', '', 'ai_video_record:get_ai_video_record_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiVideoRecord/GetAiVideoRecordList')::uuid, md5('sys_menu:admin.v1.AiVideoRecord')::uuid::text, 'AI 视频表-列表数据查询', 'button', '', 'ai_video_record:get_ai_video_record_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiVideoRecord/CreateAiVideoRecord')::uuid, md5('sys_menu:/admin.v1.AiVideoRecord/CreateAiVideoRecord')::uuid, 'POST', '/admin.v1.AiVideoRecord/CreateAiVideoRecord', 'AI 视频表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiVideoRecord/UpdateAiVideoRecord')::uuid, md5('sys_menu:/admin.v1.AiVideoRecord/UpdateAiVideoRecord')::uuid, 'POST', '/admin.v1.AiVideoRecord/UpdateAiVideoRecord', 'AI 视频表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiVideoRecord/UpdateAiVideoRecordStatus')::uuid, md5('sys_menu:/admin.v1.AiVideoRecord/UpdateAiVideoRecordStatus')::uuid, 'POST', '/admin.v1.AiVideoRecord/UpdateAiVideoRecordStatus', 'AI 视频表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.AiVideoRecord/DeleteAiVideoRecord')::uuid, md5('sys_menu:/admin.v1.AiVideoRecord/DeleteAiVideoRecord')::uuid, 'POST', '/admin.v1.AiVideoRecord/DeleteAiVideoRecord', 'AI 视频表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiVideoRecord/GetAiVideoRecordInfo')::uuid, md5('sys_menu:/admin.v1.AiVideoRecord/GetAiVideoRecordInfo')::uuid, 'GET', '/admin.v1.AiVideoRecord/GetAiVideoRecordInfo', 'AI 视频表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiVideoRecord/GetAiVideoRecordList')::uuid, md5('sys_menu:/admin.v1.AiVideoRecord/GetAiVideoRecordList')::uuid, 'GET', '/admin.v1.AiVideoRecord/GetAiVideoRecordList', 'AI 视频表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- AI 写作表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.AiWriteRecord')::uuid, '', 'AI 写作表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiWriteRecord/CreateAiWriteRecord')::uuid, md5('sys_menu:admin.v1.AiWriteRecord')::uuid::text, 'AI 写作表-创建一条数据', 'button', '', 'ai_write_record:create_ai_write_record', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiWriteRecord/UpdateAiWriteRecord')::uuid, md5('sys_menu:admin.v1.AiWriteRecord')::uuid::text, 'AI 写作表-更新一条数据', 'button', '', 'ai_write_record:update_ai_write_record', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiWriteRecord/DeleteAiWriteRecord')::uuid, md5('sys_menu:admin.v1.AiWriteRecord')::uuid::text, 'AI 写作表-删除一条数据', 'button', '', 'ai_write_record:delete_ai_write_record', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiWriteRecord/GetAiWriteRecordInfo')::uuid, md5('sys_menu:admin.v1.AiWriteRecord')::uuid::text, 'AI 写作表-单条数据查询', 'button', '', 'ai_write_record:get_ai_write_record_info', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.AiWriteRecord/GetAiWriteRecordList')::uuid, md5('sys_menu:admin.v1.AiWriteRecord')::uuid::text, 'AI 写作表-列表数据查询', 'button', '', 'ai_write_record:get_ai_write_record_list', NULL, NULL, NULL, 5, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.AiWriteRecord/CreateAiWriteRecord')::uuid, md5('sys_menu:/admin.v1.AiWriteRecord/CreateAiWriteRecord')::uuid, 'POST', '/admin.v1.AiWriteRecord/CreateAiWriteRecord', 'AI 写作表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiWriteRecord/UpdateAiWriteRecord')::uuid, md5('sys_menu:/admin.v1.AiWriteRecord/UpdateAiWriteRecord')::uuid, 'POST', '/admin.v1.AiWriteRecord/UpdateAiWriteRecord', 'AI 写作表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiWriteRecord/DeleteAiWriteRecord')::uuid, md5('sys_menu:/admin.v1.AiWriteRecord/DeleteAiWriteRecord')::uuid, 'POST', '/admin.v1.AiWriteRecord/DeleteAiWriteRecord', 'AI 写作表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.AiWriteRecord/GetAiWriteRecordInfo')::uuid, md5('sys_menu:/admin.v1.AiWriteRecord/GetAiWriteRecordInfo')::uuid, 'GET', '/admin.v1.AiWriteRecord/GetAiWriteRecordInfo', 'AI 写作表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.AiWriteRecord/GetAiWriteRecordList')::uuid, md5('sys_menu:/admin.v1.AiWriteRecord/GetAiWriteRecordList')::uuid, 'GET', '/admin.v1.AiWriteRecord/GetAiWriteRecordList', 'AI 写作表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 配置管理
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.ConfigDatum')::uuid, '', '配置管理', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.ConfigDatum/CreateConfigDatum')::uuid, md5('sys_menu:admin.v1.ConfigDatum')::uuid::text, '配置管理-创建一条数据', 'button', '', 'config_datum:create_config_datum', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.ConfigDatum/UpdateConfigDatum')::uuid, md5('sys_menu:admin.v1.ConfigDatum')::uuid::text, '配置管理-更新一条数据', 'button', '', 'config_datum:update_config_datum', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.ConfigDatum/UpdateConfigDatumStatus')::uuid, md5('sys_menu:admin.v1.ConfigDatum')::uuid::text, '配置管理-更新状态', 'button', '', 'config_datum:update_config_datum_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.ConfigDatum/DeleteConfigDatum')::uuid, md5('sys_menu:admin.v1.ConfigDatum')::uuid::text, '配置管理-删除一条数据', 'button', '', 'config_datum:delete_config_datum', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.ConfigDatum/GetConfigDatumInfo')::uuid, md5('sys_menu:admin.v1.ConfigDatum')::uuid::text, '配置管理-单条数据查询', 'button', '', 'config_datum:get_config_datum_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.ConfigDatum/GetConfigDatumList')::uuid, md5('sys_menu:admin.v1.ConfigDatum')::uuid::text, '配置管理-列表数据查询', 'button', '', 'config_datum:get_config_datum_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.ConfigDatum/CreateConfigDatum')::uuid, md5('sys_menu:/admin.v1.ConfigDatum/CreateConfigDatum')::uuid, 'POST', '/admin.v1.ConfigDatum/CreateConfigDatum', '配置管理-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.ConfigDatum/UpdateConfigDatum')::uuid, md5('sys_menu:/admin.v1.ConfigDatum/UpdateConfigDatum')::uuid, 'POST', '/admin.v1.ConfigDatum/UpdateConfigDatum', '配置管理-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.ConfigDatum/UpdateConfigDatumStatus')::uuid, md5('sys_menu:/admin.v1.ConfigDatum/UpdateConfigDatumStatus')::uuid, 'POST', '/admin.v1.ConfigDatum/UpdateConfigDatumStatus', '配置管理-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.ConfigDatum/DeleteConfigDatum')::uuid, md5('sys_menu:/admin.v1.ConfigDatum/DeleteConfigDatum')::uuid, 'POST', '/admin.v1.ConfigDatum/DeleteConfigDatum', '配置管理-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.ConfigDatum/GetConfigDatumInfo')::uuid, md5('sys_menu:/admin.v1.ConfigDatum/GetConfigDatumInfo')::uuid, 'GET', '/admin.v1.ConfigDatum/GetConfigDatumInfo', '配置管理-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.ConfigDatum/GetConfigDatumList')::uuid, md5('sys_menu:/admin.v1.ConfigDatum/GetConfigDatumList')::uuid, 'GET', '/admin.v1.ConfigDatum/GetConfigDatumList', '配置管理-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 设备表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.Device')::uuid, '', '设备表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/RegisterDevice')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-注册设备', 'button', '', 'device:register_device', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/UpdateDeviceStatus')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-更新状态', 'button', '', 'device:update_device_status', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/DeleteDevice')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-删除一条数据', 'button', '', 'device:delete_device', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/GetDeviceInfo')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-单条数据查询', 'button', '', 'device:get_device_info', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/GetDeviceList')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-列表数据查询', 'button', '', 'device:get_device_list', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/GetOnlineDeviceCount')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-在线设备数量统计', 'button', '', 'device:get_online_device_count', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/PushDevice')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-单设备推送', 'button', '', 'device:push_device', NULL, NULL, NULL, 7, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/PushDeviceBatch')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-批量设备推送', 'button', '', 'device:push_device_batch', NULL, NULL, NULL, 8, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/PushDeviceTag')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-标签推送', 'button', '', 'device:push_device_tag', NULL, NULL, NULL, 9, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/SendDeviceCommand')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-下发远程指令', 'button', '', 'device:send_device_command', NULL, NULL, NULL, 10, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Device/GetDevicePushLogList')::uuid, md5('sys_menu:admin.v1.Device')::uuid::text, '设备表-推送日志列表', 'button', '', 'device:get_device_push_log_list', NULL, NULL, NULL, 11, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.Device/RegisterDevice')::uuid, md5('sys_menu:/admin.v1.Device/RegisterDevice')::uuid, 'POST', '/admin.v1.Device/RegisterDevice', '设备表-注册设备', now(), now()),
+(md5('sys_api:/admin.v1.Device/UpdateDeviceStatus')::uuid, md5('sys_menu:/admin.v1.Device/UpdateDeviceStatus')::uuid, 'POST', '/admin.v1.Device/UpdateDeviceStatus', '设备表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.Device/DeleteDevice')::uuid, md5('sys_menu:/admin.v1.Device/DeleteDevice')::uuid, 'POST', '/admin.v1.Device/DeleteDevice', '设备表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.Device/GetDeviceInfo')::uuid, md5('sys_menu:/admin.v1.Device/GetDeviceInfo')::uuid, 'GET', '/admin.v1.Device/GetDeviceInfo', '设备表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.Device/GetDeviceList')::uuid, md5('sys_menu:/admin.v1.Device/GetDeviceList')::uuid, 'POST', '/admin.v1.Device/GetDeviceList', '设备表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.Device/GetOnlineDeviceCount')::uuid, md5('sys_menu:/admin.v1.Device/GetOnlineDeviceCount')::uuid, 'GET', '/admin.v1.Device/GetOnlineDeviceCount', '设备表-在线设备数量统计', now(), now()),
+(md5('sys_api:/admin.v1.Device/PushDevice')::uuid, md5('sys_menu:/admin.v1.Device/PushDevice')::uuid, 'POST', '/admin.v1.Device/PushDevice', '设备表-单设备推送', now(), now()),
+(md5('sys_api:/admin.v1.Device/PushDeviceBatch')::uuid, md5('sys_menu:/admin.v1.Device/PushDeviceBatch')::uuid, 'POST', '/admin.v1.Device/PushDeviceBatch', '设备表-批量设备推送', now(), now()),
+(md5('sys_api:/admin.v1.Device/PushDeviceTag')::uuid, md5('sys_menu:/admin.v1.Device/PushDeviceTag')::uuid, 'POST', '/admin.v1.Device/PushDeviceTag', '设备表-标签推送', now(), now()),
+(md5('sys_api:/admin.v1.Device/SendDeviceCommand')::uuid, md5('sys_menu:/admin.v1.Device/SendDeviceCommand')::uuid, 'POST', '/admin.v1.Device/SendDeviceCommand', '设备表-下发远程指令', now(), now()),
+(md5('sys_api:/admin.v1.Device/GetDevicePushLogList')::uuid, md5('sys_menu:/admin.v1.Device/GetDevicePushLogList')::uuid, 'POST', '/admin.v1.Device/GetDevicePushLogList', '设备表-推送日志列表', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 字典数据表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.DictDatum')::uuid, '', '字典数据表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictDatum/CreateDictDatum')::uuid, md5('sys_menu:admin.v1.DictDatum')::uuid::text, '字典数据表-创建一条数据', 'button', '', 'dict_datum:create_dict_datum', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictDatum/UpdateDictDatum')::uuid, md5('sys_menu:admin.v1.DictDatum')::uuid::text, '字典数据表-更新一条数据', 'button', '', 'dict_datum:update_dict_datum', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictDatum/UpdateDictDatumStatus')::uuid, md5('sys_menu:admin.v1.DictDatum')::uuid::text, '字典数据表-更新状态', 'button', '', 'dict_datum:update_dict_datum_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictDatum/DeleteDictDatum')::uuid, md5('sys_menu:admin.v1.DictDatum')::uuid::text, '字典数据表-删除一条数据', 'button', '', 'dict_datum:delete_dict_datum', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictDatum/GetDictDatumInfo')::uuid, md5('sys_menu:admin.v1.DictDatum')::uuid::text, '字典数据表-单条数据查询', 'button', '', 'dict_datum:get_dict_datum_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictDatum/GetDictDatumList')::uuid, md5('sys_menu:admin.v1.DictDatum')::uuid::text, '字典数据表-列表数据查询', 'button', '', 'dict_datum:get_dict_datum_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.DictDatum/CreateDictDatum')::uuid, md5('sys_menu:/admin.v1.DictDatum/CreateDictDatum')::uuid, 'POST', '/admin.v1.DictDatum/CreateDictDatum', '字典数据表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.DictDatum/UpdateDictDatum')::uuid, md5('sys_menu:/admin.v1.DictDatum/UpdateDictDatum')::uuid, 'POST', '/admin.v1.DictDatum/UpdateDictDatum', '字典数据表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.DictDatum/UpdateDictDatumStatus')::uuid, md5('sys_menu:/admin.v1.DictDatum/UpdateDictDatumStatus')::uuid, 'POST', '/admin.v1.DictDatum/UpdateDictDatumStatus', '字典数据表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.DictDatum/DeleteDictDatum')::uuid, md5('sys_menu:/admin.v1.DictDatum/DeleteDictDatum')::uuid, 'POST', '/admin.v1.DictDatum/DeleteDictDatum', '字典数据表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.DictDatum/GetDictDatumInfo')::uuid, md5('sys_menu:/admin.v1.DictDatum/GetDictDatumInfo')::uuid, 'GET', '/admin.v1.DictDatum/GetDictDatumInfo', '字典数据表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.DictDatum/GetDictDatumList')::uuid, md5('sys_menu:/admin.v1.DictDatum/GetDictDatumList')::uuid, 'GET', '/admin.v1.DictDatum/GetDictDatumList', '字典数据表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 字典类型表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.DictType')::uuid, '', '字典类型表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictType/CreateDictType')::uuid, md5('sys_menu:admin.v1.DictType')::uuid::text, '字典类型表-创建一条数据', 'button', '', 'dict_type:create_dict_type', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictType/UpdateDictType')::uuid, md5('sys_menu:admin.v1.DictType')::uuid::text, '字典类型表-更新一条数据', 'button', '', 'dict_type:update_dict_type', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictType/UpdateDictTypeStatus')::uuid, md5('sys_menu:admin.v1.DictType')::uuid::text, '字典类型表-更新状态', 'button', '', 'dict_type:update_dict_type_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictType/DeleteDictType')::uuid, md5('sys_menu:admin.v1.DictType')::uuid::text, '字典类型表-删除一条数据', 'button', '', 'dict_type:delete_dict_type', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictType/GetDictTypeInfo')::uuid, md5('sys_menu:admin.v1.DictType')::uuid::text, '字典类型表-单条数据查询', 'button', '', 'dict_type:get_dict_type_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictType/GetDictTypeList')::uuid, md5('sys_menu:admin.v1.DictType')::uuid::text, '字典类型表-列表数据查询', 'button', '', 'dict_type:get_dict_type_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.DictType/GetDictTypeSelector')::uuid, md5('sys_menu:admin.v1.DictType')::uuid::text, '字典类型表-选择器', 'button', '', 'dict_type:get_dict_type_selector', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.DictType/CreateDictType')::uuid, md5('sys_menu:/admin.v1.DictType/CreateDictType')::uuid, 'POST', '/admin.v1.DictType/CreateDictType', '字典类型表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.DictType/UpdateDictType')::uuid, md5('sys_menu:/admin.v1.DictType/UpdateDictType')::uuid, 'POST', '/admin.v1.DictType/UpdateDictType', '字典类型表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.DictType/UpdateDictTypeStatus')::uuid, md5('sys_menu:/admin.v1.DictType/UpdateDictTypeStatus')::uuid, 'POST', '/admin.v1.DictType/UpdateDictTypeStatus', '字典类型表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.DictType/DeleteDictType')::uuid, md5('sys_menu:/admin.v1.DictType/DeleteDictType')::uuid, 'POST', '/admin.v1.DictType/DeleteDictType', '字典类型表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.DictType/GetDictTypeInfo')::uuid, md5('sys_menu:/admin.v1.DictType/GetDictTypeInfo')::uuid, 'GET', '/admin.v1.DictType/GetDictTypeInfo', '字典类型表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.DictType/GetDictTypeList')::uuid, md5('sys_menu:/admin.v1.DictType/GetDictTypeList')::uuid, 'GET', '/admin.v1.DictType/GetDictTypeList', '字典类型表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.DictType/GetDictTypeSelector')::uuid, md5('sys_menu:/admin.v1.DictType/GetDictTypeSelector')::uuid, 'GET', '/admin.v1.DictType/GetDictTypeSelector', '字典类型表-选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 文件配置表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.FileConfig')::uuid, '', '文件配置表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileConfig/GetFileConfigStorageSelect')::uuid, md5('sys_menu:admin.v1.FileConfig')::uuid::text, '文件配置表-获取存储器选择器', 'button', '', 'file_config:get_file_config_storage_select', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileConfig/CreateFileConfig')::uuid, md5('sys_menu:admin.v1.FileConfig')::uuid::text, '文件配置表-创建一条数据', 'button', '', 'file_config:create_file_config', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileConfig/UpdateFileConfig')::uuid, md5('sys_menu:admin.v1.FileConfig')::uuid::text, '文件配置表-更新一条数据', 'button', '', 'file_config:update_file_config', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileConfig/SetFileConfigMaster')::uuid, md5('sys_menu:admin.v1.FileConfig')::uuid::text, '文件配置表-设置主配置', 'button', '', 'file_config:set_file_config_master', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileConfig/DeleteFileConfig')::uuid, md5('sys_menu:admin.v1.FileConfig')::uuid::text, '文件配置表-删除一条数据', 'button', '', 'file_config:delete_file_config', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileConfig/GetFileConfigInfo')::uuid, md5('sys_menu:admin.v1.FileConfig')::uuid::text, '文件配置表-单条数据查询', 'button', '', 'file_config:get_file_config_info', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileConfig/GetFileConfigList')::uuid, md5('sys_menu:admin.v1.FileConfig')::uuid::text, '文件配置表-列表数据查询', 'button', '', 'file_config:get_file_config_list', NULL, NULL, NULL, 7, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileConfig/GetFileConfigSelect')::uuid, md5('sys_menu:admin.v1.FileConfig')::uuid::text, '文件配置表-获取所有选择器', 'button', '', 'file_config:get_file_config_select', NULL, NULL, NULL, 8, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.FileConfig/GetFileConfigStorageSelect')::uuid, md5('sys_menu:/admin.v1.FileConfig/GetFileConfigStorageSelect')::uuid, 'GET', '/admin.v1.FileConfig/GetFileConfigStorageSelect', '文件配置表-获取存储器选择器', now(), now()),
+(md5('sys_api:/admin.v1.FileConfig/CreateFileConfig')::uuid, md5('sys_menu:/admin.v1.FileConfig/CreateFileConfig')::uuid, 'POST', '/admin.v1.FileConfig/CreateFileConfig', '文件配置表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.FileConfig/UpdateFileConfig')::uuid, md5('sys_menu:/admin.v1.FileConfig/UpdateFileConfig')::uuid, 'POST', '/admin.v1.FileConfig/UpdateFileConfig', '文件配置表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.FileConfig/SetFileConfigMaster')::uuid, md5('sys_menu:/admin.v1.FileConfig/SetFileConfigMaster')::uuid, 'POST', '/admin.v1.FileConfig/SetFileConfigMaster', '文件配置表-设置主配置', now(), now()),
+(md5('sys_api:/admin.v1.FileConfig/DeleteFileConfig')::uuid, md5('sys_menu:/admin.v1.FileConfig/DeleteFileConfig')::uuid, 'POST', '/admin.v1.FileConfig/DeleteFileConfig', '文件配置表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.FileConfig/GetFileConfigInfo')::uuid, md5('sys_menu:/admin.v1.FileConfig/GetFileConfigInfo')::uuid, 'GET', '/admin.v1.FileConfig/GetFileConfigInfo', '文件配置表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.FileConfig/GetFileConfigList')::uuid, md5('sys_menu:/admin.v1.FileConfig/GetFileConfigList')::uuid, 'GET', '/admin.v1.FileConfig/GetFileConfigList', '文件配置表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.FileConfig/GetFileConfigSelect')::uuid, md5('sys_menu:/admin.v1.FileConfig/GetFileConfigSelect')::uuid, 'GET', '/admin.v1.FileConfig/GetFileConfigSelect', '文件配置表-获取所有选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 文件表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.FileDatum')::uuid, '', '文件表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileDatum/DeleteFileDatum')::uuid, md5('sys_menu:admin.v1.FileDatum')::uuid::text, '文件表-删除一条数据', 'button', '', 'file_datum:delete_file_datum', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileDatum/GetFileDatumInfo')::uuid, md5('sys_menu:admin.v1.FileDatum')::uuid::text, '文件表-单条数据查询', 'button', '', 'file_datum:get_file_datum_info', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileDatum/GetFileDatumList')::uuid, md5('sys_menu:admin.v1.FileDatum')::uuid::text, '文件表-列表数据查询', 'button', '', 'file_datum:get_file_datum_list', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.FileDatum/UploadFileOSSDefaultPolicy')::uuid, md5('sys_menu:admin.v1.FileDatum')::uuid::text, '客户端上传-默认上传到 OSS 的方式和凭证获取', 'button', '', 'file_datum:upload_file_o_s_s_default_policy', NULL, NULL, NULL, 4, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.FileDatum/DeleteFileDatum')::uuid, md5('sys_menu:/admin.v1.FileDatum/DeleteFileDatum')::uuid, 'POST', '/admin.v1.FileDatum/DeleteFileDatum', '文件表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.FileDatum/GetFileDatumInfo')::uuid, md5('sys_menu:/admin.v1.FileDatum/GetFileDatumInfo')::uuid, 'GET', '/admin.v1.FileDatum/GetFileDatumInfo', '文件表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.FileDatum/GetFileDatumList')::uuid, md5('sys_menu:/admin.v1.FileDatum/GetFileDatumList')::uuid, 'GET', '/admin.v1.FileDatum/GetFileDatumList', '文件表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.FileDatum/UploadFileOSSDefaultPolicy')::uuid, md5('sys_menu:/admin.v1.FileDatum/UploadFileOSSDefaultPolicy')::uuid, 'GET', '/admin.v1.FileDatum/UploadFileOSSDefaultPolicy', '客户端上传-默认上传到 OSS 的方式和凭证获取', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 邮箱账号表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.MailAccount')::uuid, '', '邮箱账号表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailAccount/CreateMailAccount')::uuid, md5('sys_menu:admin.v1.MailAccount')::uuid::text, '邮箱账号表-创建一条数据', 'button', '', 'mail_account:create_mail_account', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailAccount/UpdateMailAccount')::uuid, md5('sys_menu:admin.v1.MailAccount')::uuid::text, '邮箱账号表-更新一条数据', 'button', '', 'mail_account:update_mail_account', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailAccount/UpdateMailAccountStatus')::uuid, md5('sys_menu:admin.v1.MailAccount')::uuid::text, '邮箱账号表-更新状态', 'button', '', 'mail_account:update_mail_account_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailAccount/DeleteMailAccount')::uuid, md5('sys_menu:admin.v1.MailAccount')::uuid::text, '邮箱账号表-删除一条数据', 'button', '', 'mail_account:delete_mail_account', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailAccount/GetMailAccountInfo')::uuid, md5('sys_menu:admin.v1.MailAccount')::uuid::text, '邮箱账号表-单条数据查询', 'button', '', 'mail_account:get_mail_account_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailAccount/GetMailAccountList')::uuid, md5('sys_menu:admin.v1.MailAccount')::uuid::text, '邮箱账号表-列表数据查询', 'button', '', 'mail_account:get_mail_account_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailAccount/GetMailAccountSelector')::uuid, md5('sys_menu:admin.v1.MailAccount')::uuid::text, '邮箱账号表-选择器', 'button', '', 'mail_account:get_mail_account_selector', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.MailAccount/CreateMailAccount')::uuid, md5('sys_menu:/admin.v1.MailAccount/CreateMailAccount')::uuid, 'POST', '/admin.v1.MailAccount/CreateMailAccount', '邮箱账号表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MailAccount/UpdateMailAccount')::uuid, md5('sys_menu:/admin.v1.MailAccount/UpdateMailAccount')::uuid, 'POST', '/admin.v1.MailAccount/UpdateMailAccount', '邮箱账号表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MailAccount/UpdateMailAccountStatus')::uuid, md5('sys_menu:/admin.v1.MailAccount/UpdateMailAccountStatus')::uuid, 'POST', '/admin.v1.MailAccount/UpdateMailAccountStatus', '邮箱账号表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.MailAccount/DeleteMailAccount')::uuid, md5('sys_menu:/admin.v1.MailAccount/DeleteMailAccount')::uuid, 'POST', '/admin.v1.MailAccount/DeleteMailAccount', '邮箱账号表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MailAccount/GetMailAccountInfo')::uuid, md5('sys_menu:/admin.v1.MailAccount/GetMailAccountInfo')::uuid, 'GET', '/admin.v1.MailAccount/GetMailAccountInfo', '邮箱账号表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MailAccount/GetMailAccountList')::uuid, md5('sys_menu:/admin.v1.MailAccount/GetMailAccountList')::uuid, 'GET', '/admin.v1.MailAccount/GetMailAccountList', '邮箱账号表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MailAccount/GetMailAccountSelector')::uuid, md5('sys_menu:/admin.v1.MailAccount/GetMailAccountSelector')::uuid, 'GET', '/admin.v1.MailAccount/GetMailAccountSelector', '邮箱账号表-选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 邮件日志表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.MailLog')::uuid, '', '邮件日志表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailLog/DeleteMailLog')::uuid, md5('sys_menu:admin.v1.MailLog')::uuid::text, '邮件日志表-删除一条数据', 'button', '', 'mail_log:delete_mail_log', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailLog/GetMailLogInfo')::uuid, md5('sys_menu:admin.v1.MailLog')::uuid::text, '邮件日志表-单条数据查询', 'button', '', 'mail_log:get_mail_log_info', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailLog/GetMailLogList')::uuid, md5('sys_menu:admin.v1.MailLog')::uuid::text, '邮件日志表-列表数据查询', 'button', '', 'mail_log:get_mail_log_list', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailLog/GetMailSuppression')::uuid, md5('sys_menu:admin.v1.MailLog')::uuid::text, '邮件日志表-收件人退信暂停状态', 'button', '', 'mail_log:get_mail_suppression', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailLog/LiftMailSuppression')::uuid, md5('sys_menu:admin.v1.MailLog')::uuid::text, '邮件日志表-解除收件人退信暂停', 'button', '', 'mail_log:lift_mail_suppression', NULL, NULL, NULL, 5, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.MailLog/DeleteMailLog')::uuid, md5('sys_menu:/admin.v1.MailLog/DeleteMailLog')::uuid, 'POST', '/admin.v1.MailLog/DeleteMailLog', '邮件日志表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MailLog/GetMailLogInfo')::uuid, md5('sys_menu:/admin.v1.MailLog/GetMailLogInfo')::uuid, 'GET', '/admin.v1.MailLog/GetMailLogInfo', '邮件日志表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MailLog/GetMailLogList')::uuid, md5('sys_menu:/admin.v1.MailLog/GetMailLogList')::uuid, 'GET', '/admin.v1.MailLog/GetMailLogList', '邮件日志表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MailLog/GetMailSuppression')::uuid, md5('sys_menu:/admin.v1.MailLog/GetMailSuppression')::uuid, 'GET', '/admin.v1.MailLog/GetMailSuppression', '邮件日志表-收件人退信暂停状态', now(), now()),
+(md5('sys_api:/admin.v1.MailLog/LiftMailSuppression')::uuid, md5('sys_menu:/admin.v1.MailLog/LiftMailSuppression')::uuid, 'POST', '/admin.v1.MailLog/LiftMailSuppression', '邮件日志表-解除收件人退信暂停', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 邮件模版表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.MailTemplate')::uuid, '', '邮件模版表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailTemplate/CreateMailTemplate')::uuid, md5('sys_menu:admin.v1.MailTemplate')::uuid::text, '邮件模版表-创建一条数据', 'button', '', 'mail_template:create_mail_template', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailTemplate/UpdateMailTemplate')::uuid, md5('sys_menu:admin.v1.MailTemplate')::uuid::text, '邮件模版表-更新一条数据', 'button', '', 'mail_template:update_mail_template', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailTemplate/UpdateMailTemplateStatus')::uuid, md5('sys_menu:admin.v1.MailTemplate')::uuid::text, '邮件模版表-更新状态', 'button', '', 'mail_template:update_mail_template_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailTemplate/DeleteMailTemplate')::uuid, md5('sys_menu:admin.v1.MailTemplate')::uuid::text, '邮件模版表-删除一条数据', 'button', '', 'mail_template:delete_mail_template', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailTemplate/GetMailTemplateInfo')::uuid, md5('sys_menu:admin.v1.MailTemplate')::uuid::text, '邮件模版表-单条数据查询', 'button', '', 'mail_template:get_mail_template_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailTemplate/GetMailTemplateList')::uuid, md5('sys_menu:admin.v1.MailTemplate')::uuid::text, '邮件模版表-列表数据查询', 'button', '', 'mail_template:get_mail_template_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailTemplate/GetMailTemplateSelector')::uuid, md5('sys_menu:admin.v1.MailTemplate')::uuid::text, '邮件模版表-选择器', 'button', '', 'mail_template:get_mail_template_selector', NULL, NULL, NULL, 7, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MailTemplate/SendMail')::uuid, md5('sys_menu:admin.v1.MailTemplate')::uuid::text, '邮件模版表-发送邮件', 'button', '', 'mail_template:send_mail', NULL, NULL, NULL, 8, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.MailTemplate/CreateMailTemplate')::uuid, md5('sys_menu:/admin.v1.MailTemplate/CreateMailTemplate')::uuid, 'POST', '/admin.v1.MailTemplate/CreateMailTemplate', '邮件模版表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MailTemplate/UpdateMailTemplate')::uuid, md5('sys_menu:/admin.v1.MailTemplate/UpdateMailTemplate')::uuid, 'POST', '/admin.v1.MailTemplate/UpdateMailTemplate', '邮件模版表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MailTemplate/UpdateMailTemplateStatus')::uuid, md5('sys_menu:/admin.v1.MailTemplate/UpdateMailTemplateStatus')::uuid, 'POST', '/admin.v1.MailTemplate/UpdateMailTemplateStatus', '邮件模版表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.MailTemplate/DeleteMailTemplate')::uuid, md5('sys_menu:/admin.v1.MailTemplate/DeleteMailTemplate')::uuid, 'POST', '/admin.v1.MailTemplate/DeleteMailTemplate', '邮件模版表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MailTemplate/GetMailTemplateInfo')::uuid, md5('sys_menu:/admin.v1.MailTemplate/GetMailTemplateInfo')::uuid, 'GET', '/admin.v1.MailTemplate/GetMailTemplateInfo', '邮件模版表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MailTemplate/GetMailTemplateList')::uuid, md5('sys_menu:/admin.v1.MailTemplate/GetMailTemplateList')::uuid, 'GET', '/admin.v1.MailTemplate/GetMailTemplateList', '邮件模版表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MailTemplate/GetMailTemplateSelector')::uuid, md5('sys_menu:/admin.v1.MailTemplate/GetMailTemplateSelector')::uuid, 'GET', '/admin.v1.MailTemplate/GetMailTemplateSelector', '邮件模版表-选择器', now(), now()),
+(md5('sys_api:/admin.v1.MailTemplate/SendMail')::uuid, md5('sys_menu:/admin.v1.MailTemplate/SendMail')::uuid, 'POST', '/admin.v1.MailTemplate/SendMail', '邮件模版表-发送邮件', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 激活码管理表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.MallActivationCode')::uuid, '', '激活码管理表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallActivationCode/BatchGenerateMallActivationCode')::uuid, md5('sys_menu:admin.v1.MallActivationCode')::uuid::text, '激活码管理表-批量生成激活码', 'button', '', 'mall_activation_code:batch_generate_mall_activation_code', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallActivationCode/UpdateMallActivationCode')::uuid, md5('sys_menu:admin.v1.MallActivationCode')::uuid::text, '激活码管理表-更新一条数据', 'button', '', 'mall_activation_code:update_mall_activation_code', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallActivationCode/UpdateMallActivationCodeStatus')::uuid, md5('sys_menu:admin.v1.MallActivationCode')::uuid::text, '激活码管理表-更新状态', 'button', '', 'mall_activation_code:update_mall_activation_code_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallActivationCode/DeleteMallActivationCode')::uuid, md5('sys_menu:admin.v1.MallActivationCode')::uuid::text, '激活码管理表-删除一条数据', 'button', '', 'mall_activation_code:delete_mall_activation_code', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallActivationCode/GetMallActivationCodeInfo')::uuid, md5('sys_menu:admin.v1.MallActivationCode')::uuid::text, '激活码管理表-单条数据查询', 'button', '', 'mall_activation_code:get_mall_activation_code_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallActivationCode/GetMallActivationCodeList')::uuid, md5('sys_menu:admin.v1.MallActivationCode')::uuid::text, '激活码管理表-列表数据查询', 'button', '', 'mall_activation_code:get_mall_activation_code_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.MallActivationCode/BatchGenerateMallActivationCode')::uuid, md5('sys_menu:/admin.v1.MallActivationCode/BatchGenerateMallActivationCode')::uuid, 'POST', '/admin.v1.MallActivationCode/BatchGenerateMallActivationCode', '激活码管理表-批量生成激活码', now(), now()),
+(md5('sys_api:/admin.v1.MallActivationCode/UpdateMallActivationCode')::uuid, md5('sys_menu:/admin.v1.MallActivationCode/UpdateMallActivationCode')::uuid, 'POST', '/admin.v1.MallActivationCode/UpdateMallActivationCode', '激活码管理表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MallActivationCode/UpdateMallActivationCodeStatus')::uuid, md5('sys_menu:/admin.v1.MallActivationCode/UpdateMallActivationCodeStatus')::uuid, 'POST', '/admin.v1.MallActivationCode/UpdateMallActivationCodeStatus', '激活码管理表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.MallActivationCode/DeleteMallActivationCode')::uuid, md5('sys_menu:/admin.v1.MallActivationCode/DeleteMallActivationCode')::uuid, 'POST', '/admin.v1.MallActivationCode/DeleteMallActivationCode', '激活码管理表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MallActivationCode/GetMallActivationCodeInfo')::uuid, md5('sys_menu:/admin.v1.MallActivationCode/GetMallActivationCodeInfo')::uuid, 'GET', '/admin.v1.MallActivationCode/GetMallActivationCodeInfo', '激活码管理表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MallActivationCode/GetMallActivationCodeList')::uuid, md5('sys_menu:/admin.v1.MallActivationCode/GetMallActivationCodeList')::uuid, 'GET', '/admin.v1.MallActivationCode/GetMallActivationCodeList', '激活码管理表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 订单信息表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.MallOrder')::uuid, '', '订单信息表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallOrder/GetMallOrderInfo')::uuid, md5('sys_menu:admin.v1.MallOrder')::uuid::text, '订单信息表-单条数据查询', 'button', '', 'mall_order:get_mall_order_info', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallOrder/GetMallOrderList')::uuid, md5('sys_menu:admin.v1.MallOrder')::uuid::text, '订单信息表-列表数据查询', 'button', '', 'mall_order:get_mall_order_list', NULL, NULL, NULL, 2, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.MallOrder/GetMallOrderInfo')::uuid, md5('sys_menu:/admin.v1.MallOrder/GetMallOrderInfo')::uuid, 'GET', '/admin.v1.MallOrder/GetMallOrderInfo', '订单信息表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MallOrder/GetMallOrderList')::uuid, md5('sys_menu:/admin.v1.MallOrder/GetMallOrderList')::uuid, 'GET', '/admin.v1.MallOrder/GetMallOrderList', '订单信息表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 支付记录表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.MallPaymentRecord')::uuid, '', '支付记录表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallPaymentRecord/GetMallPaymentRecordSuccessByOrderId')::uuid, md5('sys_menu:admin.v1.MallPaymentRecord')::uuid::text, '支付记录表-单条数据查询', 'button', '', 'mall_payment_record:get_mall_payment_record_success_by_order_id', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallPaymentRecord/GetMallPaymentRecordListByOrderId')::uuid, md5('sys_menu:admin.v1.MallPaymentRecord')::uuid::text, '支付记录表-列表数据查询', 'button', '', 'mall_payment_record:get_mall_payment_record_list_by_order_id', NULL, NULL, NULL, 2, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.MallPaymentRecord/GetMallPaymentRecordSuccessByOrderId')::uuid, md5('sys_menu:/admin.v1.MallPaymentRecord/GetMallPaymentRecordSuccessByOrderId')::uuid, 'GET', '/admin.v1.MallPaymentRecord/GetMallPaymentRecordSuccessByOrderId', '支付记录表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MallPaymentRecord/GetMallPaymentRecordListByOrderId')::uuid, md5('sys_menu:/admin.v1.MallPaymentRecord/GetMallPaymentRecordListByOrderId')::uuid, 'GET', '/admin.v1.MallPaymentRecord/GetMallPaymentRecordListByOrderId', '支付记录表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 商品表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.MallProduct')::uuid, '', '商品表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallProduct/CreateMallProduct')::uuid, md5('sys_menu:admin.v1.MallProduct')::uuid::text, '商品表-创建一条数据', 'button', '', 'mall_product:create_mall_product', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallProduct/UpdateMallProduct')::uuid, md5('sys_menu:admin.v1.MallProduct')::uuid::text, '商品表-更新一条数据', 'button', '', 'mall_product:update_mall_product', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallProduct/UpdateMallProductStatus')::uuid, md5('sys_menu:admin.v1.MallProduct')::uuid::text, '商品表-更新状态', 'button', '', 'mall_product:update_mall_product_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallProduct/DeleteMallProduct')::uuid, md5('sys_menu:admin.v1.MallProduct')::uuid::text, '商品表-删除一条数据', 'button', '', 'mall_product:delete_mall_product', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallProduct/GetMallProductInfo')::uuid, md5('sys_menu:admin.v1.MallProduct')::uuid::text, '商品表-单条数据查询', 'button', '', 'mall_product:get_mall_product_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallProduct/GetMallProductList')::uuid, md5('sys_menu:admin.v1.MallProduct')::uuid::text, '商品表-列表数据查询', 'button', '', 'mall_product:get_mall_product_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MallProduct/GetMallProductSelector')::uuid, md5('sys_menu:admin.v1.MallProduct')::uuid::text, '商品表-选择器', 'button', '', 'mall_product:get_mall_product_selector', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.MallProduct/CreateMallProduct')::uuid, md5('sys_menu:/admin.v1.MallProduct/CreateMallProduct')::uuid, 'POST', '/admin.v1.MallProduct/CreateMallProduct', '商品表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MallProduct/UpdateMallProduct')::uuid, md5('sys_menu:/admin.v1.MallProduct/UpdateMallProduct')::uuid, 'POST', '/admin.v1.MallProduct/UpdateMallProduct', '商品表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MallProduct/UpdateMallProductStatus')::uuid, md5('sys_menu:/admin.v1.MallProduct/UpdateMallProductStatus')::uuid, 'POST', '/admin.v1.MallProduct/UpdateMallProductStatus', '商品表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.MallProduct/DeleteMallProduct')::uuid, md5('sys_menu:/admin.v1.MallProduct/DeleteMallProduct')::uuid, 'POST', '/admin.v1.MallProduct/DeleteMallProduct', '商品表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MallProduct/GetMallProductInfo')::uuid, md5('sys_menu:/admin.v1.MallProduct/GetMallProductInfo')::uuid, 'GET', '/admin.v1.MallProduct/GetMallProductInfo', '商品表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MallProduct/GetMallProductList')::uuid, md5('sys_menu:/admin.v1.MallProduct/GetMallProductList')::uuid, 'GET', '/admin.v1.MallProduct/GetMallProductList', '商品表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MallProduct/GetMallProductSelector')::uuid, md5('sys_menu:/admin.v1.MallProduct/GetMallProductSelector')::uuid, 'GET', '/admin.v1.MallProduct/GetMallProductSelector', '商品表-选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 会员类型配置表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.Membership')::uuid, '', '会员类型配置表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Membership/CreateMembership')::uuid, md5('sys_menu:admin.v1.Membership')::uuid::text, '会员类型配置表-创建一条数据', 'button', '', 'membership:create_membership', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Membership/UpdateMembership')::uuid, md5('sys_menu:admin.v1.Membership')::uuid::text, '会员类型配置表-更新一条数据', 'button', '', 'membership:update_membership', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Membership/UpdateMembershipStatus')::uuid, md5('sys_menu:admin.v1.Membership')::uuid::text, '会员类型配置表-更新状态', 'button', '', 'membership:update_membership_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Membership/DeleteMembership')::uuid, md5('sys_menu:admin.v1.Membership')::uuid::text, '会员类型配置表-删除一条数据', 'button', '', 'membership:delete_membership', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Membership/GetMembershipInfo')::uuid, md5('sys_menu:admin.v1.Membership')::uuid::text, '会员类型配置表-单条数据查询', 'button', '', 'membership:get_membership_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.Membership/GetMembershipList')::uuid, md5('sys_menu:admin.v1.Membership')::uuid::text, '会员类型配置表-列表数据查询', 'button', '', 'membership:get_membership_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.Membership/CreateMembership')::uuid, md5('sys_menu:/admin.v1.Membership/CreateMembership')::uuid, 'POST', '/admin.v1.Membership/CreateMembership', '会员类型配置表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.Membership/UpdateMembership')::uuid, md5('sys_menu:/admin.v1.Membership/UpdateMembership')::uuid, 'POST', '/admin.v1.Membership/UpdateMembership', '会员类型配置表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.Membership/UpdateMembershipStatus')::uuid, md5('sys_menu:/admin.v1.Membership/UpdateMembershipStatus')::uuid, 'POST', '/admin.v1.Membership/UpdateMembershipStatus', '会员类型配置表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.Membership/DeleteMembership')::uuid, md5('sys_menu:/admin.v1.Membership/DeleteMembership')::uuid, 'POST', '/admin.v1.Membership/DeleteMembership', '会员类型配置表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.Membership/GetMembershipInfo')::uuid, md5('sys_menu:/admin.v1.Membership/GetMembershipInfo')::uuid, 'GET', '/admin.v1.Membership/GetMembershipInfo', '会员类型配置表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.Membership/GetMembershipList')::uuid, md5('sys_menu:/admin.v1.Membership/GetMembershipList')::uuid, 'GET', '/admin.v1.Membership/GetMembershipList', '会员类型配置表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 会员权益配置表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.MembershipBenefit')::uuid, '', '会员权益配置表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MembershipBenefit/GetMembershipBenefitKeySelect')::uuid, md5('sys_menu:admin.v1.MembershipBenefit')::uuid::text, '会员权益配置表-获取权益标识选择器', 'button', '', 'membership_benefit:get_membership_benefit_key_select', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MembershipBenefit/CreateMembershipBenefit')::uuid, md5('sys_menu:admin.v1.MembershipBenefit')::uuid::text, '会员权益配置表-创建一条数据', 'button', '', 'membership_benefit:create_membership_benefit', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MembershipBenefit/UpdateMembershipBenefit')::uuid, md5('sys_menu:admin.v1.MembershipBenefit')::uuid::text, '会员权益配置表-更新一条数据', 'button', '', 'membership_benefit:update_membership_benefit', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MembershipBenefit/UpdateMembershipBenefitStatus')::uuid, md5('sys_menu:admin.v1.MembershipBenefit')::uuid::text, '会员权益配置表-更新状态', 'button', '', 'membership_benefit:update_membership_benefit_status', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MembershipBenefit/DeleteMembershipBenefit')::uuid, md5('sys_menu:admin.v1.MembershipBenefit')::uuid::text, '会员权益配置表-删除一条数据', 'button', '', 'membership_benefit:delete_membership_benefit', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MembershipBenefit/GetMembershipBenefitInfo')::uuid, md5('sys_menu:admin.v1.MembershipBenefit')::uuid::text, '会员权益配置表-单条数据查询', 'button', '', 'membership_benefit:get_membership_benefit_info', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.MembershipBenefit/GetMembershipBenefitList')::uuid, md5('sys_menu:admin.v1.MembershipBenefit')::uuid::text, '会员权益配置表-列表数据查询', 'button', '', 'membership_benefit:get_membership_benefit_list', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.MembershipBenefit/GetMembershipBenefitKeySelect')::uuid, md5('sys_menu:/admin.v1.MembershipBenefit/GetMembershipBenefitKeySelect')::uuid, 'GET', '/admin.v1.MembershipBenefit/GetMembershipBenefitKeySelect', '会员权益配置表-获取权益标识选择器', now(), now()),
+(md5('sys_api:/admin.v1.MembershipBenefit/CreateMembershipBenefit')::uuid, md5('sys_menu:/admin.v1.MembershipBenefit/CreateMembershipBenefit')::uuid, 'POST', '/admin.v1.MembershipBenefit/CreateMembershipBenefit', '会员权益配置表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MembershipBenefit/UpdateMembershipBenefit')::uuid, md5('sys_menu:/admin.v1.MembershipBenefit/UpdateMembershipBenefit')::uuid, 'POST', '/admin.v1.MembershipBenefit/UpdateMembershipBenefit', '会员权益配置表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MembershipBenefit/UpdateMembershipBenefitStatus')::uuid, md5('sys_menu:/admin.v1.MembershipBenefit/UpdateMembershipBenefitStatus')::uuid, 'POST', '/admin.v1.MembershipBenefit/UpdateMembershipBenefitStatus', '会员权益配置表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.MembershipBenefit/DeleteMembershipBenefit')::uuid, md5('sys_menu:/admin.v1.MembershipBenefit/DeleteMembershipBenefit')::uuid, 'POST', '/admin.v1.MembershipBenefit/DeleteMembershipBenefit', '会员权益配置表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.MembershipBenefit/GetMembershipBenefitInfo')::uuid, md5('sys_menu:/admin.v1.MembershipBenefit/GetMembershipBenefitInfo')::uuid, 'GET', '/admin.v1.MembershipBenefit/GetMembershipBenefitInfo', '会员权益配置表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.MembershipBenefit/GetMembershipBenefitList')::uuid, md5('sys_menu:/admin.v1.MembershipBenefit/GetMembershipBenefitList')::uuid, 'GET', '/admin.v1.MembershipBenefit/GetMembershipBenefitList', '会员权益配置表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 自应用信息表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SelfApp')::uuid, '', '自应用信息表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfApp/CreateSelfApp')::uuid, md5('sys_menu:admin.v1.SelfApp')::uuid::text, '自应用信息表-创建一条数据', 'button', '', 'self_app:create_self_app', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfApp/UpdateSelfApp')::uuid, md5('sys_menu:admin.v1.SelfApp')::uuid::text, '自应用信息表-更新一条数据', 'button', '', 'self_app:update_self_app', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfApp/UpdateSelfAppStatus')::uuid, md5('sys_menu:admin.v1.SelfApp')::uuid::text, '自应用信息表-更新状态', 'button', '', 'self_app:update_self_app_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfApp/DeleteSelfApp')::uuid, md5('sys_menu:admin.v1.SelfApp')::uuid::text, '自应用信息表-删除一条数据', 'button', '', 'self_app:delete_self_app', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfApp/GetSelfAppInfo')::uuid, md5('sys_menu:admin.v1.SelfApp')::uuid::text, '自应用信息表-单条数据查询', 'button', '', 'self_app:get_self_app_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfApp/GetSelfAppList')::uuid, md5('sys_menu:admin.v1.SelfApp')::uuid::text, '自应用信息表-列表数据查询', 'button', '', 'self_app:get_self_app_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SelfApp/CreateSelfApp')::uuid, md5('sys_menu:/admin.v1.SelfApp/CreateSelfApp')::uuid, 'POST', '/admin.v1.SelfApp/CreateSelfApp', '自应用信息表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SelfApp/UpdateSelfApp')::uuid, md5('sys_menu:/admin.v1.SelfApp/UpdateSelfApp')::uuid, 'POST', '/admin.v1.SelfApp/UpdateSelfApp', '自应用信息表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SelfApp/UpdateSelfAppStatus')::uuid, md5('sys_menu:/admin.v1.SelfApp/UpdateSelfAppStatus')::uuid, 'POST', '/admin.v1.SelfApp/UpdateSelfAppStatus', '自应用信息表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SelfApp/DeleteSelfApp')::uuid, md5('sys_menu:/admin.v1.SelfApp/DeleteSelfApp')::uuid, 'POST', '/admin.v1.SelfApp/DeleteSelfApp', '自应用信息表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SelfApp/GetSelfAppInfo')::uuid, md5('sys_menu:/admin.v1.SelfApp/GetSelfAppInfo')::uuid, 'GET', '/admin.v1.SelfApp/GetSelfAppInfo', '自应用信息表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SelfApp/GetSelfAppList')::uuid, md5('sys_menu:/admin.v1.SelfApp/GetSelfAppList')::uuid, 'GET', '/admin.v1.SelfApp/GetSelfAppList', '自应用信息表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 自应用版本发布表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SelfAppRelease')::uuid, '', '自应用版本发布表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfAppRelease/CreateSelfAppRelease')::uuid, md5('sys_menu:admin.v1.SelfAppRelease')::uuid::text, '自应用版本发布表-创建一条数据', 'button', '', 'self_app_release:create_self_app_release', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfAppRelease/UpdateSelfAppRelease')::uuid, md5('sys_menu:admin.v1.SelfAppRelease')::uuid::text, '自应用版本发布表-更新一条数据', 'button', '', 'self_app_release:update_self_app_release', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfAppRelease/UpdateSelfAppReleaseStatus')::uuid, md5('sys_menu:admin.v1.SelfAppRelease')::uuid::text, '自应用版本发布表-更新状态', 'button', '', 'self_app_release:update_self_app_release_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfAppRelease/DeleteSelfAppRelease')::uuid, md5('sys_menu:admin.v1.SelfAppRelease')::uuid::text, '自应用版本发布表-删除一条数据', 'button', '', 'self_app_release:delete_self_app_release', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfAppRelease/GetSelfAppReleaseInfo')::uuid, md5('sys_menu:admin.v1.SelfAppRelease')::uuid::text, '自应用版本发布表-单条数据查询', 'button', '', 'self_app_release:get_self_app_release_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SelfAppRelease/GetSelfAppReleaseList')::uuid, md5('sys_menu:admin.v1.SelfAppRelease')::uuid::text, '自应用版本发布表-列表数据查询', 'button', '', 'self_app_release:get_self_app_release_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SelfAppRelease/CreateSelfAppRelease')::uuid, md5('sys_menu:/admin.v1.SelfAppRelease/CreateSelfAppRelease')::uuid, 'POST', '/admin.v1.SelfAppRelease/CreateSelfAppRelease', '自应用版本发布表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SelfAppRelease/UpdateSelfAppRelease')::uuid, md5('sys_menu:/admin.v1.SelfAppRelease/UpdateSelfAppRelease')::uuid, 'POST', '/admin.v1.SelfAppRelease/UpdateSelfAppRelease', '自应用版本发布表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SelfAppRelease/UpdateSelfAppReleaseStatus')::uuid, md5('sys_menu:/admin.v1.SelfAppRelease/UpdateSelfAppReleaseStatus')::uuid, 'POST', '/admin.v1.SelfAppRelease/UpdateSelfAppReleaseStatus', '自应用版本发布表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SelfAppRelease/DeleteSelfAppRelease')::uuid, md5('sys_menu:/admin.v1.SelfAppRelease/DeleteSelfAppRelease')::uuid, 'POST', '/admin.v1.SelfAppRelease/DeleteSelfAppRelease', '自应用版本发布表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SelfAppRelease/GetSelfAppReleaseInfo')::uuid, md5('sys_menu:/admin.v1.SelfAppRelease/GetSelfAppReleaseInfo')::uuid, 'GET', '/admin.v1.SelfAppRelease/GetSelfAppReleaseInfo', '自应用版本发布表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SelfAppRelease/GetSelfAppReleaseList')::uuid, md5('sys_menu:/admin.v1.SelfAppRelease/GetSelfAppReleaseList')::uuid, 'GET', '/admin.v1.SelfAppRelease/GetSelfAppReleaseList', '自应用版本发布表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 敏感词
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SensitiveWord')::uuid, '', '敏感词', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SensitiveWord/GetSensitiveWordLabsSelector')::uuid, md5('sys_menu:admin.v1.SensitiveWord')::uuid::text, '敏感词-标签选择器', 'button', '', 'sensitive_word:get_sensitive_word_labs_selector', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SensitiveWord/CreateSensitiveWord')::uuid, md5('sys_menu:admin.v1.SensitiveWord')::uuid::text, '敏感词-创建一条数据', 'button', '', 'sensitive_word:create_sensitive_word', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SensitiveWord/UpdateSensitiveWord')::uuid, md5('sys_menu:admin.v1.SensitiveWord')::uuid::text, '敏感词-更新一条数据', 'button', '', 'sensitive_word:update_sensitive_word', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SensitiveWord/DeleteSensitiveWord')::uuid, md5('sys_menu:admin.v1.SensitiveWord')::uuid::text, '敏感词-删除一条数据', 'button', '', 'sensitive_word:delete_sensitive_word', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SensitiveWord/GetSensitiveWordInfo')::uuid, md5('sys_menu:admin.v1.SensitiveWord')::uuid::text, '敏感词-单条数据查询', 'button', '', 'sensitive_word:get_sensitive_word_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SensitiveWord/GetSensitiveWordList')::uuid, md5('sys_menu:admin.v1.SensitiveWord')::uuid::text, '敏感词-列表数据查询', 'button', '', 'sensitive_word:get_sensitive_word_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SensitiveWord/GetSensitiveWordLabsSelector')::uuid, md5('sys_menu:/admin.v1.SensitiveWord/GetSensitiveWordLabsSelector')::uuid, 'GET', '/admin.v1.SensitiveWord/GetSensitiveWordLabsSelector', '敏感词-标签选择器', now(), now()),
+(md5('sys_api:/admin.v1.SensitiveWord/CreateSensitiveWord')::uuid, md5('sys_menu:/admin.v1.SensitiveWord/CreateSensitiveWord')::uuid, 'POST', '/admin.v1.SensitiveWord/CreateSensitiveWord', '敏感词-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SensitiveWord/UpdateSensitiveWord')::uuid, md5('sys_menu:/admin.v1.SensitiveWord/UpdateSensitiveWord')::uuid, 'POST', '/admin.v1.SensitiveWord/UpdateSensitiveWord', '敏感词-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SensitiveWord/DeleteSensitiveWord')::uuid, md5('sys_menu:/admin.v1.SensitiveWord/DeleteSensitiveWord')::uuid, 'POST', '/admin.v1.SensitiveWord/DeleteSensitiveWord', '敏感词-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SensitiveWord/GetSensitiveWordInfo')::uuid, md5('sys_menu:/admin.v1.SensitiveWord/GetSensitiveWordInfo')::uuid, 'GET', '/admin.v1.SensitiveWord/GetSensitiveWordInfo', '敏感词-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SensitiveWord/GetSensitiveWordList')::uuid, md5('sys_menu:/admin.v1.SensitiveWord/GetSensitiveWordList')::uuid, 'GET', '/admin.v1.SensitiveWord/GetSensitiveWordList', '敏感词-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 短信渠道
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SmsChannel')::uuid, '', '短信渠道', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsChannel/CreateSmsChannel')::uuid, md5('sys_menu:admin.v1.SmsChannel')::uuid::text, '短信渠道-创建一条数据', 'button', '', 'sms_channel:create_sms_channel', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsChannel/UpdateSmsChannel')::uuid, md5('sys_menu:admin.v1.SmsChannel')::uuid::text, '短信渠道-更新一条数据', 'button', '', 'sms_channel:update_sms_channel', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsChannel/UpdateSmsChannelStatus')::uuid, md5('sys_menu:admin.v1.SmsChannel')::uuid::text, '短信渠道-更新状态', 'button', '', 'sms_channel:update_sms_channel_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsChannel/DeleteSmsChannel')::uuid, md5('sys_menu:admin.v1.SmsChannel')::uuid::text, '短信渠道-删除一条数据', 'button', '', 'sms_channel:delete_sms_channel', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsChannel/GetSmsChannelInfo')::uuid, md5('sys_menu:admin.v1.SmsChannel')::uuid::text, '短信渠道-单条数据查询', 'button', '', 'sms_channel:get_sms_channel_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsChannel/GetSmsChannelList')::uuid, md5('sys_menu:admin.v1.SmsChannel')::uuid::text, '短信渠道-列表数据查询', 'button', '', 'sms_channel:get_sms_channel_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsChannel/GetSmsChannelOperator')::uuid, md5('sys_menu:admin.v1.SmsChannel')::uuid::text, '短信渠道-运营商', 'button', '', 'sms_channel:get_sms_channel_operator', NULL, NULL, NULL, 7, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsChannel/GetSmsChannelSelector')::uuid, md5('sys_menu:admin.v1.SmsChannel')::uuid::text, '短信渠道-选择器', 'button', '', 'sms_channel:get_sms_channel_selector', NULL, NULL, NULL, 8, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SmsChannel/CreateSmsChannel')::uuid, md5('sys_menu:/admin.v1.SmsChannel/CreateSmsChannel')::uuid, 'POST', '/admin.v1.SmsChannel/CreateSmsChannel', '短信渠道-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SmsChannel/UpdateSmsChannel')::uuid, md5('sys_menu:/admin.v1.SmsChannel/UpdateSmsChannel')::uuid, 'POST', '/admin.v1.SmsChannel/UpdateSmsChannel', '短信渠道-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SmsChannel/UpdateSmsChannelStatus')::uuid, md5('sys_menu:/admin.v1.SmsChannel/UpdateSmsChannelStatus')::uuid, 'POST', '/admin.v1.SmsChannel/UpdateSmsChannelStatus', '短信渠道-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SmsChannel/DeleteSmsChannel')::uuid, md5('sys_menu:/admin.v1.SmsChannel/DeleteSmsChannel')::uuid, 'POST', '/admin.v1.SmsChannel/DeleteSmsChannel', '短信渠道-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SmsChannel/GetSmsChannelInfo')::uuid, md5('sys_menu:/admin.v1.SmsChannel/GetSmsChannelInfo')::uuid, 'GET', '/admin.v1.SmsChannel/GetSmsChannelInfo', '短信渠道-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SmsChannel/GetSmsChannelList')::uuid, md5('sys_menu:/admin.v1.SmsChannel/GetSmsChannelList')::uuid, 'GET', '/admin.v1.SmsChannel/GetSmsChannelList', '短信渠道-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SmsChannel/GetSmsChannelOperator')::uuid, md5('sys_menu:/admin.v1.SmsChannel/GetSmsChannelOperator')::uuid, 'GET', '/admin.v1.SmsChannel/GetSmsChannelOperator', '短信渠道-运营商', now(), now()),
+(md5('sys_api:/admin.v1.SmsChannel/GetSmsChannelSelector')::uuid, md5('sys_menu:/admin.v1.SmsChannel/GetSmsChannelSelector')::uuid, 'GET', '/admin.v1.SmsChannel/GetSmsChannelSelector', '短信渠道-选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 短信日志
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SmsLog')::uuid, '', '短信日志', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsLog/GetSmsLogInfo')::uuid, md5('sys_menu:admin.v1.SmsLog')::uuid::text, '短信日志-单条数据查询', 'button', '', 'sms_log:get_sms_log_info', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsLog/GetSmsLogList')::uuid, md5('sys_menu:admin.v1.SmsLog')::uuid::text, '短信日志-列表数据查询', 'button', '', 'sms_log:get_sms_log_list', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsLog/GetSmsLogChannelStats')::uuid, md5('sys_menu:admin.v1.SmsLog')::uuid::text, '短信日志-渠道发送统计', 'button', '', 'sms_log:get_sms_log_channel_stats', NULL, NULL, NULL, 3, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SmsLog/GetSmsLogInfo')::uuid, md5('sys_menu:/admin.v1.SmsLog/GetSmsLogInfo')::uuid, 'GET', '/admin.v1.SmsLog/GetSmsLogInfo', '短信日志-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SmsLog/GetSmsLogList')::uuid, md5('sys_menu:/admin.v1.SmsLog/GetSmsLogList')::uuid, 'GET', '/admin.v1.SmsLog/GetSmsLogList', '短信日志-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SmsLog/GetSmsLogChannelStats')::uuid, md5('sys_menu:/admin.v1.SmsLog/GetSmsLogChannelStats')::uuid, 'GET', '/admin.v1.SmsLog/GetSmsLogChannelStats', '短信日志-渠道发送统计', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 短信模板
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SmsTemplate')::uuid, '', '短信模板', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsTemplate/CreateSmsTemplate')::uuid, md5('sys_menu:admin.v1.SmsTemplate')::uuid::text, '短信模板-创建一条数据', 'button', '', 'sms_template:create_sms_template', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsTemplate/UpdateSmsTemplate')::uuid, md5('sys_menu:admin.v1.SmsTemplate')::uuid::text, '短信模板-更新一条数据', 'button', '', 'sms_template:update_sms_template', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsTemplate/UpdateSmsTemplateStatus')::uuid, md5('sys_menu:admin.v1.SmsTemplate')::uuid::text, '短信模板-更新状态', 'button', '', 'sms_template:update_sms_template_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsTemplate/DeleteSmsTemplate')::uuid, md5('sys_menu:admin.v1.SmsTemplate')::uuid::text, '短信模板-删除一条数据', 'button', '', 'sms_template:delete_sms_template', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsTemplate/GetSmsTemplateInfo')::uuid, md5('sys_menu:admin.v1.SmsTemplate')::uuid::text, '短信模板-单条数据查询', 'button', '', 'sms_template:get_sms_template_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsTemplate/GetSmsTemplateList')::uuid, md5('sys_menu:admin.v1.SmsTemplate')::uuid::text, '短信模板-列表数据查询', 'button', '', 'sms_template:get_sms_template_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsTemplate/GetSmsTemplateSelector')::uuid, md5('sys_menu:admin.v1.SmsTemplate')::uuid::text, '短信模板-模板选择器', 'button', '', 'sms_template:get_sms_template_selector', NULL, NULL, NULL, 7, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SmsTemplate/SendSmsTemplateMsg')::uuid, md5('sys_menu:admin.v1.SmsTemplate')::uuid::text, '短信模板-发送短信', 'button', '', 'sms_template:send_sms_template_msg', NULL, NULL, NULL, 8, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SmsTemplate/CreateSmsTemplate')::uuid, md5('sys_menu:/admin.v1.SmsTemplate/CreateSmsTemplate')::uuid, 'POST', '/admin.v1.SmsTemplate/CreateSmsTemplate', '短信模板-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SmsTemplate/UpdateSmsTemplate')::uuid, md5('sys_menu:/admin.v1.SmsTemplate/UpdateSmsTemplate')::uuid, 'POST', '/admin.v1.SmsTemplate/UpdateSmsTemplate', '短信模板-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SmsTemplate/UpdateSmsTemplateStatus')::uuid, md5('sys_menu:/admin.v1.SmsTemplate/UpdateSmsTemplateStatus')::uuid, 'POST', '/admin.v1.SmsTemplate/UpdateSmsTemplateStatus', '短信模板-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SmsTemplate/DeleteSmsTemplate')::uuid, md5('sys_menu:/admin.v1.SmsTemplate/DeleteSmsTemplate')::uuid, 'POST', '/admin.v1.SmsTemplate/DeleteSmsTemplate', '短信模板-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SmsTemplate/GetSmsTemplateInfo')::uuid, md5('sys_menu:/admin.v1.SmsTemplate/GetSmsTemplateInfo')::uuid, 'GET', '/admin.v1.SmsTemplate/GetSmsTemplateInfo', '短信模板-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SmsTemplate/GetSmsTemplateList')::uuid, md5('sys_menu:/admin.v1.SmsTemplate/GetSmsTemplateList')::uuid, 'GET', '/admin.v1.SmsTemplate/GetSmsTemplateList', '短信模板-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SmsTemplate/GetSmsTemplateSelector')::uuid, md5('sys_menu:/admin.v1.SmsTemplate/GetSmsTemplateSelector')::uuid, 'GET', '/admin.v1.SmsTemplate/GetSmsTemplateSelector', '短信模板-模板选择器', now(), now()),
+(md5('sys_api:/admin.v1.SmsTemplate/SendSmsTemplateMsg')::uuid, md5('sys_menu:/admin.v1.SmsTemplate/SendSmsTemplateMsg')::uuid, 'POST', '/admin.v1.SmsTemplate/SendSmsTemplateMsg', '短信模板-发送短信', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 系统-接口
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysAPI')::uuid, '', '系统-接口', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAPI/CreateSysAPI')::uuid, md5('sys_menu:admin.v1.SysAPI')::uuid::text, '系统-接口-创建一条数据', 'button', '', 'sys_api:create_sys_api', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAPI/UpdateSysAPI')::uuid, md5('sys_menu:admin.v1.SysAPI')::uuid::text, '系统-接口-更新一条数据', 'button', '', 'sys_api:update_sys_api', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAPI/DeleteSysAPI')::uuid, md5('sys_menu:admin.v1.SysAPI')::uuid::text, '系统-接口-删除一条数据', 'button', '', 'sys_api:delete_sys_api', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAPI/GetSysAPIInfo')::uuid, md5('sys_menu:admin.v1.SysAPI')::uuid::text, '系统-接口-单条数据查询', 'button', '', 'sys_api:get_sys_api_info', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAPI/GetSysAPIList')::uuid, md5('sys_menu:admin.v1.SysAPI')::uuid::text, '系统-接口-列表数据查询', 'button', '', 'sys_api:get_sys_api_list', NULL, NULL, NULL, 5, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysAPI/CreateSysAPI')::uuid, md5('sys_menu:/admin.v1.SysAPI/CreateSysAPI')::uuid, 'POST', '/admin.v1.SysAPI/CreateSysAPI', '系统-接口-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysAPI/UpdateSysAPI')::uuid, md5('sys_menu:/admin.v1.SysAPI/UpdateSysAPI')::uuid, 'POST', '/admin.v1.SysAPI/UpdateSysAPI', '系统-接口-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysAPI/DeleteSysAPI')::uuid, md5('sys_menu:/admin.v1.SysAPI/DeleteSysAPI')::uuid, 'POST', '/admin.v1.SysAPI/DeleteSysAPI', '系统-接口-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysAPI/GetSysAPIInfo')::uuid, md5('sys_menu:/admin.v1.SysAPI/GetSysAPIInfo')::uuid, 'GET', '/admin.v1.SysAPI/GetSysAPIInfo', '系统-接口-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysAPI/GetSysAPIList')::uuid, md5('sys_menu:/admin.v1.SysAPI/GetSysAPIList')::uuid, 'POST', '/admin.v1.SysAPI/GetSysAPIList', '系统-接口-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 系统-用户
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysAdmin')::uuid, '', '系统-用户', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/CreateSysAdmin')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-创建一条数据', 'button', '', 'sys_admin:create_sys_admin', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/UpdateSysAdmin')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-更新一条数据', 'button', '', 'sys_admin:update_sys_admin', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/UpdateSysAdminStatus')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-更新状态', 'button', '', 'sys_admin:update_sys_admin_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/UpdateSysAdminPassword')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-重置密码', 'button', '', 'sys_admin:update_sys_admin_password', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/DeleteSysAdmin')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-删除一条数据', 'button', '', 'sys_admin:delete_sys_admin', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/GetSysAdminInfo')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-单条数据查询', 'button', '', 'sys_admin:get_sys_admin_info', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/GetSysAdminList')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-列表数据查询', 'button', '', 'sys_admin:get_sys_admin_list', NULL, NULL, NULL, 7, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/GetSysAdminSelector')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-选择器', 'button', '', 'sys_admin:get_sys_admin_selector', NULL, NULL, NULL, 8, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/GetSysAdminSessionList')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-登录会话列表', 'button', '', 'sys_admin:get_sys_admin_session_list', NULL, NULL, NULL, 9, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/DeleteSysAdminSession')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-强制下线登录会话', 'button', '', 'sys_admin:delete_sys_admin_session', NULL, NULL, NULL, 10, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/ResetSysAdminTwoFactor')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-重置两步验证', 'button', '', 'sys_admin:reset_sys_admin_two_factor', NULL, NULL, NULL, 11, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysAdmin/UnlockSysAdmin')::uuid, md5('sys_menu:admin.v1.SysAdmin')::uuid::text, '系统-用户-解除登录锁定', 'button', '', 'sys_admin:unlock_sys_admin', NULL, NULL, NULL, 12, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysAdmin/CreateSysAdmin')::uuid, md5('sys_menu:/admin.v1.SysAdmin/CreateSysAdmin')::uuid, 'POST', '/admin.v1.SysAdmin/CreateSysAdmin', '系统-用户-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/UpdateSysAdmin')::uuid, md5('sys_menu:/admin.v1.SysAdmin/UpdateSysAdmin')::uuid, 'POST', '/admin.v1.SysAdmin/UpdateSysAdmin', '系统-用户-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/UpdateSysAdminStatus')::uuid, md5('sys_menu:/admin.v1.SysAdmin/UpdateSysAdminStatus')::uuid, 'POST', '/admin.v1.SysAdmin/UpdateSysAdminStatus', '系统-用户-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/UpdateSysAdminPassword')::uuid, md5('sys_menu:/admin.v1.SysAdmin/UpdateSysAdminPassword')::uuid, 'POST', '/admin.v1.SysAdmin/UpdateSysAdminPassword', '系统-用户-重置密码', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/DeleteSysAdmin')::uuid, md5('sys_menu:/admin.v1.SysAdmin/DeleteSysAdmin')::uuid, 'POST', '/admin.v1.SysAdmin/DeleteSysAdmin', '系统-用户-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/GetSysAdminInfo')::uuid, md5('sys_menu:/admin.v1.SysAdmin/GetSysAdminInfo')::uuid, 'GET', '/admin.v1.SysAdmin/GetSysAdminInfo', '系统-用户-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/GetSysAdminList')::uuid, md5('sys_menu:/admin.v1.SysAdmin/GetSysAdminList')::uuid, 'GET', '/admin.v1.SysAdmin/GetSysAdminList', '系统-用户-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/GetSysAdminSelector')::uuid, md5('sys_menu:/admin.v1.SysAdmin/GetSysAdminSelector')::uuid, 'GET', '/admin.v1.SysAdmin/GetSysAdminSelector', '系统-用户-选择器', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/GetSysAdminSessionList')::uuid, md5('sys_menu:/admin.v1.SysAdmin/GetSysAdminSessionList')::uuid, 'GET', '/admin.v1.SysAdmin/GetSysAdminSessionList', '系统-用户-登录会话列表', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/DeleteSysAdminSession')::uuid, md5('sys_menu:/admin.v1.SysAdmin/DeleteSysAdminSession')::uuid, 'POST', '/admin.v1.SysAdmin/DeleteSysAdminSession', '系统-用户-强制下线登录会话', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/ResetSysAdminTwoFactor')::uuid, md5('sys_menu:/admin.v1.SysAdmin/ResetSysAdminTwoFactor')::uuid, 'POST', '/admin.v1.SysAdmin/ResetSysAdminTwoFactor', '系统-用户-重置两步验证', now(), now()),
+(md5('sys_api:/admin.v1.SysAdmin/UnlockSysAdmin')::uuid, md5('sys_menu:/admin.v1.SysAdmin/UnlockSysAdmin')::uuid, 'POST', '/admin.v1.SysAdmin/UnlockSysAdmin', '系统-用户-解除登录锁定', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 系统-部门
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysDept')::uuid, '', '系统-部门', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysDept/CreateSysDept')::uuid, md5('sys_menu:admin.v1.SysDept')::uuid::text, '系统-部门-创建一条数据', 'button', '', 'sys_dept:create_sys_dept', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysDept/UpdateSysDept')::uuid, md5('sys_menu:admin.v1.SysDept')::uuid::text, '系统-部门-更新一条数据', 'button', '', 'sys_dept:update_sys_dept', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysDept/UpdateSysDeptStatus')::uuid, md5('sys_menu:admin.v1.SysDept')::uuid::text, '系统-部门-更新状态', 'button', '', 'sys_dept:update_sys_dept_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysDept/DeleteSysDept')::uuid, md5('sys_menu:admin.v1.SysDept')::uuid::text, '系统-部门-删除一条数据', 'button', '', 'sys_dept:delete_sys_dept', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysDept/GetSysDeptInfo')::uuid, md5('sys_menu:admin.v1.SysDept')::uuid::text, '系统-部门-单条数据查询', 'button', '', 'sys_dept:get_sys_dept_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysDept/GetSysDeptList')::uuid, md5('sys_menu:admin.v1.SysDept')::uuid::text, '系统-部门-列表数据查询', 'button', '', 'sys_dept:get_sys_dept_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysDept/CreateSysDept')::uuid, md5('sys_menu:/admin.v1.SysDept/CreateSysDept')::uuid, 'POST', '/admin.v1.SysDept/CreateSysDept', '系统-部门-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysDept/UpdateSysDept')::uuid, md5('sys_menu:/admin.v1.SysDept/UpdateSysDept')::uuid, 'POST', '/admin.v1.SysDept/UpdateSysDept', '系统-部门-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysDept/UpdateSysDeptStatus')::uuid, md5('sys_menu:/admin.v1.SysDept/UpdateSysDeptStatus')::uuid, 'POST', '/admin.v1.SysDept/UpdateSysDeptStatus', '系统-部门-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SysDept/DeleteSysDept')::uuid, md5('sys_menu:/admin.v1.SysDept/DeleteSysDept')::uuid, 'POST', '/admin.v1.SysDept/DeleteSysDept', '系统-部门-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysDept/GetSysDeptInfo')::uuid, md5('sys_menu:/admin.v1.SysDept/GetSysDeptInfo')::uuid, 'GET', '/admin.v1.SysDept/GetSysDeptInfo', '系统-部门-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysDept/GetSysDeptList')::uuid, md5('sys_menu:/admin.v1.SysDept/GetSysDeptList')::uuid, 'GET', '/admin.v1.SysDept/GetSysDeptList', '系统-部门-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 菜单
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysMenu')::uuid, '', '菜单', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysMenu/CreateSysMenu')::uuid, md5('sys_menu:admin.v1.SysMenu')::uuid::text, '菜单-创建一条数据', 'button', '', 'sys_menu:create_sys_menu', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysMenu/UpdateSysMenu')::uuid, md5('sys_menu:admin.v1.SysMenu')::uuid::text, '菜单-更新一条数据', 'button', '', 'sys_menu:update_sys_menu', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysMenu/UpdateSysMenuStatus')::uuid, md5('sys_menu:admin.v1.SysMenu')::uuid::text, '菜单-更新状态', 'button', '', 'sys_menu:update_sys_menu_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysMenu/DeleteSysMenu')::uuid, md5('sys_menu:admin.v1.SysMenu')::uuid::text, '菜单-删除一条数据', 'button', '', 'sys_menu:delete_sys_menu', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysMenu/GetSysMenuInfo')::uuid, md5('sys_menu:admin.v1.SysMenu')::uuid::text, '菜单-单条数据查询', 'button', '', 'sys_menu:get_sys_menu_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysMenu/GetSysMenuList')::uuid, md5('sys_menu:admin.v1.SysMenu')::uuid::text, '菜单-列表数据查询', 'button', '', 'sys_menu:get_sys_menu_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysMenu/CreateSysMenu')::uuid, md5('sys_menu:/admin.v1.SysMenu/CreateSysMenu')::uuid, 'POST', '/admin.v1.SysMenu/CreateSysMenu', '菜单-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysMenu/UpdateSysMenu')::uuid, md5('sys_menu:/admin.v1.SysMenu/UpdateSysMenu')::uuid, 'POST', '/admin.v1.SysMenu/UpdateSysMenu', '菜单-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysMenu/UpdateSysMenuStatus')::uuid, md5('sys_menu:/admin.v1.SysMenu/UpdateSysMenuStatus')::uuid, 'POST', '/admin.v1.SysMenu/UpdateSysMenuStatus', '菜单-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SysMenu/DeleteSysMenu')::uuid, md5('sys_menu:/admin.v1.SysMenu/DeleteSysMenu')::uuid, 'POST', '/admin.v1.SysMenu/DeleteSysMenu', '菜单-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysMenu/GetSysMenuInfo')::uuid, md5('sys_menu:/admin.v1.SysMenu/GetSysMenuInfo')::uuid, 'GET', '/admin.v1.SysMenu/GetSysMenuInfo', '菜单-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysMenu/GetSysMenuList')::uuid, md5('sys_menu:/admin.v1.SysMenu/GetSysMenuList')::uuid, 'GET', '/admin.v1.SysMenu/GetSysMenuList', '菜单-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 系统-公告
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysNotice')::uuid, '', '系统-公告', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotice/CreateSysNotice')::uuid, md5('sys_menu:admin.v1.SysNotice')::uuid::text, '系统-公告-创建一条数据', 'button', '', 'sys_notice:create_sys_notice', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotice/UpdateSysNotice')::uuid, md5('sys_menu:admin.v1.SysNotice')::uuid::text, '系统-公告-更新一条数据', 'button', '', 'sys_notice:update_sys_notice', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotice/UpdateSysNoticeStatus')::uuid, md5('sys_menu:admin.v1.SysNotice')::uuid::text, '系统-公告-更新状态', 'button', '', 'sys_notice:update_sys_notice_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotice/DeleteSysNotice')::uuid, md5('sys_menu:admin.v1.SysNotice')::uuid::text, '系统-公告-删除一条数据', 'button', '', 'sys_notice:delete_sys_notice', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotice/GetSysNoticeInfo')::uuid, md5('sys_menu:admin.v1.SysNotice')::uuid::text, '系统-公告-单条数据查询', 'button', '', 'sys_notice:get_sys_notice_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotice/GetSysNoticeList')::uuid, md5('sys_menu:admin.v1.SysNotice')::uuid::text, '系统-公告-列表数据查询', 'button', '', 'sys_notice:get_sys_notice_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotice/PublishSysNotice')::uuid, md5('sys_menu:admin.v1.SysNotice')::uuid::text, '系统-公告-发布(指定发布对象, 支持定时发布与过期时间)', 'button', '', 'sys_notice:publish_sys_notice', NULL, NULL, NULL, 7, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotice/GetSysNoticeReadStats')::uuid, md5('sys_menu:admin.v1.SysNotice')::uuid::text, '系统-公告-阅读统计', 'button', '', 'sys_notice:get_sys_notice_read_stats', NULL, NULL, NULL, 8, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysNotice/CreateSysNotice')::uuid, md5('sys_menu:/admin.v1.SysNotice/CreateSysNotice')::uuid, 'POST', '/admin.v1.SysNotice/CreateSysNotice', '系统-公告-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysNotice/UpdateSysNotice')::uuid, md5('sys_menu:/admin.v1.SysNotice/UpdateSysNotice')::uuid, 'POST', '/admin.v1.SysNotice/UpdateSysNotice', '系统-公告-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysNotice/UpdateSysNoticeStatus')::uuid, md5('sys_menu:/admin.v1.SysNotice/UpdateSysNoticeStatus')::uuid, 'POST', '/admin.v1.SysNotice/UpdateSysNoticeStatus', '系统-公告-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SysNotice/DeleteSysNotice')::uuid, md5('sys_menu:/admin.v1.SysNotice/DeleteSysNotice')::uuid, 'POST', '/admin.v1.SysNotice/DeleteSysNotice', '系统-公告-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysNotice/GetSysNoticeInfo')::uuid, md5('sys_menu:/admin.v1.SysNotice/GetSysNoticeInfo')::uuid, 'GET', '/admin.v1.SysNotice/GetSysNoticeInfo', '系统-公告-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysNotice/GetSysNoticeList')::uuid, md5('sys_menu:/admin.v1.SysNotice/GetSysNoticeList')::uuid, 'GET', '/admin.v1.SysNotice/GetSysNoticeList', '系统-公告-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysNotice/PublishSysNotice')::uuid, md5('sys_menu:/admin.v1.SysNotice/PublishSysNotice')::uuid, 'POST', '/admin.v1.SysNotice/PublishSysNotice', '系统-公告-发布(指定发布对象, 支持定时发布与过期时间)', now(), now()),
+(md5('sys_api:/admin.v1.SysNotice/GetSysNoticeReadStats')::uuid, md5('sys_menu:/admin.v1.SysNotice/GetSysNoticeReadStats')::uuid, 'GET', '/admin.v1.SysNotice/GetSysNoticeReadStats', '系统-公告-阅读统计', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 系统-通知消息
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysNotifyMessage')::uuid, '', '系统-通知消息', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotifyMessage/GetSysNotifyMessageInfo')::uuid, md5('sys_menu:admin.v1.SysNotifyMessage')::uuid::text, '系统-通知消息-单条数据查询', 'button', '', 'sys_notify_message:get_sys_notify_message_info', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysNotifyMessage/GetSysNotifyMessageList')::uuid, md5('sys_menu:admin.v1.SysNotifyMessage')::uuid::text, '系统-通知消息-列表数据查询', 'button', '', 'sys_notify_message:get_sys_notify_message_list', NULL, NULL, NULL, 2, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysNotifyMessage/GetSysNotifyMessageInfo')::uuid, md5('sys_menu:/admin.v1.SysNotifyMessage/GetSysNotifyMessageInfo')::uuid, 'GET', '/admin.v1.SysNotifyMessage/GetSysNotifyMessageInfo', '系统-通知消息-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysNotifyMessage/GetSysNotifyMessageList')::uuid, md5('sys_menu:/admin.v1.SysNotifyMessage/GetSysNotifyMessageList')::uuid, 'GET', '/admin.v1.SysNotifyMessage/GetSysNotifyMessageList', '系统-通知消息-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 系统-操作日志
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysOperateLog')::uuid, '', '系统-操作日志', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysOperateLog/GetSysOperateLogList')::uuid, md5('sys_menu:admin.v1.SysOperateLog')::uuid::text, '系统-操作日志-列表数据查询', 'button', '', 'sys_operate_log:get_sys_operate_log_list', NULL, NULL, NULL, 1, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysOperateLog/GetSysOperateLogList')::uuid, md5('sys_menu:/admin.v1.SysOperateLog/GetSysOperateLogList')::uuid, 'GET', '/admin.v1.SysOperateLog/GetSysOperateLogList', '系统-操作日志-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 系统-工作岗位
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysPost')::uuid, '', '系统-工作岗位', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysPost/CreateSysPost')::uuid, md5('sys_menu:admin.v1.SysPost')::uuid::text, '系统-工作岗位-创建一条数据', 'button', '', 'sys_post:create_sys_post', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysPost/UpdateSysPost')::uuid, md5('sys_menu:admin.v1.SysPost')::uuid::text, '系统-工作岗位-更新一条数据', 'button', '', 'sys_post:update_sys_post', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysPost/UpdateSysPostStatus')::uuid, md5('sys_menu:admin.v1.SysPost')::uuid::text, '系统-工作岗位-更新状态', 'button', '', 'sys_post:update_sys_post_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysPost/DeleteSysPost')::uuid, md5('sys_menu:admin.v1.SysPost')::uuid::text, '系统-工作岗位-删除一条数据', 'button', '', 'sys_post:delete_sys_post', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysPost/GetSysPostInfo')::uuid, md5('sys_menu:admin.v1.SysPost')::uuid::text, '系统-工作岗位-单条数据查询', 'button', '', 'sys_post:get_sys_post_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysPost/GetSysPostList')::uuid, md5('sys_menu:admin.v1.SysPost')::uuid::text, '系统-工作岗位-列表数据查询', 'button', '', 'sys_post:get_sys_post_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysPost/GetSysPostSelector')::uuid, md5('sys_menu:admin.v1.SysPost')::uuid::text, '系统-工作岗位-选择器', 'button', '', 'sys_post:get_sys_post_selector', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysPost/CreateSysPost')::uuid, md5('sys_menu:/admin.v1.SysPost/CreateSysPost')::uuid, 'POST', '/admin.v1.SysPost/CreateSysPost', '系统-工作岗位-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysPost/UpdateSysPost')::uuid, md5('sys_menu:/admin.v1.SysPost/UpdateSysPost')::uuid, 'POST', '/admin.v1.SysPost/UpdateSysPost', '系统-工作岗位-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysPost/UpdateSysPostStatus')::uuid, md5('sys_menu:/admin.v1.SysPost/UpdateSysPostStatus')::uuid, 'POST', '/admin.v1.SysPost/UpdateSysPostStatus', '系统-工作岗位-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SysPost/DeleteSysPost')::uuid, md5('sys_menu:/admin.v1.SysPost/DeleteSysPost')::uuid, 'POST', '/admin.v1.SysPost/DeleteSysPost', '系统-工作岗位-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysPost/GetSysPostInfo')::uuid, md5('sys_menu:/admin.v1.SysPost/GetSysPostInfo')::uuid, 'GET', '/admin.v1.SysPost/GetSysPostInfo', '系统-工作岗位-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysPost/GetSysPostList')::uuid, md5('sys_menu:/admin.v1.SysPost/GetSysPostList')::uuid, 'GET', '/admin.v1.SysPost/GetSysPostList', '系统-工作岗位-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysPost/GetSysPostSelector')::uuid, md5('sys_menu:/admin.v1.SysPost/GetSysPostSelector')::uuid, 'GET', '/admin.v1.SysPost/GetSysPostSelector', '系统-工作岗位-选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 系统-角色
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysRole')::uuid, '', '系统-角色', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysRole/CreateSysRole')::uuid, md5('sys_menu:admin.v1.SysRole')::uuid::text, '系统-角色-创建一条数据', 'button', '', 'sys_role:create_sys_role', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysRole/UpdateSysRole')::uuid, md5('sys_menu:admin.v1.SysRole')::uuid::text, '系统-角色-更新一条数据', 'button', '', 'sys_role:update_sys_role', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysRole/UpdateSysRoleStatus')::uuid, md5('sys_menu:admin.v1.SysRole')::uuid::text, '系统-角色-更新状态', 'button', '', 'sys_role:update_sys_role_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysRole/DeleteSysRole')::uuid, md5('sys_menu:admin.v1.SysRole')::uuid::text, '系统-角色-删除一条数据', 'button', '', 'sys_role:delete_sys_role', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysRole/GetSysRoleInfo')::uuid, md5('sys_menu:admin.v1.SysRole')::uuid::text, '系统-角色-单条数据查询', 'button', '', 'sys_role:get_sys_role_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysRole/GetSysRoleList')::uuid, md5('sys_menu:admin.v1.SysRole')::uuid::text, '系统-角色-列表数据查询', 'button', '', 'sys_role:get_sys_role_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysRole/GetSysRoleSelector')::uuid, md5('sys_menu:admin.v1.SysRole')::uuid::text, '系统-角色-选择器', 'button', '', 'sys_role:get_sys_role_selector', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysRole/CreateSysRole')::uuid, md5('sys_menu:/admin.v1.SysRole/CreateSysRole')::uuid, 'POST', '/admin.v1.SysRole/CreateSysRole', '系统-角色-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysRole/UpdateSysRole')::uuid, md5('sys_menu:/admin.v1.SysRole/UpdateSysRole')::uuid, 'POST', '/admin.v1.SysRole/UpdateSysRole', '系统-角色-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysRole/UpdateSysRoleStatus')::uuid, md5('sys_menu:/admin.v1.SysRole/UpdateSysRoleStatus')::uuid, 'POST', '/admin.v1.SysRole/UpdateSysRoleStatus', '系统-角色-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SysRole/DeleteSysRole')::uuid, md5('sys_menu:/admin.v1.SysRole/DeleteSysRole')::uuid, 'POST', '/admin.v1.SysRole/DeleteSysRole', '系统-角色-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysRole/GetSysRoleInfo')::uuid, md5('sys_menu:/admin.v1.SysRole/GetSysRoleInfo')::uuid, 'GET', '/admin.v1.SysRole/GetSysRoleInfo', '系统-角色-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysRole/GetSysRoleList')::uuid, md5('sys_menu:/admin.v1.SysRole/GetSysRoleList')::uuid, 'GET', '/admin.v1.SysRole/GetSysRoleList', '系统-角色-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysRole/GetSysRoleSelector')::uuid, md5('sys_menu:/admin.v1.SysRole/GetSysRoleSelector')::uuid, 'GET', '/admin.v1.SysRole/GetSysRoleSelector', '系统-角色-选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 系统-租户
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.SysTenant')::uuid, '', '系统-租户', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysTenant/CreateSysTenant')::uuid, md5('sys_menu:admin.v1.SysTenant')::uuid::text, '系统-租户-创建一条数据', 'button', '', 'sys_tenant:create_sys_tenant', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysTenant/UpdateSysTenant')::uuid, md5('sys_menu:admin.v1.SysTenant')::uuid::text, '系统-租户-更新一条数据', 'button', '', 'sys_tenant:update_sys_tenant', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysTenant/UpdateSysTenantStatus')::uuid, md5('sys_menu:admin.v1.SysTenant')::uuid::text, '系统-租户-更新状态', 'button', '', 'sys_tenant:update_sys_tenant_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysTenant/DeleteSysTenant')::uuid, md5('sys_menu:admin.v1.SysTenant')::uuid::text, '系统-租户-删除一条数据', 'button', '', 'sys_tenant:delete_sys_tenant', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysTenant/GetSysTenantInfo')::uuid, md5('sys_menu:admin.v1.SysTenant')::uuid::text, '系统-租户-单条数据查询', 'button', '', 'sys_tenant:get_sys_tenant_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.SysTenant/GetSysTenantList')::uuid, md5('sys_menu:admin.v1.SysTenant')::uuid::text, '系统-租户-列表数据查询', 'button', '', 'sys_tenant:get_sys_tenant_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.SysTenant/CreateSysTenant')::uuid, md5('sys_menu:/admin.v1.SysTenant/CreateSysTenant')::uuid, 'POST', '/admin.v1.SysTenant/CreateSysTenant', '系统-租户-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysTenant/UpdateSysTenant')::uuid, md5('sys_menu:/admin.v1.SysTenant/UpdateSysTenant')::uuid, 'POST', '/admin.v1.SysTenant/UpdateSysTenant', '系统-租户-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysTenant/UpdateSysTenantStatus')::uuid, md5('sys_menu:/admin.v1.SysTenant/UpdateSysTenantStatus')::uuid, 'POST', '/admin.v1.SysTenant/UpdateSysTenantStatus', '系统-租户-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.SysTenant/DeleteSysTenant')::uuid, md5('sys_menu:/admin.v1.SysTenant/DeleteSysTenant')::uuid, 'POST', '/admin.v1.SysTenant/DeleteSysTenant', '系统-租户-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.SysTenant/GetSysTenantInfo')::uuid, md5('sys_menu:/admin.v1.SysTenant/GetSysTenantInfo')::uuid, 'GET', '/admin.v1.SysTenant/GetSysTenantInfo', '系统-租户-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.SysTenant/GetSysTenantList')::uuid, md5('sys_menu:/admin.v1.SysTenant/GetSysTenantList')::uuid, 'GET', '/admin.v1.SysTenant/GetSysTenantList', '系统-租户-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 用户表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.User')::uuid, '', '用户表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.User/CreateUser')::uuid, md5('sys_menu:admin.v1.User')::uuid::text, '用户表-创建一条数据', 'button', '', 'user:create_user', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.User/UpdateUser')::uuid, md5('sys_menu:admin.v1.User')::uuid::text, '用户表-更新一条数据', 'button', '', 'user:update_user', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.User/UpdateUserStatus')::uuid, md5('sys_menu:admin.v1.User')::uuid::text, '用户表-更新状态', 'button', '', 'user:update_user_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.User/DeleteUser')::uuid, md5('sys_menu:admin.v1.User')::uuid::text, '用户表-删除一条数据', 'button', '', 'user:delete_user', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.User/GetUserInfo')::uuid, md5('sys_menu:admin.v1.User')::uuid::text, '用户表-单条数据查询', 'button', '', 'user:get_user_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.User/GetUserList')::uuid, md5('sys_menu:admin.v1.User')::uuid::text, '用户表-列表数据查询', 'button', '', 'user:get_user_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.User/GetUserSessionList')::uuid, md5('sys_menu:admin.v1.User')::uuid::text, '用户表-登录会话列表', 'button', '', 'user:get_user_session_list', NULL, NULL, NULL, 7, 1, now(), now()),
+(md5('sys_menu:/admin.v1.User/DeleteUserSession')::uuid, md5('sys_menu:admin.v1.User')::uuid::text, '用户表-强制下线登录会话', 'button', '', 'user:delete_user_session', NULL, NULL, NULL, 8, 1, now(), now()),
+(md5('sys_menu:/admin.v1.User/UnlockUser')::uuid, md5('sys_menu:admin.v1.User')::uuid::text, '用户表-解除登录锁定', 'button', '', 'user:unlock_user', NULL, NULL, NULL, 9, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.User/CreateUser')::uuid, md5('sys_menu:/admin.v1.User/CreateUser')::uuid, 'POST', '/admin.v1.User/CreateUser', '用户表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.User/UpdateUser')::uuid, md5('sys_menu:/admin.v1.User/UpdateUser')::uuid, 'POST', '/admin.v1.User/UpdateUser', '用户表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.User/UpdateUserStatus')::uuid, md5('sys_menu:/admin.v1.User/UpdateUserStatus')::uuid, 'POST', '/admin.v1.User/UpdateUserStatus', '用户表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.User/DeleteUser')::uuid, md5('sys_menu:/admin.v1.User/DeleteUser')::uuid, 'POST', '/admin.v1.User/DeleteUser', '用户表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.User/GetUserInfo')::uuid, md5('sys_menu:/admin.v1.User/GetUserInfo')::uuid, 'GET', '/admin.v1.User/GetUserInfo', '用户表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.User/GetUserList')::uuid, md5('sys_menu:/admin.v1.User/GetUserList')::uuid, 'GET', '/admin.v1.User/GetUserList', '用户表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.User/GetUserSessionList')::uuid, md5('sys_menu:/admin.v1.User/GetUserSessionList')::uuid, 'GET', '/admin.v1.User/GetUserSessionList', '用户表-登录会话列表', now(), now()),
+(md5('sys_api:/admin.v1.User/DeleteUserSession')::uuid, md5('sys_menu:/admin.v1.User/DeleteUserSession')::uuid, 'POST', '/admin.v1.User/DeleteUserSession', '用户表-强制下线登录会话', now(), now()),
+(md5('sys_api:/admin.v1.User/UnlockUser')::uuid, md5('sys_menu:/admin.v1.User/UnlockUser')::uuid, 'POST', '/admin.v1.User/UnlockUser', '用户表-解除登录锁定', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 用户会员关系表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.UserMembership')::uuid, '', '用户会员关系表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.UserMembership/CreateUserMembership')::uuid, md5('sys_menu:admin.v1.UserMembership')::uuid::text, '用户会员关系表-创建一条数据', 'button', '', 'user_membership:create_user_membership', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.UserMembership/UpdateUserMembership')::uuid, md5('sys_menu:admin.v1.UserMembership')::uuid::text, '用户会员关系表-更新一条数据', 'button', '', 'user_membership:update_user_membership', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.UserMembership/UpdateUserMembershipStatus')::uuid, md5('sys_menu:admin.v1.UserMembership')::uuid::text, '用户会员关系表-更新状态', 'button', '', 'user_membership:update_user_membership_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.UserMembership/DeleteUserMembership')::uuid, md5('sys_menu:admin.v1.UserMembership')::uuid::text, '用户会员关系表-删除一条数据', 'button', '', 'user_membership:delete_user_membership', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.UserMembership/GetUserMembershipInfo')::uuid, md5('sys_menu:admin.v1.UserMembership')::uuid::text, '用户会员关系表-单条数据查询', 'button', '', 'user_membership:get_user_membership_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.UserMembership/GetUserMembershipList')::uuid, md5('sys_menu:admin.v1.UserMembership')::uuid::text, '用户会员关系表-列表数据查询', 'button', '', 'user_membership:get_user_membership_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.UserMembership/GetUserMembershipInfoByUserId')::uuid, md5('sys_menu:admin.v1.UserMembership')::uuid::text, '用户会员关系表-根据用户ID查询单条数据', 'button', '', 'user_membership:get_user_membership_info_by_user_id', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.UserMembership/CreateUserMembership')::uuid, md5('sys_menu:/admin.v1.UserMembership/CreateUserMembership')::uuid, 'POST', '/admin.v1.UserMembership/CreateUserMembership', '用户会员关系表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.UserMembership/UpdateUserMembership')::uuid, md5('sys_menu:/admin.v1.UserMembership/UpdateUserMembership')::uuid, 'POST', '/admin.v1.UserMembership/UpdateUserMembership', '用户会员关系表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.UserMembership/UpdateUserMembershipStatus')::uuid, md5('sys_menu:/admin.v1.UserMembership/UpdateUserMembershipStatus')::uuid, 'POST', '/admin.v1.UserMembership/UpdateUserMembershipStatus', '用户会员关系表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.UserMembership/DeleteUserMembership')::uuid, md5('sys_menu:/admin.v1.UserMembership/DeleteUserMembership')::uuid, 'POST', '/admin.v1.UserMembership/DeleteUserMembership', '用户会员关系表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.UserMembership/GetUserMembershipInfo')::uuid, md5('sys_menu:/admin.v1.UserMembership/GetUserMembershipInfo')::uuid, 'GET', '/admin.v1.UserMembership/GetUserMembershipInfo', '用户会员关系表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.UserMembership/GetUserMembershipList')::uuid, md5('sys_menu:/admin.v1.UserMembership/GetUserMembershipList')::uuid, 'GET', '/admin.v1.UserMembership/GetUserMembershipList', '用户会员关系表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.UserMembership/GetUserMembershipInfoByUserId')::uuid, md5('sys_menu:/admin.v1.UserMembership/GetUserMembershipInfoByUserId')::uuid, 'GET', '/admin.v1.UserMembership/GetUserMembershipInfoByUserId', '用户会员关系表-根据用户ID查询单条数据', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 用户通知投递日志表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.UserNotifyLog')::uuid, '', '用户通知投递日志表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.UserNotifyLog/SendUserNotify')::uuid, md5('sys_menu:admin.v1.UserNotifyLog')::uuid::text, '用户通知投递日志表-发送用户通知', 'button', '', 'user_notify_log:send_user_notify', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.UserNotifyLog/GetUserNotifyLogList')::uuid, md5('sys_menu:admin.v1.UserNotifyLog')::uuid::text, '用户通知投递日志表-列表数据查询', 'button', '', 'user_notify_log:get_user_notify_log_list', NULL, NULL, NULL, 2, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.UserNotifyLog/SendUserNotify')::uuid, md5('sys_menu:/admin.v1.UserNotifyLog/SendUserNotify')::uuid, 'POST', '/admin.v1.UserNotifyLog/SendUserNotify', '用户通知投递日志表-发送用户通知', now(), now()),
+(md5('sys_api:/admin.v1.UserNotifyLog/GetUserNotifyLogList')::uuid, md5('sys_menu:/admin.v1.UserNotifyLog/GetUserNotifyLogList')::uuid, 'GET', '/admin.v1.UserNotifyLog/GetUserNotifyLogList', '用户通知投递日志表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 公众号账号表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.WxGzhAccount')::uuid, '', '公众号账号表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAccount/CreateWxGzhAccount')::uuid, md5('sys_menu:admin.v1.WxGzhAccount')::uuid::text, '公众号账号表-创建一条数据', 'button', '', 'wx_gzh_account:create_wx_gzh_account', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAccount/UpdateWxGzhAccount')::uuid, md5('sys_menu:admin.v1.WxGzhAccount')::uuid::text, '公众号账号表-更新一条数据', 'button', '', 'wx_gzh_account:update_wx_gzh_account', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAccount/DeleteWxGzhAccount')::uuid, md5('sys_menu:admin.v1.WxGzhAccount')::uuid::text, '公众号账号表-删除一条数据', 'button', '', 'wx_gzh_account:delete_wx_gzh_account', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAccount/GetWxGzhAccountInfo')::uuid, md5('sys_menu:admin.v1.WxGzhAccount')::uuid::text, '公众号账号表-单条数据查询', 'button', '', 'wx_gzh_account:get_wx_gzh_account_info', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAccount/GetWxGzhAccountList')::uuid, md5('sys_menu:admin.v1.WxGzhAccount')::uuid::text, '公众号账号表-列表数据查询', 'button', '', 'wx_gzh_account:get_wx_gzh_account_list', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAccount/GetWxGzhAccountSelector')::uuid, md5('sys_menu:admin.v1.WxGzhAccount')::uuid::text, '公众号账号表-公众号选择器', 'button', '', 'wx_gzh_account:get_wx_gzh_account_selector', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.WxGzhAccount/CreateWxGzhAccount')::uuid, md5('sys_menu:/admin.v1.WxGzhAccount/CreateWxGzhAccount')::uuid, 'POST', '/admin.v1.WxGzhAccount/CreateWxGzhAccount', '公众号账号表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAccount/UpdateWxGzhAccount')::uuid, md5('sys_menu:/admin.v1.WxGzhAccount/UpdateWxGzhAccount')::uuid, 'POST', '/admin.v1.WxGzhAccount/UpdateWxGzhAccount', '公众号账号表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAccount/DeleteWxGzhAccount')::uuid, md5('sys_menu:/admin.v1.WxGzhAccount/DeleteWxGzhAccount')::uuid, 'POST', '/admin.v1.WxGzhAccount/DeleteWxGzhAccount', '公众号账号表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAccount/GetWxGzhAccountInfo')::uuid, md5('sys_menu:/admin.v1.WxGzhAccount/GetWxGzhAccountInfo')::uuid, 'GET', '/admin.v1.WxGzhAccount/GetWxGzhAccountInfo', '公众号账号表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAccount/GetWxGzhAccountList')::uuid, md5('sys_menu:/admin.v1.WxGzhAccount/GetWxGzhAccountList')::uuid, 'GET', '/admin.v1.WxGzhAccount/GetWxGzhAccountList', '公众号账号表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAccount/GetWxGzhAccountSelector')::uuid, md5('sys_menu:/admin.v1.WxGzhAccount/GetWxGzhAccountSelector')::uuid, 'GET', '/admin.v1.WxGzhAccount/GetWxGzhAccountSelector', '公众号账号表-公众号选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 公众号消息自动回复表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.WxGzhAutoReply')::uuid, '', '公众号消息自动回复表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAutoReply/CreateWxGzhAutoReply')::uuid, md5('sys_menu:admin.v1.WxGzhAutoReply')::uuid::text, '公众号消息自动回复表-创建一条数据', 'button', '', 'wx_gzh_auto_reply:create_wx_gzh_auto_reply', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAutoReply/UpdateWxGzhAutoReply')::uuid, md5('sys_menu:admin.v1.WxGzhAutoReply')::uuid::text, '公众号消息自动回复表-更新一条数据', 'button', '', 'wx_gzh_auto_reply:update_wx_gzh_auto_reply', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAutoReply/UpdateWxGzhAutoReplyStatus')::uuid, md5('sys_menu:admin.v1.WxGzhAutoReply')::uuid::text, '公众号消息自动回复表-更新状态', 'button', '', 'wx_gzh_auto_reply:update_wx_gzh_auto_reply_status', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAutoReply/DeleteWxGzhAutoReply')::uuid, md5('sys_menu:admin.v1.WxGzhAutoReply')::uuid::text, '公众号消息自动回复表-删除一条数据', 'button', '', 'wx_gzh_auto_reply:delete_wx_gzh_auto_reply', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAutoReply/GetWxGzhAutoReplyInfo')::uuid, md5('sys_menu:admin.v1.WxGzhAutoReply')::uuid::text, '公众号消息自动回复表-单条数据查询', 'button', '', 'wx_gzh_auto_reply:get_wx_gzh_auto_reply_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhAutoReply/GetWxGzhAutoReplyList')::uuid, md5('sys_menu:admin.v1.WxGzhAutoReply')::uuid::text, '公众号消息自动回复表-列表数据查询', 'button', '', 'wx_gzh_auto_reply:get_wx_gzh_auto_reply_list', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.WxGzhAutoReply/CreateWxGzhAutoReply')::uuid, md5('sys_menu:/admin.v1.WxGzhAutoReply/CreateWxGzhAutoReply')::uuid, 'POST', '/admin.v1.WxGzhAutoReply/CreateWxGzhAutoReply', '公众号消息自动回复表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAutoReply/UpdateWxGzhAutoReply')::uuid, md5('sys_menu:/admin.v1.WxGzhAutoReply/UpdateWxGzhAutoReply')::uuid, 'POST', '/admin.v1.WxGzhAutoReply/UpdateWxGzhAutoReply', '公众号消息自动回复表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAutoReply/UpdateWxGzhAutoReplyStatus')::uuid, md5('sys_menu:/admin.v1.WxGzhAutoReply/UpdateWxGzhAutoReplyStatus')::uuid, 'POST', '/admin.v1.WxGzhAutoReply/UpdateWxGzhAutoReplyStatus', '公众号消息自动回复表-更新状态', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAutoReply/DeleteWxGzhAutoReply')::uuid, md5('sys_menu:/admin.v1.WxGzhAutoReply/DeleteWxGzhAutoReply')::uuid, 'POST', '/admin.v1.WxGzhAutoReply/DeleteWxGzhAutoReply', '公众号消息自动回复表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAutoReply/GetWxGzhAutoReplyInfo')::uuid, md5('sys_menu:/admin.v1.WxGzhAutoReply/GetWxGzhAutoReplyInfo')::uuid, 'GET', '/admin.v1.WxGzhAutoReply/GetWxGzhAutoReplyInfo', '公众号消息自动回复表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhAutoReply/GetWxGzhAutoReplyList')::uuid, md5('sys_menu:/admin.v1.WxGzhAutoReply/GetWxGzhAutoReplyList')::uuid, 'GET', '/admin.v1.WxGzhAutoReply/GetWxGzhAutoReplyList', '公众号消息自动回复表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 公众号素材表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.WxGzhMaterial')::uuid, '', '公众号素材表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMaterial/DeleteWxGzhMaterial')::uuid, md5('sys_menu:admin.v1.WxGzhMaterial')::uuid::text, '公众号素材表-删除一条数据', 'button', '', 'wx_gzh_material:delete_wx_gzh_material', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMaterial/GetWxGzhMaterialInfo')::uuid, md5('sys_menu:admin.v1.WxGzhMaterial')::uuid::text, '公众号素材表-单条数据查询', 'button', '', 'wx_gzh_material:get_wx_gzh_material_info', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMaterial/GetWxGzhMaterialList')::uuid, md5('sys_menu:admin.v1.WxGzhMaterial')::uuid::text, '公众号素材表-列表数据查询', 'button', '', 'wx_gzh_material:get_wx_gzh_material_list', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMaterial/GetWxGzhMaterialStats')::uuid, md5('sys_menu:admin.v1.WxGzhMaterial')::uuid::text, '公众号素材表-统计', 'button', '', 'wx_gzh_material:get_wx_gzh_material_stats', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMaterial/SyncWxGzhMaterial')::uuid, md5('sys_menu:admin.v1.WxGzhMaterial')::uuid::text, '公众号素材表-素材同步', 'button', '', 'wx_gzh_material:sync_wx_gzh_material', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMaterial/UploadWxGzhMaterial')::uuid, md5('sys_menu:admin.v1.WxGzhMaterial')::uuid::text, '上传素材', 'button', '', 'wx_gzh_material:upload_wx_gzh_material', NULL, NULL, NULL, 6, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.WxGzhMaterial/DeleteWxGzhMaterial')::uuid, md5('sys_menu:/admin.v1.WxGzhMaterial/DeleteWxGzhMaterial')::uuid, 'POST', '/admin.v1.WxGzhMaterial/DeleteWxGzhMaterial', '公众号素材表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMaterial/GetWxGzhMaterialInfo')::uuid, md5('sys_menu:/admin.v1.WxGzhMaterial/GetWxGzhMaterialInfo')::uuid, 'GET', '/admin.v1.WxGzhMaterial/GetWxGzhMaterialInfo', '公众号素材表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMaterial/GetWxGzhMaterialList')::uuid, md5('sys_menu:/admin.v1.WxGzhMaterial/GetWxGzhMaterialList')::uuid, 'GET', '/admin.v1.WxGzhMaterial/GetWxGzhMaterialList', '公众号素材表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMaterial/GetWxGzhMaterialStats')::uuid, md5('sys_menu:/admin.v1.WxGzhMaterial/GetWxGzhMaterialStats')::uuid, 'GET', '/admin.v1.WxGzhMaterial/GetWxGzhMaterialStats', '公众号素材表-统计', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMaterial/SyncWxGzhMaterial')::uuid, md5('sys_menu:/admin.v1.WxGzhMaterial/SyncWxGzhMaterial')::uuid, 'POST', '/admin.v1.WxGzhMaterial/SyncWxGzhMaterial', '公众号素材表-素材同步', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMaterial/UploadWxGzhMaterial')::uuid, md5('sys_menu:/admin.v1.WxGzhMaterial/UploadWxGzhMaterial')::uuid, 'POST', '/admin.v1.WxGzhMaterial/UploadWxGzhMaterial', '上传素材', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 公众号菜单表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.WxGzhMenu')::uuid, '', '公众号菜单表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMenu/StoreWxGzhMenu')::uuid, md5('sys_menu:admin.v1.WxGzhMenu')::uuid::text, '公众号菜单表-创建/更新一条数据', 'button', '', 'wx_gzh_menu:store_wx_gzh_menu', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMenu/DeleteWxGzhMenu')::uuid, md5('sys_menu:admin.v1.WxGzhMenu')::uuid::text, '公众号菜单表-删除一条数据', 'button', '', 'wx_gzh_menu:delete_wx_gzh_menu', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMenu/GetWxGzhMenuInfo')::uuid, md5('sys_menu:admin.v1.WxGzhMenu')::uuid::text, '公众号菜单表-单条数据查询', 'button', '', 'wx_gzh_menu:get_wx_gzh_menu_info', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMenu/GetWxGzhMenuList')::uuid, md5('sys_menu:admin.v1.WxGzhMenu')::uuid::text, '公众号菜单表-列表数据查询', 'button', '', 'wx_gzh_menu:get_wx_gzh_menu_list', NULL, NULL, NULL, 4, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.WxGzhMenu/StoreWxGzhMenu')::uuid, md5('sys_menu:/admin.v1.WxGzhMenu/StoreWxGzhMenu')::uuid, 'POST', '/admin.v1.WxGzhMenu/StoreWxGzhMenu', '公众号菜单表-创建/更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMenu/DeleteWxGzhMenu')::uuid, md5('sys_menu:/admin.v1.WxGzhMenu/DeleteWxGzhMenu')::uuid, 'POST', '/admin.v1.WxGzhMenu/DeleteWxGzhMenu', '公众号菜单表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMenu/GetWxGzhMenuInfo')::uuid, md5('sys_menu:/admin.v1.WxGzhMenu/GetWxGzhMenuInfo')::uuid, 'GET', '/admin.v1.WxGzhMenu/GetWxGzhMenuInfo', '公众号菜单表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMenu/GetWxGzhMenuList')::uuid, md5('sys_menu:/admin.v1.WxGzhMenu/GetWxGzhMenuList')::uuid, 'GET', '/admin.v1.WxGzhMenu/GetWxGzhMenuList', '公众号菜单表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 公众号消息表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.WxGzhMessage')::uuid, '', '公众号消息表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMessage/CreateWxGzhMessage')::uuid, md5('sys_menu:admin.v1.WxGzhMessage')::uuid::text, '公众号消息表 -创建一条数据', 'button', '', 'wx_gzh_message:create_wx_gzh_message', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMessage/UpdateWxGzhMessage')::uuid, md5('sys_menu:admin.v1.WxGzhMessage')::uuid::text, '公众号消息表 -更新一条数据', 'button', '', 'wx_gzh_message:update_wx_gzh_message', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMessage/DeleteWxGzhMessage')::uuid, md5('sys_menu:admin.v1.WxGzhMessage')::uuid::text, '公众号消息表 -删除一条数据', 'button', '', 'wx_gzh_message:delete_wx_gzh_message', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMessage/GetWxGzhMessageInfo')::uuid, md5('sys_menu:admin.v1.WxGzhMessage')::uuid::text, '公众号消息表 -单条数据查询', 'button', '', 'wx_gzh_message:get_wx_gzh_message_info', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhMessage/GetWxGzhMessageList')::uuid, md5('sys_menu:admin.v1.WxGzhMessage')::uuid::text, '公众号消息表 -列表数据查询', 'button', '', 'wx_gzh_message:get_wx_gzh_message_list', NULL, NULL, NULL, 5, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.WxGzhMessage/CreateWxGzhMessage')::uuid, md5('sys_menu:/admin.v1.WxGzhMessage/CreateWxGzhMessage')::uuid, 'POST', '/admin.v1.WxGzhMessage/CreateWxGzhMessage', '公众号消息表 -创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMessage/UpdateWxGzhMessage')::uuid, md5('sys_menu:/admin.v1.WxGzhMessage/UpdateWxGzhMessage')::uuid, 'POST', '/admin.v1.WxGzhMessage/UpdateWxGzhMessage', '公众号消息表 -更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMessage/DeleteWxGzhMessage')::uuid, md5('sys_menu:/admin.v1.WxGzhMessage/DeleteWxGzhMessage')::uuid, 'POST', '/admin.v1.WxGzhMessage/DeleteWxGzhMessage', '公众号消息表 -删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMessage/GetWxGzhMessageInfo')::uuid, md5('sys_menu:/admin.v1.WxGzhMessage/GetWxGzhMessageInfo')::uuid, 'GET', '/admin.v1.WxGzhMessage/GetWxGzhMessageInfo', '公众号消息表 -单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhMessage/GetWxGzhMessageList')::uuid, md5('sys_menu:/admin.v1.WxGzhMessage/GetWxGzhMessageList')::uuid, 'GET', '/admin.v1.WxGzhMessage/GetWxGzhMessageList', '公众号消息表 -列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 公众号标签表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.WxGzhTag')::uuid, '', '公众号标签表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhTag/CreateWxGzhTag')::uuid, md5('sys_menu:admin.v1.WxGzhTag')::uuid::text, '公众号标签表-创建一条数据', 'button', '', 'wx_gzh_tag:create_wx_gzh_tag', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhTag/UpdateWxGzhTag')::uuid, md5('sys_menu:admin.v1.WxGzhTag')::uuid::text, '公众号标签表-更新一条数据', 'button', '', 'wx_gzh_tag:update_wx_gzh_tag', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhTag/SyncWxGzhTag')::uuid, md5('sys_menu:admin.v1.WxGzhTag')::uuid::text, '公众号标签表-同步标签', 'button', '', 'wx_gzh_tag:sync_wx_gzh_tag', NULL, NULL, NULL, 3, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhTag/DeleteWxGzhTag')::uuid, md5('sys_menu:admin.v1.WxGzhTag')::uuid::text, '公众号标签表-删除一条数据', 'button', '', 'wx_gzh_tag:delete_wx_gzh_tag', NULL, NULL, NULL, 4, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhTag/GetWxGzhTagInfo')::uuid, md5('sys_menu:admin.v1.WxGzhTag')::uuid::text, '公众号标签表-单条数据查询', 'button', '', 'wx_gzh_tag:get_wx_gzh_tag_info', NULL, NULL, NULL, 5, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhTag/GetWxGzhTagList')::uuid, md5('sys_menu:admin.v1.WxGzhTag')::uuid::text, '公众号标签表-列表数据查询', 'button', '', 'wx_gzh_tag:get_wx_gzh_tag_list', NULL, NULL, NULL, 6, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhTag/GetWxGzhTagSelector')::uuid, md5('sys_menu:admin.v1.WxGzhTag')::uuid::text, '公众号标签表-选择器', 'button', '', 'wx_gzh_tag:get_wx_gzh_tag_selector', NULL, NULL, NULL, 7, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.WxGzhTag/CreateWxGzhTag')::uuid, md5('sys_menu:/admin.v1.WxGzhTag/CreateWxGzhTag')::uuid, 'POST', '/admin.v1.WxGzhTag/CreateWxGzhTag', '公众号标签表-创建一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhTag/UpdateWxGzhTag')::uuid, md5('sys_menu:/admin.v1.WxGzhTag/UpdateWxGzhTag')::uuid, 'POST', '/admin.v1.WxGzhTag/UpdateWxGzhTag', '公众号标签表-更新一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhTag/SyncWxGzhTag')::uuid, md5('sys_menu:/admin.v1.WxGzhTag/SyncWxGzhTag')::uuid, 'POST', '/admin.v1.WxGzhTag/SyncWxGzhTag', '公众号标签表-同步标签', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhTag/DeleteWxGzhTag')::uuid, md5('sys_menu:/admin.v1.WxGzhTag/DeleteWxGzhTag')::uuid, 'POST', '/admin.v1.WxGzhTag/DeleteWxGzhTag', '公众号标签表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhTag/GetWxGzhTagInfo')::uuid, md5('sys_menu:/admin.v1.WxGzhTag/GetWxGzhTagInfo')::uuid, 'GET', '/admin.v1.WxGzhTag/GetWxGzhTagInfo', '公众号标签表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhTag/GetWxGzhTagList')::uuid, md5('sys_menu:/admin.v1.WxGzhTag/GetWxGzhTagList')::uuid, 'GET', '/admin.v1.WxGzhTag/GetWxGzhTagList', '公众号标签表-列表数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhTag/GetWxGzhTagSelector')::uuid, md5('sys_menu:/admin.v1.WxGzhTag/GetWxGzhTagSelector')::uuid, 'GET', '/admin.v1.WxGzhTag/GetWxGzhTagSelector', '公众号标签表-选择器', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 公众号粉丝表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.WxGzhUser')::uuid, '', '公众号粉丝表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhUser/DeleteWxGzhUser')::uuid, md5('sys_menu:admin.v1.WxGzhUser')::uuid::text, '公众号粉丝表-删除一条数据', 'button', '', 'wx_gzh_user:delete_wx_gzh_user', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhUser/GetWxGzhUserInfo')::uuid, md5('sys_menu:admin.v1.WxGzhUser')::uuid::text, '公众号粉丝表-单条数据查询', 'button', '', 'wx_gzh_user:get_wx_gzh_user_info', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxGzhUser/GetWxGzhUserList')::uuid, md5('sys_menu:admin.v1.WxGzhUser')::uuid::text, '公众号粉丝表-列表数据查询', 'button', '', 'wx_gzh_user:get_wx_gzh_user_list', NULL, NULL, NULL, 3, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.WxGzhUser/DeleteWxGzhUser')::uuid, md5('sys_menu:/admin.v1.WxGzhUser/DeleteWxGzhUser')::uuid, 'POST', '/admin.v1.WxGzhUser/DeleteWxGzhUser', '公众号粉丝表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhUser/GetWxGzhUserInfo')::uuid, md5('sys_menu:/admin.v1.WxGzhUser/GetWxGzhUserInfo')::uuid, 'GET', '/admin.v1.WxGzhUser/GetWxGzhUserInfo', '公众号粉丝表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxGzhUser/GetWxGzhUserList')::uuid, md5('sys_menu:/admin.v1.WxGzhUser/GetWxGzhUserList')::uuid, 'GET', '/admin.v1.WxGzhUser/GetWxGzhUserList', '公众号粉丝表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 小程序用户表
+INSERT INTO public.sys_menu (id, pid, name, type, path, permission, icon, component, component_name, sort, status, created_at, updated_at) VALUES
+(md5('sys_menu:admin.v1.WxXcxUser')::uuid, '', '小程序用户表', 'button', '', NULL, NULL, NULL, NULL, 0, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxXcxUser/DeleteWxXcxUser')::uuid, md5('sys_menu:admin.v1.WxXcxUser')::uuid::text, '小程序用户表-删除一条数据', 'button', '', 'wx_xcx_user:delete_wx_xcx_user', NULL, NULL, NULL, 1, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxXcxUser/GetWxXcxUserInfo')::uuid, md5('sys_menu:admin.v1.WxXcxUser')::uuid::text, '小程序用户表-单条数据查询', 'button', '', 'wx_xcx_user:get_wx_xcx_user_info', NULL, NULL, NULL, 2, 1, now(), now()),
+(md5('sys_menu:/admin.v1.WxXcxUser/GetWxXcxUserList')::uuid, md5('sys_menu:admin.v1.WxXcxUser')::uuid::text, '小程序用户表-列表数据查询', 'button', '', 'wx_xcx_user:get_wx_xcx_user_list', NULL, NULL, NULL, 3, 1, now(), now())
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.sys_api (id, permission_id, method, path, "desc", created_at, updated_at) VALUES
+(md5('sys_api:/admin.v1.WxXcxUser/DeleteWxXcxUser')::uuid, md5('sys_menu:/admin.v1.WxXcxUser/DeleteWxXcxUser')::uuid, 'POST', '/admin.v1.WxXcxUser/DeleteWxXcxUser', '小程序用户表-删除一条数据', now(), now()),
+(md5('sys_api:/admin.v1.WxXcxUser/GetWxXcxUserInfo')::uuid, md5('sys_menu:/admin.v1.WxXcxUser/GetWxXcxUserInfo')::uuid, 'GET', '/admin.v1.WxXcxUser/GetWxXcxUserInfo', '小程序用户表-单条数据查询', now(), now()),
+(md5('sys_api:/admin.v1.WxXcxUser/GetWxXcxUserList')::uuid, md5('sys_menu:/admin.v1.WxXcxUser/GetWxXcxUserList')::uuid, 'GET', '/admin.v1.WxXcxUser/GetWxXcxUserList', '小程序用户表-列表数据查询', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+-- 可选: 把全部按钮分配给已有角色(替换 <role_id>), 执行后删除 Redis 中的角色权限缓存(sys_role_permission)
+-- UPDATE public.sys_role SET "menuIds" = (
+--     SELECT jsonb_agg(DISTINCT menu_id) FROM (
+--         SELECT jsonb_array_elements_text(COALESCE(sys_role."menuIds", '[]'::jsonb)) AS menu_id
+--         UNION SELECT id::text FROM public.sys_menu WHERE type = 'button' AND deleted_at IS NULL
+--     ) t
+-- ), updated_at = now() WHERE id = '<role_id>';
@@ -0,0 +1,25 @@
+package data
+
+import (
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewAiAPICallLogRepo(
+	logger log.Logger,
+	data *Data,
+	aiAPICallLogRepo *ai_boilerplate_repo.AiAPICallLogRepo,
+) *AiAPICallLogRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/aiAPICallLog"))
+	return &AiAPICallLogRepo{
+		log:              l,
+		data:             data,
+		AiAPICallLogRepo: aiAPICallLogRepo,
+	}
+}
+
+type AiAPICallLogRepo struct {
+	log  *log.Helper
+	data *Data
+	*ai_boilerplate_repo.AiAPICallLogRepo
+}
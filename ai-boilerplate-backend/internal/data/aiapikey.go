@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/rueidis"
+)
+
+// 接口密钥前缀, 与 OpenAI 密钥格式保持一致
+const aiAPIKeyPrefix = "sk-"
+
+func NewAiAPIKeyRepo(
+	logger log.Logger,
+	data *Data,
+	aiAPIKeyRepo *ai_boilerplate_repo.AiAPIKeyRepo,
+) *AiAPIKeyRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/aiAPIKey"))
+	return &AiAPIKeyRepo{
+		log:          l,
+		data:         data,
+		AiAPIKeyRepo: aiAPIKeyRepo,
+	}
+}
+
+type AiAPIKeyRepo struct {
+	log  *log.Helper
+	data *Data
+	*ai_boilerplate_repo.AiAPIKeyRepo
+}
+
+// GenerateKey 生成接口密钥, 返回明文密钥、展示用前缀和密钥摘要
+// 明文密钥只在创建时返回一次, 数据库中只保存摘要
+func (r *AiAPIKeyRepo) GenerateKey() (key, keyPrefix, keyHash string, err error) {
+	randomBytes := make([]byte, 24)
+	if _, err = rand.Read(randomBytes); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	key = aiAPIKeyPrefix + hex.EncodeToString(randomBytes)
+	keyPrefix = key[:len(aiAPIKeyPrefix)+4] + "..." + key[len(key)-4:]
+	return key, keyPrefix, r.HashKey(key), nil
+}
+
+// HashKey 计算接口密钥摘要
+func (r *AiAPIKeyRepo) HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// TouchLastUsed 刷新最后使用时间, 每个密钥在刷新间隔内只写一次库, 避免每次调用都写库并清除缓存
+func (r *AiAPIKeyRepo) TouchLastUsed(ctx context.Context, data *ai_boilerplate_model.AiAPIKey) error {
+	err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(constant.AiAPIKeyLastUsed.Key(data.ID)).Value("1").Nx().Ex(constant.AiAPIKeyLastUsed.TTL()).Build()).Error()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil
+		}
+		return err
+	}
+	oldData := r.DeepCopy(data)
+	data.LastUsedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	return r.UpdateOneCache(ctx, data, oldData)
+}
@@ -1,6 +1,18 @@
 package data
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -11,10 +23,15 @@ func NewAiAudioRecordRepo(
 	aiAudioRecordRepo *ai_boilerplate_repo.AiAudioRecordRepo,
 ) *AiAudioRecordRepo {
 	l := log.NewHelper(log.With(logger, "module", "data/aiAudioRecord"))
+	httpClient := &http.Client{Timeout: time.Minute}
 	return &AiAudioRecordRepo{
 		log:               l,
 		data:              data,
 		AiAudioRecordRepo: aiAudioRecordRepo,
+		providers: map[string]AiAudioProvider{
+			constant.AiProviderPlatformSuno.String(): &AiAudioSunoProvider{httpClient: httpClient},
+		},
+		defaultProvider: &AiAudioSunoProvider{httpClient: httpClient},
 	}
 }
 
@@ -22,4 +39,216 @@ type AiAudioRecordRepo struct {
 	log  *log.Helper
 	data *Data
 	*ai_boilerplate_repo.AiAudioRecordRepo
+	mu              sync.RWMutex
+	providers       map[string]AiAudioProvider // 按平台编码注册的音乐生成平台
+	defaultProvider AiAudioProvider            // 未注册的平台按 Suno 兼容接口处理
+}
+
+// AiAudioProvider AI 音乐生成平台
+type AiAudioProvider interface {
+	// Submit 提交生成任务, 返回平台任务编号
+	Submit(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiAudioGenerateReq) (string, error)
+	// Query 查询生成任务
+	Query(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, taskID string) (*AiAudioGenerateResult, error)
+}
+
+// AiAudioGenerateMessage AI 音乐生成任务消息
+type AiAudioGenerateMessage struct {
+	ID           string `json:"id"`           // 音乐记录编号
+	Instrumental bool   `json:"instrumental"` // 是否纯音乐
+}
+
+// AiAudioGenerateReq AI 音乐生成参数
+type AiAudioGenerateReq struct {
+	Model        string                       // 平台模型标识
+	Mode         constant.AiAudioGenerateMode // 生成模式
+	Title        string                       // 音乐名称(歌词模式)
+	Description  string                       // 描述词(描述模式)
+	Lyric        string                       // 歌词(歌词模式)
+	Tags         string                       // 风格标签(歌词模式)
+	Instrumental bool                         // 是否纯音乐
+}
+
+// AiAudioGenerateResult AI 音乐生成结果
+type AiAudioGenerateResult struct {
+	Status       constant.AiAudioStatus // 任务状态: 生成中、成功、失败
+	Title        string                 // 音乐名称
+	Lyric        string                 // 歌词
+	Tags         string                 // 风格标签
+	ImageURL     string                 // 封面地址
+	AudioURL     string                 // 音频地址
+	Duration     float64                // 时长(秒)
+	ErrorMessage string                 // 错误信息
+}
+
+// SetProvider 设置平台对应的音乐生成平台(加锁, 可在运行中替换), 可用于替换为 AiAudioFakeProvider 等本地实现
+func (r *AiAudioRecordRepo) SetProvider(platform string, provider AiAudioProvider) {
+	r.mu.Lock()
+	r.providers[platform] = provider
+	r.mu.Unlock()
+}
+
+// GetProvider 获取平台对应的音乐生成平台
+func (r *AiAudioRecordRepo) GetProvider(platform string) AiAudioProvider {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if provider, ok := r.providers[platform]; ok {
+		return provider
+	}
+	return r.defaultProvider
+}
+
+// SendGenerateTask 投递 AI 音乐生成任务
+func (r *AiAudioRecordRepo) SendGenerateTask(ctx context.Context, id string, instrumental bool) error {
+	payload, err := json.Marshal(&AiAudioGenerateMessage{ID: id, Instrumental: instrumental})
+	if err != nil {
+		return err
+	}
+	return r.data.MQClient.SendMessage(ctx, constant.MQAiAudioGenerate, payload)
+}
+
+// AiAudioSunoProvider Suno 兼容接口(POST /api/generate、/api/custom_generate, GET /api/get)
+type AiAudioSunoProvider struct {
+	httpClient *http.Client
+}
+
+// aiAudioSunoClip Suno 兼容接口生成的歌曲
+type aiAudioSunoClip struct {
+	ID           string  `json:"id"`
+	Title        string  `json:"title"`
+	ImageURL     string  `json:"image_url"`
+	Lyric        string  `json:"lyric"`
+	AudioURL     string  `json:"audio_url"`
+	Status       string  `json:"status"`
+	Tags         string  `json:"tags"`
+	Duration     float64 `json:"duration"`
+	ErrorMessage string  `json:"error_message"`
+}
+
+// apiURL Suno 兼容接口地址
+func (p *AiAudioSunoProvider) apiURL(platform *ai_boilerplate_model.AiProviderPlatform) (string, error) {
+	if platform.APIURL == "" {
+		return "", fmt.Errorf("platform %s api url is empty", platform.Platform)
+	}
+	return strings.TrimRight(platform.APIURL, "/"), nil
+}
+
+// Submit 提交生成任务, 每次生成返回多首歌曲, 取第一首的编号作为任务编号
+func (p *AiAudioSunoProvider) Submit(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiAudioGenerateReq) (string, error) {
+	apiURL, err := p.apiURL(platform)
+	if err != nil {
+		return "", err
+	}
+	var body map[string]any
+	switch req.Mode {
+	case constant.AiAudioGenerateModeDescription:
+		apiURL += "/api/generate"
+		body = map[string]any{
+			"prompt":            req.Description,
+			"make_instrumental": req.Instrumental,
+			"model":             req.Model,
+			"wait_audio":        false,
+		}
+	case constant.AiAudioGenerateModeLyric:
+		apiURL += "/api/custom_generate"
+		body = map[string]any{
+			"prompt":            req.Lyric,
+			"tags":              req.Tags,
+			"title":             req.Title,
+			"make_instrumental": req.Instrumental,
+			"model":             req.Model,
+			"wait_audio":        false,
+		}
+	default:
+		return "", fmt.Errorf("generate mode %d is not supported", req.Mode)
+	}
+	reply := make([]*aiAudioSunoClip, 0)
+	err = aiPlatformDoJSON(ctx, p.httpClient, http.MethodPost, apiURL, platform.APIKey, nil, body, &reply)
+	if err != nil {
+		return "", err
+	}
+	if len(reply) == 0 || reply[0].ID == "" {
+		return "", errors.New("suno clip is empty")
+	}
+	return reply[0].ID, nil
+}
+
+// Query 查询生成任务
+func (p *AiAudioSunoProvider) Query(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, taskID string) (*AiAudioGenerateResult, error) {
+	apiURL, err := p.apiURL(platform)
+	if err != nil {
+		return nil, err
+	}
+	reply := make([]*aiAudioSunoClip, 0)
+	err = aiPlatformDoJSON(ctx, p.httpClient, http.MethodGet, apiURL+"/api/get?ids="+url.QueryEscape(taskID), platform.APIKey, nil, nil, &reply)
+	if err != nil {
+		return nil, err
+	}
+	if len(reply) == 0 {
+		return nil, fmt.Errorf("suno clip %s is not found", taskID)
+	}
+	clip := reply[0]
+	result := &AiAudioGenerateResult{
+		Status:   constant.AiAudioStatusRunning,
+		Title:    clip.Title,
+		Lyric:    clip.Lyric,
+		Tags:     clip.Tags,
+		ImageURL: clip.ImageURL,
+		AudioURL: clip.AudioURL,
+		Duration: clip.Duration,
+	}
+	switch clip.Status {
+	case "complete":
+		result.Status = constant.AiAudioStatusSucceeded
+	case "error":
+		result.Status = constant.AiAudioStatusFailed
+		result.ErrorMessage = clip.ErrorMessage
+	}
+	return result, nil
+}
+
+// AiAudioFakeProvider 本地模拟的音乐生成平台, 提交后立即生成完成, 不调用外部接口
+type AiAudioFakeProvider struct {
+	mu    sync.Mutex
+	tasks map[string]*AiAudioGenerateReq
+}
+
+// NewAiAudioFakeProvider 创建本地模拟的音乐生成平台
+func NewAiAudioFakeProvider() *AiAudioFakeProvider {
+	return &AiAudioFakeProvider{
+		tasks: make(map[string]*AiAudioGenerateReq),
+	}
+}
+
+// Submit 提交生成任务
+func (p *AiAudioFakeProvider) Submit(_ context.Context, _ *ai_boilerplate_model.AiProviderPlatform, req *AiAudioGenerateReq) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	taskID := fmt.Sprintf("fake-%d", len(p.tasks)+1)
+	p.tasks[taskID] = req
+	return taskID, nil
+}
+
+// Query 查询生成任务
+func (p *AiAudioFakeProvider) Query(_ context.Context, _ *ai_boilerplate_model.AiProviderPlatform, taskID string) (*AiAudioGenerateResult, error) {
+	p.mu.Lock()
+	req, ok := p.tasks[taskID]
+	p.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("fake task %s is not found", taskID)
+	}
+	result := &AiAudioGenerateResult{
+		Status:   constant.AiAudioStatusSucceeded,
+		Title:    req.Title,
+		Lyric:    req.Lyric,
+		Tags:     req.Tags,
+		ImageURL: "https://example.com/" + taskID + ".png",
+		AudioURL: "https://example.com/" + taskID + ".mp3",
+		Duration: 30,
+	}
+	if req.Mode == constant.AiAudioGenerateModeDescription {
+		result.Title = "Fake Song"
+		result.Lyric = req.Description
+	}
+	return result, nil
 }
@@ -1,8 +1,29 @@
 package data
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/volcengine/volcengine-go-sdk/service/arkruntime"
+	arkmodel "github.com/volcengine/volcengine-go-sdk/service/arkruntime/model"
+)
+
+const (
+	// 阿里云百炼异步任务轮询间隔
+	aiImageDashScopePollInterval = 3 * time.Second
+	// 单次绘画的最长等待时间(含异步任务轮询与图片下载)
+	aiImageGenerateTimeout = 5 * time.Minute
 )
 
 func NewAiImageRecordRepo(
@@ -15,6 +36,7 @@ func NewAiImageRecordRepo(
 		log:               l,
 		data:              data,
 		AiImageRecordRepo: aiImageRecordRepo,
+		httpClient:        &http.Client{Timeout: aiImageGenerateTimeout},
 	}
 }
 
@@ -22,4 +44,248 @@ type AiImageRecordRepo struct {
 	log  *log.Helper
 	data *Data
 	*ai_boilerplate_repo.AiImageRecordRepo
+	httpClient *http.Client
+}
+
+// AiImageGenerateMessage AI 绘画生成任务消息
+type AiImageGenerateMessage struct {
+	ID string `json:"id"` // 绘画记录编号
+}
+
+// AiImageGenerateReq AI 绘画生成参数
+type AiImageGenerateReq struct {
+	Model   string         // 平台模型标识
+	Prompt  string         // 提示词
+	Width   int32          // 图片宽度
+	Height  int32          // 图片高度
+	Options map[string]any // 绘制参数,透传给模型平台
+}
+
+// AiImageGenerateResult AI 绘画生成结果
+type AiImageGenerateResult struct {
+	TaskID      string             // 平台任务编号(异步平台)
+	Image       []byte             // 图片内容
+	ContentType string             // 图片类型
+	Usage       *schema.TokenUsage // Token 用量,平台未返回时为空
+}
+
+// SendGenerateTask 投递 AI 绘画生成任务
+func (r *AiImageRecordRepo) SendGenerateTask(ctx context.Context, id string) error {
+	payload, err := json.Marshal(&AiImageGenerateMessage{ID: id})
+	if err != nil {
+		return err
+	}
+	return r.data.MQClient.SendMessage(ctx, constant.MQAiImageGenerate, payload)
+}
+
+// GenerateImage 调用平台生成图片并下载图片内容
+// 火山引擎方舟使用 SDK, 阿里云百炼使用原生异步接口并轮询结果, 其余平台按 OpenAI 兼容接口处理
+func (r *AiImageRecordRepo) GenerateImage(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiImageGenerateReq) (*AiImageGenerateResult, error) {
+	if platform == nil || platform.ID == "" {
+		return nil, errors.New("platform is empty")
+	}
+	ctx, cancel := context.WithTimeout(ctx, aiImageGenerateTimeout)
+	defer cancel()
+	platformCode, _ := constant.ParseAiProviderPlatform(platform.Platform)
+	var (
+		result *AiImageGenerateResult
+		imgURL string
+		b64    string
+		err    error
+	)
+	switch platformCode {
+	case constant.AiProviderPlatformVolcengine:
+		result, imgURL, b64, err = r.generateArkImage(ctx, platform, req)
+	case constant.AiProviderPlatformAliyun:
+		result, imgURL, err = r.generateDashScopeImage(ctx, platform, req)
+	default:
+		result, imgURL, b64, err = r.generateOpenAIImage(ctx, platform, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if b64 != "" {
+		result.Image, err = base64.StdEncoding.DecodeString(b64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode image: %w", err)
+		}
+		result.ContentType = http.DetectContentType(result.Image)
+		return result, nil
+	}
+	if imgURL == "" {
+		return nil, errors.New("image is empty")
+	}
+	result.Image, result.ContentType, err = aiPlatformDownload(ctx, r.httpClient, imgURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// generateArkImage 火山引擎方舟文生图
+func (r *AiImageRecordRepo) generateArkImage(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiImageGenerateReq) (*AiImageGenerateResult, string, string, error) {
+	body := aiPlatformMergeOptions(map[string]any{
+		"model":  req.Model,
+		"prompt": req.Prompt,
+		"size":   fmt.Sprintf("%dx%d", req.Width, req.Height),
+	}, req.Options)
+	arkReq := arkmodel.GenerateImagesRequest{}
+	err := aiPlatformConvert(body, &arkReq)
+	if err != nil {
+		return nil, "", "", err
+	}
+	opts := make([]arkruntime.ConfigOption, 0)
+	if platform.APIURL != "" {
+		opts = append(opts, arkruntime.WithBaseUrl(platform.APIURL))
+	}
+	client := arkruntime.NewClientWithApiKey(platform.APIKey, opts...)
+	resp, err := client.GenerateImages(ctx, arkReq)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to generate ark image: %w", err)
+	}
+	if resp.Error != nil {
+		return nil, "", "", fmt.Errorf("failed to generate ark image: %s %s", resp.Error.Code, resp.Error.Message)
+	}
+	if len(resp.Data) == 0 || resp.Data[0] == nil {
+		return nil, "", "", errors.New("ark image is empty")
+	}
+	result := &AiImageGenerateResult{}
+	if resp.Usage != nil {
+		result.Usage = &schema.TokenUsage{
+			CompletionTokens: int(resp.Usage.OutputTokens),
+			TotalTokens:      int(resp.Usage.TotalTokens),
+		}
+	}
+	var imgURL, b64 string
+	if resp.Data[0].Url != nil {
+		imgURL = *resp.Data[0].Url
+	}
+	if resp.Data[0].B64Json != nil {
+		b64 = *resp.Data[0].B64Json
+	}
+	return result, imgURL, b64, nil
+}
+
+// aiImageOpenAIReply OpenAI 兼容接口文生图响应
+type aiImageOpenAIReply struct {
+	Data []struct {
+		URL     string `json:"url"`
+		B64JSON string `json:"b64_json"`
+	} `json:"data"`
+	Usage *struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+		TotalTokens  int `json:"total_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Code    any    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// generateOpenAIImage OpenAI 兼容接口文生图
+func (r *AiImageRecordRepo) generateOpenAIImage(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiImageGenerateReq) (*AiImageGenerateResult, string, string, error) {
+	platformCode, _ := constant.ParseAiProviderPlatform(platform.Platform)
+	apiURL := platform.APIURL
+	if apiURL == "" {
+		apiURL = aiPlatformDefaultAPIURL[platformCode]
+	}
+	if apiURL == "" {
+		return nil, "", "", fmt.Errorf("platform %s api url is empty", platform.Platform)
+	}
+	body := aiPlatformMergeOptions(map[string]any{
+		"model":  req.Model,
+		"prompt": req.Prompt,
+		"size":   fmt.Sprintf("%dx%d", req.Width, req.Height),
+		"n":      1,
+	}, req.Options)
+	reply := &aiImageOpenAIReply{}
+	err := aiPlatformDoJSON(ctx, r.httpClient, http.MethodPost, strings.TrimRight(apiURL, "/")+"/images/generations", platform.APIKey, nil, body, reply)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if reply.Error != nil {
+		return nil, "", "", fmt.Errorf("failed to generate image: %v %s", reply.Error.Code, reply.Error.Message)
+	}
+	if len(reply.Data) == 0 {
+		return nil, "", "", errors.New("image is empty")
+	}
+	result := &AiImageGenerateResult{}
+	if reply.Usage != nil {
+		result.Usage = &schema.TokenUsage{
+			PromptTokens:     reply.Usage.InputTokens,
+			CompletionTokens: reply.Usage.OutputTokens,
+			TotalTokens:      reply.Usage.TotalTokens,
+		}
+	}
+	return result, reply.Data[0].URL, reply.Data[0].B64JSON, nil
+}
+
+// aiImageDashScopeReply 阿里云百炼文生图异步任务响应
+type aiImageDashScopeReply struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Output  struct {
+		TaskID     string `json:"task_id"`
+		TaskStatus string `json:"task_status"`
+		Code       string `json:"code"`
+		Message    string `json:"message"`
+		Results    []struct {
+			URL     string `json:"url"`
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"results"`
+	} `json:"output"`
+}
+
+// generateDashScopeImage 阿里云百炼文生图: 创建异步任务后轮询任务结果
+func (r *AiImageRecordRepo) generateDashScopeImage(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiImageGenerateReq) (*AiImageGenerateResult, string, error) {
+	apiURL := aiPlatformDashScopeAPIURL(platform)
+	body := map[string]any{
+		"model": req.Model,
+		"input": map[string]any{
+			"prompt": req.Prompt,
+		},
+		"parameters": aiPlatformMergeOptions(map[string]any{
+			"size": fmt.Sprintf("%d*%d", req.Width, req.Height),
+			"n":    1,
+		}, req.Options),
+	}
+	reply := &aiImageDashScopeReply{}
+	header := map[string]string{"X-DashScope-Async": "enable"}
+	err := aiPlatformDoJSON(ctx, r.httpClient, http.MethodPost, apiURL+"/services/aigc/text2image/image-synthesis", platform.APIKey, header, body, reply)
+	if err != nil {
+		return nil, "", err
+	}
+	if reply.Output.TaskID == "" {
+		return nil, "", fmt.Errorf("failed to create dashscope task: %s %s", reply.Code, reply.Message)
+	}
+	result := &AiImageGenerateResult{TaskID: reply.Output.TaskID}
+	ticker := time.NewTicker(aiImageDashScopePollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, "", fmt.Errorf("dashscope task %s timeout: %w", result.TaskID, ctx.Err())
+		case <-ticker.C:
+		}
+		reply = &aiImageDashScopeReply{}
+		err = aiPlatformDoJSON(ctx, r.httpClient, http.MethodGet, apiURL+"/tasks/"+result.TaskID, platform.APIKey, nil, nil, reply)
+		if err != nil {
+			return nil, "", err
+		}
+		switch reply.Output.TaskStatus {
+		case "PENDING", "RUNNING":
+			continue
+		case "SUCCEEDED":
+			for _, v := range reply.Output.Results {
+				if v.URL != "" {
+					return result, v.URL, nil
+				}
+			}
+			return nil, "", fmt.Errorf("dashscope task %s image is empty", result.TaskID)
+		default:
+			return nil, "", fmt.Errorf("dashscope task %s %s: %s %s", result.TaskID, reply.Output.TaskStatus, reply.Output.Code, reply.Output.Message)
+		}
+	}
 }
@@ -1,7 +1,15 @@
 package data
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/goutil/jsonutil"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -23,3 +31,79 @@ type AiProviderModelRepo struct {
 	data *Data
 	*ai_boilerplate_repo.AiProviderModelRepo
 }
+
+// AiProviderModelConfig 模型配置中的默认生成参数
+type AiProviderModelConfig struct {
+	Temperature *float32 `json:"temperature,omitempty"` // 采样温度
+	TopP        *float32 `json:"top_p,omitempty"`       // 核采样概率阈值
+	MaxTokens   *int     `json:"max_tokens,omitempty"`  // 最大输出长度
+	Stop        []string `json:"stop,omitempty"`        // 停止词
+}
+
+// ChatModelOptions 将模型标识与模型配置转换为聊天模型调用参数
+func (r *AiProviderModelRepo) ChatModelOptions(data *ai_boilerplate_model.AiProviderModel) ([]model.Option, error) {
+	opts := []model.Option{model.WithModel(data.ModelID)}
+	if len(data.ModelConfig) == 0 {
+		return opts, nil
+	}
+	config := &AiProviderModelConfig{}
+	err := jsonutil.Unmarshal(data.ModelConfig, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal model config: %w", err)
+	}
+	if config.Temperature != nil {
+		opts = append(opts, model.WithTemperature(*config.Temperature))
+	}
+	if config.TopP != nil {
+		opts = append(opts, model.WithTopP(*config.TopP))
+	}
+	if config.MaxTokens != nil {
+		opts = append(opts, model.WithMaxTokens(*config.MaxTokens))
+	}
+	if len(config.Stop) > 0 {
+		opts = append(opts, model.WithStop(config.Stop))
+	}
+	return opts, nil
+}
+
+// FindOneCacheEnableByModelID 根据模型标识查询租户下启用的模型, 存在多个时取排序最靠前的一个
+func (r *AiProviderModelRepo) FindOneCacheEnableByModelID(ctx context.Context, tenantID, modelID string) (*ai_boilerplate_model.AiProviderModel, error) {
+	param := &condition.Req{
+		Page:     1,
+		PageSize: 1,
+		Query: []*condition.QueryParam{
+			{
+				Field: "tenant_id",
+				Value: tenantID,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+			{
+				Field: "model_id",
+				Value: modelID,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+			{
+				Field: "status",
+				Value: int32(constant.StatusEnable),
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+		},
+		Order: []*condition.OrderParam{
+			{
+				Field: "sort",
+				Order: condition.ASC,
+			},
+		},
+	}
+	list, _, err := r.FindMultiCacheByCondition(ctx, param)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find provider model: %w", err)
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
@@ -1,10 +1,37 @@
 package data
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino-ext/components/model/ark"
+	"github.com/cloudwego/eino-ext/components/model/openai"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// 各平台未配置 API 地址时使用的默认地址(均为 OpenAI 兼容接口)
+var aiPlatformDefaultAPIURL = map[constant.AiProviderPlatform]string{
+	constant.AiProviderPlatformOpenai:   "https://api.openai.com/v1",
+	constant.AiProviderPlatformDeepseek: "https://api.deepseek.com",
+	constant.AiProviderPlatformAliyun:   "https://dashscope.aliyuncs.com/compatible-mode/v1",
+	constant.AiProviderPlatformOllama:   "http://localhost:11434/v1",
+}
+
+// 阿里云百炼(DashScope)原生接口默认地址, 文生图、文生视频仅支持原生异步接口
+const aiPlatformDashScopeDefaultAPIURL = "https://dashscope.aliyuncs.com/api/v1"
+
 func NewAiProviderPlatformRepo(
 	logger log.Logger,
 	data *Data,
@@ -15,6 +42,7 @@ func NewAiProviderPlatformRepo(
 		log:                    l,
 		data:                   data,
 		AiProviderPlatformRepo: aiProviderPlatformRepo,
+		chatModels:             make(map[string]*aiChatModelEntry),
 	}
 }
 
@@ -22,4 +50,180 @@ type AiProviderPlatformRepo struct {
 	log  *log.Helper
 	data *Data
 	*ai_boilerplate_repo.AiProviderPlatformRepo
+	mu         sync.RWMutex
+	chatModels map[string]*aiChatModelEntry // 按平台编号缓存的聊天模型客户端
+}
+
+// aiChatModelEntry 缓存的聊天模型客户端
+type aiChatModelEntry struct {
+	fingerprint string // 平台配置指纹,平台被修改后指纹变化会触发重建
+	chatModel   model.ToolCallingChatModel
+}
+
+// GetChatModel 获取平台对应的聊天模型客户端,平台配置变化时重建,平台禁用时移除
+func (r *AiProviderPlatformRepo) GetChatModel(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform) (model.ToolCallingChatModel, error) {
+	if platform == nil || platform.ID == "" {
+		return nil, errors.New("platform is empty")
+	}
+	if platform.Status != int32(constant.StatusEnable) {
+		r.RemoveChatModel(platform.ID)
+		return nil, fmt.Errorf("platform %s is disabled", platform.ID)
+	}
+	fingerprint := aiPlatformFingerprint(platform)
+	r.mu.RLock()
+	entry, ok := r.chatModels[platform.ID]
+	r.mu.RUnlock()
+	if ok && entry.fingerprint == fingerprint {
+		return entry.chatModel, nil
+	}
+	chatModel, err := newAiChatModel(ctx, platform)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.chatModels[platform.ID] = &aiChatModelEntry{
+		fingerprint: fingerprint,
+		chatModel:   chatModel,
+	}
+	r.mu.Unlock()
+	return chatModel, nil
+}
+
+// RemoveChatModel 移除平台缓存的聊天模型客户端
+func (r *AiProviderPlatformRepo) RemoveChatModel(platformID string) {
+	r.mu.Lock()
+	delete(r.chatModels, platformID)
+	r.mu.Unlock()
+}
+
+// aiPlatformFingerprint 平台配置指纹
+func aiPlatformFingerprint(platform *ai_boilerplate_model.AiProviderPlatform) string {
+	return strings.Join([]string{
+		platform.Platform,
+		platform.APIURL,
+		platform.APIKey,
+		platform.UpdatedAt.Format(time.RFC3339Nano),
+	}, "|")
+}
+
+// newAiChatModel 根据平台编码创建聊天模型客户端
+func newAiChatModel(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform) (model.ToolCallingChatModel, error) {
+	platformCode, err := constant.ParseAiProviderPlatform(platform.Platform)
+	if err != nil && platform.APIURL == "" {
+		return nil, fmt.Errorf("platform %s is not supported: %w", platform.Platform, err)
+	}
+	if platformCode == constant.AiProviderPlatformVolcengine {
+		chatModel, err := ark.NewChatModel(ctx, &ark.ChatModelConfig{
+			BaseURL: platform.APIURL,
+			APIKey:  platform.APIKey,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create ark chat model: %w", err)
+		}
+		return chatModel, nil
+	}
+	// 其余平台及未知平台均按 OpenAI 兼容接口处理
+	apiURL := platform.APIURL
+	if apiURL == "" {
+		apiURL = aiPlatformDefaultAPIURL[platformCode]
+	}
+	chatModel, err := openai.NewChatModel(ctx, &openai.ChatModelConfig{
+		BaseURL: apiURL,
+		APIKey:  platform.APIKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create openai chat model: %w", err)
+	}
+	return chatModel, nil
+}
+
+// aiPlatformDashScopeAPIURL 阿里云百炼原生接口地址
+// 平台配置的通常是 OpenAI 兼容地址, 转换为同域名下的原生接口地址
+func aiPlatformDashScopeAPIURL(platform *ai_boilerplate_model.AiProviderPlatform) string {
+	if platform.APIURL == "" {
+		return aiPlatformDashScopeDefaultAPIURL
+	}
+	return strings.Replace(strings.TrimRight(platform.APIURL, "/"), "/compatible-mode/v1", "/api/v1", 1)
+}
+
+// aiPlatformDoJSON 调用平台原生 HTTP 接口: 发送 JSON 请求并解析响应, 非 2xx 响应返回错误
+func aiPlatformDoJSON(ctx context.Context, client *http.Client, method, url, apiKey string, header map[string]string, body, reply any) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("request %s failed: status %d, body %s", url, resp.StatusCode, string(respBody))
+	}
+	return json.Unmarshal(respBody, reply)
+}
+
+// aiPlatformDownload 下载平台生成的文件, 平台文件地址通常有时效, 需要转存
+func aiPlatformDownload(ctx context.Context, client *http.Client, url string, header map[string]string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, "", err
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download file: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to download file: status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download file: %w", err)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(body)
+	}
+	return body, contentType, nil
+}
+
+// aiPlatformMergeOptions 合并生成参数, 生成参数不能覆盖已有字段
+func aiPlatformMergeOptions(body, options map[string]any) map[string]any {
+	for k, v := range options {
+		if _, ok := body[k]; ok {
+			continue
+		}
+		body[k] = v
+	}
+	return body
+}
+
+// aiPlatformConvert 通过 JSON 转换请求结构
+func aiPlatformConvert(from, to any) error {
+	b, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, to)
 }
@@ -0,0 +1,25 @@
+package data
+
+import (
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewAiTokenQuotaRepo(
+	logger log.Logger,
+	data *Data,
+	aiTokenQuotaRepo *ai_boilerplate_repo.AiTokenQuotaRepo,
+) *AiTokenQuotaRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/aiTokenQuota"))
+	return &AiTokenQuotaRepo{
+		log:              l,
+		data:             data,
+		AiTokenQuotaRepo: aiTokenQuotaRepo,
+	}
+}
+
+type AiTokenQuotaRepo struct {
+	log  *log.Helper
+	data *Data
+	*ai_boilerplate_repo.AiTokenQuotaRepo
+}
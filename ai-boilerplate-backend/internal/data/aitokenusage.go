@@ -0,0 +1,205 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/dromara/carbon/v2"
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_dao"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/rueidis"
+	"gorm.io/gen/field"
+)
+
+// ErrAiTokenUsageGroupByInvalid 用量统计维度无效
+var ErrAiTokenUsageGroupByInvalid = errors.New("ai token usage group by is invalid")
+
+func NewAiTokenUsageRepo(
+	logger log.Logger,
+	data *Data,
+	aiTokenUsageRepo *ai_boilerplate_repo.AiTokenUsageRepo,
+	aiTokenQuotaRepo *ai_boilerplate_repo.AiTokenQuotaRepo,
+) *AiTokenUsageRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/aiTokenUsage"))
+	return &AiTokenUsageRepo{
+		log:              l,
+		data:             data,
+		aiTokenQuotaRepo: aiTokenQuotaRepo,
+		AiTokenUsageRepo: aiTokenUsageRepo,
+	}
+}
+
+type AiTokenUsageRepo struct {
+	log              *log.Helper
+	data             *Data
+	aiTokenQuotaRepo *ai_boilerplate_repo.AiTokenQuotaRepo
+	*ai_boilerplate_repo.AiTokenUsageRepo
+}
+
+// AiTokenUsageReportItem Token 用量统计项
+type AiTokenUsageReportItem struct {
+	ID               string `gorm:"column:id"`
+	PromptTokens     int64  `gorm:"column:prompt_tokens"`
+	CompletionTokens int64  `gorm:"column:completion_tokens"`
+	TotalTokens      int64  `gorm:"column:total_tokens"`
+	Count            int64  `gorm:"column:count"`
+}
+
+// RecordUsage 记录一次模型调用的 Token 用量, 并累加租户当日与当月的用量计数
+// 模型未返回用量时不记录
+func (r *AiTokenUsageRepo) RecordUsage(
+	ctx context.Context,
+	tenantID, adminID string,
+	scene constant.AiTokenUsageScene,
+	providerModel *ai_boilerplate_model.AiProviderModel,
+	bizID string,
+	usage *schema.TokenUsage,
+) error {
+	if usage == nil || usage.TotalTokens == 0 {
+		return nil
+	}
+	data := r.NewData()
+	data.TenantID = tenantID
+	data.AdminID = adminID
+	data.Scene = scene.String()
+	data.BizID = bizID
+	data.PromptTokens = int32(usage.PromptTokens)
+	data.CompletionTokens = int32(usage.CompletionTokens)
+	data.TotalTokens = int32(usage.TotalTokens)
+	if providerModel != nil {
+		data.ModelID = providerModel.ID
+		data.Model = providerModel.ModelID
+	}
+	err := r.CreateOne(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to create token usage: %w", err)
+	}
+	now := carbon.Now().StdTime()
+	dailyKey := constant.AiTokenUsageDaily.Key(tenantID, now.Format(time.DateOnly))
+	monthlyKey := constant.AiTokenUsageMonthly.Key(tenantID, now.Format("2006-01"))
+	results := r.data.rueidis.DoMulti(ctx,
+		r.data.rueidis.B().Incrby().Key(dailyKey).Increment(int64(usage.TotalTokens)).Build(),
+		r.data.rueidis.B().Expire().Key(dailyKey).Seconds(int64(constant.AiTokenUsageDaily.TTL().Seconds())).Build(),
+		r.data.rueidis.B().Incrby().Key(monthlyKey).Increment(int64(usage.TotalTokens)).Build(),
+		r.data.rueidis.B().Expire().Key(monthlyKey).Seconds(int64(constant.AiTokenUsageMonthly.TTL().Seconds())).Build(),
+	)
+	for _, result := range results {
+		if result.Error() != nil {
+			return fmt.Errorf("failed to incr token usage: %w", result.Error())
+		}
+	}
+	return nil
+}
+
+// GetUsedTokens 获取租户当日与当月已使用的 Token 数
+func (r *AiTokenUsageRepo) GetUsedTokens(ctx context.Context, tenantID string) (daily, monthly int64, err error) {
+	now := carbon.Now()
+	daily, err = r.getUsedTokens(ctx, constant.AiTokenUsageDaily.Key(tenantID, now.StdTime().Format(time.DateOnly)), constant.AiTokenUsageDaily.TTL(), tenantID, now.StartOfDay().StdTime())
+	if err != nil {
+		return 0, 0, err
+	}
+	monthly, err = r.getUsedTokens(ctx, constant.AiTokenUsageMonthly.Key(tenantID, now.StdTime().Format("2006-01")), constant.AiTokenUsageMonthly.TTL(), tenantID, now.StartOfMonth().StdTime())
+	if err != nil {
+		return 0, 0, err
+	}
+	return daily, monthly, nil
+}
+
+// getUsedTokens 读取用量计数, 计数不存在时(如缓存被清理)从用量表汇总回填
+func (r *AiTokenUsageRepo) getUsedTokens(ctx context.Context, cacheKey string, ttl time.Duration, tenantID string, start time.Time) (int64, error) {
+	used, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Get().Key(cacheKey).Build()).AsInt64()
+	if err == nil {
+		return used, nil
+	}
+	if !rueidis.IsRedisNil(err) {
+		return 0, fmt.Errorf("failed to get token usage: %w", err)
+	}
+	dao := ai_boilerplate_dao.Use(r.data.gorm).AiTokenUsage
+	var result struct {
+		TotalTokens int64 `gorm:"column:total_tokens"`
+	}
+	err = dao.WithContext(ctx).Select(dao.TotalTokens.Sum().IfNull(0).As("total_tokens")).Where(dao.TenantID.Eq(tenantID), dao.CreatedAt.Gte(start)).Scan(&result)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum token usage: %w", err)
+	}
+	// 只在计数不存在时回填, 避免覆盖并发累加的结果
+	err = r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(cacheKey).Value(strconv.FormatInt(result.TotalTokens, 10)).Nx().Ex(ttl).Build()).Error()
+	if err != nil && !rueidis.IsRedisNil(err) {
+		return 0, fmt.Errorf("failed to set token usage: %w", err)
+	}
+	return result.TotalTokens, nil
+}
+
+// CheckTokenQuota 校验租户的 Token 配额, 未配置或已禁用的配额不限制
+func (r *AiTokenUsageRepo) CheckTokenQuota(ctx context.Context, tenantID string) error {
+	quota, err := r.aiTokenQuotaRepo.FindOneCacheByTenantID(ctx, tenantID)
+	if err != nil {
+		return pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if quota == nil || quota.ID == "" || quota.Status != int32(constant.StatusEnable) {
+		return nil
+	}
+	if quota.DailyLimit <= 0 && quota.MonthlyLimit <= 0 {
+		return nil
+	}
+	daily, monthly, err := r.GetUsedTokens(ctx, tenantID)
+	if err != nil {
+		return pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	if quota.DailyLimit > 0 && daily >= quota.DailyLimit {
+		return pb.ErrorReasonAiTokenQuotaExceeded(pb.WithFmtMsg(fmt.Sprintf("今日 Token 用量已达上限%d", quota.DailyLimit)))
+	}
+	if quota.MonthlyLimit > 0 && monthly >= quota.MonthlyLimit {
+		return pb.ErrorReasonAiTokenQuotaExceeded(pb.WithFmtMsg(fmt.Sprintf("本月 Token 用量已达上限%d", quota.MonthlyLimit)))
+	}
+	return nil
+}
+
+// GetUsageReport 按租户、用户或模型汇总 Token 用量, tenantID 为空时统计全部租户
+func (r *AiTokenUsageRepo) GetUsageReport(ctx context.Context, groupBy, tenantID, scene string, start, end time.Time) ([]*AiTokenUsageReportItem, error) {
+	result := make([]*AiTokenUsageReportItem, 0)
+	dao := ai_boilerplate_dao.Use(r.data.gorm).AiTokenUsage
+	var groupField field.String
+	switch groupBy {
+	case "tenant":
+		groupField = dao.TenantID
+	case "admin":
+		groupField = dao.AdminID
+	case "model":
+		groupField = dao.ModelID
+	default:
+		return nil, ErrAiTokenUsageGroupByInvalid
+	}
+	query := dao.WithContext(ctx).Select(
+		groupField.As("id"),
+		dao.PromptTokens.Sum().As("prompt_tokens"),
+		dao.CompletionTokens.Sum().As("completion_tokens"),
+		dao.TotalTokens.Sum().As("total_tokens"),
+		dao.ID.Count().As("count"),
+	)
+	if tenantID != "" {
+		query = query.Where(dao.TenantID.Eq(tenantID))
+	}
+	if scene != "" {
+		query = query.Where(dao.Scene.Eq(scene))
+	}
+	if !start.IsZero() {
+		query = query.Where(dao.CreatedAt.Gte(start))
+	}
+	if !end.IsZero() {
+		query = query.Where(dao.CreatedAt.Lte(end))
+	}
+	err := query.Group(groupField).Order(field.NewField("", "total_tokens").Desc()).Scan(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token usage report: %w", err)
+	}
+	return result, nil
+}
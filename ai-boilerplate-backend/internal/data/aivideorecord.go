@@ -1,10 +1,28 @@
 package data
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_dao"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/rueidis"
+	"github.com/volcengine/volcengine-go-sdk/service/arkruntime"
+	arkmodel "github.com/volcengine/volcengine-go-sdk/service/arkruntime/model"
 )
 
+// 视频文件下载的最长等待时间
+const aiVideoDownloadTimeout = 5 * time.Minute
+
 func NewAiVideoRecordRepo(
 	logger log.Logger,
 	data *Data,
@@ -15,6 +33,7 @@ func NewAiVideoRecordRepo(
 		log:               l,
 		data:              data,
 		AiVideoRecordRepo: aiVideoRecordRepo,
+		httpClient:        &http.Client{Timeout: aiVideoDownloadTimeout},
 	}
 }
 
@@ -22,4 +41,283 @@ type AiVideoRecordRepo struct {
 	log  *log.Helper
 	data *Data
 	*ai_boilerplate_repo.AiVideoRecordRepo
+	httpClient *http.Client
+}
+
+// AiVideoTaskReq AI 视频任务参数
+type AiVideoTaskReq struct {
+	Model   string         // 平台模型标识
+	Prompt  string         // 提示词
+	Options map[string]any // 生成参数,透传给模型平台
+}
+
+// AiVideoTaskResult AI 视频任务结果
+type AiVideoTaskResult struct {
+	Status       constant.AiVideoStatus // 任务状态: 生成中、成功、失败
+	VideoURL     string                 // 视频地址
+	ErrorMessage string                 // 错误信息
+	Usage        *schema.TokenUsage     // Token 用量,平台未返回时为空
+	downloadAuth bool                   // 下载视频是否需要平台鉴权
+}
+
+// FindUnfinished 查询未完成(排队中、生成中)的视频记录, 按创建时间升序
+func (r *AiVideoRecordRepo) FindUnfinished(ctx context.Context, limit int) ([]*ai_boilerplate_model.AiVideoRecord, error) {
+	dao := ai_boilerplate_dao.Use(r.data.gorm).AiVideoRecord
+	result, err := dao.WithContext(ctx).Where(
+		dao.Status.In(int32(constant.AiVideoStatusPending), int32(constant.AiVideoStatusRunning)),
+	).Order(dao.CreatedAt).Limit(limit).Find()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AcquirePoll 获取视频记录的轮询机会, interval 内只允许轮询一次, 同时避免多实例重复处理
+func (r *AiVideoRecordRepo) AcquirePoll(ctx context.Context, id string, interval time.Duration) (bool, error) {
+	err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(constant.AiVideoTaskPoll.Key(id)).Value("1").Nx().Ex(interval).Build()).Error()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// SubmitVideoTask 向平台提交视频生成任务, 返回平台任务编号
+// 火山引擎方舟使用 SDK, 阿里云百炼使用原生异步接口, 其余平台按 OpenAI 兼容的 /videos 接口处理
+func (r *AiVideoRecordRepo) SubmitVideoTask(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiVideoTaskReq) (string, error) {
+	if platform == nil || platform.ID == "" {
+		return "", errors.New("platform is empty")
+	}
+	platformCode, _ := constant.ParseAiProviderPlatform(platform.Platform)
+	switch platformCode {
+	case constant.AiProviderPlatformVolcengine:
+		return r.submitArkVideoTask(ctx, platform, req)
+	case constant.AiProviderPlatformAliyun:
+		return r.submitDashScopeVideoTask(ctx, platform, req)
+	default:
+		return r.submitOpenAIVideoTask(ctx, platform, req)
+	}
+}
+
+// GetVideoTask 查询平台视频生成任务
+func (r *AiVideoRecordRepo) GetVideoTask(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, taskID string) (*AiVideoTaskResult, error) {
+	if platform == nil || platform.ID == "" {
+		return nil, errors.New("platform is empty")
+	}
+	platformCode, _ := constant.ParseAiProviderPlatform(platform.Platform)
+	switch platformCode {
+	case constant.AiProviderPlatformVolcengine:
+		return r.getArkVideoTask(ctx, platform, taskID)
+	case constant.AiProviderPlatformAliyun:
+		return r.getDashScopeVideoTask(ctx, platform, taskID)
+	default:
+		return r.getOpenAIVideoTask(ctx, platform, taskID)
+	}
+}
+
+// DownloadVideo 下载平台生成的视频
+func (r *AiVideoRecordRepo) DownloadVideo(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, result *AiVideoTaskResult) ([]byte, string, error) {
+	if result.VideoURL == "" {
+		return nil, "", errors.New("video is empty")
+	}
+	var header map[string]string
+	if result.downloadAuth {
+		header = map[string]string{"Authorization": "Bearer " + platform.APIKey}
+	}
+	return aiPlatformDownload(ctx, r.httpClient, result.VideoURL, header)
+}
+
+// newArkClient 创建火山引擎方舟客户端
+func (r *AiVideoRecordRepo) newArkClient(platform *ai_boilerplate_model.AiProviderPlatform) *arkruntime.Client {
+	opts := make([]arkruntime.ConfigOption, 0)
+	if platform.APIURL != "" {
+		opts = append(opts, arkruntime.WithBaseUrl(platform.APIURL))
+	}
+	return arkruntime.NewClientWithApiKey(platform.APIKey, opts...)
+}
+
+// submitArkVideoTask 火山引擎方舟创建视频生成任务, 生成参数以 "--key value" 形式追加在提示词后
+func (r *AiVideoRecordRepo) submitArkVideoTask(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiVideoTaskReq) (string, error) {
+	text := req.Prompt
+	keys := make([]string, 0, len(req.Options))
+	for k := range req.Options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		text += fmt.Sprintf(" --%s %v", k, req.Options[k])
+	}
+	resp, err := r.newArkClient(platform).CreateContentGenerationTask(ctx, arkmodel.CreateContentGenerationTaskRequest{
+		Model: req.Model,
+		Content: []*arkmodel.CreateContentGenerationContentItem{
+			{
+				Type: arkmodel.ContentGenerationContentItemTypeText,
+				Text: &text,
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create ark video task: %w", err)
+	}
+	if resp.ID == "" {
+		return "", errors.New("ark video task id is empty")
+	}
+	return resp.ID, nil
+}
+
+// getArkVideoTask 火山引擎方舟查询视频生成任务
+func (r *AiVideoRecordRepo) getArkVideoTask(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, taskID string) (*AiVideoTaskResult, error) {
+	resp, err := r.newArkClient(platform).GetContentGenerationTask(ctx, arkmodel.GetContentGenerationTaskRequest{ID: taskID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ark video task: %w", err)
+	}
+	result := &AiVideoTaskResult{Status: constant.AiVideoStatusRunning}
+	switch resp.Status {
+	case arkmodel.StatusSucceeded:
+		result.Status = constant.AiVideoStatusSucceeded
+		result.VideoURL = resp.Content.VideoURL
+		result.Usage = &schema.TokenUsage{
+			PromptTokens:     resp.Usage.PromptTokens,
+			CompletionTokens: resp.Usage.CompletionTokens,
+			TotalTokens:      resp.Usage.TotalTokens,
+		}
+	case arkmodel.StatusFailed, arkmodel.StatusCancelled:
+		result.Status = constant.AiVideoStatusFailed
+		result.ErrorMessage = resp.Status
+		if resp.Error != nil {
+			result.ErrorMessage = fmt.Sprintf("%s %s", resp.Error.Code, resp.Error.Message)
+		}
+	}
+	return result, nil
+}
+
+// aiVideoDashScopeReply 阿里云百炼文生视频异步任务响应
+type aiVideoDashScopeReply struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Output  struct {
+		TaskID     string `json:"task_id"`
+		TaskStatus string `json:"task_status"`
+		VideoURL   string `json:"video_url"`
+		Code       string `json:"code"`
+		Message    string `json:"message"`
+	} `json:"output"`
+}
+
+// submitDashScopeVideoTask 阿里云百炼创建文生视频异步任务
+func (r *AiVideoRecordRepo) submitDashScopeVideoTask(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiVideoTaskReq) (string, error) {
+	body := map[string]any{
+		"model": req.Model,
+		"input": map[string]any{
+			"prompt": req.Prompt,
+		},
+		"parameters": aiPlatformMergeOptions(map[string]any{}, req.Options),
+	}
+	reply := &aiVideoDashScopeReply{}
+	header := map[string]string{"X-DashScope-Async": "enable"}
+	err := aiPlatformDoJSON(ctx, r.httpClient, http.MethodPost, aiPlatformDashScopeAPIURL(platform)+"/services/aigc/video-generation/video-synthesis", platform.APIKey, header, body, reply)
+	if err != nil {
+		return "", err
+	}
+	if reply.Output.TaskID == "" {
+		return "", fmt.Errorf("failed to create dashscope video task: %s %s", reply.Code, reply.Message)
+	}
+	return reply.Output.TaskID, nil
+}
+
+// getDashScopeVideoTask 阿里云百炼查询异步任务
+func (r *AiVideoRecordRepo) getDashScopeVideoTask(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, taskID string) (*AiVideoTaskResult, error) {
+	reply := &aiVideoDashScopeReply{}
+	err := aiPlatformDoJSON(ctx, r.httpClient, http.MethodGet, aiPlatformDashScopeAPIURL(platform)+"/tasks/"+taskID, platform.APIKey, nil, nil, reply)
+	if err != nil {
+		return nil, err
+	}
+	result := &AiVideoTaskResult{Status: constant.AiVideoStatusRunning}
+	switch reply.Output.TaskStatus {
+	case "PENDING", "RUNNING":
+	case "SUCCEEDED":
+		result.Status = constant.AiVideoStatusSucceeded
+		result.VideoURL = reply.Output.VideoURL
+	default:
+		result.Status = constant.AiVideoStatusFailed
+		result.ErrorMessage = strings.TrimSpace(fmt.Sprintf("%s %s %s", reply.Output.TaskStatus, reply.Output.Code, reply.Output.Message))
+	}
+	return result, nil
+}
+
+// aiVideoOpenAIReply OpenAI 兼容接口视频任务响应
+type aiVideoOpenAIReply struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  *struct {
+		Code    any    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// openAIVideoAPIURL OpenAI 兼容接口地址
+func (r *AiVideoRecordRepo) openAIVideoAPIURL(platform *ai_boilerplate_model.AiProviderPlatform) (string, error) {
+	platformCode, _ := constant.ParseAiProviderPlatform(platform.Platform)
+	apiURL := platform.APIURL
+	if apiURL == "" {
+		apiURL = aiPlatformDefaultAPIURL[platformCode]
+	}
+	if apiURL == "" {
+		return "", fmt.Errorf("platform %s api url is empty", platform.Platform)
+	}
+	return strings.TrimRight(apiURL, "/"), nil
+}
+
+// submitOpenAIVideoTask OpenAI 兼容接口创建视频任务
+func (r *AiVideoRecordRepo) submitOpenAIVideoTask(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, req *AiVideoTaskReq) (string, error) {
+	apiURL, err := r.openAIVideoAPIURL(platform)
+	if err != nil {
+		return "", err
+	}
+	body := aiPlatformMergeOptions(map[string]any{
+		"model":  req.Model,
+		"prompt": req.Prompt,
+	}, req.Options)
+	reply := &aiVideoOpenAIReply{}
+	err = aiPlatformDoJSON(ctx, r.httpClient, http.MethodPost, apiURL+"/videos", platform.APIKey, nil, body, reply)
+	if err != nil {
+		return "", err
+	}
+	if reply.ID == "" {
+		if reply.Error != nil {
+			return "", fmt.Errorf("failed to create video task: %v %s", reply.Error.Code, reply.Error.Message)
+		}
+		return "", errors.New("video task id is empty")
+	}
+	return reply.ID, nil
+}
+
+// getOpenAIVideoTask OpenAI 兼容接口查询视频任务, 视频内容需带鉴权下载
+func (r *AiVideoRecordRepo) getOpenAIVideoTask(ctx context.Context, platform *ai_boilerplate_model.AiProviderPlatform, taskID string) (*AiVideoTaskResult, error) {
+	apiURL, err := r.openAIVideoAPIURL(platform)
+	if err != nil {
+		return nil, err
+	}
+	reply := &aiVideoOpenAIReply{}
+	err = aiPlatformDoJSON(ctx, r.httpClient, http.MethodGet, apiURL+"/videos/"+taskID, platform.APIKey, nil, nil, reply)
+	if err != nil {
+		return nil, err
+	}
+	result := &AiVideoTaskResult{Status: constant.AiVideoStatusRunning}
+	switch reply.Status {
+	case "queued", "in_progress":
+	case "completed":
+		result.Status = constant.AiVideoStatusSucceeded
+		result.VideoURL = apiURL + "/videos/" + taskID + "/content"
+		result.downloadAuth = true
+	default:
+		result.Status = constant.AiVideoStatusFailed
+		result.ErrorMessage = reply.Status
+		if reply.Error != nil {
+			result.ErrorMessage = fmt.Sprintf("%v %s", reply.Error.Code, reply.Error.Message)
+		}
+	}
+	return result, nil
 }
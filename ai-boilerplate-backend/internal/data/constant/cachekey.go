@@ -18,5 +18,50 @@ var (
 	// 短信验证码相关缓存键
 	UserSmsCode           = cacheKey.AddKey("user_sms_code", time.Minute*5, "用户短信验证码")
 	UserSmsCodeFrequency  = cacheKey.AddKey("user_sms_code_frequency", time.Hour*24, "用户短信验证码发送频率")
+	UserSmsCodeAttempt    = cacheKey.AddKey("user_sms_code_attempt", time.Minute*10, "用户短信验证码校验失败次数")
 	ActivationCodeBatchNo = cacheKey.AddKey("activation_code_batch_no", time.Hour*24, "激活码批次号")
+
+	// 短信渠道熔断相关缓存键
+	SmsChannelBreakerOpen    = cacheKey.AddKey("sms_channel_breaker_open", time.Minute*5, "短信渠道熔断中")
+	SmsChannelBreakerResume  = cacheKey.AddKey("sms_channel_breaker_resume", time.Minute*15, "短信渠道熔断恢复时间")
+	SmsChannelBreakerChecked = cacheKey.AddKey("sms_channel_breaker_checked", time.Second*30, "短信渠道失败率已检查")
+
+	// 邮件退信相关缓存键
+	MailSuppressionLift = cacheKey.AddKey("mail_suppression_lift", time.Hour*24*365, "邮件收件人解除暂停时间")
+
+	// AI Token 用量相关缓存键
+	AiTokenUsageDaily   = cacheKey.AddKey("ai_token_usage_daily", time.Hour*48, "AI Token 每日用量")
+	AiTokenUsageMonthly = cacheKey.AddKey("ai_token_usage_monthly", time.Hour*24*32, "AI Token 每月用量")
+
+	// AI 接口密钥相关缓存键
+	AiAPIKeyLastUsed = cacheKey.AddKey("ai_api_key_last_used", time.Minute, "AI 接口密钥最后使用时间刷新间隔")
+
+	// AI 视频任务相关缓存键
+	AiVideoTaskPoll = cacheKey.AddKey("ai_video_task_poll", time.Minute*2, "AI 视频任务轮询间隔")
+
+	// 接口权限相关缓存键
+	SysAPIPermission  = cacheKey.AddKey("sys_api_permission", time.Hour, "接口与权限编号映射")
+	SysRolePermission = cacheKey.AddKey("sys_role_permission", time.Hour, "角色权限标识集合")
+
+	// 登录会话相关缓存键
+	SysAdminSession        = cacheKey.AddKey("sys_admin_session", time.Hour*24*14, "管理员登录会话")
+	SysAdminRefreshToken   = cacheKey.AddKey("sys_admin_refresh_token", time.Hour*24*14, "管理员刷新 token")
+	SysAdminTokenBlacklist = cacheKey.AddKey("sys_admin_token_blacklist", time.Hour*2, "管理员 token 黑名单(按会话编号)")
+	UserSession            = cacheKey.AddKey("user_session", time.Hour*24*30, "用户登录会话")
+	UserRefreshToken       = cacheKey.AddKey("user_refresh_token", time.Hour*24*30, "用户刷新 token")
+	UserTokenBlacklist     = cacheKey.AddKey("user_token_blacklist", time.Hour*2, "用户 token 黑名单(按会话编号)")
+
+	// 登录防暴力破解相关缓存键
+	LoginFailure   = cacheKey.AddKey("login_failure", time.Minute*15, "登录失败次数")
+	LoginLock      = cacheKey.AddKey("login_lock", time.Minute*5, "登录锁定")
+	LoginLockCount = cacheKey.AddKey("login_lock_count", time.Hour*24, "登录锁定次数")
+
+	// 管理员实时事件相关缓存键
+	SysNotifyEventStream  = cacheKey.AddKey("sys_notify_event_stream", time.Hour*24, "管理员实时事件流")
+	SysNotifyEventChannel = cacheKey.AddKey("sys_notify_event_channel", 0, "管理员实时事件发布频道")
+
+	// 两步验证相关缓存键
+	TwoFactorChallenge = cacheKey.AddKey("two_factor_challenge", time.Minute*5, "两步验证登录挑战")
+	TwoFactorSetup     = cacheKey.AddKey("two_factor_setup", time.Minute*10, "两步验证待绑定密钥")
+	TwoFactorUsedCode  = cacheKey.AddKey("two_factor_used_code", time.Second*90, "两步验证已使用的验证码")
 )
@@ -6,6 +6,8 @@ type ContextWithValueKey string
 const (
 	XMdIP        = "x-md-ip"
 	XMdUseragent = "x-md-useragent"
+	XMdDevice    = "x-md-device"
+	XMdSessionID = "x-md-session-id"
 
 	XMdAdminID       = "x-md-admin-id"
 	XMdAdminUsername = "x-md-admin-username"
@@ -14,4 +16,22 @@ const (
 	XMdUserID        = "x-md-user-id"
 	XMdWxGzhUserID   = "x-md-wx-gzh-user-id"
 	XMdWxXcxUserID   = "x-md-wx-xcx-user-id"
+	XMdAPIKeyID      = "x-md-api-key-id"
+)
+
+// 登录场景
+const (
+	LoginSceneAdmin = "admin" // 后台登录
+	LoginSceneApp   = "app"   // App 登录
+)
+
+// SystemOperatorID 系统操作人编号, 用于无法关联管理员的操作日志
+const SystemOperatorID = "00000000-0000-0000-0000-000000000000"
+
+// 字典类型
+const (
+	DictTypeAiWriteLength   = "ai_write_length"   // AI 写作长度
+	DictTypeAiWriteFormat   = "ai_write_format"   // AI 写作格式
+	DictTypeAiWriteTone     = "ai_write_tone"     // AI 写作语气
+	DictTypeAiWriteLanguage = "ai_write_language" // AI 写作语言
 )
@@ -137,82 +137,82 @@ func (x *ActivationCodeStatus) Type() string {
 }
 
 const (
-	// 禁用
-	DeviceStatusDisable DeviceStatus = iota + -1
-	// 启用
-	DeviceStatusEnable DeviceStatus = iota + 0
+	// 描述模式: 根据描述词生成歌词与音乐
+	AiAudioGenerateModeDescription AiAudioGenerateMode = iota + 1
+	// 歌词模式: 根据自定义歌词与风格标签生成音乐
+	AiAudioGenerateModeLyric
 )
 
-var ErrInvalidDeviceStatus = fmt.Errorf("not a valid DeviceStatus, try [%s]", strings.Join(_DeviceStatusNames, ", "))
+var ErrInvalidAiAudioGenerateMode = fmt.Errorf("not a valid AiAudioGenerateMode, try [%s]", strings.Join(_AiAudioGenerateModeNames, ", "))
 
-const _DeviceStatusName = "disableenable"
+const _AiAudioGenerateModeName = "descriptionlyric"
 
-var _DeviceStatusNames = []string{
-	_DeviceStatusName[0:7],
-	_DeviceStatusName[7:13],
+var _AiAudioGenerateModeNames = []string{
+	_AiAudioGenerateModeName[0:11],
+	_AiAudioGenerateModeName[11:16],
 }
 
-// DeviceStatusNames returns a list of possible string values of DeviceStatus.
-func DeviceStatusNames() []string {
-	tmp := make([]string, len(_DeviceStatusNames))
-	copy(tmp, _DeviceStatusNames)
+// AiAudioGenerateModeNames returns a list of possible string values of AiAudioGenerateMode.
+func AiAudioGenerateModeNames() []string {
+	tmp := make([]string, len(_AiAudioGenerateModeNames))
+	copy(tmp, _AiAudioGenerateModeNames)
 	return tmp
 }
 
-// DeviceStatusValues returns a list of the values for DeviceStatus
-func DeviceStatusValues() []DeviceStatus {
-	return []DeviceStatus{
-		DeviceStatusDisable,
-		DeviceStatusEnable,
+// AiAudioGenerateModeValues returns a list of the values for AiAudioGenerateMode
+func AiAudioGenerateModeValues() []AiAudioGenerateMode {
+	return []AiAudioGenerateMode{
+		AiAudioGenerateModeDescription,
+		AiAudioGenerateModeLyric,
 	}
 }
 
-var _DeviceStatusMap = map[DeviceStatus]string{
-	DeviceStatusDisable: _DeviceStatusName[0:7],
-	DeviceStatusEnable:  _DeviceStatusName[7:13],
+var _AiAudioGenerateModeMap = map[AiAudioGenerateMode]string{
+	AiAudioGenerateModeDescription: _AiAudioGenerateModeName[0:11],
+	AiAudioGenerateModeLyric:       _AiAudioGenerateModeName[11:16],
 }
 
 // String implements the Stringer interface.
-func (x DeviceStatus) String() string {
-	if str, ok := _DeviceStatusMap[x]; ok {
+func (x AiAudioGenerateMode) String() string {
+	if str, ok := _AiAudioGenerateModeMap[x]; ok {
 		return str
 	}
-	return fmt.Sprintf("DeviceStatus(%d)", x)
+	return fmt.Sprintf("AiAudioGenerateMode(%d)", x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x DeviceStatus) IsValid() bool {
-	_, ok := _DeviceStatusMap[x]
+func (x AiAudioGenerateMode) IsValid() bool {
+	_, ok := _AiAudioGenerateModeMap[x]
 	return ok
 }
 
-var _DeviceStatusValue = map[string]DeviceStatus{
-	_DeviceStatusName[0:7]:  DeviceStatusDisable,
-	_DeviceStatusName[7:13]: DeviceStatusEnable,
+var _AiAudioGenerateModeValue = map[string]AiAudioGenerateMode{
+	_AiAudioGenerateModeName[0:11]:  AiAudioGenerateModeDescription,
+	_AiAudioGenerateModeName[11:16]: AiAudioGenerateModeLyric,
 }
 
-// ParseDeviceStatus attempts to convert a string to a DeviceStatus.
-func ParseDeviceStatus(name string) (DeviceStatus, error) {
-	if x, ok := _DeviceStatusValue[name]; ok {
+// ParseAiAudioGenerateMode attempts to convert a string to a AiAudioGenerateMode.
+func ParseAiAudioGenerateMode(name string) (AiAudioGenerateMode, error) {
+	if x, ok := _AiAudioGenerateModeValue[name]; ok {
 		return x, nil
 	}
-	return DeviceStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidDeviceStatus)
+	return AiAudioGenerateMode(0), fmt.Errorf("%s is %w", name, ErrInvalidAiAudioGenerateMode)
 }
 
-func (x DeviceStatus) Ptr() *DeviceStatus {
+func (x AiAudioGenerateMode) Ptr() *AiAudioGenerateMode {
 	return &x
 }
 
 // MarshalText implements the text marshaller method.
-func (x DeviceStatus) MarshalText() ([]byte, error) {
+func (x AiAudioGenerateMode) MarshalText() ([]byte, error) {
 	return []byte(x.String()), nil
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *DeviceStatus) UnmarshalText(text []byte) error {
+func (x *AiAudioGenerateMode) UnmarshalText(text []byte) error {
 	name := string(text)
-	tmp, err := ParseDeviceStatus(name)
+	tmp, err := ParseAiAudioGenerateMode(name)
 	if err != nil {
 		return err
 	}
@@ -221,98 +221,111 @@ func (x *DeviceStatus) UnmarshalText(text []byte) error {
 }
 
 // Set implements the Golang flag.Value interface func.
-func (x *DeviceStatus) Set(val string) error {
-	v, err := ParseDeviceStatus(val)
+func (x *AiAudioGenerateMode) Set(val string) error {
+	v, err := ParseAiAudioGenerateMode(val)
 	*x = v
 	return err
 }
 
 // Get implements the Golang flag.Getter interface func.
-func (x *DeviceStatus) Get() interface{} {
+func (x *AiAudioGenerateMode) Get() interface{} {
 	return *x
 }
 
 // Type implements the github.com/spf13/pFlag Value interface.
-func (x *DeviceStatus) Type() string {
-	return "DeviceStatus"
+func (x *AiAudioGenerateMode) Type() string {
+	return "AiAudioGenerateMode"
 }
 
 const (
-	// 火山云
-	FileStorageVolcengine FileStorage = "volcengine"
-	// 腾讯云
-	FileStorageTencent FileStorage = "tencent"
-	// 阿里云
-	FileStorageAliyun FileStorage = "aliyun"
-	// 七牛云
-	FileStorageQiniu FileStorage = "qiniu"
+	// 失败
+	AiAudioStatusFailed AiAudioStatus = iota + -1
+	// 排队中
+	AiAudioStatusPending
+	// 生成中
+	AiAudioStatusRunning
+	// 成功
+	AiAudioStatusSucceeded
 )
 
-var ErrInvalidFileStorage = fmt.Errorf("not a valid FileStorage, try [%s]", strings.Join(_FileStorageNames, ", "))
+var ErrInvalidAiAudioStatus = fmt.Errorf("not a valid AiAudioStatus, try [%s]", strings.Join(_AiAudioStatusNames, ", "))
 
-var _FileStorageNames = []string{
-	string(FileStorageVolcengine),
-	string(FileStorageTencent),
-	string(FileStorageAliyun),
-	string(FileStorageQiniu),
+const _AiAudioStatusName = "failedpendingrunningsucceeded"
+
+var _AiAudioStatusNames = []string{
+	_AiAudioStatusName[0:6],
+	_AiAudioStatusName[6:13],
+	_AiAudioStatusName[13:20],
+	_AiAudioStatusName[20:29],
 }
 
-// FileStorageNames returns a list of possible string values of FileStorage.
-func FileStorageNames() []string {
-	tmp := make([]string, len(_FileStorageNames))
-	copy(tmp, _FileStorageNames)
+// AiAudioStatusNames returns a list of possible string values of AiAudioStatus.
+func AiAudioStatusNames() []string {
+	tmp := make([]string, len(_AiAudioStatusNames))
+	copy(tmp, _AiAudioStatusNames)
 	return tmp
 }
 
-// FileStorageValues returns a list of the values for FileStorage
-func FileStorageValues() []FileStorage {
-	return []FileStorage{
-		FileStorageVolcengine,
-		FileStorageTencent,
-		FileStorageAliyun,
-		FileStorageQiniu,
+// AiAudioStatusValues returns a list of the values for AiAudioStatus
+func AiAudioStatusValues() []AiAudioStatus {
+	return []AiAudioStatus{
+		AiAudioStatusFailed,
+		AiAudioStatusPending,
+		AiAudioStatusRunning,
+		AiAudioStatusSucceeded,
 	}
 }
 
+var _AiAudioStatusMap = map[AiAudioStatus]string{
+	AiAudioStatusFailed:    _AiAudioStatusName[0:6],
+	AiAudioStatusPending:   _AiAudioStatusName[6:13],
+	AiAudioStatusRunning:   _AiAudioStatusName[13:20],
+	AiAudioStatusSucceeded: _AiAudioStatusName[20:29],
+}
+
 // String implements the Stringer interface.
-func (x FileStorage) String() string {
-	return string(x)
+func (x AiAudioStatus) String() string {
+	if str, ok := _AiAudioStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AiAudioStatus(%d)", x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x FileStorage) IsValid() bool {
-	_, err := ParseFileStorage(string(x))
-	return err == nil
+func (x AiAudioStatus) IsValid() bool {
+	_, ok := _AiAudioStatusMap[x]
+	return ok
 }
 
-var _FileStorageValue = map[string]FileStorage{
-	"volcengine": FileStorageVolcengine,
-	"tencent":    FileStorageTencent,
-	"aliyun":     FileStorageAliyun,
-	"qiniu":      FileStorageQiniu,
+var _AiAudioStatusValue = map[string]AiAudioStatus{
+	_AiAudioStatusName[0:6]:   AiAudioStatusFailed,
+	_AiAudioStatusName[6:13]:  AiAudioStatusPending,
+	_AiAudioStatusName[13:20]: AiAudioStatusRunning,
+	_AiAudioStatusName[20:29]: AiAudioStatusSucceeded,
 }
 
-// ParseFileStorage attempts to convert a string to a FileStorage.
-func ParseFileStorage(name string) (FileStorage, error) {
-	if x, ok := _FileStorageValue[name]; ok {
+// ParseAiAudioStatus attempts to convert a string to a AiAudioStatus.
+func ParseAiAudioStatus(name string) (AiAudioStatus, error) {
+	if x, ok := _AiAudioStatusValue[name]; ok {
 		return x, nil
 	}
-	return FileStorage(""), fmt.Errorf("%s is %w", name, ErrInvalidFileStorage)
+	return AiAudioStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidAiAudioStatus)
 }
 
-func (x FileStorage) Ptr() *FileStorage {
+func (x AiAudioStatus) Ptr() *AiAudioStatus {
 	return &x
 }
 
 // MarshalText implements the text marshaller method.
-func (x FileStorage) MarshalText() ([]byte, error) {
-	return []byte(string(x)), nil
+func (x AiAudioStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *FileStorage) UnmarshalText(text []byte) error {
-	tmp, err := ParseFileStorage(string(text))
+func (x *AiAudioStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseAiAudioStatus(name)
 	if err != nil {
 		return err
 	}
@@ -321,93 +334,111 @@ func (x *FileStorage) UnmarshalText(text []byte) error {
 }
 
 // Set implements the Golang flag.Value interface func.
-func (x *FileStorage) Set(val string) error {
-	v, err := ParseFileStorage(val)
+func (x *AiAudioStatus) Set(val string) error {
+	v, err := ParseAiAudioStatus(val)
 	*x = v
 	return err
 }
 
 // Get implements the Golang flag.Getter interface func.
-func (x *FileStorage) Get() interface{} {
+func (x *AiAudioStatus) Get() interface{} {
 	return *x
 }
 
 // Type implements the github.com/spf13/pFlag Value interface.
-func (x *FileStorage) Type() string {
-	return "FileStorage"
+func (x *AiAudioStatus) Type() string {
+	return "AiAudioStatus"
 }
 
 const (
-	// 会员
-	MallProductTypeMembership MallProductType = "membership"
-	// 服务
-	MallProductTypeService MallProductType = "service"
-	// 商品
-	MallProductTypeGoods MallProductType = "goods"
+	// 失败
+	AiImageStatusFailed AiImageStatus = iota + -1
+	// 排队中
+	AiImageStatusPending
+	// 生成中
+	AiImageStatusRunning
+	// 成功
+	AiImageStatusSucceeded
 )
 
-var ErrInvalidMallProductType = fmt.Errorf("not a valid MallProductType, try [%s]", strings.Join(_MallProductTypeNames, ", "))
+var ErrInvalidAiImageStatus = fmt.Errorf("not a valid AiImageStatus, try [%s]", strings.Join(_AiImageStatusNames, ", "))
 
-var _MallProductTypeNames = []string{
-	string(MallProductTypeMembership),
-	string(MallProductTypeService),
-	string(MallProductTypeGoods),
+const _AiImageStatusName = "failedpendingrunningsucceeded"
+
+var _AiImageStatusNames = []string{
+	_AiImageStatusName[0:6],
+	_AiImageStatusName[6:13],
+	_AiImageStatusName[13:20],
+	_AiImageStatusName[20:29],
 }
 
-// MallProductTypeNames returns a list of possible string values of MallProductType.
-func MallProductTypeNames() []string {
-	tmp := make([]string, len(_MallProductTypeNames))
-	copy(tmp, _MallProductTypeNames)
+// AiImageStatusNames returns a list of possible string values of AiImageStatus.
+func AiImageStatusNames() []string {
+	tmp := make([]string, len(_AiImageStatusNames))
+	copy(tmp, _AiImageStatusNames)
 	return tmp
 }
 
-// MallProductTypeValues returns a list of the values for MallProductType
-func MallProductTypeValues() []MallProductType {
-	return []MallProductType{
-		MallProductTypeMembership,
-		MallProductTypeService,
-		MallProductTypeGoods,
+// AiImageStatusValues returns a list of the values for AiImageStatus
+func AiImageStatusValues() []AiImageStatus {
+	return []AiImageStatus{
+		AiImageStatusFailed,
+		AiImageStatusPending,
+		AiImageStatusRunning,
+		AiImageStatusSucceeded,
 	}
 }
 
+var _AiImageStatusMap = map[AiImageStatus]string{
+	AiImageStatusFailed:    _AiImageStatusName[0:6],
+	AiImageStatusPending:   _AiImageStatusName[6:13],
+	AiImageStatusRunning:   _AiImageStatusName[13:20],
+	AiImageStatusSucceeded: _AiImageStatusName[20:29],
+}
+
 // String implements the Stringer interface.
-func (x MallProductType) String() string {
-	return string(x)
+func (x AiImageStatus) String() string {
+	if str, ok := _AiImageStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AiImageStatus(%d)", x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x MallProductType) IsValid() bool {
-	_, err := ParseMallProductType(string(x))
-	return err == nil
+func (x AiImageStatus) IsValid() bool {
+	_, ok := _AiImageStatusMap[x]
+	return ok
 }
 
-var _MallProductTypeValue = map[string]MallProductType{
-	"membership": MallProductTypeMembership,
-	"service":    MallProductTypeService,
-	"goods":      MallProductTypeGoods,
+var _AiImageStatusValue = map[string]AiImageStatus{
+	_AiImageStatusName[0:6]:   AiImageStatusFailed,
+	_AiImageStatusName[6:13]:  AiImageStatusPending,
+	_AiImageStatusName[13:20]: AiImageStatusRunning,
+	_AiImageStatusName[20:29]: AiImageStatusSucceeded,
 }
 
-// ParseMallProductType attempts to convert a string to a MallProductType.
-func ParseMallProductType(name string) (MallProductType, error) {
-	if x, ok := _MallProductTypeValue[name]; ok {
+// ParseAiImageStatus attempts to convert a string to a AiImageStatus.
+func ParseAiImageStatus(name string) (AiImageStatus, error) {
+	if x, ok := _AiImageStatusValue[name]; ok {
 		return x, nil
 	}
-	return MallProductType(""), fmt.Errorf("%s is %w", name, ErrInvalidMallProductType)
+	return AiImageStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidAiImageStatus)
 }
 
-func (x MallProductType) Ptr() *MallProductType {
+func (x AiImageStatus) Ptr() *AiImageStatus {
 	return &x
 }
 
 // MarshalText implements the text marshaller method.
-func (x MallProductType) MarshalText() ([]byte, error) {
-	return []byte(string(x)), nil
+func (x AiImageStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *MallProductType) UnmarshalText(text []byte) error {
-	tmp, err := ParseMallProductType(string(text))
+func (x *AiImageStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseAiImageStatus(name)
 	if err != nil {
 		return err
 	}
@@ -416,93 +447,93 @@ func (x *MallProductType) UnmarshalText(text []byte) error {
 }
 
 // Set implements the Golang flag.Value interface func.
-func (x *MallProductType) Set(val string) error {
-	v, err := ParseMallProductType(val)
+func (x *AiImageStatus) Set(val string) error {
+	v, err := ParseAiImageStatus(val)
 	*x = v
 	return err
 }
 
 // Get implements the Golang flag.Getter interface func.
-func (x *MallProductType) Get() interface{} {
+func (x *AiImageStatus) Get() interface{} {
 	return *x
 }
 
 // Type implements the github.com/spf13/pFlag Value interface.
-func (x *MallProductType) Type() string {
-	return "MallProductType"
+func (x *AiImageStatus) Type() string {
+	return "AiImageStatus"
 }
 
 const (
-	// 定位
-	MembershipBenefitKeyLocation MembershipBenefitKey = "location"
-	// 截图
-	MembershipBenefitKeyScreen MembershipBenefitKey = "screen"
-	// 敲一敲
-	MembershipBenefitKeyKnock MembershipBenefitKey = "knock"
+	// 绘画
+	AiJobTypeImage AiJobType = "image"
+	// 音乐
+	AiJobTypeAudio AiJobType = "audio"
+	// 视频
+	AiJobTypeVideo AiJobType = "video"
 )
 
-var ErrInvalidMembershipBenefitKey = fmt.Errorf("not a valid MembershipBenefitKey, try [%s]", strings.Join(_MembershipBenefitKeyNames, ", "))
+var ErrInvalidAiJobType = fmt.Errorf("not a valid AiJobType, try [%s]", strings.Join(_AiJobTypeNames, ", "))
 
-var _MembershipBenefitKeyNames = []string{
-	string(MembershipBenefitKeyLocation),
-	string(MembershipBenefitKeyScreen),
-	string(MembershipBenefitKeyKnock),
+var _AiJobTypeNames = []string{
+	string(AiJobTypeImage),
+	string(AiJobTypeAudio),
+	string(AiJobTypeVideo),
 }
 
-// MembershipBenefitKeyNames returns a list of possible string values of MembershipBenefitKey.
-func MembershipBenefitKeyNames() []string {
-	tmp := make([]string, len(_MembershipBenefitKeyNames))
-	copy(tmp, _MembershipBenefitKeyNames)
+// AiJobTypeNames returns a list of possible string values of AiJobType.
+func AiJobTypeNames() []string {
+	tmp := make([]string, len(_AiJobTypeNames))
+	copy(tmp, _AiJobTypeNames)
 	return tmp
 }
 
-// MembershipBenefitKeyValues returns a list of the values for MembershipBenefitKey
-func MembershipBenefitKeyValues() []MembershipBenefitKey {
-	return []MembershipBenefitKey{
-		MembershipBenefitKeyLocation,
-		MembershipBenefitKeyScreen,
-		MembershipBenefitKeyKnock,
+// AiJobTypeValues returns a list of the values for AiJobType
+func AiJobTypeValues() []AiJobType {
+	return []AiJobType{
+		AiJobTypeImage,
+		AiJobTypeAudio,
+		AiJobTypeVideo,
 	}
 }
 
 // String implements the Stringer interface.
-func (x MembershipBenefitKey) String() string {
+func (x AiJobType) String() string {
 	return string(x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x MembershipBenefitKey) IsValid() bool {
-	_, err := ParseMembershipBenefitKey(string(x))
+func (x AiJobType) IsValid() bool {
+	_, err := ParseAiJobType(string(x))
 	return err == nil
 }
 
-var _MembershipBenefitKeyValue = map[string]MembershipBenefitKey{
-	"location": MembershipBenefitKeyLocation,
-	"screen":   MembershipBenefitKeyScreen,
-	"knock":    MembershipBenefitKeyKnock,
+var _AiJobTypeValue = map[string]AiJobType{
+	"image": AiJobTypeImage,
+	"audio": AiJobTypeAudio,
+	"video": AiJobTypeVideo,
 }
 
-// ParseMembershipBenefitKey attempts to convert a string to a MembershipBenefitKey.
-func ParseMembershipBenefitKey(name string) (MembershipBenefitKey, error) {
-	if x, ok := _MembershipBenefitKeyValue[name]; ok {
+// ParseAiJobType attempts to convert a string to a AiJobType.
+func ParseAiJobType(name string) (AiJobType, error) {
+	if x, ok := _AiJobTypeValue[name]; ok {
 		return x, nil
 	}
-	return MembershipBenefitKey(""), fmt.Errorf("%s is %w", name, ErrInvalidMembershipBenefitKey)
+	return AiJobType(""), fmt.Errorf("%s is %w", name, ErrInvalidAiJobType)
 }
 
-func (x MembershipBenefitKey) Ptr() *MembershipBenefitKey {
+func (x AiJobType) Ptr() *AiJobType {
 	return &x
 }
 
 // MarshalText implements the text marshaller method.
-func (x MembershipBenefitKey) MarshalText() ([]byte, error) {
+func (x AiJobType) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *MembershipBenefitKey) UnmarshalText(text []byte) error {
-	tmp, err := ParseMembershipBenefitKey(string(text))
+func (x *AiJobType) UnmarshalText(text []byte) error {
+	tmp, err := ParseAiJobType(string(text))
 	if err != nil {
 		return err
 	}
@@ -511,93 +542,108 @@ func (x *MembershipBenefitKey) UnmarshalText(text []byte) error {
 }
 
 // Set implements the Golang flag.Value interface func.
-func (x *MembershipBenefitKey) Set(val string) error {
-	v, err := ParseMembershipBenefitKey(val)
+func (x *AiJobType) Set(val string) error {
+	v, err := ParseAiJobType(val)
 	*x = v
 	return err
 }
 
 // Get implements the Golang flag.Getter interface func.
-func (x *MembershipBenefitKey) Get() interface{} {
+func (x *AiJobType) Get() interface{} {
 	return *x
 }
 
 // Type implements the github.com/spf13/pFlag Value interface.
-func (x *MembershipBenefitKey) Type() string {
-	return "MembershipBenefitKey"
+func (x *AiJobType) Type() string {
+	return "AiJobType"
 }
 
 const (
-	// 普通会员
-	MembershipTypeNormal MembershipType = "normal"
-	// 会员
-	MembershipTypeVip MembershipType = "vip"
-	// 超级会员
-	MembershipTypeSvip MembershipType = "svip"
+	// OpenAI 及兼容接口
+	AiProviderPlatformOpenai AiProviderPlatform = "openai"
+	// 火山引擎方舟
+	AiProviderPlatformVolcengine AiProviderPlatform = "volcengine"
+	// DeepSeek
+	AiProviderPlatformDeepseek AiProviderPlatform = "deepseek"
+	// 阿里云百炼通义千问
+	AiProviderPlatformAliyun AiProviderPlatform = "aliyun"
+	// Ollama
+	AiProviderPlatformOllama AiProviderPlatform = "ollama"
+	// Suno 音乐
+	AiProviderPlatformSuno AiProviderPlatform = "suno"
 )
 
-var ErrInvalidMembershipType = fmt.Errorf("not a valid MembershipType, try [%s]", strings.Join(_MembershipTypeNames, ", "))
+var ErrInvalidAiProviderPlatform = fmt.Errorf("not a valid AiProviderPlatform, try [%s]", strings.Join(_AiProviderPlatformNames, ", "))
 
-var _MembershipTypeNames = []string{
-	string(MembershipTypeNormal),
-	string(MembershipTypeVip),
-	string(MembershipTypeSvip),
+var _AiProviderPlatformNames = []string{
+	string(AiProviderPlatformOpenai),
+	string(AiProviderPlatformVolcengine),
+	string(AiProviderPlatformDeepseek),
+	string(AiProviderPlatformAliyun),
+	string(AiProviderPlatformOllama),
+	string(AiProviderPlatformSuno),
 }
 
-// MembershipTypeNames returns a list of possible string values of MembershipType.
-func MembershipTypeNames() []string {
-	tmp := make([]string, len(_MembershipTypeNames))
-	copy(tmp, _MembershipTypeNames)
+// AiProviderPlatformNames returns a list of possible string values of AiProviderPlatform.
+func AiProviderPlatformNames() []string {
+	tmp := make([]string, len(_AiProviderPlatformNames))
+	copy(tmp, _AiProviderPlatformNames)
 	return tmp
 }
 
-// MembershipTypeValues returns a list of the values for MembershipType
-func MembershipTypeValues() []MembershipType {
-	return []MembershipType{
-		MembershipTypeNormal,
-		MembershipTypeVip,
-		MembershipTypeSvip,
+// AiProviderPlatformValues returns a list of the values for AiProviderPlatform
+func AiProviderPlatformValues() []AiProviderPlatform {
+	return []AiProviderPlatform{
+		AiProviderPlatformOpenai,
+		AiProviderPlatformVolcengine,
+		AiProviderPlatformDeepseek,
+		AiProviderPlatformAliyun,
+		AiProviderPlatformOllama,
+		AiProviderPlatformSuno,
 	}
 }
 
 // String implements the Stringer interface.
-func (x MembershipType) String() string {
+func (x AiProviderPlatform) String() string {
 	return string(x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x MembershipType) IsValid() bool {
-	_, err := ParseMembershipType(string(x))
+func (x AiProviderPlatform) IsValid() bool {
+	_, err := ParseAiProviderPlatform(string(x))
 	return err == nil
 }
 
-var _MembershipTypeValue = map[string]MembershipType{
-	"normal": MembershipTypeNormal,
-	"vip":    MembershipTypeVip,
-	"svip":   MembershipTypeSvip,
+var _AiProviderPlatformValue = map[string]AiProviderPlatform{
+	"openai":     AiProviderPlatformOpenai,
+	"volcengine": AiProviderPlatformVolcengine,
+	"deepseek":   AiProviderPlatformDeepseek,
+	"aliyun":     AiProviderPlatformAliyun,
+	"ollama":     AiProviderPlatformOllama,
+	"suno":       AiProviderPlatformSuno,
 }
 
-// ParseMembershipType attempts to convert a string to a MembershipType.
-func ParseMembershipType(name string) (MembershipType, error) {
-	if x, ok := _MembershipTypeValue[name]; ok {
+// ParseAiProviderPlatform attempts to convert a string to a AiProviderPlatform.
+func ParseAiProviderPlatform(name string) (AiProviderPlatform, error) {
+	if x, ok := _AiProviderPlatformValue[name]; ok {
 		return x, nil
 	}
-	return MembershipType(""), fmt.Errorf("%s is %w", name, ErrInvalidMembershipType)
+	return AiProviderPlatform(""), fmt.Errorf("%s is %w", name, ErrInvalidAiProviderPlatform)
 }
 
-func (x MembershipType) Ptr() *MembershipType {
+func (x AiProviderPlatform) Ptr() *AiProviderPlatform {
 	return &x
 }
 
 // MarshalText implements the text marshaller method.
-func (x MembershipType) MarshalText() ([]byte, error) {
+func (x AiProviderPlatform) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *MembershipType) UnmarshalText(text []byte) error {
-	tmp, err := ParseMembershipType(string(text))
+func (x *AiProviderPlatform) UnmarshalText(text []byte) error {
+	tmp, err := ParseAiProviderPlatform(string(text))
 	if err != nil {
 		return err
 	}
@@ -606,103 +652,2232 @@ func (x *MembershipType) UnmarshalText(text []byte) error {
 }
 
 // Set implements the Golang flag.Value interface func.
-func (x *MembershipType) Set(val string) error {
-	v, err := ParseMembershipType(val)
+func (x *AiProviderPlatform) Set(val string) error {
+	v, err := ParseAiProviderPlatform(val)
 	*x = v
 	return err
 }
 
 // Get implements the Golang flag.Getter interface func.
-func (x *MembershipType) Get() interface{} {
+func (x *AiProviderPlatform) Get() interface{} {
 	return *x
 }
 
 // Type implements the github.com/spf13/pFlag Value interface.
-func (x *MembershipType) Type() string {
-	return "MembershipType"
+func (x *AiProviderPlatform) Type() string {
+	return "AiProviderPlatform"
 }
 
 const (
-	// 阿里云
-	SmsChannelCodeALIYUN SmsChannelCode = "ALIYUN"
-	// 腾讯云
-	SmsChannelCodeTENCENT SmsChannelCode = "TENCENT"
-	// 华为云
-	SmsChannelCodeHUAWEI SmsChannelCode = "HUAWEI"
-	// 七牛云
-	SmsChannelCodeQINIU SmsChannelCode = "QINIU"
-	// 云片
-	SmsChannelCodeYUNPIAN SmsChannelCode = "YUNPIAN"
+	// 聊天
+	AiTokenUsageSceneChat AiTokenUsageScene = "chat"
+	// 写作
+	AiTokenUsageSceneWrite AiTokenUsageScene = "write"
+	// 绘画
+	AiTokenUsageSceneImage AiTokenUsageScene = "image"
+	// 视频
+	AiTokenUsageSceneVideo AiTokenUsageScene = "video"
+	// 接口
+	AiTokenUsageSceneApi AiTokenUsageScene = "api"
+)
+
+var ErrInvalidAiTokenUsageScene = fmt.Errorf("not a valid AiTokenUsageScene, try [%s]", strings.Join(_AiTokenUsageSceneNames, ", "))
+
+var _AiTokenUsageSceneNames = []string{
+	string(AiTokenUsageSceneChat),
+	string(AiTokenUsageSceneWrite),
+	string(AiTokenUsageSceneImage),
+	string(AiTokenUsageSceneVideo),
+	string(AiTokenUsageSceneApi),
+}
+
+// AiTokenUsageSceneNames returns a list of possible string values of AiTokenUsageScene.
+func AiTokenUsageSceneNames() []string {
+	tmp := make([]string, len(_AiTokenUsageSceneNames))
+	copy(tmp, _AiTokenUsageSceneNames)
+	return tmp
+}
+
+// AiTokenUsageSceneValues returns a list of the values for AiTokenUsageScene
+func AiTokenUsageSceneValues() []AiTokenUsageScene {
+	return []AiTokenUsageScene{
+		AiTokenUsageSceneChat,
+		AiTokenUsageSceneWrite,
+		AiTokenUsageSceneImage,
+		AiTokenUsageSceneVideo,
+		AiTokenUsageSceneApi,
+	}
+}
+
+// String implements the Stringer interface.
+func (x AiTokenUsageScene) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AiTokenUsageScene) IsValid() bool {
+	_, err := ParseAiTokenUsageScene(string(x))
+	return err == nil
+}
+
+var _AiTokenUsageSceneValue = map[string]AiTokenUsageScene{
+	"chat":  AiTokenUsageSceneChat,
+	"write": AiTokenUsageSceneWrite,
+	"image": AiTokenUsageSceneImage,
+	"video": AiTokenUsageSceneVideo,
+	"api":   AiTokenUsageSceneApi,
+}
+
+// ParseAiTokenUsageScene attempts to convert a string to a AiTokenUsageScene.
+func ParseAiTokenUsageScene(name string) (AiTokenUsageScene, error) {
+	if x, ok := _AiTokenUsageSceneValue[name]; ok {
+		return x, nil
+	}
+	return AiTokenUsageScene(""), fmt.Errorf("%s is %w", name, ErrInvalidAiTokenUsageScene)
+}
+
+func (x AiTokenUsageScene) Ptr() *AiTokenUsageScene {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x AiTokenUsageScene) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AiTokenUsageScene) UnmarshalText(text []byte) error {
+	tmp, err := ParseAiTokenUsageScene(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *AiTokenUsageScene) Set(val string) error {
+	v, err := ParseAiTokenUsageScene(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *AiTokenUsageScene) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *AiTokenUsageScene) Type() string {
+	return "AiTokenUsageScene"
+}
+
+const (
+	// 已取消
+	AiVideoStatusCanceled AiVideoStatus = iota + -2
+	// 失败
+	AiVideoStatusFailed
+	// 排队中
+	AiVideoStatusPending
+	// 生成中
+	AiVideoStatusRunning
+	// 成功
+	AiVideoStatusSucceeded
+)
+
+var ErrInvalidAiVideoStatus = fmt.Errorf("not a valid AiVideoStatus, try [%s]", strings.Join(_AiVideoStatusNames, ", "))
+
+const _AiVideoStatusName = "canceledfailedpendingrunningsucceeded"
+
+var _AiVideoStatusNames = []string{
+	_AiVideoStatusName[0:8],
+	_AiVideoStatusName[8:14],
+	_AiVideoStatusName[14:21],
+	_AiVideoStatusName[21:28],
+	_AiVideoStatusName[28:37],
+}
+
+// AiVideoStatusNames returns a list of possible string values of AiVideoStatus.
+func AiVideoStatusNames() []string {
+	tmp := make([]string, len(_AiVideoStatusNames))
+	copy(tmp, _AiVideoStatusNames)
+	return tmp
+}
+
+// AiVideoStatusValues returns a list of the values for AiVideoStatus
+func AiVideoStatusValues() []AiVideoStatus {
+	return []AiVideoStatus{
+		AiVideoStatusCanceled,
+		AiVideoStatusFailed,
+		AiVideoStatusPending,
+		AiVideoStatusRunning,
+		AiVideoStatusSucceeded,
+	}
+}
+
+var _AiVideoStatusMap = map[AiVideoStatus]string{
+	AiVideoStatusCanceled:  _AiVideoStatusName[0:8],
+	AiVideoStatusFailed:    _AiVideoStatusName[8:14],
+	AiVideoStatusPending:   _AiVideoStatusName[14:21],
+	AiVideoStatusRunning:   _AiVideoStatusName[21:28],
+	AiVideoStatusSucceeded: _AiVideoStatusName[28:37],
+}
+
+// String implements the Stringer interface.
+func (x AiVideoStatus) String() string {
+	if str, ok := _AiVideoStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AiVideoStatus(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AiVideoStatus) IsValid() bool {
+	_, ok := _AiVideoStatusMap[x]
+	return ok
+}
+
+var _AiVideoStatusValue = map[string]AiVideoStatus{
+	_AiVideoStatusName[0:8]:   AiVideoStatusCanceled,
+	_AiVideoStatusName[8:14]:  AiVideoStatusFailed,
+	_AiVideoStatusName[14:21]: AiVideoStatusPending,
+	_AiVideoStatusName[21:28]: AiVideoStatusRunning,
+	_AiVideoStatusName[28:37]: AiVideoStatusSucceeded,
+}
+
+// ParseAiVideoStatus attempts to convert a string to a AiVideoStatus.
+func ParseAiVideoStatus(name string) (AiVideoStatus, error) {
+	if x, ok := _AiVideoStatusValue[name]; ok {
+		return x, nil
+	}
+	return AiVideoStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidAiVideoStatus)
+}
+
+func (x AiVideoStatus) Ptr() *AiVideoStatus {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x AiVideoStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AiVideoStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseAiVideoStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *AiVideoStatus) Set(val string) error {
+	v, err := ParseAiVideoStatus(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *AiVideoStatus) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *AiVideoStatus) Type() string {
+	return "AiVideoStatus"
+}
+
+const (
+	// 撰写
+	AiWriteTypeCompose AiWriteType = iota + 1
+	// 回复
+	AiWriteTypeReply
+)
+
+var ErrInvalidAiWriteType = fmt.Errorf("not a valid AiWriteType, try [%s]", strings.Join(_AiWriteTypeNames, ", "))
+
+const _AiWriteTypeName = "composereply"
+
+var _AiWriteTypeNames = []string{
+	_AiWriteTypeName[0:7],
+	_AiWriteTypeName[7:12],
+}
+
+// AiWriteTypeNames returns a list of possible string values of AiWriteType.
+func AiWriteTypeNames() []string {
+	tmp := make([]string, len(_AiWriteTypeNames))
+	copy(tmp, _AiWriteTypeNames)
+	return tmp
+}
+
+// AiWriteTypeValues returns a list of the values for AiWriteType
+func AiWriteTypeValues() []AiWriteType {
+	return []AiWriteType{
+		AiWriteTypeCompose,
+		AiWriteTypeReply,
+	}
+}
+
+var _AiWriteTypeMap = map[AiWriteType]string{
+	AiWriteTypeCompose: _AiWriteTypeName[0:7],
+	AiWriteTypeReply:   _AiWriteTypeName[7:12],
+}
+
+// String implements the Stringer interface.
+func (x AiWriteType) String() string {
+	if str, ok := _AiWriteTypeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AiWriteType(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AiWriteType) IsValid() bool {
+	_, ok := _AiWriteTypeMap[x]
+	return ok
+}
+
+var _AiWriteTypeValue = map[string]AiWriteType{
+	_AiWriteTypeName[0:7]:  AiWriteTypeCompose,
+	_AiWriteTypeName[7:12]: AiWriteTypeReply,
+}
+
+// ParseAiWriteType attempts to convert a string to a AiWriteType.
+func ParseAiWriteType(name string) (AiWriteType, error) {
+	if x, ok := _AiWriteTypeValue[name]; ok {
+		return x, nil
+	}
+	return AiWriteType(0), fmt.Errorf("%s is %w", name, ErrInvalidAiWriteType)
+}
+
+func (x AiWriteType) Ptr() *AiWriteType {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x AiWriteType) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AiWriteType) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseAiWriteType(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *AiWriteType) Set(val string) error {
+	v, err := ParseAiWriteType(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *AiWriteType) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *AiWriteType) Type() string {
+	return "AiWriteType"
+}
+
+const (
+	// 定位
+	DeviceCommandLocate DeviceCommand = "locate"
+	// 截图
+	DeviceCommandScreenshot DeviceCommand = "screenshot"
+)
+
+var ErrInvalidDeviceCommand = fmt.Errorf("not a valid DeviceCommand, try [%s]", strings.Join(_DeviceCommandNames, ", "))
+
+var _DeviceCommandNames = []string{
+	string(DeviceCommandLocate),
+	string(DeviceCommandScreenshot),
+}
+
+// DeviceCommandNames returns a list of possible string values of DeviceCommand.
+func DeviceCommandNames() []string {
+	tmp := make([]string, len(_DeviceCommandNames))
+	copy(tmp, _DeviceCommandNames)
+	return tmp
+}
+
+// DeviceCommandValues returns a list of the values for DeviceCommand
+func DeviceCommandValues() []DeviceCommand {
+	return []DeviceCommand{
+		DeviceCommandLocate,
+		DeviceCommandScreenshot,
+	}
+}
+
+// String implements the Stringer interface.
+func (x DeviceCommand) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DeviceCommand) IsValid() bool {
+	_, err := ParseDeviceCommand(string(x))
+	return err == nil
+}
+
+var _DeviceCommandValue = map[string]DeviceCommand{
+	"locate":     DeviceCommandLocate,
+	"screenshot": DeviceCommandScreenshot,
+}
+
+// ParseDeviceCommand attempts to convert a string to a DeviceCommand.
+func ParseDeviceCommand(name string) (DeviceCommand, error) {
+	if x, ok := _DeviceCommandValue[name]; ok {
+		return x, nil
+	}
+	return DeviceCommand(""), fmt.Errorf("%s is %w", name, ErrInvalidDeviceCommand)
+}
+
+func (x DeviceCommand) Ptr() *DeviceCommand {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x DeviceCommand) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *DeviceCommand) UnmarshalText(text []byte) error {
+	tmp, err := ParseDeviceCommand(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *DeviceCommand) Set(val string) error {
+	v, err := ParseDeviceCommand(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *DeviceCommand) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *DeviceCommand) Type() string {
+	return "DeviceCommand"
+}
+
+const (
+	// 透传消息
+	DevicePushMsgTypeMessage DevicePushMsgType = iota
+	// 通知
+	DevicePushMsgTypeNotification
+)
+
+var ErrInvalidDevicePushMsgType = fmt.Errorf("not a valid DevicePushMsgType, try [%s]", strings.Join(_DevicePushMsgTypeNames, ", "))
+
+const _DevicePushMsgTypeName = "messagenotification"
+
+var _DevicePushMsgTypeNames = []string{
+	_DevicePushMsgTypeName[0:7],
+	_DevicePushMsgTypeName[7:19],
+}
+
+// DevicePushMsgTypeNames returns a list of possible string values of DevicePushMsgType.
+func DevicePushMsgTypeNames() []string {
+	tmp := make([]string, len(_DevicePushMsgTypeNames))
+	copy(tmp, _DevicePushMsgTypeNames)
+	return tmp
+}
+
+// DevicePushMsgTypeValues returns a list of the values for DevicePushMsgType
+func DevicePushMsgTypeValues() []DevicePushMsgType {
+	return []DevicePushMsgType{
+		DevicePushMsgTypeMessage,
+		DevicePushMsgTypeNotification,
+	}
+}
+
+var _DevicePushMsgTypeMap = map[DevicePushMsgType]string{
+	DevicePushMsgTypeMessage:      _DevicePushMsgTypeName[0:7],
+	DevicePushMsgTypeNotification: _DevicePushMsgTypeName[7:19],
+}
+
+// String implements the Stringer interface.
+func (x DevicePushMsgType) String() string {
+	if str, ok := _DevicePushMsgTypeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("DevicePushMsgType(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DevicePushMsgType) IsValid() bool {
+	_, ok := _DevicePushMsgTypeMap[x]
+	return ok
+}
+
+var _DevicePushMsgTypeValue = map[string]DevicePushMsgType{
+	_DevicePushMsgTypeName[0:7]:  DevicePushMsgTypeMessage,
+	_DevicePushMsgTypeName[7:19]: DevicePushMsgTypeNotification,
+}
+
+// ParseDevicePushMsgType attempts to convert a string to a DevicePushMsgType.
+func ParseDevicePushMsgType(name string) (DevicePushMsgType, error) {
+	if x, ok := _DevicePushMsgTypeValue[name]; ok {
+		return x, nil
+	}
+	return DevicePushMsgType(0), fmt.Errorf("%s is %w", name, ErrInvalidDevicePushMsgType)
+}
+
+func (x DevicePushMsgType) Ptr() *DevicePushMsgType {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x DevicePushMsgType) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *DevicePushMsgType) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseDevicePushMsgType(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *DevicePushMsgType) Set(val string) error {
+	v, err := ParseDevicePushMsgType(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *DevicePushMsgType) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *DevicePushMsgType) Type() string {
+	return "DevicePushMsgType"
+}
+
+const (
+	// 失败
+	DevicePushStatusFailed DevicePushStatus = iota + -1
+	// 待推送
+	DevicePushStatusPending
+	// 成功
+	DevicePushStatusSuccess DevicePushStatus = iota + 0
+)
+
+var ErrInvalidDevicePushStatus = fmt.Errorf("not a valid DevicePushStatus, try [%s]", strings.Join(_DevicePushStatusNames, ", "))
+
+const _DevicePushStatusName = "failedpendingsuccess"
+
+var _DevicePushStatusNames = []string{
+	_DevicePushStatusName[0:6],
+	_DevicePushStatusName[6:13],
+	_DevicePushStatusName[13:20],
+}
+
+// DevicePushStatusNames returns a list of possible string values of DevicePushStatus.
+func DevicePushStatusNames() []string {
+	tmp := make([]string, len(_DevicePushStatusNames))
+	copy(tmp, _DevicePushStatusNames)
+	return tmp
+}
+
+// DevicePushStatusValues returns a list of the values for DevicePushStatus
+func DevicePushStatusValues() []DevicePushStatus {
+	return []DevicePushStatus{
+		DevicePushStatusFailed,
+		DevicePushStatusPending,
+		DevicePushStatusSuccess,
+	}
+}
+
+var _DevicePushStatusMap = map[DevicePushStatus]string{
+	DevicePushStatusFailed:  _DevicePushStatusName[0:6],
+	DevicePushStatusPending: _DevicePushStatusName[6:13],
+	DevicePushStatusSuccess: _DevicePushStatusName[13:20],
+}
+
+// String implements the Stringer interface.
+func (x DevicePushStatus) String() string {
+	if str, ok := _DevicePushStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("DevicePushStatus(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DevicePushStatus) IsValid() bool {
+	_, ok := _DevicePushStatusMap[x]
+	return ok
+}
+
+var _DevicePushStatusValue = map[string]DevicePushStatus{
+	_DevicePushStatusName[0:6]:   DevicePushStatusFailed,
+	_DevicePushStatusName[6:13]:  DevicePushStatusPending,
+	_DevicePushStatusName[13:20]: DevicePushStatusSuccess,
+}
+
+// ParseDevicePushStatus attempts to convert a string to a DevicePushStatus.
+func ParseDevicePushStatus(name string) (DevicePushStatus, error) {
+	if x, ok := _DevicePushStatusValue[name]; ok {
+		return x, nil
+	}
+	return DevicePushStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidDevicePushStatus)
+}
+
+func (x DevicePushStatus) Ptr() *DevicePushStatus {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x DevicePushStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *DevicePushStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseDevicePushStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *DevicePushStatus) Set(val string) error {
+	v, err := ParseDevicePushStatus(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *DevicePushStatus) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *DevicePushStatus) Type() string {
+	return "DevicePushStatus"
+}
+
+const (
+	// 单设备
+	DevicePushTypeSingle DevicePushType = "single"
+	// 批量设备
+	DevicePushTypeBatch DevicePushType = "batch"
+	// 标签
+	DevicePushTypeTag DevicePushType = "tag"
+)
+
+var ErrInvalidDevicePushType = fmt.Errorf("not a valid DevicePushType, try [%s]", strings.Join(_DevicePushTypeNames, ", "))
+
+var _DevicePushTypeNames = []string{
+	string(DevicePushTypeSingle),
+	string(DevicePushTypeBatch),
+	string(DevicePushTypeTag),
+}
+
+// DevicePushTypeNames returns a list of possible string values of DevicePushType.
+func DevicePushTypeNames() []string {
+	tmp := make([]string, len(_DevicePushTypeNames))
+	copy(tmp, _DevicePushTypeNames)
+	return tmp
+}
+
+// DevicePushTypeValues returns a list of the values for DevicePushType
+func DevicePushTypeValues() []DevicePushType {
+	return []DevicePushType{
+		DevicePushTypeSingle,
+		DevicePushTypeBatch,
+		DevicePushTypeTag,
+	}
+}
+
+// String implements the Stringer interface.
+func (x DevicePushType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DevicePushType) IsValid() bool {
+	_, err := ParseDevicePushType(string(x))
+	return err == nil
+}
+
+var _DevicePushTypeValue = map[string]DevicePushType{
+	"single": DevicePushTypeSingle,
+	"batch":  DevicePushTypeBatch,
+	"tag":    DevicePushTypeTag,
+}
+
+// ParseDevicePushType attempts to convert a string to a DevicePushType.
+func ParseDevicePushType(name string) (DevicePushType, error) {
+	if x, ok := _DevicePushTypeValue[name]; ok {
+		return x, nil
+	}
+	return DevicePushType(""), fmt.Errorf("%s is %w", name, ErrInvalidDevicePushType)
+}
+
+func (x DevicePushType) Ptr() *DevicePushType {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x DevicePushType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *DevicePushType) UnmarshalText(text []byte) error {
+	tmp, err := ParseDevicePushType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *DevicePushType) Set(val string) error {
+	v, err := ParseDevicePushType(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *DevicePushType) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *DevicePushType) Type() string {
+	return "DevicePushType"
+}
+
+const (
+	// 禁用
+	DeviceStatusDisable DeviceStatus = iota + -1
+	// 启用
+	DeviceStatusEnable DeviceStatus = iota + 0
+)
+
+var ErrInvalidDeviceStatus = fmt.Errorf("not a valid DeviceStatus, try [%s]", strings.Join(_DeviceStatusNames, ", "))
+
+const _DeviceStatusName = "disableenable"
+
+var _DeviceStatusNames = []string{
+	_DeviceStatusName[0:7],
+	_DeviceStatusName[7:13],
+}
+
+// DeviceStatusNames returns a list of possible string values of DeviceStatus.
+func DeviceStatusNames() []string {
+	tmp := make([]string, len(_DeviceStatusNames))
+	copy(tmp, _DeviceStatusNames)
+	return tmp
+}
+
+// DeviceStatusValues returns a list of the values for DeviceStatus
+func DeviceStatusValues() []DeviceStatus {
+	return []DeviceStatus{
+		DeviceStatusDisable,
+		DeviceStatusEnable,
+	}
+}
+
+var _DeviceStatusMap = map[DeviceStatus]string{
+	DeviceStatusDisable: _DeviceStatusName[0:7],
+	DeviceStatusEnable:  _DeviceStatusName[7:13],
+}
+
+// String implements the Stringer interface.
+func (x DeviceStatus) String() string {
+	if str, ok := _DeviceStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("DeviceStatus(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DeviceStatus) IsValid() bool {
+	_, ok := _DeviceStatusMap[x]
+	return ok
+}
+
+var _DeviceStatusValue = map[string]DeviceStatus{
+	_DeviceStatusName[0:7]:  DeviceStatusDisable,
+	_DeviceStatusName[7:13]: DeviceStatusEnable,
+}
+
+// ParseDeviceStatus attempts to convert a string to a DeviceStatus.
+func ParseDeviceStatus(name string) (DeviceStatus, error) {
+	if x, ok := _DeviceStatusValue[name]; ok {
+		return x, nil
+	}
+	return DeviceStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidDeviceStatus)
+}
+
+func (x DeviceStatus) Ptr() *DeviceStatus {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x DeviceStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *DeviceStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseDeviceStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *DeviceStatus) Set(val string) error {
+	v, err := ParseDeviceStatus(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *DeviceStatus) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *DeviceStatus) Type() string {
+	return "DeviceStatus"
+}
+
+const (
+	// 火山云
+	FileStorageVolcengine FileStorage = "volcengine"
+	// 腾讯云
+	FileStorageTencent FileStorage = "tencent"
+	// 阿里云
+	FileStorageAliyun FileStorage = "aliyun"
+	// 七牛云
+	FileStorageQiniu FileStorage = "qiniu"
+)
+
+var ErrInvalidFileStorage = fmt.Errorf("not a valid FileStorage, try [%s]", strings.Join(_FileStorageNames, ", "))
+
+var _FileStorageNames = []string{
+	string(FileStorageVolcengine),
+	string(FileStorageTencent),
+	string(FileStorageAliyun),
+	string(FileStorageQiniu),
+}
+
+// FileStorageNames returns a list of possible string values of FileStorage.
+func FileStorageNames() []string {
+	tmp := make([]string, len(_FileStorageNames))
+	copy(tmp, _FileStorageNames)
+	return tmp
+}
+
+// FileStorageValues returns a list of the values for FileStorage
+func FileStorageValues() []FileStorage {
+	return []FileStorage{
+		FileStorageVolcengine,
+		FileStorageTencent,
+		FileStorageAliyun,
+		FileStorageQiniu,
+	}
+}
+
+// String implements the Stringer interface.
+func (x FileStorage) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x FileStorage) IsValid() bool {
+	_, err := ParseFileStorage(string(x))
+	return err == nil
+}
+
+var _FileStorageValue = map[string]FileStorage{
+	"volcengine": FileStorageVolcengine,
+	"tencent":    FileStorageTencent,
+	"aliyun":     FileStorageAliyun,
+	"qiniu":      FileStorageQiniu,
+}
+
+// ParseFileStorage attempts to convert a string to a FileStorage.
+func ParseFileStorage(name string) (FileStorage, error) {
+	if x, ok := _FileStorageValue[name]; ok {
+		return x, nil
+	}
+	return FileStorage(""), fmt.Errorf("%s is %w", name, ErrInvalidFileStorage)
+}
+
+func (x FileStorage) Ptr() *FileStorage {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x FileStorage) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *FileStorage) UnmarshalText(text []byte) error {
+	tmp, err := ParseFileStorage(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *FileStorage) Set(val string) error {
+	v, err := ParseFileStorage(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *FileStorage) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *FileStorage) Type() string {
+	return "FileStorage"
+}
+
+const (
+	// 发送失败
+	MailSendStatusFailed MailSendStatus = iota + -1
+	// 发送中
+	MailSendStatusPending
+	// 发送成功
+	MailSendStatusSuccess
+	// 退信(永久失败)
+	MailSendStatusBounced
+	// 退信(临时失败)
+	MailSendStatusSoftBounced
+)
+
+var ErrInvalidMailSendStatus = fmt.Errorf("not a valid MailSendStatus, try [%s]", strings.Join(_MailSendStatusNames, ", "))
+
+const _MailSendStatusName = "failedpendingsuccessbouncedsoftBounced"
+
+var _MailSendStatusNames = []string{
+	_MailSendStatusName[0:6],
+	_MailSendStatusName[6:13],
+	_MailSendStatusName[13:20],
+	_MailSendStatusName[20:27],
+	_MailSendStatusName[27:38],
+}
+
+// MailSendStatusNames returns a list of possible string values of MailSendStatus.
+func MailSendStatusNames() []string {
+	tmp := make([]string, len(_MailSendStatusNames))
+	copy(tmp, _MailSendStatusNames)
+	return tmp
+}
+
+// MailSendStatusValues returns a list of the values for MailSendStatus
+func MailSendStatusValues() []MailSendStatus {
+	return []MailSendStatus{
+		MailSendStatusFailed,
+		MailSendStatusPending,
+		MailSendStatusSuccess,
+		MailSendStatusBounced,
+		MailSendStatusSoftBounced,
+	}
+}
+
+var _MailSendStatusMap = map[MailSendStatus]string{
+	MailSendStatusFailed:      _MailSendStatusName[0:6],
+	MailSendStatusPending:     _MailSendStatusName[6:13],
+	MailSendStatusSuccess:     _MailSendStatusName[13:20],
+	MailSendStatusBounced:     _MailSendStatusName[20:27],
+	MailSendStatusSoftBounced: _MailSendStatusName[27:38],
+}
+
+// String implements the Stringer interface.
+func (x MailSendStatus) String() string {
+	if str, ok := _MailSendStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("MailSendStatus(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x MailSendStatus) IsValid() bool {
+	_, ok := _MailSendStatusMap[x]
+	return ok
+}
+
+var _MailSendStatusValue = map[string]MailSendStatus{
+	_MailSendStatusName[0:6]:   MailSendStatusFailed,
+	_MailSendStatusName[6:13]:  MailSendStatusPending,
+	_MailSendStatusName[13:20]: MailSendStatusSuccess,
+	_MailSendStatusName[20:27]: MailSendStatusBounced,
+	_MailSendStatusName[27:38]: MailSendStatusSoftBounced,
+}
+
+// ParseMailSendStatus attempts to convert a string to a MailSendStatus.
+func ParseMailSendStatus(name string) (MailSendStatus, error) {
+	if x, ok := _MailSendStatusValue[name]; ok {
+		return x, nil
+	}
+	return MailSendStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidMailSendStatus)
+}
+
+func (x MailSendStatus) Ptr() *MailSendStatus {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x MailSendStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *MailSendStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseMailSendStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *MailSendStatus) Set(val string) error {
+	v, err := ParseMailSendStatus(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *MailSendStatus) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *MailSendStatus) Type() string {
+	return "MailSendStatus"
+}
+
+const (
+	// 会员
+	MallProductTypeMembership MallProductType = "membership"
+	// 服务
+	MallProductTypeService MallProductType = "service"
+	// 商品
+	MallProductTypeGoods MallProductType = "goods"
+)
+
+var ErrInvalidMallProductType = fmt.Errorf("not a valid MallProductType, try [%s]", strings.Join(_MallProductTypeNames, ", "))
+
+var _MallProductTypeNames = []string{
+	string(MallProductTypeMembership),
+	string(MallProductTypeService),
+	string(MallProductTypeGoods),
+}
+
+// MallProductTypeNames returns a list of possible string values of MallProductType.
+func MallProductTypeNames() []string {
+	tmp := make([]string, len(_MallProductTypeNames))
+	copy(tmp, _MallProductTypeNames)
+	return tmp
+}
+
+// MallProductTypeValues returns a list of the values for MallProductType
+func MallProductTypeValues() []MallProductType {
+	return []MallProductType{
+		MallProductTypeMembership,
+		MallProductTypeService,
+		MallProductTypeGoods,
+	}
+}
+
+// String implements the Stringer interface.
+func (x MallProductType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x MallProductType) IsValid() bool {
+	_, err := ParseMallProductType(string(x))
+	return err == nil
+}
+
+var _MallProductTypeValue = map[string]MallProductType{
+	"membership": MallProductTypeMembership,
+	"service":    MallProductTypeService,
+	"goods":      MallProductTypeGoods,
+}
+
+// ParseMallProductType attempts to convert a string to a MallProductType.
+func ParseMallProductType(name string) (MallProductType, error) {
+	if x, ok := _MallProductTypeValue[name]; ok {
+		return x, nil
+	}
+	return MallProductType(""), fmt.Errorf("%s is %w", name, ErrInvalidMallProductType)
+}
+
+func (x MallProductType) Ptr() *MallProductType {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x MallProductType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *MallProductType) UnmarshalText(text []byte) error {
+	tmp, err := ParseMallProductType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *MallProductType) Set(val string) error {
+	v, err := ParseMallProductType(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *MallProductType) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *MallProductType) Type() string {
+	return "MallProductType"
+}
+
+const (
+	// 定位
+	MembershipBenefitKeyLocation MembershipBenefitKey = "location"
+	// 截图
+	MembershipBenefitKeyScreen MembershipBenefitKey = "screen"
+	// 敲一敲
+	MembershipBenefitKeyKnock MembershipBenefitKey = "knock"
+)
+
+var ErrInvalidMembershipBenefitKey = fmt.Errorf("not a valid MembershipBenefitKey, try [%s]", strings.Join(_MembershipBenefitKeyNames, ", "))
+
+var _MembershipBenefitKeyNames = []string{
+	string(MembershipBenefitKeyLocation),
+	string(MembershipBenefitKeyScreen),
+	string(MembershipBenefitKeyKnock),
+}
+
+// MembershipBenefitKeyNames returns a list of possible string values of MembershipBenefitKey.
+func MembershipBenefitKeyNames() []string {
+	tmp := make([]string, len(_MembershipBenefitKeyNames))
+	copy(tmp, _MembershipBenefitKeyNames)
+	return tmp
+}
+
+// MembershipBenefitKeyValues returns a list of the values for MembershipBenefitKey
+func MembershipBenefitKeyValues() []MembershipBenefitKey {
+	return []MembershipBenefitKey{
+		MembershipBenefitKeyLocation,
+		MembershipBenefitKeyScreen,
+		MembershipBenefitKeyKnock,
+	}
+}
+
+// String implements the Stringer interface.
+func (x MembershipBenefitKey) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x MembershipBenefitKey) IsValid() bool {
+	_, err := ParseMembershipBenefitKey(string(x))
+	return err == nil
+}
+
+var _MembershipBenefitKeyValue = map[string]MembershipBenefitKey{
+	"location": MembershipBenefitKeyLocation,
+	"screen":   MembershipBenefitKeyScreen,
+	"knock":    MembershipBenefitKeyKnock,
+}
+
+// ParseMembershipBenefitKey attempts to convert a string to a MembershipBenefitKey.
+func ParseMembershipBenefitKey(name string) (MembershipBenefitKey, error) {
+	if x, ok := _MembershipBenefitKeyValue[name]; ok {
+		return x, nil
+	}
+	return MembershipBenefitKey(""), fmt.Errorf("%s is %w", name, ErrInvalidMembershipBenefitKey)
+}
+
+func (x MembershipBenefitKey) Ptr() *MembershipBenefitKey {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x MembershipBenefitKey) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *MembershipBenefitKey) UnmarshalText(text []byte) error {
+	tmp, err := ParseMembershipBenefitKey(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *MembershipBenefitKey) Set(val string) error {
+	v, err := ParseMembershipBenefitKey(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *MembershipBenefitKey) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *MembershipBenefitKey) Type() string {
+	return "MembershipBenefitKey"
+}
+
+const (
+	// 普通会员
+	MembershipTypeNormal MembershipType = "normal"
+	// 会员
+	MembershipTypeVip MembershipType = "vip"
+	// 超级会员
+	MembershipTypeSvip MembershipType = "svip"
+)
+
+var ErrInvalidMembershipType = fmt.Errorf("not a valid MembershipType, try [%s]", strings.Join(_MembershipTypeNames, ", "))
+
+var _MembershipTypeNames = []string{
+	string(MembershipTypeNormal),
+	string(MembershipTypeVip),
+	string(MembershipTypeSvip),
+}
+
+// MembershipTypeNames returns a list of possible string values of MembershipType.
+func MembershipTypeNames() []string {
+	tmp := make([]string, len(_MembershipTypeNames))
+	copy(tmp, _MembershipTypeNames)
+	return tmp
+}
+
+// MembershipTypeValues returns a list of the values for MembershipType
+func MembershipTypeValues() []MembershipType {
+	return []MembershipType{
+		MembershipTypeNormal,
+		MembershipTypeVip,
+		MembershipTypeSvip,
+	}
+}
+
+// String implements the Stringer interface.
+func (x MembershipType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x MembershipType) IsValid() bool {
+	_, err := ParseMembershipType(string(x))
+	return err == nil
+}
+
+var _MembershipTypeValue = map[string]MembershipType{
+	"normal": MembershipTypeNormal,
+	"vip":    MembershipTypeVip,
+	"svip":   MembershipTypeSvip,
+}
+
+// ParseMembershipType attempts to convert a string to a MembershipType.
+func ParseMembershipType(name string) (MembershipType, error) {
+	if x, ok := _MembershipTypeValue[name]; ok {
+		return x, nil
+	}
+	return MembershipType(""), fmt.Errorf("%s is %w", name, ErrInvalidMembershipType)
+}
+
+func (x MembershipType) Ptr() *MembershipType {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x MembershipType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *MembershipType) UnmarshalText(text []byte) error {
+	tmp, err := ParseMembershipType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *MembershipType) Set(val string) error {
+	v, err := ParseMembershipType(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *MembershipType) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *MembershipType) Type() string {
+	return "MembershipType"
+}
+
+const (
+	// 系统通知
+	NotifyCategorySystem NotifyCategory = "system"
+	// 活动通知
+	NotifyCategoryActivity NotifyCategory = "activity"
+	// 订单通知
+	NotifyCategoryOrder NotifyCategory = "order"
+	// 消息通知
+	NotifyCategoryMessage NotifyCategory = "message"
+)
+
+var ErrInvalidNotifyCategory = fmt.Errorf("not a valid NotifyCategory, try [%s]", strings.Join(_NotifyCategoryNames, ", "))
+
+var _NotifyCategoryNames = []string{
+	string(NotifyCategorySystem),
+	string(NotifyCategoryActivity),
+	string(NotifyCategoryOrder),
+	string(NotifyCategoryMessage),
+}
+
+// NotifyCategoryNames returns a list of possible string values of NotifyCategory.
+func NotifyCategoryNames() []string {
+	tmp := make([]string, len(_NotifyCategoryNames))
+	copy(tmp, _NotifyCategoryNames)
+	return tmp
+}
+
+// NotifyCategoryValues returns a list of the values for NotifyCategory
+func NotifyCategoryValues() []NotifyCategory {
+	return []NotifyCategory{
+		NotifyCategorySystem,
+		NotifyCategoryActivity,
+		NotifyCategoryOrder,
+		NotifyCategoryMessage,
+	}
+}
+
+// String implements the Stringer interface.
+func (x NotifyCategory) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x NotifyCategory) IsValid() bool {
+	_, err := ParseNotifyCategory(string(x))
+	return err == nil
+}
+
+var _NotifyCategoryValue = map[string]NotifyCategory{
+	"system":   NotifyCategorySystem,
+	"activity": NotifyCategoryActivity,
+	"order":    NotifyCategoryOrder,
+	"message":  NotifyCategoryMessage,
+}
+
+// ParseNotifyCategory attempts to convert a string to a NotifyCategory.
+func ParseNotifyCategory(name string) (NotifyCategory, error) {
+	if x, ok := _NotifyCategoryValue[name]; ok {
+		return x, nil
+	}
+	return NotifyCategory(""), fmt.Errorf("%s is %w", name, ErrInvalidNotifyCategory)
+}
+
+func (x NotifyCategory) Ptr() *NotifyCategory {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x NotifyCategory) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *NotifyCategory) UnmarshalText(text []byte) error {
+	tmp, err := ParseNotifyCategory(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *NotifyCategory) Set(val string) error {
+	v, err := ParseNotifyCategory(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *NotifyCategory) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *NotifyCategory) Type() string {
+	return "NotifyCategory"
+}
+
+const (
+	// 站内信
+	NotifyChannelInApp NotifyChannel = "inApp"
+	// 短信
+	NotifyChannelSms NotifyChannel = "sms"
+	// 邮件
+	NotifyChannelMail NotifyChannel = "mail"
+	// 公众号模板消息
+	NotifyChannelWxGzh NotifyChannel = "wxGzh"
+	// 推送
+	NotifyChannelPush NotifyChannel = "push"
+)
+
+var ErrInvalidNotifyChannel = fmt.Errorf("not a valid NotifyChannel, try [%s]", strings.Join(_NotifyChannelNames, ", "))
+
+var _NotifyChannelNames = []string{
+	string(NotifyChannelInApp),
+	string(NotifyChannelSms),
+	string(NotifyChannelMail),
+	string(NotifyChannelWxGzh),
+	string(NotifyChannelPush),
+}
+
+// NotifyChannelNames returns a list of possible string values of NotifyChannel.
+func NotifyChannelNames() []string {
+	tmp := make([]string, len(_NotifyChannelNames))
+	copy(tmp, _NotifyChannelNames)
+	return tmp
+}
+
+// NotifyChannelValues returns a list of the values for NotifyChannel
+func NotifyChannelValues() []NotifyChannel {
+	return []NotifyChannel{
+		NotifyChannelInApp,
+		NotifyChannelSms,
+		NotifyChannelMail,
+		NotifyChannelWxGzh,
+		NotifyChannelPush,
+	}
+}
+
+// String implements the Stringer interface.
+func (x NotifyChannel) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x NotifyChannel) IsValid() bool {
+	_, err := ParseNotifyChannel(string(x))
+	return err == nil
+}
+
+var _NotifyChannelValue = map[string]NotifyChannel{
+	"inApp": NotifyChannelInApp,
+	"sms":   NotifyChannelSms,
+	"mail":  NotifyChannelMail,
+	"wxGzh": NotifyChannelWxGzh,
+	"push":  NotifyChannelPush,
+}
+
+// ParseNotifyChannel attempts to convert a string to a NotifyChannel.
+func ParseNotifyChannel(name string) (NotifyChannel, error) {
+	if x, ok := _NotifyChannelValue[name]; ok {
+		return x, nil
+	}
+	return NotifyChannel(""), fmt.Errorf("%s is %w", name, ErrInvalidNotifyChannel)
+}
+
+func (x NotifyChannel) Ptr() *NotifyChannel {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x NotifyChannel) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *NotifyChannel) UnmarshalText(text []byte) error {
+	tmp, err := ParseNotifyChannel(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *NotifyChannel) Set(val string) error {
+	v, err := ParseNotifyChannel(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *NotifyChannel) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *NotifyChannel) Type() string {
+	return "NotifyChannel"
+}
+
+const (
+	// 阿里云
+	SmsChannelCodeALIYUN SmsChannelCode = "ALIYUN"
+	// 腾讯云
+	SmsChannelCodeTENCENT SmsChannelCode = "TENCENT"
+	// 华为云
+	SmsChannelCodeHUAWEI SmsChannelCode = "HUAWEI"
+	// 七牛云
+	SmsChannelCodeQINIU SmsChannelCode = "QINIU"
+	// 云片
+	SmsChannelCodeYUNPIAN SmsChannelCode = "YUNPIAN"
+)
+
+var ErrInvalidSmsChannelCode = fmt.Errorf("not a valid SmsChannelCode, try [%s]", strings.Join(_SmsChannelCodeNames, ", "))
+
+var _SmsChannelCodeNames = []string{
+	string(SmsChannelCodeALIYUN),
+	string(SmsChannelCodeTENCENT),
+	string(SmsChannelCodeHUAWEI),
+	string(SmsChannelCodeQINIU),
+	string(SmsChannelCodeYUNPIAN),
+}
+
+// SmsChannelCodeNames returns a list of possible string values of SmsChannelCode.
+func SmsChannelCodeNames() []string {
+	tmp := make([]string, len(_SmsChannelCodeNames))
+	copy(tmp, _SmsChannelCodeNames)
+	return tmp
+}
+
+// SmsChannelCodeValues returns a list of the values for SmsChannelCode
+func SmsChannelCodeValues() []SmsChannelCode {
+	return []SmsChannelCode{
+		SmsChannelCodeALIYUN,
+		SmsChannelCodeTENCENT,
+		SmsChannelCodeHUAWEI,
+		SmsChannelCodeQINIU,
+		SmsChannelCodeYUNPIAN,
+	}
+}
+
+// String implements the Stringer interface.
+func (x SmsChannelCode) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SmsChannelCode) IsValid() bool {
+	_, err := ParseSmsChannelCode(string(x))
+	return err == nil
+}
+
+var _SmsChannelCodeValue = map[string]SmsChannelCode{
+	"ALIYUN":  SmsChannelCodeALIYUN,
+	"TENCENT": SmsChannelCodeTENCENT,
+	"HUAWEI":  SmsChannelCodeHUAWEI,
+	"QINIU":   SmsChannelCodeQINIU,
+	"YUNPIAN": SmsChannelCodeYUNPIAN,
+}
+
+// ParseSmsChannelCode attempts to convert a string to a SmsChannelCode.
+func ParseSmsChannelCode(name string) (SmsChannelCode, error) {
+	if x, ok := _SmsChannelCodeValue[name]; ok {
+		return x, nil
+	}
+	return SmsChannelCode(""), fmt.Errorf("%s is %w", name, ErrInvalidSmsChannelCode)
+}
+
+func (x SmsChannelCode) Ptr() *SmsChannelCode {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x SmsChannelCode) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *SmsChannelCode) UnmarshalText(text []byte) error {
+	tmp, err := ParseSmsChannelCode(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *SmsChannelCode) Set(val string) error {
+	v, err := ParseSmsChannelCode(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *SmsChannelCode) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *SmsChannelCode) Type() string {
+	return "SmsChannelCode"
+}
+
+const (
+	// 等待回执
+	SmsReceiveStatusPending SmsReceiveStatus = "pending"
+	// 接收成功
+	SmsReceiveStatusSuccess SmsReceiveStatus = "success"
+	// 接收失败
+	SmsReceiveStatusFailed SmsReceiveStatus = "failed"
+)
+
+var ErrInvalidSmsReceiveStatus = fmt.Errorf("not a valid SmsReceiveStatus, try [%s]", strings.Join(_SmsReceiveStatusNames, ", "))
+
+var _SmsReceiveStatusNames = []string{
+	string(SmsReceiveStatusPending),
+	string(SmsReceiveStatusSuccess),
+	string(SmsReceiveStatusFailed),
+}
+
+// SmsReceiveStatusNames returns a list of possible string values of SmsReceiveStatus.
+func SmsReceiveStatusNames() []string {
+	tmp := make([]string, len(_SmsReceiveStatusNames))
+	copy(tmp, _SmsReceiveStatusNames)
+	return tmp
+}
+
+// SmsReceiveStatusValues returns a list of the values for SmsReceiveStatus
+func SmsReceiveStatusValues() []SmsReceiveStatus {
+	return []SmsReceiveStatus{
+		SmsReceiveStatusPending,
+		SmsReceiveStatusSuccess,
+		SmsReceiveStatusFailed,
+	}
+}
+
+// String implements the Stringer interface.
+func (x SmsReceiveStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SmsReceiveStatus) IsValid() bool {
+	_, err := ParseSmsReceiveStatus(string(x))
+	return err == nil
+}
+
+var _SmsReceiveStatusValue = map[string]SmsReceiveStatus{
+	"pending": SmsReceiveStatusPending,
+	"success": SmsReceiveStatusSuccess,
+	"failed":  SmsReceiveStatusFailed,
+}
+
+// ParseSmsReceiveStatus attempts to convert a string to a SmsReceiveStatus.
+func ParseSmsReceiveStatus(name string) (SmsReceiveStatus, error) {
+	if x, ok := _SmsReceiveStatusValue[name]; ok {
+		return x, nil
+	}
+	return SmsReceiveStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidSmsReceiveStatus)
+}
+
+func (x SmsReceiveStatus) Ptr() *SmsReceiveStatus {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x SmsReceiveStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *SmsReceiveStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseSmsReceiveStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *SmsReceiveStatus) Set(val string) error {
+	v, err := ParseSmsReceiveStatus(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *SmsReceiveStatus) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *SmsReceiveStatus) Type() string {
+	return "SmsReceiveStatus"
+}
+
+const (
+	// 发送成功
+	SmsSendStatusSuccess SmsSendStatus = "success"
+	// 发送失败
+	SmsSendStatusFailed SmsSendStatus = "failed"
+)
+
+var ErrInvalidSmsSendStatus = fmt.Errorf("not a valid SmsSendStatus, try [%s]", strings.Join(_SmsSendStatusNames, ", "))
+
+var _SmsSendStatusNames = []string{
+	string(SmsSendStatusSuccess),
+	string(SmsSendStatusFailed),
+}
+
+// SmsSendStatusNames returns a list of possible string values of SmsSendStatus.
+func SmsSendStatusNames() []string {
+	tmp := make([]string, len(_SmsSendStatusNames))
+	copy(tmp, _SmsSendStatusNames)
+	return tmp
+}
+
+// SmsSendStatusValues returns a list of the values for SmsSendStatus
+func SmsSendStatusValues() []SmsSendStatus {
+	return []SmsSendStatus{
+		SmsSendStatusSuccess,
+		SmsSendStatusFailed,
+	}
+}
+
+// String implements the Stringer interface.
+func (x SmsSendStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SmsSendStatus) IsValid() bool {
+	_, err := ParseSmsSendStatus(string(x))
+	return err == nil
+}
+
+var _SmsSendStatusValue = map[string]SmsSendStatus{
+	"success": SmsSendStatusSuccess,
+	"failed":  SmsSendStatusFailed,
+}
+
+// ParseSmsSendStatus attempts to convert a string to a SmsSendStatus.
+func ParseSmsSendStatus(name string) (SmsSendStatus, error) {
+	if x, ok := _SmsSendStatusValue[name]; ok {
+		return x, nil
+	}
+	return SmsSendStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidSmsSendStatus)
+}
+
+func (x SmsSendStatus) Ptr() *SmsSendStatus {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x SmsSendStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *SmsSendStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseSmsSendStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *SmsSendStatus) Set(val string) error {
+	v, err := ParseSmsSendStatus(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *SmsSendStatus) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *SmsSendStatus) Type() string {
+	return "SmsSendStatus"
+}
+
+const (
+	// 禁用
+	StatusDisable Status = iota + -1
+	// 启用
+	StatusEnable Status = iota + 0
+)
+
+var ErrInvalidStatus = fmt.Errorf("not a valid Status, try [%s]", strings.Join(_StatusNames, ", "))
+
+const _StatusName = "disableenable"
+
+var _StatusNames = []string{
+	_StatusName[0:7],
+	_StatusName[7:13],
+}
+
+// StatusNames returns a list of possible string values of Status.
+func StatusNames() []string {
+	tmp := make([]string, len(_StatusNames))
+	copy(tmp, _StatusNames)
+	return tmp
+}
+
+// StatusValues returns a list of the values for Status
+func StatusValues() []Status {
+	return []Status{
+		StatusDisable,
+		StatusEnable,
+	}
+}
+
+var _StatusMap = map[Status]string{
+	StatusDisable: _StatusName[0:7],
+	StatusEnable:  _StatusName[7:13],
+}
+
+// String implements the Stringer interface.
+func (x Status) String() string {
+	if str, ok := _StatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Status(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Status) IsValid() bool {
+	_, ok := _StatusMap[x]
+	return ok
+}
+
+var _StatusValue = map[string]Status{
+	_StatusName[0:7]:  StatusDisable,
+	_StatusName[7:13]: StatusEnable,
+}
+
+// ParseStatus attempts to convert a string to a Status.
+func ParseStatus(name string) (Status, error) {
+	if x, ok := _StatusValue[name]; ok {
+		return x, nil
+	}
+	return Status(0), fmt.Errorf("%s is %w", name, ErrInvalidStatus)
+}
+
+func (x Status) Ptr() *Status {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x Status) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Status) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *Status) Set(val string) error {
+	v, err := ParseStatus(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *Status) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *Status) Type() string {
+	return "Status"
+}
+
+const (
+	// 根节点
+	SysDeptTypeRoot SysDeptType = "root"
+	// 子节点
+	SysDeptTypeChild SysDeptType = "child"
+	// 叶子节点
+	SysDeptTypeLeaf SysDeptType = "leaf"
+)
+
+var ErrInvalidSysDeptType = fmt.Errorf("not a valid SysDeptType, try [%s]", strings.Join(_SysDeptTypeNames, ", "))
+
+var _SysDeptTypeNames = []string{
+	string(SysDeptTypeRoot),
+	string(SysDeptTypeChild),
+	string(SysDeptTypeLeaf),
+}
+
+// SysDeptTypeNames returns a list of possible string values of SysDeptType.
+func SysDeptTypeNames() []string {
+	tmp := make([]string, len(_SysDeptTypeNames))
+	copy(tmp, _SysDeptTypeNames)
+	return tmp
+}
+
+// SysDeptTypeValues returns a list of the values for SysDeptType
+func SysDeptTypeValues() []SysDeptType {
+	return []SysDeptType{
+		SysDeptTypeRoot,
+		SysDeptTypeChild,
+		SysDeptTypeLeaf,
+	}
+}
+
+// String implements the Stringer interface.
+func (x SysDeptType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SysDeptType) IsValid() bool {
+	_, err := ParseSysDeptType(string(x))
+	return err == nil
+}
+
+var _SysDeptTypeValue = map[string]SysDeptType{
+	"root":  SysDeptTypeRoot,
+	"child": SysDeptTypeChild,
+	"leaf":  SysDeptTypeLeaf,
+}
+
+// ParseSysDeptType attempts to convert a string to a SysDeptType.
+func ParseSysDeptType(name string) (SysDeptType, error) {
+	if x, ok := _SysDeptTypeValue[name]; ok {
+		return x, nil
+	}
+	return SysDeptType(""), fmt.Errorf("%s is %w", name, ErrInvalidSysDeptType)
+}
+
+func (x SysDeptType) Ptr() *SysDeptType {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x SysDeptType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *SysDeptType) UnmarshalText(text []byte) error {
+	tmp, err := ParseSysDeptType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *SysDeptType) Set(val string) error {
+	v, err := ParseSysDeptType(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *SysDeptType) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *SysDeptType) Type() string {
+	return "SysDeptType"
+}
+
+const (
+	// 目录
+	SysMenuTypeDir SysMenuType = "dir"
+	// 菜单
+	SysMenuTypeMenu SysMenuType = "menu"
+	// 按钮
+	SysMenuTypeButton SysMenuType = "button"
+)
+
+var ErrInvalidSysMenuType = fmt.Errorf("not a valid SysMenuType, try [%s]", strings.Join(_SysMenuTypeNames, ", "))
+
+var _SysMenuTypeNames = []string{
+	string(SysMenuTypeDir),
+	string(SysMenuTypeMenu),
+	string(SysMenuTypeButton),
+}
+
+// SysMenuTypeNames returns a list of possible string values of SysMenuType.
+func SysMenuTypeNames() []string {
+	tmp := make([]string, len(_SysMenuTypeNames))
+	copy(tmp, _SysMenuTypeNames)
+	return tmp
+}
+
+// SysMenuTypeValues returns a list of the values for SysMenuType
+func SysMenuTypeValues() []SysMenuType {
+	return []SysMenuType{
+		SysMenuTypeDir,
+		SysMenuTypeMenu,
+		SysMenuTypeButton,
+	}
+}
+
+// String implements the Stringer interface.
+func (x SysMenuType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SysMenuType) IsValid() bool {
+	_, err := ParseSysMenuType(string(x))
+	return err == nil
+}
+
+var _SysMenuTypeValue = map[string]SysMenuType{
+	"dir":    SysMenuTypeDir,
+	"menu":   SysMenuTypeMenu,
+	"button": SysMenuTypeButton,
+}
+
+// ParseSysMenuType attempts to convert a string to a SysMenuType.
+func ParseSysMenuType(name string) (SysMenuType, error) {
+	if x, ok := _SysMenuTypeValue[name]; ok {
+		return x, nil
+	}
+	return SysMenuType(""), fmt.Errorf("%s is %w", name, ErrInvalidSysMenuType)
+}
+
+func (x SysMenuType) Ptr() *SysMenuType {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x SysMenuType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *SysMenuType) UnmarshalText(text []byte) error {
+	tmp, err := ParseSysMenuType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *SysMenuType) Set(val string) error {
+	v, err := ParseSysMenuType(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *SysMenuType) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *SysMenuType) Type() string {
+	return "SysMenuType"
+}
+
+const (
+	// 发布失败
+	SysNoticePublishStatusFailed SysNoticePublishStatus = iota + -1
+	// 草稿
+	SysNoticePublishStatusDraft
+	// 待发布
+	SysNoticePublishStatusScheduled
+	// 发布中
+	SysNoticePublishStatusPublishing
+	// 已发布
+	SysNoticePublishStatusPublished
+	// 已过期
+	SysNoticePublishStatusExpired
 )
 
-var ErrInvalidSmsChannelCode = fmt.Errorf("not a valid SmsChannelCode, try [%s]", strings.Join(_SmsChannelCodeNames, ", "))
+var ErrInvalidSysNoticePublishStatus = fmt.Errorf("not a valid SysNoticePublishStatus, try [%s]", strings.Join(_SysNoticePublishStatusNames, ", "))
 
-var _SmsChannelCodeNames = []string{
-	string(SmsChannelCodeALIYUN),
-	string(SmsChannelCodeTENCENT),
-	string(SmsChannelCodeHUAWEI),
-	string(SmsChannelCodeQINIU),
-	string(SmsChannelCodeYUNPIAN),
+const _SysNoticePublishStatusName = "faileddraftscheduledpublishingpublishedexpired"
+
+var _SysNoticePublishStatusNames = []string{
+	_SysNoticePublishStatusName[0:6],
+	_SysNoticePublishStatusName[6:11],
+	_SysNoticePublishStatusName[11:20],
+	_SysNoticePublishStatusName[20:30],
+	_SysNoticePublishStatusName[30:39],
+	_SysNoticePublishStatusName[39:46],
 }
 
-// SmsChannelCodeNames returns a list of possible string values of SmsChannelCode.
-func SmsChannelCodeNames() []string {
-	tmp := make([]string, len(_SmsChannelCodeNames))
-	copy(tmp, _SmsChannelCodeNames)
+// SysNoticePublishStatusNames returns a list of possible string values of SysNoticePublishStatus.
+func SysNoticePublishStatusNames() []string {
+	tmp := make([]string, len(_SysNoticePublishStatusNames))
+	copy(tmp, _SysNoticePublishStatusNames)
 	return tmp
 }
 
-// SmsChannelCodeValues returns a list of the values for SmsChannelCode
-func SmsChannelCodeValues() []SmsChannelCode {
-	return []SmsChannelCode{
-		SmsChannelCodeALIYUN,
-		SmsChannelCodeTENCENT,
-		SmsChannelCodeHUAWEI,
-		SmsChannelCodeQINIU,
-		SmsChannelCodeYUNPIAN,
+// SysNoticePublishStatusValues returns a list of the values for SysNoticePublishStatus
+func SysNoticePublishStatusValues() []SysNoticePublishStatus {
+	return []SysNoticePublishStatus{
+		SysNoticePublishStatusFailed,
+		SysNoticePublishStatusDraft,
+		SysNoticePublishStatusScheduled,
+		SysNoticePublishStatusPublishing,
+		SysNoticePublishStatusPublished,
+		SysNoticePublishStatusExpired,
 	}
 }
 
+var _SysNoticePublishStatusMap = map[SysNoticePublishStatus]string{
+	SysNoticePublishStatusFailed:     _SysNoticePublishStatusName[0:6],
+	SysNoticePublishStatusDraft:      _SysNoticePublishStatusName[6:11],
+	SysNoticePublishStatusScheduled:  _SysNoticePublishStatusName[11:20],
+	SysNoticePublishStatusPublishing: _SysNoticePublishStatusName[20:30],
+	SysNoticePublishStatusPublished:  _SysNoticePublishStatusName[30:39],
+	SysNoticePublishStatusExpired:    _SysNoticePublishStatusName[39:46],
+}
+
 // String implements the Stringer interface.
-func (x SmsChannelCode) String() string {
-	return string(x)
+func (x SysNoticePublishStatus) String() string {
+	if str, ok := _SysNoticePublishStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("SysNoticePublishStatus(%d)", x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x SmsChannelCode) IsValid() bool {
-	_, err := ParseSmsChannelCode(string(x))
-	return err == nil
+func (x SysNoticePublishStatus) IsValid() bool {
+	_, ok := _SysNoticePublishStatusMap[x]
+	return ok
 }
 
-var _SmsChannelCodeValue = map[string]SmsChannelCode{
-	"ALIYUN":  SmsChannelCodeALIYUN,
-	"TENCENT": SmsChannelCodeTENCENT,
-	"HUAWEI":  SmsChannelCodeHUAWEI,
-	"QINIU":   SmsChannelCodeQINIU,
-	"YUNPIAN": SmsChannelCodeYUNPIAN,
+var _SysNoticePublishStatusValue = map[string]SysNoticePublishStatus{
+	_SysNoticePublishStatusName[0:6]:   SysNoticePublishStatusFailed,
+	_SysNoticePublishStatusName[6:11]:  SysNoticePublishStatusDraft,
+	_SysNoticePublishStatusName[11:20]: SysNoticePublishStatusScheduled,
+	_SysNoticePublishStatusName[20:30]: SysNoticePublishStatusPublishing,
+	_SysNoticePublishStatusName[30:39]: SysNoticePublishStatusPublished,
+	_SysNoticePublishStatusName[39:46]: SysNoticePublishStatusExpired,
 }
 
-// ParseSmsChannelCode attempts to convert a string to a SmsChannelCode.
-func ParseSmsChannelCode(name string) (SmsChannelCode, error) {
-	if x, ok := _SmsChannelCodeValue[name]; ok {
+// ParseSysNoticePublishStatus attempts to convert a string to a SysNoticePublishStatus.
+func ParseSysNoticePublishStatus(name string) (SysNoticePublishStatus, error) {
+	if x, ok := _SysNoticePublishStatusValue[name]; ok {
 		return x, nil
 	}
-	return SmsChannelCode(""), fmt.Errorf("%s is %w", name, ErrInvalidSmsChannelCode)
+	return SysNoticePublishStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidSysNoticePublishStatus)
 }
 
-func (x SmsChannelCode) Ptr() *SmsChannelCode {
+func (x SysNoticePublishStatus) Ptr() *SysNoticePublishStatus {
 	return &x
 }
 
 // MarshalText implements the text marshaller method.
-func (x SmsChannelCode) MarshalText() ([]byte, error) {
-	return []byte(string(x)), nil
+func (x SysNoticePublishStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *SmsChannelCode) UnmarshalText(text []byte) error {
-	tmp, err := ParseSmsChannelCode(string(text))
+func (x *SysNoticePublishStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseSysNoticePublishStatus(name)
 	if err != nil {
 		return err
 	}
@@ -711,99 +2886,98 @@ func (x *SmsChannelCode) UnmarshalText(text []byte) error {
 }
 
 // Set implements the Golang flag.Value interface func.
-func (x *SmsChannelCode) Set(val string) error {
-	v, err := ParseSmsChannelCode(val)
+func (x *SysNoticePublishStatus) Set(val string) error {
+	v, err := ParseSysNoticePublishStatus(val)
 	*x = v
 	return err
 }
 
 // Get implements the Golang flag.Getter interface func.
-func (x *SmsChannelCode) Get() interface{} {
+func (x *SysNoticePublishStatus) Get() interface{} {
 	return *x
 }
 
 // Type implements the github.com/spf13/pFlag Value interface.
-func (x *SmsChannelCode) Type() string {
-	return "SmsChannelCode"
+func (x *SysNoticePublishStatus) Type() string {
+	return "SysNoticePublishStatus"
 }
 
 const (
-	// 禁用
-	StatusDisable Status = iota + -1
-	// 启用
-	StatusEnable Status = iota + 0
+	// 全部租户
+	SysNoticeTargetTypeAll SysNoticeTargetType = "all"
+	// 指定租户
+	SysNoticeTargetTypeTenant SysNoticeTargetType = "tenant"
+	// 指定角色
+	SysNoticeTargetTypeRole SysNoticeTargetType = "role"
+	// 指定部门
+	SysNoticeTargetTypeDept SysNoticeTargetType = "dept"
 )
 
-var ErrInvalidStatus = fmt.Errorf("not a valid Status, try [%s]", strings.Join(_StatusNames, ", "))
-
-const _StatusName = "disableenable"
+var ErrInvalidSysNoticeTargetType = fmt.Errorf("not a valid SysNoticeTargetType, try [%s]", strings.Join(_SysNoticeTargetTypeNames, ", "))
 
-var _StatusNames = []string{
-	_StatusName[0:7],
-	_StatusName[7:13],
+var _SysNoticeTargetTypeNames = []string{
+	string(SysNoticeTargetTypeAll),
+	string(SysNoticeTargetTypeTenant),
+	string(SysNoticeTargetTypeRole),
+	string(SysNoticeTargetTypeDept),
 }
 
-// StatusNames returns a list of possible string values of Status.
-func StatusNames() []string {
-	tmp := make([]string, len(_StatusNames))
-	copy(tmp, _StatusNames)
+// SysNoticeTargetTypeNames returns a list of possible string values of SysNoticeTargetType.
+func SysNoticeTargetTypeNames() []string {
+	tmp := make([]string, len(_SysNoticeTargetTypeNames))
+	copy(tmp, _SysNoticeTargetTypeNames)
 	return tmp
 }
 
-// StatusValues returns a list of the values for Status
-func StatusValues() []Status {
-	return []Status{
-		StatusDisable,
-		StatusEnable,
+// SysNoticeTargetTypeValues returns a list of the values for SysNoticeTargetType
+func SysNoticeTargetTypeValues() []SysNoticeTargetType {
+	return []SysNoticeTargetType{
+		SysNoticeTargetTypeAll,
+		SysNoticeTargetTypeTenant,
+		SysNoticeTargetTypeRole,
+		SysNoticeTargetTypeDept,
 	}
 }
 
-var _StatusMap = map[Status]string{
-	StatusDisable: _StatusName[0:7],
-	StatusEnable:  _StatusName[7:13],
-}
-
 // String implements the Stringer interface.
-func (x Status) String() string {
-	if str, ok := _StatusMap[x]; ok {
-		return str
-	}
-	return fmt.Sprintf("Status(%d)", x)
+func (x SysNoticeTargetType) String() string {
+	return string(x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x Status) IsValid() bool {
-	_, ok := _StatusMap[x]
-	return ok
+func (x SysNoticeTargetType) IsValid() bool {
+	_, err := ParseSysNoticeTargetType(string(x))
+	return err == nil
 }
 
-var _StatusValue = map[string]Status{
-	_StatusName[0:7]:  StatusDisable,
-	_StatusName[7:13]: StatusEnable,
+var _SysNoticeTargetTypeValue = map[string]SysNoticeTargetType{
+	"all":    SysNoticeTargetTypeAll,
+	"tenant": SysNoticeTargetTypeTenant,
+	"role":   SysNoticeTargetTypeRole,
+	"dept":   SysNoticeTargetTypeDept,
 }
 
-// ParseStatus attempts to convert a string to a Status.
-func ParseStatus(name string) (Status, error) {
-	if x, ok := _StatusValue[name]; ok {
+// ParseSysNoticeTargetType attempts to convert a string to a SysNoticeTargetType.
+func ParseSysNoticeTargetType(name string) (SysNoticeTargetType, error) {
+	if x, ok := _SysNoticeTargetTypeValue[name]; ok {
 		return x, nil
 	}
-	return Status(0), fmt.Errorf("%s is %w", name, ErrInvalidStatus)
+	return SysNoticeTargetType(""), fmt.Errorf("%s is %w", name, ErrInvalidSysNoticeTargetType)
 }
 
-func (x Status) Ptr() *Status {
+func (x SysNoticeTargetType) Ptr() *SysNoticeTargetType {
 	return &x
 }
 
 // MarshalText implements the text marshaller method.
-func (x Status) MarshalText() ([]byte, error) {
-	return []byte(x.String()), nil
+func (x SysNoticeTargetType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *Status) UnmarshalText(text []byte) error {
-	name := string(text)
-	tmp, err := ParseStatus(name)
+func (x *SysNoticeTargetType) UnmarshalText(text []byte) error {
+	tmp, err := ParseSysNoticeTargetType(string(text))
 	if err != nil {
 		return err
 	}
@@ -812,93 +2986,93 @@ func (x *Status) UnmarshalText(text []byte) error {
 }
 
 // Set implements the Golang flag.Value interface func.
-func (x *Status) Set(val string) error {
-	v, err := ParseStatus(val)
+func (x *SysNoticeTargetType) Set(val string) error {
+	v, err := ParseSysNoticeTargetType(val)
 	*x = v
 	return err
 }
 
 // Get implements the Golang flag.Getter interface func.
-func (x *Status) Get() interface{} {
+func (x *SysNoticeTargetType) Get() interface{} {
 	return *x
 }
 
 // Type implements the github.com/spf13/pFlag Value interface.
-func (x *Status) Type() string {
-	return "Status"
+func (x *SysNoticeTargetType) Type() string {
+	return "SysNoticeTargetType"
 }
 
 const (
-	// 根节点
-	SysDeptTypeRoot SysDeptType = "root"
-	// 子节点
-	SysDeptTypeChild SysDeptType = "child"
-	// 叶子节点
-	SysDeptTypeLeaf SysDeptType = "leaf"
+	// 新通知消息
+	SysNotifyEventTypeMessage SysNotifyEventType = "message"
+	// 未读数量变更
+	SysNotifyEventTypeUnreadCount SysNotifyEventType = "unread_count"
+	// AI 任务完成
+	SysNotifyEventTypeAiJob SysNotifyEventType = "ai_job"
 )
 
-var ErrInvalidSysDeptType = fmt.Errorf("not a valid SysDeptType, try [%s]", strings.Join(_SysDeptTypeNames, ", "))
+var ErrInvalidSysNotifyEventType = fmt.Errorf("not a valid SysNotifyEventType, try [%s]", strings.Join(_SysNotifyEventTypeNames, ", "))
 
-var _SysDeptTypeNames = []string{
-	string(SysDeptTypeRoot),
-	string(SysDeptTypeChild),
-	string(SysDeptTypeLeaf),
+var _SysNotifyEventTypeNames = []string{
+	string(SysNotifyEventTypeMessage),
+	string(SysNotifyEventTypeUnreadCount),
+	string(SysNotifyEventTypeAiJob),
 }
 
-// SysDeptTypeNames returns a list of possible string values of SysDeptType.
-func SysDeptTypeNames() []string {
-	tmp := make([]string, len(_SysDeptTypeNames))
-	copy(tmp, _SysDeptTypeNames)
+// SysNotifyEventTypeNames returns a list of possible string values of SysNotifyEventType.
+func SysNotifyEventTypeNames() []string {
+	tmp := make([]string, len(_SysNotifyEventTypeNames))
+	copy(tmp, _SysNotifyEventTypeNames)
 	return tmp
 }
 
-// SysDeptTypeValues returns a list of the values for SysDeptType
-func SysDeptTypeValues() []SysDeptType {
-	return []SysDeptType{
-		SysDeptTypeRoot,
-		SysDeptTypeChild,
-		SysDeptTypeLeaf,
+// SysNotifyEventTypeValues returns a list of the values for SysNotifyEventType
+func SysNotifyEventTypeValues() []SysNotifyEventType {
+	return []SysNotifyEventType{
+		SysNotifyEventTypeMessage,
+		SysNotifyEventTypeUnreadCount,
+		SysNotifyEventTypeAiJob,
 	}
 }
 
 // String implements the Stringer interface.
-func (x SysDeptType) String() string {
+func (x SysNotifyEventType) String() string {
 	return string(x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x SysDeptType) IsValid() bool {
-	_, err := ParseSysDeptType(string(x))
+func (x SysNotifyEventType) IsValid() bool {
+	_, err := ParseSysNotifyEventType(string(x))
 	return err == nil
 }
 
-var _SysDeptTypeValue = map[string]SysDeptType{
-	"root":  SysDeptTypeRoot,
-	"child": SysDeptTypeChild,
-	"leaf":  SysDeptTypeLeaf,
+var _SysNotifyEventTypeValue = map[string]SysNotifyEventType{
+	"message":      SysNotifyEventTypeMessage,
+	"unread_count": SysNotifyEventTypeUnreadCount,
+	"ai_job":       SysNotifyEventTypeAiJob,
 }
 
-// ParseSysDeptType attempts to convert a string to a SysDeptType.
-func ParseSysDeptType(name string) (SysDeptType, error) {
-	if x, ok := _SysDeptTypeValue[name]; ok {
+// ParseSysNotifyEventType attempts to convert a string to a SysNotifyEventType.
+func ParseSysNotifyEventType(name string) (SysNotifyEventType, error) {
+	if x, ok := _SysNotifyEventTypeValue[name]; ok {
 		return x, nil
 	}
-	return SysDeptType(""), fmt.Errorf("%s is %w", name, ErrInvalidSysDeptType)
+	return SysNotifyEventType(""), fmt.Errorf("%s is %w", name, ErrInvalidSysNotifyEventType)
 }
 
-func (x SysDeptType) Ptr() *SysDeptType {
+func (x SysNotifyEventType) Ptr() *SysNotifyEventType {
 	return &x
 }
 
 // MarshalText implements the text marshaller method.
-func (x SysDeptType) MarshalText() ([]byte, error) {
+func (x SysNotifyEventType) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *SysDeptType) UnmarshalText(text []byte) error {
-	tmp, err := ParseSysDeptType(string(text))
+func (x *SysNotifyEventType) UnmarshalText(text []byte) error {
+	tmp, err := ParseSysNotifyEventType(string(text))
 	if err != nil {
 		return err
 	}
@@ -907,93 +3081,108 @@ func (x *SysDeptType) UnmarshalText(text []byte) error {
 }
 
 // Set implements the Golang flag.Value interface func.
-func (x *SysDeptType) Set(val string) error {
-	v, err := ParseSysDeptType(val)
+func (x *SysNotifyEventType) Set(val string) error {
+	v, err := ParseSysNotifyEventType(val)
 	*x = v
 	return err
 }
 
 // Get implements the Golang flag.Getter interface func.
-func (x *SysDeptType) Get() interface{} {
+func (x *SysNotifyEventType) Get() interface{} {
 	return *x
 }
 
 // Type implements the github.com/spf13/pFlag Value interface.
-func (x *SysDeptType) Type() string {
-	return "SysDeptType"
+func (x *SysNotifyEventType) Type() string {
+	return "SysNotifyEventType"
 }
 
 const (
-	// 目录
-	SysMenuTypeDir SysMenuType = "dir"
-	// 菜单
-	SysMenuTypeMenu SysMenuType = "menu"
-	// 按钮
-	SysMenuTypeButton SysMenuType = "button"
+	// 系统通知
+	SysNotifyMessageTypeSystem SysNotifyMessageType = "system"
+	// AI 视频
+	SysNotifyMessageTypeAiVideo SysNotifyMessageType = "ai_video"
+	// 活动通知
+	SysNotifyMessageTypeActivity SysNotifyMessageType = "activity"
+	// 订单通知
+	SysNotifyMessageTypeOrder SysNotifyMessageType = "order"
+	// 消息通知
+	SysNotifyMessageTypeMessage SysNotifyMessageType = "message"
+	// 系统公告
+	SysNotifyMessageTypeNotice SysNotifyMessageType = "notice"
 )
 
-var ErrInvalidSysMenuType = fmt.Errorf("not a valid SysMenuType, try [%s]", strings.Join(_SysMenuTypeNames, ", "))
+var ErrInvalidSysNotifyMessageType = fmt.Errorf("not a valid SysNotifyMessageType, try [%s]", strings.Join(_SysNotifyMessageTypeNames, ", "))
 
-var _SysMenuTypeNames = []string{
-	string(SysMenuTypeDir),
-	string(SysMenuTypeMenu),
-	string(SysMenuTypeButton),
+var _SysNotifyMessageTypeNames = []string{
+	string(SysNotifyMessageTypeSystem),
+	string(SysNotifyMessageTypeAiVideo),
+	string(SysNotifyMessageTypeActivity),
+	string(SysNotifyMessageTypeOrder),
+	string(SysNotifyMessageTypeMessage),
+	string(SysNotifyMessageTypeNotice),
 }
 
-// SysMenuTypeNames returns a list of possible string values of SysMenuType.
-func SysMenuTypeNames() []string {
-	tmp := make([]string, len(_SysMenuTypeNames))
-	copy(tmp, _SysMenuTypeNames)
+// SysNotifyMessageTypeNames returns a list of possible string values of SysNotifyMessageType.
+func SysNotifyMessageTypeNames() []string {
+	tmp := make([]string, len(_SysNotifyMessageTypeNames))
+	copy(tmp, _SysNotifyMessageTypeNames)
 	return tmp
 }
 
-// SysMenuTypeValues returns a list of the values for SysMenuType
-func SysMenuTypeValues() []SysMenuType {
-	return []SysMenuType{
-		SysMenuTypeDir,
-		SysMenuTypeMenu,
-		SysMenuTypeButton,
+// SysNotifyMessageTypeValues returns a list of the values for SysNotifyMessageType
+func SysNotifyMessageTypeValues() []SysNotifyMessageType {
+	return []SysNotifyMessageType{
+		SysNotifyMessageTypeSystem,
+		SysNotifyMessageTypeAiVideo,
+		SysNotifyMessageTypeActivity,
+		SysNotifyMessageTypeOrder,
+		SysNotifyMessageTypeMessage,
+		SysNotifyMessageTypeNotice,
 	}
 }
 
 // String implements the Stringer interface.
-func (x SysMenuType) String() string {
+func (x SysNotifyMessageType) String() string {
 	return string(x)
 }
 
 // IsValid provides a quick way to determine if the typed value is
 // part of the allowed enumerated values
-func (x SysMenuType) IsValid() bool {
-	_, err := ParseSysMenuType(string(x))
+func (x SysNotifyMessageType) IsValid() bool {
+	_, err := ParseSysNotifyMessageType(string(x))
 	return err == nil
 }
 
-var _SysMenuTypeValue = map[string]SysMenuType{
-	"dir":    SysMenuTypeDir,
-	"menu":   SysMenuTypeMenu,
-	"button": SysMenuTypeButton,
+var _SysNotifyMessageTypeValue = map[string]SysNotifyMessageType{
+	"system":   SysNotifyMessageTypeSystem,
+	"ai_video": SysNotifyMessageTypeAiVideo,
+	"activity": SysNotifyMessageTypeActivity,
+	"order":    SysNotifyMessageTypeOrder,
+	"message":  SysNotifyMessageTypeMessage,
+	"notice":   SysNotifyMessageTypeNotice,
 }
 
-// ParseSysMenuType attempts to convert a string to a SysMenuType.
-func ParseSysMenuType(name string) (SysMenuType, error) {
-	if x, ok := _SysMenuTypeValue[name]; ok {
+// ParseSysNotifyMessageType attempts to convert a string to a SysNotifyMessageType.
+func ParseSysNotifyMessageType(name string) (SysNotifyMessageType, error) {
+	if x, ok := _SysNotifyMessageTypeValue[name]; ok {
 		return x, nil
 	}
-	return SysMenuType(""), fmt.Errorf("%s is %w", name, ErrInvalidSysMenuType)
+	return SysNotifyMessageType(""), fmt.Errorf("%s is %w", name, ErrInvalidSysNotifyMessageType)
 }
 
-func (x SysMenuType) Ptr() *SysMenuType {
+func (x SysNotifyMessageType) Ptr() *SysNotifyMessageType {
 	return &x
 }
 
 // MarshalText implements the text marshaller method.
-func (x SysMenuType) MarshalText() ([]byte, error) {
+func (x SysNotifyMessageType) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
 // UnmarshalText implements the text unmarshaller method.
-func (x *SysMenuType) UnmarshalText(text []byte) error {
-	tmp, err := ParseSysMenuType(string(text))
+func (x *SysNotifyMessageType) UnmarshalText(text []byte) error {
+	tmp, err := ParseSysNotifyMessageType(string(text))
 	if err != nil {
 		return err
 	}
@@ -1002,20 +3191,20 @@ func (x *SysMenuType) UnmarshalText(text []byte) error {
 }
 
 // Set implements the Golang flag.Value interface func.
-func (x *SysMenuType) Set(val string) error {
-	v, err := ParseSysMenuType(val)
+func (x *SysNotifyMessageType) Set(val string) error {
+	v, err := ParseSysNotifyMessageType(val)
 	*x = v
 	return err
 }
 
 // Get implements the Golang flag.Getter interface func.
-func (x *SysMenuType) Get() interface{} {
+func (x *SysNotifyMessageType) Get() interface{} {
 	return *x
 }
 
 // Type implements the github.com/spf13/pFlag Value interface.
-func (x *SysMenuType) Type() string {
-	return "SysMenuType"
+func (x *SysNotifyMessageType) Type() string {
+	return "SysNotifyMessageType"
 }
 
 const (
@@ -1308,3 +3497,116 @@ func (x *UserBindDeviceIdentity) Get() interface{} {
 func (x *UserBindDeviceIdentity) Type() string {
 	return "UserBindDeviceIdentity"
 }
+
+const (
+	// 投递失败
+	UserNotifyStatusFailed UserNotifyStatus = iota + -1
+	// 待投递
+	UserNotifyStatusPending
+	// 勿扰时段延迟投递
+	UserNotifyStatusDeferred
+	// 投递成功
+	UserNotifyStatusSuccess
+)
+
+var ErrInvalidUserNotifyStatus = fmt.Errorf("not a valid UserNotifyStatus, try [%s]", strings.Join(_UserNotifyStatusNames, ", "))
+
+const _UserNotifyStatusName = "failedpendingdeferredsuccess"
+
+var _UserNotifyStatusNames = []string{
+	_UserNotifyStatusName[0:6],
+	_UserNotifyStatusName[6:13],
+	_UserNotifyStatusName[13:21],
+	_UserNotifyStatusName[21:28],
+}
+
+// UserNotifyStatusNames returns a list of possible string values of UserNotifyStatus.
+func UserNotifyStatusNames() []string {
+	tmp := make([]string, len(_UserNotifyStatusNames))
+	copy(tmp, _UserNotifyStatusNames)
+	return tmp
+}
+
+// UserNotifyStatusValues returns a list of the values for UserNotifyStatus
+func UserNotifyStatusValues() []UserNotifyStatus {
+	return []UserNotifyStatus{
+		UserNotifyStatusFailed,
+		UserNotifyStatusPending,
+		UserNotifyStatusDeferred,
+		UserNotifyStatusSuccess,
+	}
+}
+
+var _UserNotifyStatusMap = map[UserNotifyStatus]string{
+	UserNotifyStatusFailed:   _UserNotifyStatusName[0:6],
+	UserNotifyStatusPending:  _UserNotifyStatusName[6:13],
+	UserNotifyStatusDeferred: _UserNotifyStatusName[13:21],
+	UserNotifyStatusSuccess:  _UserNotifyStatusName[21:28],
+}
+
+// String implements the Stringer interface.
+func (x UserNotifyStatus) String() string {
+	if str, ok := _UserNotifyStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("UserNotifyStatus(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x UserNotifyStatus) IsValid() bool {
+	_, ok := _UserNotifyStatusMap[x]
+	return ok
+}
+
+var _UserNotifyStatusValue = map[string]UserNotifyStatus{
+	_UserNotifyStatusName[0:6]:   UserNotifyStatusFailed,
+	_UserNotifyStatusName[6:13]:  UserNotifyStatusPending,
+	_UserNotifyStatusName[13:21]: UserNotifyStatusDeferred,
+	_UserNotifyStatusName[21:28]: UserNotifyStatusSuccess,
+}
+
+// ParseUserNotifyStatus attempts to convert a string to a UserNotifyStatus.
+func ParseUserNotifyStatus(name string) (UserNotifyStatus, error) {
+	if x, ok := _UserNotifyStatusValue[name]; ok {
+		return x, nil
+	}
+	return UserNotifyStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidUserNotifyStatus)
+}
+
+func (x UserNotifyStatus) Ptr() *UserNotifyStatus {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x UserNotifyStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *UserNotifyStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseUserNotifyStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *UserNotifyStatus) Set(val string) error {
+	v, err := ParseUserNotifyStatus(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *UserNotifyStatus) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *UserNotifyStatus) Type() string {
+	return "UserNotifyStatus"
+}
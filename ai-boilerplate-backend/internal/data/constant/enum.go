@@ -53,6 +53,25 @@ YUNPIAN // 云片
 )*/
 type SmsChannelCode string
 
+// SmsSendStatus 短信发送状态
+/*
+ENUM(
+success // 发送成功
+failed // 发送失败
+)
+*/
+type SmsSendStatus string
+
+// SmsReceiveStatus 短信接收状态
+/*
+ENUM(
+pending // 等待回执
+success // 接收成功
+failed // 接收失败
+)
+*/
+type SmsReceiveStatus string
+
 // DeviceStatus 设备状态
 /*ENUM(
 disable=-1 // 禁用
@@ -116,3 +135,221 @@ goods // 商品
 )
 */
 type MallProductType string
+
+// AiProviderPlatform AI 模型平台
+/*
+ENUM(
+openai // OpenAI 及兼容接口
+volcengine // 火山引擎方舟
+deepseek // DeepSeek
+aliyun // 阿里云百炼通义千问
+ollama // Ollama
+suno // Suno 音乐
+)
+*/
+type AiProviderPlatform string
+
+// AiTokenUsageScene AI Token 用量场景
+/*
+ENUM(
+chat // 聊天
+write // 写作
+image // 绘画
+video // 视频
+api // 接口
+)
+*/
+type AiTokenUsageScene string
+
+// AiImageStatus AI 绘画状态
+/*
+ENUM(
+failed=-1 // 失败
+pending=0 // 排队中
+running=1 // 生成中
+succeeded=2 // 成功
+)
+*/
+type AiImageStatus int32
+
+// AiVideoStatus AI 视频状态
+/*
+ENUM(
+canceled=-2 // 已取消
+failed=-1 // 失败
+pending=0 // 排队中
+running=1 // 生成中
+succeeded=2 // 成功
+)
+*/
+type AiVideoStatus int32
+
+// SysNotifyMessageType 通知消息类型
+/*
+ENUM(
+system // 系统通知
+ai_video // AI 视频
+activity // 活动通知
+order // 订单通知
+message // 消息通知
+notice // 系统公告
+)
+*/
+type SysNotifyMessageType string
+
+// SysNotifyEventType 管理员实时事件类型
+/*
+ENUM(
+message // 新通知消息
+unread_count // 未读数量变更
+ai_job // AI 任务完成
+)
+*/
+type SysNotifyEventType string
+
+// SysNoticeTargetType 公告发布对象类型
+/*
+ENUM(
+all // 全部租户
+tenant // 指定租户
+role // 指定角色
+dept // 指定部门
+)
+*/
+type SysNoticeTargetType string
+
+// SysNoticePublishStatus 公告发布状态
+/*
+ENUM(
+failed=-1 // 发布失败
+draft=0 // 草稿
+scheduled=1 // 待发布
+publishing=2 // 发布中
+published=3 // 已发布
+expired=4 // 已过期
+)
+*/
+type SysNoticePublishStatus int32
+
+// DevicePushType 设备推送方式
+/*
+ENUM(
+single // 单设备
+batch // 批量设备
+tag // 标签
+)
+*/
+type DevicePushType string
+
+// DevicePushMsgType 设备推送消息类型
+/*
+ENUM(
+message=0 // 透传消息
+notification=1 // 通知
+)
+*/
+type DevicePushMsgType int32
+
+// DevicePushStatus 设备推送状态
+/*
+ENUM(
+failed=-1 // 失败
+pending=0 // 待推送
+success=2 // 成功
+)
+*/
+type DevicePushStatus int32
+
+// DeviceCommand 设备远程指令
+/*
+ENUM(
+locate // 定位
+screenshot // 截图
+)
+*/
+type DeviceCommand string
+
+// AiJobType AI 异步任务类型
+/*
+ENUM(
+image // 绘画
+audio // 音乐
+video // 视频
+)
+*/
+type AiJobType string
+
+// AiAudioStatus AI 音乐状态
+/*
+ENUM(
+failed=-1 // 失败
+pending=0 // 排队中
+running=1 // 生成中
+succeeded=2 // 成功
+)
+*/
+type AiAudioStatus int32
+
+// AiAudioGenerateMode AI 音乐生成模式
+/*
+ENUM(
+description=1 // 描述模式: 根据描述词生成歌词与音乐
+lyric=2 // 歌词模式: 根据自定义歌词与风格标签生成音乐
+)
+*/
+type AiAudioGenerateMode int32
+
+// AiWriteType AI 写作类型
+/*
+ENUM(
+compose=1 // 撰写
+reply=2 // 回复
+)
+*/
+type AiWriteType int32
+
+// MailSendStatus 邮件发送状态
+/*
+ENUM(
+failed=-1 // 发送失败
+pending=0 // 发送中
+success=1 // 发送成功
+bounced=2 // 退信(永久失败)
+softBounced=3 // 退信(临时失败)
+)
+*/
+type MailSendStatus int32
+
+// NotifyCategory 用户通知类别, 对应用户通知设置中的开关
+/*
+ENUM(
+system // 系统通知
+activity // 活动通知
+order // 订单通知
+message // 消息通知
+)
+*/
+type NotifyCategory string
+
+// NotifyChannel 用户通知投递渠道
+/*
+ENUM(
+inApp // 站内信
+sms // 短信
+mail // 邮件
+wxGzh // 公众号模板消息
+push // 推送
+)
+*/
+type NotifyChannel string
+
+// UserNotifyStatus 用户通知投递状态
+/*
+ENUM(
+failed=-1 // 投递失败
+pending=0 // 待投递
+deferred=1 // 勿扰时段延迟投递
+success=2 // 投递成功
+)
+*/
+type UserNotifyStatus int32
@@ -12,3 +12,67 @@ var MQTest = mqKey.Register(&mq.MessageConfig{
 		mq.MetaKeyAsynqQueue: "MQ_TEST",
 	},
 })
+
+// MQAiImageGenerate AI 绘画生成任务
+var MQAiImageGenerate = mqKey.Register(&mq.MessageConfig{
+	Key: "MQ_AI_IMAGE_GENERATE",
+	Metadata: map[mq.MetaKey]string{
+		mq.MetaKeyAsynqQueue: "MQ_AI_IMAGE_GENERATE",
+	},
+})
+
+// MQAiVideoTask AI 视频任务提交与轮询
+var MQAiVideoTask = mqKey.Register(&mq.MessageConfig{
+	Key: "MQ_AI_VIDEO_TASK",
+	Metadata: map[mq.MetaKey]string{
+		mq.MetaKeyAsynqQueue: "MQ_AI_VIDEO_TASK",
+	},
+})
+
+// MQAiAudioGenerate AI 音乐生成任务
+var MQAiAudioGenerate = mqKey.Register(&mq.MessageConfig{
+	Key: "MQ_AI_AUDIO_GENERATE",
+	Metadata: map[mq.MetaKey]string{
+		mq.MetaKeyAsynqQueue: "MQ_AI_AUDIO_GENERATE",
+	},
+})
+
+// MQMailSend 邮件发送任务
+var MQMailSend = mqKey.Register(&mq.MessageConfig{
+	Key: "MQ_MAIL_SEND",
+	Metadata: map[mq.MetaKey]string{
+		mq.MetaKeyAsynqQueue: "MQ_MAIL_SEND",
+	},
+})
+
+// MQNotifyDispatch 用户通知多渠道投递任务
+var MQNotifyDispatch = mqKey.Register(&mq.MessageConfig{
+	Key: "MQ_NOTIFY_DISPATCH",
+	Metadata: map[mq.MetaKey]string{
+		mq.MetaKeyAsynqQueue: "MQ_NOTIFY_DISPATCH",
+	},
+})
+
+// MQSysNoticePublish 系统公告定时发布任务
+var MQSysNoticePublish = mqKey.Register(&mq.MessageConfig{
+	Key: "MQ_SYS_NOTICE_PUBLISH",
+	Metadata: map[mq.MetaKey]string{
+		mq.MetaKeyAsynqQueue: "MQ_SYS_NOTICE_PUBLISH",
+	},
+})
+
+// MQSysNoticeExpire 系统公告过期任务
+var MQSysNoticeExpire = mqKey.Register(&mq.MessageConfig{
+	Key: "MQ_SYS_NOTICE_EXPIRE",
+	Metadata: map[mq.MetaKey]string{
+		mq.MetaKeyAsynqQueue: "MQ_SYS_NOTICE_EXPIRE",
+	},
+})
+
+// MQDevicePush 设备推送任务
+var MQDevicePush = mqKey.Register(&mq.MessageConfig{
+	Key: "MQ_DEVICE_PUSH",
+	Metadata: map[mq.MetaKey]string{
+		mq.MetaKeyAsynqQueue: "MQ_DEVICE_PUSH",
+	},
+})
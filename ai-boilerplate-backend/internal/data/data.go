@@ -1,7 +1,11 @@
 package data
 
 import (
+	"context"
+	"time"
+
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/rpc"
 	"github.com/fzf-labs/godb/cache/rueidiscache"
 	"github.com/fzf-labs/godb/orm/dbcache"
 	"github.com/fzf-labs/godb/orm/dbcache/rueidisdbcache"
@@ -26,9 +30,21 @@ var ProviderSet = wire.NewSet(
 	NewDBCache,
 	NewCommonRepo,
 	NewConfigRepo,
+	NewDataScopeRepo,
+	NewLoginLimitRepo,
+	NewTwoFactorRepo,
+	NewSmsCodeRepo,
+	NewSmsSendRepo,
+	NewMailSendRepo,
+	NewNotifyRepo,
+	rpc.NewBaiduPushHTTPRPC,
+	NewDevicePushSender,
 	NewAsynqClient,
 	NewHTTPClient,
 	NewDeviceHeartbeatRepo,
+	NewDevicePushRepo,
+	NewAiAPICallLogRepo,
+	NewAiAPIKeyRepo,
 	NewAiAudioRecordRepo,
 	NewAiChatConversationRepo,
 	NewAiChatMessageRepo,
@@ -36,6 +52,8 @@ var ProviderSet = wire.NewSet(
 	NewAiPromptRepo,
 	NewAiProviderModelRepo,
 	NewAiProviderPlatformRepo,
+	NewAiTokenQuotaRepo,
+	NewAiTokenUsageRepo,
 	NewAiVideoRecordRepo,
 	NewAiWriteRecordRepo,
 	NewConfigDatumRepo,
@@ -75,6 +93,7 @@ var ProviderSet = wire.NewSet(
 	NewUserBindDeviceRepo,
 	NewUserMembershipRepo,
 	NewUserNotificationSettingRepo,
+	NewUserNotifyLogRepo,
 	NewUserRepo,
 	NewWxGzhAccountRepo,
 	NewWxGzhAutoReplyRepo,
@@ -84,6 +103,8 @@ var ProviderSet = wire.NewSet(
 	NewWxGzhTagRepo,
 	NewWxGzhUserRepo,
 	NewWxXcxUserRepo,
+	ai_boilerplate_repo.NewAiAPICallLogRepo,
+	ai_boilerplate_repo.NewAiAPIKeyRepo,
 	ai_boilerplate_repo.NewAiAudioRecordRepo,
 	ai_boilerplate_repo.NewAiChatConversationRepo,
 	ai_boilerplate_repo.NewAiChatMessageRepo,
@@ -91,10 +112,13 @@ var ProviderSet = wire.NewSet(
 	ai_boilerplate_repo.NewAiPromptRepo,
 	ai_boilerplate_repo.NewAiProviderModelRepo,
 	ai_boilerplate_repo.NewAiProviderPlatformRepo,
+	ai_boilerplate_repo.NewAiTokenQuotaRepo,
+	ai_boilerplate_repo.NewAiTokenUsageRepo,
 	ai_boilerplate_repo.NewAiVideoRecordRepo,
 	ai_boilerplate_repo.NewAiWriteRecordRepo,
 	ai_boilerplate_repo.NewConfigDatumRepo,
 	ai_boilerplate_repo.NewDeviceRepo,
+	ai_boilerplate_repo.NewDevicePushLogRepo,
 	ai_boilerplate_repo.NewDictDatumRepo,
 	ai_boilerplate_repo.NewDictTypeRepo,
 	ai_boilerplate_repo.NewFileConfigRepo,
@@ -130,6 +154,7 @@ var ProviderSet = wire.NewSet(
 	ai_boilerplate_repo.NewUserBindDeviceRepo,
 	ai_boilerplate_repo.NewUserMembershipRepo,
 	ai_boilerplate_repo.NewUserNotificationSettingRepo,
+	ai_boilerplate_repo.NewUserNotifyLogRepo,
 	ai_boilerplate_repo.NewUserRepo,
 	ai_boilerplate_repo.NewWxGzhAccountRepo,
 	ai_boilerplate_repo.NewWxGzhAutoReplyRepo,
@@ -149,6 +174,8 @@ type Data struct {
 	rueidis  rueidis.Client
 	DBCache  dbcache.IDBCache
 	MQClient mq.Client
+	// asynqClient 投递延迟任务, 与 MQClient 使用相同的 redis
+	asynqClient *asynq.Client
 }
 
 // NewData .
@@ -160,20 +187,37 @@ func NewData(
 	dbCache dbcache.IDBCache,
 	mqClient mq.Client,
 ) (*Data, func(), error) {
+	asynqClient := asynq.NewClient(asynq.RedisClientOpt{
+		Addr:     c.GetData().GetRedis().GetAddr(),
+		Password: c.GetData().GetRedis().GetPassword(),
+		DB:       int(c.GetData().GetRedis().GetDb()),
+	})
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := asynqClient.Close(); err != nil {
+			log.NewHelper(logger).Errorf("failed to close asynq client: %v", err)
+		}
 	}
 	d := Data{
-		cfg:      c,
-		logger:   &log.Helper{},
-		gorm:     gorm,
-		rueidis:  rueidis,
-		DBCache:  dbCache,
-		MQClient: mqClient,
+		cfg:         c,
+		logger:      &log.Helper{},
+		gorm:        gorm,
+		rueidis:     rueidis,
+		DBCache:     dbCache,
+		MQClient:    mqClient,
+		asynqClient: asynqClient,
 	}
 	return &d, cleanup, nil
 }
 
+// SendDelayMessage 投递延迟任务, 与 MQClient.SendMessage 使用相同的任务类型与队列, 由同一消费者处理
+// 延迟时间不大于0时立即执行
+func (d *Data) SendDelayMessage(ctx context.Context, msgConfig *mq.MessageConfig, payload []byte, delay time.Duration) error {
+	task := asynq.NewTask(msgConfig.Key, payload)
+	_, err := d.asynqClient.EnqueueContext(ctx, task, asynq.Queue(msgConfig.Metadata[mq.MetaKeyAsynqQueue]), asynq.ProcessIn(delay))
+	return err
+}
+
 // NewGorm 创建gorm实例
 func NewGorm(c *conf.Bootstrap) *gorm.DB {
 	gorm, err := gormx.NewPostgresGormClient(&gormx.ClientConfig{
@@ -0,0 +1,219 @@
+package data
+
+import (
+	"context"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/samber/lo"
+)
+
+func NewDataScopeRepo(
+	logger log.Logger,
+	data *Data,
+	sysAdminRepo *ai_boilerplate_repo.SysAdminRepo,
+	sysRoleRepo *ai_boilerplate_repo.SysRoleRepo,
+	sysDeptRepo *ai_boilerplate_repo.SysDeptRepo,
+) *DataScopeRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/dataScope"))
+	return &DataScopeRepo{
+		log:          l,
+		data:         data,
+		sysAdminRepo: sysAdminRepo,
+		sysRoleRepo:  sysRoleRepo,
+		sysDeptRepo:  sysDeptRepo,
+	}
+}
+
+// DataScopeRepo 数据权限范围解析
+type DataScopeRepo struct {
+	log          *log.Helper
+	data         *Data
+	sysAdminRepo *ai_boilerplate_repo.SysAdminRepo
+	sysRoleRepo  *ai_boilerplate_repo.SysRoleRepo
+	sysDeptRepo  *ai_boilerplate_repo.SysDeptRepo
+}
+
+// DataScope 管理员的数据权限范围
+type DataScope struct {
+	Type     constant.SysRoleDataPermissionType // 数据范围类型
+	AdminID  string                             // 当前管理员编号
+	DeptIDs  []string                           // 可见的部门编号(本部门、本部门及以下)
+	AdminIDs []string                           // 可见部门下的管理员编号(含本人)
+}
+
+// GetDataScope 根据管理员角色的数据范围解析可见的部门与管理员
+// 角色不存在、已禁用或数据范围无效时按仅本人数据处理; 管理员未分配部门时部门范围同样退化为仅本人数据
+func (r *DataScopeRepo) GetDataScope(ctx context.Context, adminID string) (*DataScope, error) {
+	scope := &DataScope{
+		Type:     constant.SysRoleDataPermissionTypeSelf,
+		AdminID:  adminID,
+		DeptIDs:  []string{},
+		AdminIDs: []string{adminID},
+	}
+	admin, err := r.sysAdminRepo.FindOneCacheByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if admin == nil || admin.ID == "" || admin.RoleID == "" {
+		return scope, nil
+	}
+	role, err := r.sysRoleRepo.FindOneCacheByID(ctx, admin.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil || role.ID == "" || role.Status != int16(constant.StatusEnable) {
+		return scope, nil
+	}
+	scopeType, err := constant.ParseSysRoleDataPermissionType(role.DataScope)
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("invalid role data scope %s: %s", role.ID, role.DataScope)
+		return scope, nil
+	}
+	switch scopeType {
+	case constant.SysRoleDataPermissionTypeAll:
+		scope.Type = scopeType
+		return scope, nil
+	case constant.SysRoleDataPermissionTypeDept, constant.SysRoleDataPermissionTypeDeptAndBelow:
+		if admin.DeptID == "" {
+			return scope, nil
+		}
+		scope.Type = scopeType
+		scope.DeptIDs = []string{admin.DeptID}
+		if scopeType == constant.SysRoleDataPermissionTypeDeptAndBelow {
+			scope.DeptIDs, err = r.findDeptAndBelowIDs(ctx, admin.DeptID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		admins, err := r.sysAdminRepo.FindMultiCacheByDeptIDS(ctx, scope.DeptIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range admins {
+			scope.AdminIDs = append(scope.AdminIDs, v.ID)
+		}
+		scope.AdminIDs = lo.Uniq(scope.AdminIDs)
+		return scope, nil
+	default:
+		return scope, nil
+	}
+}
+
+// CheckAdmin 校验管理员归属数据(按 admin_id 归属)是否在操作人的数据范围内, 用于按编号查询、更新与删除
+func (r *DataScopeRepo) CheckAdmin(ctx context.Context, operatorID, adminID string) (bool, error) {
+	scope, err := r.GetDataScope(ctx, operatorID)
+	if err != nil {
+		return false, err
+	}
+	return scope.ContainsAdmin(adminID), nil
+}
+
+// CheckDept 校验部门归属数据(按部门与本人字段归属)是否在操作人的数据范围内, 用于按编号操作管理员等数据
+func (r *DataScopeRepo) CheckDept(ctx context.Context, operatorID, deptID, adminID string) (bool, error) {
+	scope, err := r.GetDataScope(ctx, operatorID)
+	if err != nil {
+		return false, err
+	}
+	return scope.ContainsDept(deptID, adminID), nil
+}
+
+// findDeptAndBelowIDs 从部门开始逐层遍历部门树, 获取本部门及所有下级部门编号
+func (r *DataScopeRepo) findDeptAndBelowIDs(ctx context.Context, deptID string) ([]string, error) {
+	deptIDs := []string{deptID}
+	visited := map[string]bool{deptID: true}
+	pids := []string{deptID}
+	for len(pids) > 0 {
+		children, err := r.sysDeptRepo.FindMultiCacheByPids(ctx, pids)
+		if err != nil {
+			return nil, err
+		}
+		pids = make([]string, 0)
+		for _, v := range children {
+			// 防止部门数据成环导致死循环
+			if visited[v.ID] {
+				continue
+			}
+			visited[v.ID] = true
+			deptIDs = append(deptIDs, v.ID)
+			pids = append(pids, v.ID)
+		}
+	}
+	return deptIDs, nil
+}
+
+// DeptQueryParams 部门归属数据的过滤条件: 部门范围按部门字段过滤, 仅本人数据按本人字段过滤
+func (d *DataScope) DeptQueryParams(deptField, adminField string) []*condition.QueryParam {
+	switch d.Type {
+	case constant.SysRoleDataPermissionTypeAll:
+		return nil
+	case constant.SysRoleDataPermissionTypeDept, constant.SysRoleDataPermissionTypeDeptAndBelow:
+		return []*condition.QueryParam{
+			{
+				Field: deptField,
+				Value: d.DeptIDs,
+				Exp:   condition.IN,
+				Logic: condition.AND,
+			},
+		}
+	default:
+		return d.selfQueryParams(adminField)
+	}
+}
+
+// AdminQueryParams 管理员归属数据的过滤条件: 部门范围按部门下的管理员过滤, 仅本人数据按本人过滤
+func (d *DataScope) AdminQueryParams(adminField string) []*condition.QueryParam {
+	switch d.Type {
+	case constant.SysRoleDataPermissionTypeAll:
+		return nil
+	case constant.SysRoleDataPermissionTypeDept, constant.SysRoleDataPermissionTypeDeptAndBelow:
+		return []*condition.QueryParam{
+			{
+				Field: adminField,
+				Value: d.AdminIDs,
+				Exp:   condition.IN,
+				Logic: condition.AND,
+			},
+		}
+	default:
+		return d.selfQueryParams(adminField)
+	}
+}
+
+// ContainsAdmin 管理员归属数据是否在数据范围内, 与 AdminQueryParams 的过滤规则一致
+func (d *DataScope) ContainsAdmin(adminID string) bool {
+	switch d.Type {
+	case constant.SysRoleDataPermissionTypeAll:
+		return true
+	case constant.SysRoleDataPermissionTypeDept, constant.SysRoleDataPermissionTypeDeptAndBelow:
+		return lo.Contains(d.AdminIDs, adminID)
+	default:
+		return adminID == d.AdminID
+	}
+}
+
+// ContainsDept 部门归属数据是否在数据范围内, 与 DeptQueryParams 的过滤规则一致
+func (d *DataScope) ContainsDept(deptID, adminID string) bool {
+	switch d.Type {
+	case constant.SysRoleDataPermissionTypeAll:
+		return true
+	case constant.SysRoleDataPermissionTypeDept, constant.SysRoleDataPermissionTypeDeptAndBelow:
+		return lo.Contains(d.DeptIDs, deptID)
+	default:
+		return adminID == d.AdminID
+	}
+}
+
+// selfQueryParams 仅本人数据的过滤条件
+func (d *DataScope) selfQueryParams(adminField string) []*condition.QueryParam {
+	return []*condition.QueryParam{
+		{
+			Field: adminField,
+			Value: d.AdminID,
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		},
+	}
+}
@@ -0,0 +1,391 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/rpc"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/redis/rueidis"
+)
+
+const (
+	devicePushMaxRetry = 3             // 设备推送最大重试次数
+	devicePushTopicID  = "device_push" // 批量推送的分类主题
+)
+
+var (
+	// ErrDevicePushNoChannel 设备不存在、已禁用或没有推送通道
+	ErrDevicePushNoChannel = errors.New("device has no push channel")
+	// ErrDeviceCommandInProgress 设备正在执行同一远程指令
+	ErrDeviceCommandInProgress = errors.New("device command is in progress")
+	// ErrDeviceCommandInvalid 远程指令无效
+	ErrDeviceCommandInvalid = errors.New("device command is invalid")
+)
+
+// DevicePush 设备表 push 字段
+type DevicePush struct {
+	ChannelID string `json:"channelID"` // 推送通道ID
+}
+
+// DevicePushMessage 设备推送任务消息
+type DevicePushMessage struct {
+	PushLogID string `json:"pushLogId"` // 推送日志编号
+}
+
+// DeviceCommandMessage 远程指令透传消息, 设备收到后按指令执行
+type DeviceCommandMessage struct {
+	Command   string            `json:"command"`          // 指令
+	RequestID string            `json:"requestId"`        // 指令请求编号, 设备上报结果时回传
+	Params    map[string]string `json:"params,omitempty"` // 指令参数
+}
+
+// DevicePushSender 设备推送发送方, 返回推送平台的消息编号
+type DevicePushSender interface {
+	PushSingle(ctx context.Context, channelID string, msgType constant.DevicePushMsgType, msg string) (string, error)
+	PushBatch(ctx context.Context, channelIDs []string, msgType constant.DevicePushMsgType, msg string) (string, error)
+	PushTag(ctx context.Context, tag string, msgType constant.DevicePushMsgType, msg string) (string, error)
+}
+
+// NewDevicePushSender 设备推送发送方, 调试模式下只打印日志不实际推送
+func NewDevicePushSender(
+	logger log.Logger,
+	data *Data,
+	baiduPushHTTPRPC *rpc.BaiduPushHTTPRPC,
+) DevicePushSender {
+	if data.cfg.GetBusiness()["baiduPush"].GetFields()["debug"].GetBoolValue() {
+		return &debugDevicePushSender{log: log.NewHelper(log.With(logger, "module", "data/devicePush"))}
+	}
+	return &baiduDevicePushSender{rpc: baiduPushHTTPRPC}
+}
+
+func NewDevicePushRepo(
+	logger log.Logger,
+	data *Data,
+	deviceRepo *ai_boilerplate_repo.DeviceRepo,
+	devicePushLogRepo *ai_boilerplate_repo.DevicePushLogRepo,
+	sender DevicePushSender,
+) *DevicePushRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/devicePush"))
+	return &DevicePushRepo{
+		log:               l,
+		data:              data,
+		deviceRepo:        deviceRepo,
+		sender:            sender,
+		DevicePushLogRepo: devicePushLogRepo,
+	}
+}
+
+// DevicePushRepo 设备推送: 写入待推送的推送日志并投递推送任务, 由消费者通过百度推送发送
+type DevicePushRepo struct {
+	log        *log.Helper
+	data       *Data
+	deviceRepo *ai_boilerplate_repo.DeviceRepo
+	sender     DevicePushSender // 推送发送方, 调试模式下不实际推送
+	*ai_boilerplate_repo.DevicePushLogRepo
+}
+
+// NewNotification 构建通知栏消息内容
+func (r *DevicePushRepo) NewNotification(title, content, url string) (string, error) {
+	msg, err := jsonutil.Marshal(&rpc.NotifyMessage{
+		Title:       title,
+		Description: content,
+		URL:         url,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(msg), nil
+}
+
+// PushDevices 向设备推送, 只推送已启用且有推送通道的设备, 一个通道时单设备推送, 多个通道时批量推送
+func (r *DevicePushRepo) PushDevices(ctx context.Context, sns []string, msgType constant.DevicePushMsgType, msg string) (*ai_boilerplate_model.DevicePushLog, error) {
+	return r.pushDevices(ctx, sns, msgType, msg, "")
+}
+
+// PushTag 向标签下的设备推送
+func (r *DevicePushRepo) PushTag(ctx context.Context, tag string, msgType constant.DevicePushMsgType, msg string) (*ai_boilerplate_model.DevicePushLog, error) {
+	pushLog := r.NewData()
+	pushLog.PushType = constant.DevicePushTypeTag.String()
+	pushLog.MsgType = int32(msgType)
+	pushLog.Tag = tag
+	pushLog.Msg = msg
+	pushLog.Status = int32(constant.DevicePushStatusPending)
+	err := r.enqueue(ctx, pushLog)
+	if err != nil {
+		return nil, err
+	}
+	return pushLog, nil
+}
+
+// SendCommand 向设备下发远程指令, 同一设备的同一指令在有效期内只能下发一次, 返回推送日志与指令请求编号
+func (r *DevicePushRepo) SendCommand(ctx context.Context, sn string, command constant.DeviceCommand, params map[string]string) (*ai_boilerplate_model.DevicePushLog, string, error) {
+	var cacheKey string
+	var ttl time.Duration
+	switch command {
+	case constant.DeviceCommandLocate:
+		cacheKey, ttl = constant.DeviceControlLocation.Key(sn), constant.DeviceControlLocation.TTL()
+	case constant.DeviceCommandScreenshot:
+		cacheKey, ttl = constant.DeviceControlScreenshot.Key(sn), constant.DeviceControlScreenshot.TTL()
+	default:
+		return nil, "", ErrDeviceCommandInvalid
+	}
+	requestID := uuid.New().String()
+	err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(cacheKey).Value(requestID).Nx().Ex(ttl).Build()).Error()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, "", ErrDeviceCommandInProgress
+		}
+		return nil, "", err
+	}
+	msg, err := jsonutil.Marshal(&DeviceCommandMessage{
+		Command:   command.String(),
+		RequestID: requestID,
+		Params:    params,
+	})
+	if err != nil {
+		r.releaseCommand(ctx, cacheKey)
+		return nil, "", err
+	}
+	pushLog, err := r.pushDevices(ctx, []string{sn}, constant.DevicePushMsgTypeMessage, string(msg), command.String())
+	if err != nil {
+		r.releaseCommand(ctx, cacheKey)
+		return nil, "", err
+	}
+	return pushLog, requestID, nil
+}
+
+// releaseCommand 下发失败时释放管控, 允许立即重新下发
+func (r *DevicePushRepo) releaseCommand(ctx context.Context, cacheKey string) {
+	err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Del().Key(cacheKey).Build()).Error()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to release device command %s: %v", cacheKey, err)
+	}
+}
+
+// Deliver 消费推送任务: 按推送方式调用推送平台并更新推送结果
+// 失败时返回 error 交由队列重试, 超过最大重试次数时记录为推送失败
+func (r *DevicePushRepo) Deliver(ctx context.Context, pushLogID string) error {
+	pushLog, err := r.FindOneCacheByID(ctx, pushLogID)
+	if err != nil {
+		return err
+	}
+	// 已有推送结果的日志不再重复推送
+	if pushLog == nil || pushLog.ID == "" || pushLog.Status != int32(constant.DevicePushStatusPending) {
+		return nil
+	}
+	// 重试次数同时受队列任务的最大重试次数限制, 保证最后一次尝试会写入推送结果
+	retry, ok := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	return r.deliver(ctx, pushLog, retry, ok && shouldRetryDevicePush(retry, maxRetry))
+}
+
+// deliver 推送并更新推送结果, canRetry 为 true 时失败返回 error 交由队列重试, 否则记录为推送失败
+func (r *DevicePushRepo) deliver(ctx context.Context, pushLog *ai_boilerplate_model.DevicePushLog, retry int, canRetry bool) error {
+	oldData := r.DeepCopy(pushLog)
+	sendErr := r.attempt(ctx, pushLog, canRetry)
+	if sendErr != nil {
+		r.log.WithContext(ctx).Warnf("failed to push device %s, retry %d: %v", pushLog.ID, retry, sendErr)
+	}
+	err := r.UpdateOneCacheWithZero(ctx, pushLog, oldData)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to update device push log %s: %v", pushLog.ID, err)
+	}
+	return sendErr
+}
+
+// attempt 推送一次并将推送结果写入推送日志(不保存), canRetry 为 true 时失败保持待推送并返回 error
+func (r *DevicePushRepo) attempt(ctx context.Context, pushLog *ai_boilerplate_model.DevicePushLog, canRetry bool) error {
+	msgID, sendErr := r.send(ctx, pushLog)
+	if sendErr != nil && canRetry {
+		pushLog.Attempts++
+		pushLog.ErrorMessage = truncateRunes(sendErr.Error(), 1024)
+		return sendErr
+	}
+	setDevicePushResult(pushLog, msgID, sendErr)
+	return nil
+}
+
+// shouldRetryDevicePush 推送失败后是否交由队列重试: 未超过设备推送与队列任务的最大重试次数
+func shouldRetryDevicePush(retry, maxRetry int) bool {
+	return retry < min(devicePushMaxRetry, maxRetry)
+}
+
+// pushDevices 解析设备的推送通道并写入推送日志
+func (r *DevicePushRepo) pushDevices(ctx context.Context, sns []string, msgType constant.DevicePushMsgType, msg, command string) (*ai_boilerplate_model.DevicePushLog, error) {
+	devices, err := r.deviceRepo.FindMultiCacheBySns(ctx, sns)
+	if err != nil {
+		return nil, err
+	}
+	pushSns := make([]string, 0, len(devices))
+	channelIDs := make([]string, 0, len(devices))
+	for _, device := range devices {
+		if device.Status != int32(constant.DeviceStatusEnable) || device.Push.String() == "" {
+			continue
+		}
+		devicePush := &DevicePush{}
+		if err := jsonutil.Unmarshal(device.Push, devicePush); err != nil || devicePush.ChannelID == "" {
+			continue
+		}
+		pushSns = append(pushSns, device.Sn)
+		channelIDs = append(channelIDs, devicePush.ChannelID)
+	}
+	if len(channelIDs) == 0 {
+		return nil, ErrDevicePushNoChannel
+	}
+	snsContent, err := jsonutil.Marshal(pushSns)
+	if err != nil {
+		return nil, err
+	}
+	channelIDsContent, err := jsonutil.Marshal(channelIDs)
+	if err != nil {
+		return nil, err
+	}
+	pushLog := r.NewData()
+	pushLog.PushType = constant.DevicePushTypeSingle.String()
+	if len(channelIDs) > 1 {
+		pushLog.PushType = constant.DevicePushTypeBatch.String()
+	}
+	pushLog.MsgType = int32(msgType)
+	pushLog.Sns = snsContent
+	pushLog.ChannelIds = channelIDsContent
+	pushLog.Command = command
+	pushLog.Msg = msg
+	pushLog.Status = int32(constant.DevicePushStatusPending)
+	err = r.enqueue(ctx, pushLog)
+	if err != nil {
+		return nil, err
+	}
+	return pushLog, nil
+}
+
+// enqueue 写入推送日志并投递推送任务
+func (r *DevicePushRepo) enqueue(ctx context.Context, pushLog *ai_boilerplate_model.DevicePushLog) error {
+	err := r.CreateOneCache(ctx, pushLog)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(&DevicePushMessage{PushLogID: pushLog.ID})
+	if err != nil {
+		return err
+	}
+	err = r.data.MQClient.SendMessage(ctx, constant.MQDevicePush, payload)
+	if err != nil {
+		// 投递失败时记录为推送失败, 避免日志一直处于待推送
+		r.finishLog(ctx, pushLog, "", err)
+		return err
+	}
+	return nil
+}
+
+// send 按推送方式调用推送平台
+func (r *DevicePushRepo) send(ctx context.Context, pushLog *ai_boilerplate_model.DevicePushLog) (string, error) {
+	msgType := constant.DevicePushMsgType(pushLog.MsgType)
+	if pushLog.PushType == constant.DevicePushTypeTag.String() {
+		return r.sender.PushTag(ctx, pushLog.Tag, msgType, pushLog.Msg)
+	}
+	channelIDs := make([]string, 0)
+	err := jsonutil.Unmarshal(pushLog.ChannelIds, &channelIDs)
+	if err != nil {
+		return "", err
+	}
+	if pushLog.PushType == constant.DevicePushTypeSingle.String() && len(channelIDs) == 1 {
+		return r.sender.PushSingle(ctx, channelIDs[0], msgType, pushLog.Msg)
+	}
+	return r.sender.PushBatch(ctx, channelIDs, msgType, pushLog.Msg)
+}
+
+// finishLog 更新推送日志的推送结果
+func (r *DevicePushRepo) finishLog(ctx context.Context, pushLog *ai_boilerplate_model.DevicePushLog, msgID string, sendErr error) {
+	oldData := r.DeepCopy(pushLog)
+	setDevicePushResult(pushLog, msgID, sendErr)
+	err := r.UpdateOneCacheWithZero(ctx, pushLog, oldData)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to update device push log %s: %v", pushLog.ID, err)
+	}
+}
+
+// setDevicePushResult 写入最终推送结果: 推送成功或推送失败
+func setDevicePushResult(pushLog *ai_boilerplate_model.DevicePushLog, msgID string, sendErr error) {
+	pushLog.Attempts++
+	pushLog.MsgID = msgID
+	pushLog.SendTime = sql.NullTime{Time: time.Now(), Valid: true}
+	pushLog.Status = int32(constant.DevicePushStatusSuccess)
+	pushLog.ErrorMessage = ""
+	if sendErr != nil {
+		pushLog.Status = int32(constant.DevicePushStatusFailed)
+		pushLog.ErrorMessage = truncateRunes(sendErr.Error(), 1024)
+	}
+}
+
+// baiduDevicePushSender 百度推送
+type baiduDevicePushSender struct {
+	rpc *rpc.BaiduPushHTTPRPC
+}
+
+func (s *baiduDevicePushSender) PushSingle(ctx context.Context, channelID string, msgType constant.DevicePushMsgType, msg string) (string, error) {
+	reply, err := s.rpc.PushSingleDevice(ctx, &rpc.BaiduSingleDevicePushReq{
+		ChannelID: channelID,
+		MsgType:   strconv.Itoa(int(msgType)),
+		Msg:       msg,
+	})
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(reply.ResponseParams.MsgID, 10), nil
+}
+
+func (s *baiduDevicePushSender) PushBatch(ctx context.Context, channelIDs []string, msgType constant.DevicePushMsgType, msg string) (string, error) {
+	reply, err := s.rpc.PushBatchDevice(ctx, &rpc.BaiduBatchDevicePushReq{
+		ChannelIDs: channelIDs,
+		MsgType:    strconv.Itoa(int(msgType)),
+		Msg:        msg,
+		TopicID:    devicePushTopicID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return reply.ResponseParams.MsgID, nil
+}
+
+func (s *baiduDevicePushSender) PushTag(ctx context.Context, tag string, msgType constant.DevicePushMsgType, msg string) (string, error) {
+	reply, err := s.rpc.PushTags(ctx, &rpc.BaiduTagsPushReq{
+		Tag:     tag,
+		MsgType: strconv.Itoa(int(msgType)),
+		Msg:     msg,
+	})
+	if err != nil {
+		return "", err
+	}
+	return reply.ResponseParams.MsgID, nil
+}
+
+// debugDevicePushSender 调试发送方, 只打印日志不实际推送, 用于本地开发与测试
+type debugDevicePushSender struct {
+	log *log.Helper
+}
+
+func (d *debugDevicePushSender) PushSingle(ctx context.Context, channelID string, msgType constant.DevicePushMsgType, msg string) (string, error) {
+	d.log.WithContext(ctx).Infof("[push debug] single channelId=%s msgType=%s msg=%s", channelID, msgType, msg)
+	return uuid.New().String(), nil
+}
+
+func (d *debugDevicePushSender) PushBatch(ctx context.Context, channelIDs []string, msgType constant.DevicePushMsgType, msg string) (string, error) {
+	d.log.WithContext(ctx).Infof("[push debug] batch channelIds=%v msgType=%s msg=%s", channelIDs, msgType, msg)
+	return uuid.New().String(), nil
+}
+
+func (d *debugDevicePushSender) PushTag(ctx context.Context, tag string, msgType constant.DevicePushMsgType, msg string) (string, error) {
+	d.log.WithContext(ctx).Infof("[push debug] tag=%s msgType=%s msg=%s", tag, msgType, msg)
+	return uuid.New().String(), nil
+}
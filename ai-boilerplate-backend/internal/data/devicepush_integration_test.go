@@ -0,0 +1,172 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// newTestDevicePushRepo 使用指定发送方创建设备推送
+func newTestDevicePushRepo(t *testing.T, sender DevicePushSender) *DevicePushRepo {
+	t.Helper()
+	d, repo := NewTestData(t)
+	return NewDevicePushRepo(log.DefaultLogger, d, ai_boilerplate_repo.NewDeviceRepo(repo), ai_boilerplate_repo.NewDevicePushLogRepo(repo), sender)
+}
+
+// newTestDevicePushLog 写入待推送的推送日志, 测试结束后删除
+func newTestDevicePushLog(t *testing.T, r *DevicePushRepo, pushType constant.DevicePushType, target string) *ai_boilerplate_model.DevicePushLog {
+	t.Helper()
+	pushLog := r.NewData()
+	pushLog.PushType = pushType.String()
+	pushLog.MsgType = int32(constant.DevicePushMsgTypeMessage)
+	pushLog.Msg = `{"command":"locate"}`
+	pushLog.Status = int32(constant.DevicePushStatusPending)
+	if pushType == constant.DevicePushTypeTag {
+		pushLog.Tag = target
+	} else {
+		pushLog.ChannelIds = datatypes.JSON(target)
+	}
+	if err := r.CreateOneCache(context.Background(), pushLog); err != nil {
+		t.Fatalf("create device push log: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = r.DeleteOneCacheByID(context.Background(), pushLog.ID)
+	})
+	return pushLog
+}
+
+// findTestDevicePushLog 重新查询推送日志
+func findTestDevicePushLog(t *testing.T, r *DevicePushRepo, id string) *ai_boilerplate_model.DevicePushLog {
+	t.Helper()
+	pushLog, err := r.FindOneCacheByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("find device push log: %v", err)
+	}
+	return pushLog
+}
+
+func TestDevicePushRepo_Deliver(t *testing.T) {
+	tests := []struct {
+		name     string
+		pushType constant.DevicePushType
+		target   string
+		wantCall string
+	}{
+		{name: "single", pushType: constant.DevicePushTypeSingle, target: `["channel-1"]`, wantCall: "single:channel-1"},
+		{name: "batch", pushType: constant.DevicePushTypeBatch, target: `["channel-1","channel-2"]`, wantCall: "batch:channel-1,channel-2"},
+		{name: "tag", pushType: constant.DevicePushTypeTag, target: "vip", wantCall: "tag:vip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := &fakeDevicePushSender{msgID: "msg-" + tt.name}
+			r := newTestDevicePushRepo(t, sender)
+			pushLog := newTestDevicePushLog(t, r, tt.pushType, tt.target)
+			if err := r.Deliver(context.Background(), pushLog.ID); err != nil {
+				t.Fatalf("Deliver() error = %v", err)
+			}
+			if len(sender.calls) != 1 || sender.calls[0] != tt.wantCall {
+				t.Errorf("calls = %v, want [%s]", sender.calls, tt.wantCall)
+			}
+			got := findTestDevicePushLog(t, r, pushLog.ID)
+			if got.Status != int32(constant.DevicePushStatusSuccess) || got.MsgID != sender.msgID || got.Attempts != 1 || !got.SendTime.Valid {
+				t.Errorf("push log = status %d msgId %q attempts %d", got.Status, got.MsgID, got.Attempts)
+			}
+			// 已有推送结果的日志不再重复推送
+			if err := r.Deliver(context.Background(), pushLog.ID); err != nil {
+				t.Fatalf("Deliver() again error = %v", err)
+			}
+			if len(sender.calls) != 1 {
+				t.Errorf("calls = %d, want 1", len(sender.calls))
+			}
+		})
+	}
+}
+
+func TestDevicePushRepo_DeliverRetry(t *testing.T) {
+	sendErr := errors.New("baidu push failed: 30602 Request Params Not Valid")
+	sender := &fakeDevicePushSender{err: sendErr}
+	r := newTestDevicePushRepo(t, sender)
+	pushLog := newTestDevicePushLog(t, r, constant.DevicePushTypeSingle, `["channel-1"]`)
+	// 未超过重试次数时返回错误交由队列重试, 推送日志保持待推送
+	err := r.deliver(context.Background(), pushLog, 0, true)
+	if !errors.Is(err, sendErr) {
+		t.Fatalf("deliver() error = %v, want %v", err, sendErr)
+	}
+	got := findTestDevicePushLog(t, r, pushLog.ID)
+	if got.Status != int32(constant.DevicePushStatusPending) || got.Attempts != 1 || got.ErrorMessage != sendErr.Error() {
+		t.Errorf("push log = status %d attempts %d error %q", got.Status, got.Attempts, got.ErrorMessage)
+	}
+	// 最后一次尝试记录为推送失败, 不再返回错误
+	if err = r.deliver(context.Background(), got, devicePushMaxRetry, false); err != nil {
+		t.Fatalf("deliver() last attempt error = %v", err)
+	}
+	got = findTestDevicePushLog(t, r, pushLog.ID)
+	if got.Status != int32(constant.DevicePushStatusFailed) || got.Attempts != 2 || got.ErrorMessage != sendErr.Error() || !got.SendTime.Valid {
+		t.Errorf("push log = status %d attempts %d error %q", got.Status, got.Attempts, got.ErrorMessage)
+	}
+	if len(sender.calls) != 2 {
+		t.Errorf("calls = %d, want 2", len(sender.calls))
+	}
+}
+
+func TestDevicePushRepo_DeliverFinalFailure(t *testing.T) {
+	sendErr := errors.New("baidu push failed: 30608 Bind Relation Not Found")
+	r := newTestDevicePushRepo(t, &fakeDevicePushSender{err: sendErr})
+	pushLog := newTestDevicePushLog(t, r, constant.DevicePushTypeTag, "vip")
+	// 不在队列任务中执行时没有重试次数, 直接记录为推送失败
+	if err := r.Deliver(context.Background(), pushLog.ID); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	got := findTestDevicePushLog(t, r, pushLog.ID)
+	if got.Status != int32(constant.DevicePushStatusFailed) || got.ErrorMessage != sendErr.Error() {
+		t.Errorf("push log = status %d error %q", got.Status, got.ErrorMessage)
+	}
+}
+
+func TestDevicePushRepo_SendCommand(t *testing.T) {
+	sender := &fakeDevicePushSender{}
+	r := newTestDevicePushRepo(t, sender)
+	ctx := context.Background()
+	sn := "test-device-" + uuid.New().String()
+	cacheKey := constant.DeviceControlLocation.Key(sn)
+	t.Cleanup(func() {
+		_ = r.data.rueidis.Do(ctx, r.data.rueidis.B().Del().Key(cacheKey).Build()).Error()
+	})
+	if _, _, err := r.SendCommand(ctx, sn, constant.DeviceCommand("reboot"), nil); !errors.Is(err, ErrDeviceCommandInvalid) {
+		t.Fatalf("SendCommand() error = %v, want %v", err, ErrDeviceCommandInvalid)
+	}
+	// 设备没有推送通道时下发失败并释放管控, 可以立即重新下发
+	for i := 0; i < 2; i++ {
+		_, _, err := r.SendCommand(ctx, sn, constant.DeviceCommandLocate, nil)
+		if !errors.Is(err, ErrDevicePushNoChannel) {
+			t.Fatalf("SendCommand() #%d error = %v, want %v", i, err, ErrDevicePushNoChannel)
+		}
+		exists, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Exists().Key(cacheKey).Build()).AsInt64()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists != 0 {
+			t.Fatalf("device command lock %s is not released", cacheKey)
+		}
+	}
+	// 管控有效期内同一指令不能重复下发
+	err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(cacheKey).Value(uuid.New().String()).Ex(constant.DeviceControlLocation.TTL()).Build()).Error()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = r.SendCommand(ctx, sn, constant.DeviceCommandLocate, nil); !errors.Is(err, ErrDeviceCommandInProgress) {
+		t.Fatalf("SendCommand() error = %v, want %v", err, ErrDeviceCommandInProgress)
+	}
+	if len(sender.calls) != 0 {
+		t.Errorf("calls = %d, want 0", len(sender.calls))
+	}
+}
@@ -0,0 +1,156 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"gorm.io/datatypes"
+)
+
+// fakeDevicePushSender 记录推送调用并返回固定结果
+type fakeDevicePushSender struct {
+	mu    sync.Mutex
+	calls []string // 推送方式与推送对象
+	msgID string
+	err   error
+}
+
+func (f *fakeDevicePushSender) record(call string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, call)
+	return f.msgID, f.err
+}
+
+func (f *fakeDevicePushSender) PushSingle(_ context.Context, channelID string, _ constant.DevicePushMsgType, _ string) (string, error) {
+	return f.record("single:" + channelID)
+}
+
+func (f *fakeDevicePushSender) PushBatch(_ context.Context, channelIDs []string, _ constant.DevicePushMsgType, _ string) (string, error) {
+	return f.record("batch:" + strings.Join(channelIDs, ","))
+}
+
+func (f *fakeDevicePushSender) PushTag(_ context.Context, tag string, _ constant.DevicePushMsgType, _ string) (string, error) {
+	return f.record("tag:" + tag)
+}
+
+// newFakeDevicePushLog 内存中的待推送日志
+func newFakeDevicePushLog(pushType constant.DevicePushType, target string) *ai_boilerplate_model.DevicePushLog {
+	pushLog := &ai_boilerplate_model.DevicePushLog{
+		ID:       "push-log-1",
+		PushType: pushType.String(),
+		MsgType:  int32(constant.DevicePushMsgTypeMessage),
+		Msg:      `{"command":"locate"}`,
+		Status:   int32(constant.DevicePushStatusPending),
+	}
+	if pushType == constant.DevicePushTypeTag {
+		pushLog.Tag = target
+	} else {
+		pushLog.ChannelIds = datatypes.JSON(target)
+	}
+	return pushLog
+}
+
+func TestShouldRetryDevicePush(t *testing.T) {
+	tests := []struct {
+		retry    int
+		maxRetry int
+		want     bool
+	}{
+		{retry: 0, maxRetry: 25, want: true},
+		{retry: devicePushMaxRetry - 1, maxRetry: 25, want: true},
+		{retry: devicePushMaxRetry, maxRetry: 25, want: false},
+		{retry: 1, maxRetry: 1, want: false},
+		{retry: 0, maxRetry: 0, want: false},
+	}
+	for _, tt := range tests {
+		if got := shouldRetryDevicePush(tt.retry, tt.maxRetry); got != tt.want {
+			t.Errorf("shouldRetryDevicePush(%d, %d) = %v, want %v", tt.retry, tt.maxRetry, got, tt.want)
+		}
+	}
+}
+
+func TestDevicePushRepo_Attempt(t *testing.T) {
+	tests := []struct {
+		name     string
+		pushType constant.DevicePushType
+		target   string
+		wantCall string
+	}{
+		{name: "single", pushType: constant.DevicePushTypeSingle, target: `["channel-1"]`, wantCall: "single:channel-1"},
+		{name: "batch", pushType: constant.DevicePushTypeBatch, target: `["channel-1","channel-2"]`, wantCall: "batch:channel-1,channel-2"},
+		{name: "tag", pushType: constant.DevicePushTypeTag, target: "vip", wantCall: "tag:vip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := &fakeDevicePushSender{msgID: "msg-" + tt.name}
+			r := &DevicePushRepo{sender: sender}
+			pushLog := newFakeDevicePushLog(tt.pushType, tt.target)
+			if err := r.attempt(context.Background(), pushLog, true); err != nil {
+				t.Fatalf("attempt() error = %v", err)
+			}
+			if len(sender.calls) != 1 || sender.calls[0] != tt.wantCall {
+				t.Errorf("calls = %v, want [%s]", sender.calls, tt.wantCall)
+			}
+			if pushLog.Status != int32(constant.DevicePushStatusSuccess) || pushLog.MsgID != sender.msgID || pushLog.Attempts != 1 || !pushLog.SendTime.Valid {
+				t.Errorf("push log = status %d msgId %q attempts %d", pushLog.Status, pushLog.MsgID, pushLog.Attempts)
+			}
+		})
+	}
+}
+
+func TestDevicePushRepo_AttemptRetry(t *testing.T) {
+	sendErr := errors.New("baidu push failed: 30602 Request Params Not Valid")
+	sender := &fakeDevicePushSender{err: sendErr}
+	r := &DevicePushRepo{sender: sender}
+	pushLog := newFakeDevicePushLog(constant.DevicePushTypeSingle, `["channel-1"]`)
+	// 可以重试时返回错误交由队列重试, 推送日志保持待推送
+	err := r.attempt(context.Background(), pushLog, true)
+	if !errors.Is(err, sendErr) {
+		t.Fatalf("attempt() error = %v, want %v", err, sendErr)
+	}
+	if pushLog.Status != int32(constant.DevicePushStatusPending) || pushLog.Attempts != 1 || pushLog.ErrorMessage != sendErr.Error() || pushLog.SendTime.Valid {
+		t.Errorf("push log = status %d attempts %d error %q", pushLog.Status, pushLog.Attempts, pushLog.ErrorMessage)
+	}
+	// 最后一次尝试记录为推送失败, 不再返回错误
+	if err = r.attempt(context.Background(), pushLog, false); err != nil {
+		t.Fatalf("attempt() last attempt error = %v", err)
+	}
+	if pushLog.Status != int32(constant.DevicePushStatusFailed) || pushLog.Attempts != 2 || pushLog.ErrorMessage != sendErr.Error() || !pushLog.SendTime.Valid {
+		t.Errorf("push log = status %d attempts %d error %q", pushLog.Status, pushLog.Attempts, pushLog.ErrorMessage)
+	}
+	if len(sender.calls) != 2 {
+		t.Errorf("calls = %d, want 2", len(sender.calls))
+	}
+}
+
+func TestDevicePushRepo_AttemptFinalFailure(t *testing.T) {
+	sendErr := errors.New("baidu push failed: 30608 Bind Relation Not Found")
+	r := &DevicePushRepo{sender: &fakeDevicePushSender{err: sendErr}}
+	pushLog := newFakeDevicePushLog(constant.DevicePushTypeTag, "vip")
+	// 不能重试时直接记录为推送失败
+	if err := r.attempt(context.Background(), pushLog, false); err != nil {
+		t.Fatalf("attempt() error = %v", err)
+	}
+	if pushLog.Status != int32(constant.DevicePushStatusFailed) || pushLog.Attempts != 1 || pushLog.ErrorMessage != sendErr.Error() {
+		t.Errorf("push log = status %d attempts %d error %q", pushLog.Status, pushLog.Attempts, pushLog.ErrorMessage)
+	}
+}
+
+func TestDevicePushRepo_AttemptInvalidChannelIDs(t *testing.T) {
+	sender := &fakeDevicePushSender{}
+	r := &DevicePushRepo{sender: sender}
+	pushLog := newFakeDevicePushLog(constant.DevicePushTypeBatch, `not-json`)
+	// 推送通道无法解析时不调用推送平台
+	if err := r.attempt(context.Background(), pushLog, true); err == nil {
+		t.Fatal("attempt() error = nil, want unmarshal error")
+	}
+	if len(sender.calls) != 0 || pushLog.Status != int32(constant.DevicePushStatusPending) {
+		t.Errorf("calls = %v status %d", sender.calls, pushLog.Status)
+	}
+}
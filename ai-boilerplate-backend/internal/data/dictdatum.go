@@ -1,6 +1,8 @@
 package data
 
 import (
+	"context"
+
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -23,3 +25,17 @@ type DictDatumRepo struct {
 	data *Data
 	*ai_boilerplate_repo.DictDatumRepo
 }
+
+// GetLabelByValue 根据字典类型与字典值获取字典标签, 未找到时返回空字符串
+func (r *DictDatumRepo) GetLabelByValue(ctx context.Context, dictType, value string) (string, error) {
+	list, err := r.FindMultiCacheByType(ctx, dictType)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range list {
+		if v.Value == value {
+			return v.Label, nil
+		}
+	}
+	return "", nil
+}
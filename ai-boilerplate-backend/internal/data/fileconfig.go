@@ -1,11 +1,24 @@
 package data
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/goutil/jsonutil"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -19,6 +32,7 @@ func NewFileConfigRepo(
 		log:            l,
 		data:           data,
 		FileConfigRepo: fileConfigRepo,
+		httpClient:     &http.Client{Timeout: time.Minute},
 	}
 }
 
@@ -26,6 +40,7 @@ type FileConfigRepo struct {
 	log  *log.Helper
 	data *Data
 	*ai_boilerplate_repo.FileConfigRepo
+	httpClient *http.Client
 }
 
 // FindMasterConfig 查询主配置
@@ -39,3 +54,93 @@ func (f *FileConfigRepo) FindMasterConfig(ctx context.Context) (*ai_boilerplate_
 	}
 	return result[0], nil
 }
+
+// UploadToMaster 服务端上传文件到主配置的存储, 返回主配置与文件访问地址
+// 目前仅支持火山云 TOS, 其余存储与客户端直传策略保持一致, 暂未接入
+func (f *FileConfigRepo) UploadToMaster(ctx context.Context, path, contentType string, body []byte) (*ai_boilerplate_model.FileConfig, string, error) {
+	fileConfig, err := f.FindMasterConfig(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	config := pb.StorageConfig{}
+	if fileConfig.Config.String() != "" {
+		err = jsonutil.Unmarshal(fileConfig.Config, &config)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	switch fileConfig.Storage {
+	case constant.FileStorageVolcengine.String():
+		fileURL, err := f.uploadVolcengine(ctx, config.GetVolcengine(), path, contentType, body)
+		if err != nil {
+			return nil, "", err
+		}
+		return fileConfig, fileURL, nil
+	default:
+		return nil, "", fmt.Errorf("storage %s does not support server upload", fileConfig.Storage)
+	}
+}
+
+// uploadVolcengine 上传文件到火山云 TOS, 使用 TOS V4 签名
+func (f *FileConfigRepo) uploadVolcengine(ctx context.Context, config *pb.VolcengineConfig, path, contentType string, body []byte) (string, error) {
+	if config == nil || config.GetBucket() == "" || config.GetEndpoint() == "" {
+		return "", errors.New("volcengine storage config is empty")
+	}
+	host := config.GetBucket() + "." + config.GetEndpoint()
+	uri := "/" + strings.TrimLeft(path, "/")
+	escapedURI := (&url.URL{Path: uri}).EscapedPath()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "https://"+host+escapedURI, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	date := now.Format("20060102T150405Z")
+	payloadHash := tosSHA256Hex(body)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Tos-Date", date)
+	req.Header.Set("X-Tos-Content-Sha256", payloadHash)
+	// 规范请求
+	signedHeaders := "content-type;host;x-tos-content-sha256;x-tos-date"
+	canonicalRequest := strings.Join([]string{
+		http.MethodPut,
+		escapedURI,
+		"",
+		"content-type:" + contentType,
+		"host:" + host,
+		"x-tos-content-sha256:" + payloadHash,
+		"x-tos-date:" + date,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	// 待签字符串与签名
+	scope := strings.Join([]string{now.Format("20060102"), config.GetRegion(), "tos", "request"}, "/")
+	stringToSign := strings.Join([]string{"TOS4-HMAC-SHA256", date, scope, tosSHA256Hex([]byte(canonicalRequest))}, "\n")
+	signingKey := []byte(config.GetSecretKey())
+	for _, v := range []string{now.Format("20060102"), config.GetRegion(), "tos", "request"} {
+		signingKey = tosHMACSHA256(signingKey, v)
+	}
+	signature := hex.EncodeToString(tosHMACSHA256(signingKey, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("TOS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", config.GetAccessKey(), scope, signedHeaders, signature))
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload to tos: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("failed to upload to tos: status %d, body %s", resp.StatusCode, string(respBody))
+	}
+	return "https://" + host + escapedURI, nil
+}
+
+func tosSHA256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func tosHMACSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -39,6 +39,7 @@ func newAiChatMessage(db *gorm.DB, opts ...gen.DOOption) aiChatMessage {
 	_aiChatMessage.Content = field.NewString(tableName, "content")
 	_aiChatMessage.UseContext = field.NewBool(tableName, "use_context")
 	_aiChatMessage.SegmentIds = field.NewString(tableName, "segment_ids")
+	_aiChatMessage.Partial = field.NewBool(tableName, "partial")
 	_aiChatMessage.CreatedAt = field.NewTime(tableName, "created_at")
 	_aiChatMessage.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_aiChatMessage.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -64,6 +65,7 @@ type aiChatMessage struct {
 	Content        field.String // 消息内容
 	UseContext     field.Bool   // 是否携带上下文
 	SegmentIds     field.String // 段落编号数组
+	Partial        field.Bool   // 是否为中断的部分回复
 	CreatedAt      field.Time   // 创建时间
 	UpdatedAt      field.Time   // 更新时间
 	DeletedAt      field.Field  // 删除时间
@@ -95,6 +97,7 @@ func (a *aiChatMessage) updateTableName(table string) *aiChatMessage {
 	a.Content = field.NewString(table, "content")
 	a.UseContext = field.NewBool(table, "use_context")
 	a.SegmentIds = field.NewString(table, "segment_ids")
+	a.Partial = field.NewBool(table, "partial")
 	a.CreatedAt = field.NewTime(table, "created_at")
 	a.UpdatedAt = field.NewTime(table, "updated_at")
 	a.DeletedAt = field.NewField(table, "deleted_at")
@@ -126,7 +129,7 @@ func (a *aiChatMessage) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (a *aiChatMessage) fillFieldMap() {
-	a.fieldMap = make(map[string]field.Expr, 15)
+	a.fieldMap = make(map[string]field.Expr, 16)
 	a.fieldMap["id"] = a.ID
 	a.fieldMap["tenant_id"] = a.TenantID
 	a.fieldMap["conversation_id"] = a.ConversationID
@@ -139,6 +142,7 @@ func (a *aiChatMessage) fillFieldMap() {
 	a.fieldMap["content"] = a.Content
 	a.fieldMap["use_context"] = a.UseContext
 	a.fieldMap["segment_ids"] = a.SegmentIds
+	a.fieldMap["partial"] = a.Partial
 	a.fieldMap["created_at"] = a.CreatedAt
 	a.fieldMap["updated_at"] = a.UpdatedAt
 	a.fieldMap["deleted_at"] = a.DeletedAt
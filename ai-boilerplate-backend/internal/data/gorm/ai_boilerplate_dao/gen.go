@@ -18,6 +18,8 @@ import (
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:                      db,
+		AiAPICallLog:            newAiAPICallLog(db, opts...),
+		AiAPIKey:                newAiAPIKey(db, opts...),
 		AiAudioRecord:           newAiAudioRecord(db, opts...),
 		AiChatConversation:      newAiChatConversation(db, opts...),
 		AiChatMessage:           newAiChatMessage(db, opts...),
@@ -25,10 +27,13 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		AiPrompt:                newAiPrompt(db, opts...),
 		AiProviderModel:         newAiProviderModel(db, opts...),
 		AiProviderPlatform:      newAiProviderPlatform(db, opts...),
+		AiTokenQuota:            newAiTokenQuota(db, opts...),
+		AiTokenUsage:            newAiTokenUsage(db, opts...),
 		AiVideoRecord:           newAiVideoRecord(db, opts...),
 		AiWriteRecord:           newAiWriteRecord(db, opts...),
 		ConfigDatum:             newConfigDatum(db, opts...),
 		Device:                  newDevice(db, opts...),
+		DevicePushLog:           newDevicePushLog(db, opts...),
 		DictDatum:               newDictDatum(db, opts...),
 		DictType:                newDictType(db, opts...),
 		FileConfig:              newFileConfig(db, opts...),
@@ -65,6 +70,7 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		UserBindDevice:          newUserBindDevice(db, opts...),
 		UserMembership:          newUserMembership(db, opts...),
 		UserNotificationSetting: newUserNotificationSetting(db, opts...),
+		UserNotifyLog:           newUserNotifyLog(db, opts...),
 		WxGzhAccount:            newWxGzhAccount(db, opts...),
 		WxGzhAutoReply:          newWxGzhAutoReply(db, opts...),
 		WxGzhMaterial:           newWxGzhMaterial(db, opts...),
@@ -79,6 +85,8 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 type Query struct {
 	db *gorm.DB
 
+	AiAPICallLog            aiAPICallLog
+	AiAPIKey                aiAPIKey
 	AiAudioRecord           aiAudioRecord
 	AiChatConversation      aiChatConversation
 	AiChatMessage           aiChatMessage
@@ -86,10 +94,13 @@ type Query struct {
 	AiPrompt                aiPrompt
 	AiProviderModel         aiProviderModel
 	AiProviderPlatform      aiProviderPlatform
+	AiTokenQuota            aiTokenQuota
+	AiTokenUsage            aiTokenUsage
 	AiVideoRecord           aiVideoRecord
 	AiWriteRecord           aiWriteRecord
 	ConfigDatum             configDatum
 	Device                  device
+	DevicePushLog           devicePushLog
 	DictDatum               dictDatum
 	DictType                dictType
 	FileConfig              fileConfig
@@ -126,6 +137,7 @@ type Query struct {
 	UserBindDevice          userBindDevice
 	UserMembership          userMembership
 	UserNotificationSetting userNotificationSetting
+	UserNotifyLog           userNotifyLog
 	WxGzhAccount            wxGzhAccount
 	WxGzhAutoReply          wxGzhAutoReply
 	WxGzhMaterial           wxGzhMaterial
@@ -141,6 +153,8 @@ func (q *Query) Available() bool { return q.db != nil }
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:                      db,
+		AiAPICallLog:            q.AiAPICallLog.clone(db),
+		AiAPIKey:                q.AiAPIKey.clone(db),
 		AiAudioRecord:           q.AiAudioRecord.clone(db),
 		AiChatConversation:      q.AiChatConversation.clone(db),
 		AiChatMessage:           q.AiChatMessage.clone(db),
@@ -148,10 +162,13 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		AiPrompt:                q.AiPrompt.clone(db),
 		AiProviderModel:         q.AiProviderModel.clone(db),
 		AiProviderPlatform:      q.AiProviderPlatform.clone(db),
+		AiTokenQuota:            q.AiTokenQuota.clone(db),
+		AiTokenUsage:            q.AiTokenUsage.clone(db),
 		AiVideoRecord:           q.AiVideoRecord.clone(db),
 		AiWriteRecord:           q.AiWriteRecord.clone(db),
 		ConfigDatum:             q.ConfigDatum.clone(db),
 		Device:                  q.Device.clone(db),
+		DevicePushLog:           q.DevicePushLog.clone(db),
 		DictDatum:               q.DictDatum.clone(db),
 		DictType:                q.DictType.clone(db),
 		FileConfig:              q.FileConfig.clone(db),
@@ -188,6 +205,7 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		UserBindDevice:          q.UserBindDevice.clone(db),
 		UserMembership:          q.UserMembership.clone(db),
 		UserNotificationSetting: q.UserNotificationSetting.clone(db),
+		UserNotifyLog:           q.UserNotifyLog.clone(db),
 		WxGzhAccount:            q.WxGzhAccount.clone(db),
 		WxGzhAutoReply:          q.WxGzhAutoReply.clone(db),
 		WxGzhMaterial:           q.WxGzhMaterial.clone(db),
@@ -210,6 +228,8 @@ func (q *Query) WriteDB() *Query {
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:                      db,
+		AiAPICallLog:            q.AiAPICallLog.replaceDB(db),
+		AiAPIKey:                q.AiAPIKey.replaceDB(db),
 		AiAudioRecord:           q.AiAudioRecord.replaceDB(db),
 		AiChatConversation:      q.AiChatConversation.replaceDB(db),
 		AiChatMessage:           q.AiChatMessage.replaceDB(db),
@@ -217,10 +237,13 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		AiPrompt:                q.AiPrompt.replaceDB(db),
 		AiProviderModel:         q.AiProviderModel.replaceDB(db),
 		AiProviderPlatform:      q.AiProviderPlatform.replaceDB(db),
+		AiTokenQuota:            q.AiTokenQuota.replaceDB(db),
+		AiTokenUsage:            q.AiTokenUsage.replaceDB(db),
 		AiVideoRecord:           q.AiVideoRecord.replaceDB(db),
 		AiWriteRecord:           q.AiWriteRecord.replaceDB(db),
 		ConfigDatum:             q.ConfigDatum.replaceDB(db),
 		Device:                  q.Device.replaceDB(db),
+		DevicePushLog:           q.DevicePushLog.replaceDB(db),
 		DictDatum:               q.DictDatum.replaceDB(db),
 		DictType:                q.DictType.replaceDB(db),
 		FileConfig:              q.FileConfig.replaceDB(db),
@@ -257,6 +280,7 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		UserBindDevice:          q.UserBindDevice.replaceDB(db),
 		UserMembership:          q.UserMembership.replaceDB(db),
 		UserNotificationSetting: q.UserNotificationSetting.replaceDB(db),
+		UserNotifyLog:           q.UserNotifyLog.replaceDB(db),
 		WxGzhAccount:            q.WxGzhAccount.replaceDB(db),
 		WxGzhAutoReply:          q.WxGzhAutoReply.replaceDB(db),
 		WxGzhMaterial:           q.WxGzhMaterial.replaceDB(db),
@@ -269,6 +293,8 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 }
 
 type queryCtx struct {
+	AiAPICallLog            *aiAPICallLogDo
+	AiAPIKey                *aiAPIKeyDo
 	AiAudioRecord           *aiAudioRecordDo
 	AiChatConversation      *aiChatConversationDo
 	AiChatMessage           *aiChatMessageDo
@@ -276,10 +302,13 @@ type queryCtx struct {
 	AiPrompt                *aiPromptDo
 	AiProviderModel         *aiProviderModelDo
 	AiProviderPlatform      *aiProviderPlatformDo
+	AiTokenQuota            *aiTokenQuotaDo
+	AiTokenUsage            *aiTokenUsageDo
 	AiVideoRecord           *aiVideoRecordDo
 	AiWriteRecord           *aiWriteRecordDo
 	ConfigDatum             *configDatumDo
 	Device                  *deviceDo
+	DevicePushLog           *devicePushLogDo
 	DictDatum               *dictDatumDo
 	DictType                *dictTypeDo
 	FileConfig              *fileConfigDo
@@ -316,6 +345,7 @@ type queryCtx struct {
 	UserBindDevice          *userBindDeviceDo
 	UserMembership          *userMembershipDo
 	UserNotificationSetting *userNotificationSettingDo
+	UserNotifyLog           *userNotifyLogDo
 	WxGzhAccount            *wxGzhAccountDo
 	WxGzhAutoReply          *wxGzhAutoReplyDo
 	WxGzhMaterial           *wxGzhMaterialDo
@@ -328,6 +358,8 @@ type queryCtx struct {
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
+		AiAPICallLog:            q.AiAPICallLog.WithContext(ctx),
+		AiAPIKey:                q.AiAPIKey.WithContext(ctx),
 		AiAudioRecord:           q.AiAudioRecord.WithContext(ctx),
 		AiChatConversation:      q.AiChatConversation.WithContext(ctx),
 		AiChatMessage:           q.AiChatMessage.WithContext(ctx),
@@ -335,10 +367,13 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		AiPrompt:                q.AiPrompt.WithContext(ctx),
 		AiProviderModel:         q.AiProviderModel.WithContext(ctx),
 		AiProviderPlatform:      q.AiProviderPlatform.WithContext(ctx),
+		AiTokenQuota:            q.AiTokenQuota.WithContext(ctx),
+		AiTokenUsage:            q.AiTokenUsage.WithContext(ctx),
 		AiVideoRecord:           q.AiVideoRecord.WithContext(ctx),
 		AiWriteRecord:           q.AiWriteRecord.WithContext(ctx),
 		ConfigDatum:             q.ConfigDatum.WithContext(ctx),
 		Device:                  q.Device.WithContext(ctx),
+		DevicePushLog:           q.DevicePushLog.WithContext(ctx),
 		DictDatum:               q.DictDatum.WithContext(ctx),
 		DictType:                q.DictType.WithContext(ctx),
 		FileConfig:              q.FileConfig.WithContext(ctx),
@@ -375,6 +410,7 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		UserBindDevice:          q.UserBindDevice.WithContext(ctx),
 		UserMembership:          q.UserMembership.WithContext(ctx),
 		UserNotificationSetting: q.UserNotificationSetting.WithContext(ctx),
+		UserNotifyLog:           q.UserNotifyLog.WithContext(ctx),
 		WxGzhAccount:            q.WxGzhAccount.WithContext(ctx),
 		WxGzhAutoReply:          q.WxGzhAutoReply.WithContext(ctx),
 		WxGzhMaterial:           q.WxGzhMaterial.WithContext(ctx),
@@ -41,6 +41,11 @@ func newMailLog(db *gorm.DB, opts ...gen.DOOption) mailLog {
 	_mailLog.SendTime = field.NewTime(tableName, "send_time")
 	_mailLog.SendMessageID = field.NewString(tableName, "send_message_id")
 	_mailLog.SendException = field.NewString(tableName, "send_exception")
+	_mailLog.OpenCount = field.NewInt32(tableName, "open_count")
+	_mailLog.OpenTime = field.NewField(tableName, "open_time")
+	_mailLog.ClickCount = field.NewInt32(tableName, "click_count")
+	_mailLog.BounceTime = field.NewField(tableName, "bounce_time")
+	_mailLog.BounceReason = field.NewString(tableName, "bounce_reason")
 	_mailLog.CreatedAt = field.NewTime(tableName, "created_at")
 	_mailLog.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_mailLog.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -68,6 +73,11 @@ type mailLog struct {
 	SendTime         field.Time   // 发送时间
 	SendMessageID    field.String // 发送返回的消息 ID
 	SendException    field.String // 发送异常
+	OpenCount        field.Int32  // 打开次数
+	OpenTime         field.Field  // 首次打开时间
+	ClickCount       field.Int32  // 点击次数
+	BounceTime       field.Field  // 退信时间
+	BounceReason     field.String // 退信原因
 	CreatedAt        field.Time   // 创建时间
 	UpdatedAt        field.Time   // 更新时间
 	DeletedAt        field.Field  // 删除时间
@@ -101,6 +111,11 @@ func (m *mailLog) updateTableName(table string) *mailLog {
 	m.SendTime = field.NewTime(table, "send_time")
 	m.SendMessageID = field.NewString(table, "send_message_id")
 	m.SendException = field.NewString(table, "send_exception")
+	m.OpenCount = field.NewInt32(table, "open_count")
+	m.OpenTime = field.NewField(table, "open_time")
+	m.ClickCount = field.NewInt32(table, "click_count")
+	m.BounceTime = field.NewField(table, "bounce_time")
+	m.BounceReason = field.NewString(table, "bounce_reason")
 	m.CreatedAt = field.NewTime(table, "created_at")
 	m.UpdatedAt = field.NewTime(table, "updated_at")
 	m.DeletedAt = field.NewField(table, "deleted_at")
@@ -128,7 +143,7 @@ func (m *mailLog) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (m *mailLog) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 17)
+	m.fieldMap = make(map[string]field.Expr, 22)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["account_id"] = m.AccountID
 	m.fieldMap["from_mail"] = m.FromMail
@@ -143,6 +158,11 @@ func (m *mailLog) fillFieldMap() {
 	m.fieldMap["send_time"] = m.SendTime
 	m.fieldMap["send_message_id"] = m.SendMessageID
 	m.fieldMap["send_exception"] = m.SendException
+	m.fieldMap["open_count"] = m.OpenCount
+	m.fieldMap["open_time"] = m.OpenTime
+	m.fieldMap["click_count"] = m.ClickCount
+	m.fieldMap["bounce_time"] = m.BounceTime
+	m.fieldMap["bounce_reason"] = m.BounceReason
 	m.fieldMap["created_at"] = m.CreatedAt
 	m.fieldMap["updated_at"] = m.UpdatedAt
 	m.fieldMap["deleted_at"] = m.DeletedAt
@@ -37,6 +37,8 @@ func newMailTemplate(db *gorm.DB, opts ...gen.DOOption) mailTemplate {
 	_mailTemplate.Params = field.NewField(tableName, "params")
 	_mailTemplate.Remark = field.NewString(tableName, "remark")
 	_mailTemplate.Status = field.NewInt32(tableName, "status")
+	_mailTemplate.TrackOpen = field.NewBool(tableName, "track_open")
+	_mailTemplate.TrackClick = field.NewBool(tableName, "track_click")
 	_mailTemplate.CreatedAt = field.NewTime(tableName, "created_at")
 	_mailTemplate.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_mailTemplate.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -49,20 +51,22 @@ func newMailTemplate(db *gorm.DB, opts ...gen.DOOption) mailTemplate {
 type mailTemplate struct {
 	mailTemplateDo mailTemplateDo
 
-	ALL       field.Asterisk
-	ID        field.String // id
-	Name      field.String // 模板名称
-	Code      field.String // 模板编码
-	AccountID field.String // 发送的邮箱账号编号
-	Nickname  field.String // 发送人名称
-	Title     field.String // 模板标题
-	Content   field.String // 模板内容
-	Params    field.Field  // 参数数组
-	Remark    field.String // 备注
-	Status    field.Int32  // 状态(-1禁用,1开启)
-	CreatedAt field.Time   // 创建时间
-	UpdatedAt field.Time   // 更新时间
-	DeletedAt field.Field  // 删除时间
+	ALL        field.Asterisk
+	ID         field.String // id
+	Name       field.String // 模板名称
+	Code       field.String // 模板编码
+	AccountID  field.String // 发送的邮箱账号编号
+	Nickname   field.String // 发送人名称
+	Title      field.String // 模板标题
+	Content    field.String // 模板内容
+	Params     field.Field  // 参数数组
+	Remark     field.String // 备注
+	Status     field.Int32  // 状态(-1禁用,1开启)
+	TrackOpen  field.Bool   // 是否开启打开追踪
+	TrackClick field.Bool   // 是否开启点击追踪
+	CreatedAt  field.Time   // 创建时间
+	UpdatedAt  field.Time   // 更新时间
+	DeletedAt  field.Field  // 删除时间
 
 	fieldMap map[string]field.Expr
 }
@@ -89,6 +93,8 @@ func (m *mailTemplate) updateTableName(table string) *mailTemplate {
 	m.Params = field.NewField(table, "params")
 	m.Remark = field.NewString(table, "remark")
 	m.Status = field.NewInt32(table, "status")
+	m.TrackOpen = field.NewBool(table, "track_open")
+	m.TrackClick = field.NewBool(table, "track_click")
 	m.CreatedAt = field.NewTime(table, "created_at")
 	m.UpdatedAt = field.NewTime(table, "updated_at")
 	m.DeletedAt = field.NewField(table, "deleted_at")
@@ -120,7 +126,7 @@ func (m *mailTemplate) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (m *mailTemplate) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 13)
+	m.fieldMap = make(map[string]field.Expr, 15)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["name"] = m.Name
 	m.fieldMap["code"] = m.Code
@@ -131,6 +137,8 @@ func (m *mailTemplate) fillFieldMap() {
 	m.fieldMap["params"] = m.Params
 	m.fieldMap["remark"] = m.Remark
 	m.fieldMap["status"] = m.Status
+	m.fieldMap["track_open"] = m.TrackOpen
+	m.fieldMap["track_click"] = m.TrackClick
 	m.fieldMap["created_at"] = m.CreatedAt
 	m.fieldMap["updated_at"] = m.UpdatedAt
 	m.fieldMap["deleted_at"] = m.DeletedAt
@@ -35,6 +35,7 @@ func newSmsChannel(db *gorm.DB, opts ...gen.DOOption) smsChannel {
 	_smsChannel.APISecret = field.NewString(tableName, "api_secret")
 	_smsChannel.CallbackURL = field.NewString(tableName, "callback_url")
 	_smsChannel.Status = field.NewInt16(tableName, "status")
+	_smsChannel.Config = field.NewField(tableName, "config")
 	_smsChannel.CreatedAt = field.NewTime(tableName, "created_at")
 	_smsChannel.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_smsChannel.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -56,6 +57,7 @@ type smsChannel struct {
 	APISecret   field.String // 短信 API 的秘钥
 	CallbackURL field.String // 短信发送回调 URL
 	Status      field.Int16  // 状态(-1禁用,1开启)
+	Config      field.Field  // 渠道配置
 	CreatedAt   field.Time   // 创建时间
 	UpdatedAt   field.Time   // 更新时间
 	DeletedAt   field.Field  // 删除时间
@@ -83,6 +85,7 @@ func (s *smsChannel) updateTableName(table string) *smsChannel {
 	s.APISecret = field.NewString(table, "api_secret")
 	s.CallbackURL = field.NewString(table, "callback_url")
 	s.Status = field.NewInt16(table, "status")
+	s.Config = field.NewField(table, "config")
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
@@ -112,7 +115,7 @@ func (s *smsChannel) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *smsChannel) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 11)
+	s.fieldMap = make(map[string]field.Expr, 12)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["operator"] = s.Operator
@@ -121,6 +124,7 @@ func (s *smsChannel) fillFieldMap() {
 	s.fieldMap["api_secret"] = s.APISecret
 	s.fieldMap["callback_url"] = s.CallbackURL
 	s.fieldMap["status"] = s.Status
+	s.fieldMap["config"] = s.Config
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
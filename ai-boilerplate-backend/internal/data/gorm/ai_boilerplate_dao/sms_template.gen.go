@@ -37,6 +37,8 @@ func newSmsTemplate(db *gorm.DB, opts ...gen.DOOption) smsTemplate {
 	_smsTemplate.Remark = field.NewString(tableName, "remark")
 	_smsTemplate.APITemplateID = field.NewString(tableName, "api_template_id")
 	_smsTemplate.Status = field.NewInt16(tableName, "status")
+	_smsTemplate.Priority = field.NewInt32(tableName, "priority")
+	_smsTemplate.Weight = field.NewInt32(tableName, "weight")
 	_smsTemplate.CreatedAt = field.NewTime(tableName, "created_at")
 	_smsTemplate.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_smsTemplate.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -60,6 +62,8 @@ type smsTemplate struct {
 	Remark          field.String // 备注
 	APITemplateID   field.String // 短信供应商的模板编号
 	Status          field.Int16  // 状态(-1禁用,1开启)
+	Priority        field.Int32  // 渠道优先级(同模板编码下数值越小越优先)
+	Weight          field.Int32  // 渠道权重(同优先级下按权重随机)
 	CreatedAt       field.Time   // 创建时间
 	UpdatedAt       field.Time   // 更新时间
 	DeletedAt       field.Field  // 删除时间
@@ -89,6 +93,8 @@ func (s *smsTemplate) updateTableName(table string) *smsTemplate {
 	s.Remark = field.NewString(table, "remark")
 	s.APITemplateID = field.NewString(table, "api_template_id")
 	s.Status = field.NewInt16(table, "status")
+	s.Priority = field.NewInt32(table, "priority")
+	s.Weight = field.NewInt32(table, "weight")
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
@@ -118,7 +124,7 @@ func (s *smsTemplate) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *smsTemplate) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 13)
+	s.fieldMap = make(map[string]field.Expr, 15)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["sms_channel_id"] = s.SmsChannelID
 	s.fieldMap["template_type"] = s.TemplateType
@@ -129,6 +135,8 @@ func (s *smsTemplate) fillFieldMap() {
 	s.fieldMap["remark"] = s.Remark
 	s.fieldMap["api_template_id"] = s.APITemplateID
 	s.fieldMap["status"] = s.Status
+	s.fieldMap["priority"] = s.Priority
+	s.fieldMap["weight"] = s.Weight
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
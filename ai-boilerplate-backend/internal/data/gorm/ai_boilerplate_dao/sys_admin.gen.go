@@ -40,6 +40,9 @@ func newSysAdmin(db *gorm.DB, opts ...gen.DOOption) sysAdmin {
 	_sysAdmin.DeptID = field.NewString(tableName, "dept_id")
 	_sysAdmin.PostID = field.NewString(tableName, "post_id")
 	_sysAdmin.Status = field.NewInt16(tableName, "status")
+	_sysAdmin.TwoFactorEnabled = field.NewBool(tableName, "two_factor_enabled")
+	_sysAdmin.TwoFactorSecret = field.NewString(tableName, "two_factor_secret")
+	_sysAdmin.TwoFactorRecoveryCodes = field.NewField(tableName, "two_factor_recovery_codes")
 	_sysAdmin.CreatedAt = field.NewTime(tableName, "created_at")
 	_sysAdmin.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_sysAdmin.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -52,23 +55,26 @@ func newSysAdmin(db *gorm.DB, opts ...gen.DOOption) sysAdmin {
 type sysAdmin struct {
 	sysAdminDo sysAdminDo
 
-	ALL       field.Asterisk
-	ID        field.String // id
-	TenantID  field.String // 租户Id
-	Username  field.String // 用户名
-	Password  field.String // 密码
-	Nickname  field.String // 昵称
-	Avatar    field.String // 头像
-	Email     field.String // 邮件
-	Sex       field.Int16  // 性别
-	Mobile    field.String // 手机号
-	RoleID    field.String // 角色Id
-	DeptID    field.String // 部门
-	PostID    field.String // 岗位
-	Status    field.Int16  // 状态(-1禁用,1开启)
-	CreatedAt field.Time   // 创建时间
-	UpdatedAt field.Time   // 更新时间
-	DeletedAt field.Field  // 删除时间
+	ALL                    field.Asterisk
+	ID                     field.String // id
+	TenantID               field.String // 租户Id
+	Username               field.String // 用户名
+	Password               field.String // 密码
+	Nickname               field.String // 昵称
+	Avatar                 field.String // 头像
+	Email                  field.String // 邮件
+	Sex                    field.Int16  // 性别
+	Mobile                 field.String // 手机号
+	RoleID                 field.String // 角色Id
+	DeptID                 field.String // 部门
+	PostID                 field.String // 岗位
+	Status                 field.Int16  // 状态(-1禁用,1开启)
+	TwoFactorEnabled       field.Bool   // 是否开启两步验证
+	TwoFactorSecret        field.String // 两步验证密钥
+	TwoFactorRecoveryCodes field.Field  // 两步验证恢复码摘要
+	CreatedAt              field.Time   // 创建时间
+	UpdatedAt              field.Time   // 更新时间
+	DeletedAt              field.Field  // 删除时间
 
 	fieldMap map[string]field.Expr
 }
@@ -98,6 +104,9 @@ func (s *sysAdmin) updateTableName(table string) *sysAdmin {
 	s.DeptID = field.NewString(table, "dept_id")
 	s.PostID = field.NewString(table, "post_id")
 	s.Status = field.NewInt16(table, "status")
+	s.TwoFactorEnabled = field.NewBool(table, "two_factor_enabled")
+	s.TwoFactorSecret = field.NewString(table, "two_factor_secret")
+	s.TwoFactorRecoveryCodes = field.NewField(table, "two_factor_recovery_codes")
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
@@ -125,7 +134,7 @@ func (s *sysAdmin) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysAdmin) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 16)
+	s.fieldMap = make(map[string]field.Expr, 19)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["tenant_id"] = s.TenantID
 	s.fieldMap["username"] = s.Username
@@ -139,6 +148,9 @@ func (s *sysAdmin) fillFieldMap() {
 	s.fieldMap["dept_id"] = s.DeptID
 	s.fieldMap["post_id"] = s.PostID
 	s.fieldMap["status"] = s.Status
+	s.fieldMap["two_factor_enabled"] = s.TwoFactorEnabled
+	s.fieldMap["two_factor_secret"] = s.TwoFactorSecret
+	s.fieldMap["two_factor_recovery_codes"] = s.TwoFactorRecoveryCodes
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
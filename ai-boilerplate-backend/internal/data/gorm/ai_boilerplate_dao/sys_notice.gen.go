@@ -33,6 +33,13 @@ func newSysNotice(db *gorm.DB, opts ...gen.DOOption) sysNotice {
 	_sysNotice.Title = field.NewString(tableName, "title")
 	_sysNotice.Content = field.NewString(tableName, "content")
 	_sysNotice.Status = field.NewInt16(tableName, "status")
+	_sysNotice.TargetType = field.NewString(tableName, "target_type")
+	_sysNotice.TargetIds = field.NewField(tableName, "target_ids")
+	_sysNotice.PublishStatus = field.NewInt16(tableName, "publish_status")
+	_sysNotice.PublishTime = field.NewField(tableName, "publish_time")
+	_sysNotice.ExpireTime = field.NewField(tableName, "expire_time")
+	_sysNotice.PublishedAt = field.NewField(tableName, "published_at")
+	_sysNotice.RecipientCount = field.NewInt32(tableName, "recipient_count")
 	_sysNotice.CreatedAt = field.NewTime(tableName, "created_at")
 	_sysNotice.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_sysNotice.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -45,16 +52,23 @@ func newSysNotice(db *gorm.DB, opts ...gen.DOOption) sysNotice {
 type sysNotice struct {
 	sysNoticeDo sysNoticeDo
 
-	ALL       field.Asterisk
-	ID        field.String // id
-	TenantID  field.String // 租户id
-	Type      field.String // 类型
-	Title     field.String // 标题
-	Content   field.String // 内容
-	Status    field.Int16  // 状态(-1禁用,1开启)
-	CreatedAt field.Time   // 创建时间
-	UpdatedAt field.Time   // 更新时间
-	DeletedAt field.Field  // 删除时间
+	ALL            field.Asterisk
+	ID             field.String // id
+	TenantID       field.String // 租户id
+	Type           field.String // 类型
+	Title          field.String // 标题
+	Content        field.String // 内容
+	Status         field.Int16  // 状态(-1禁用,1开启)
+	TargetType     field.String // 发布对象类型(all全部租户,tenant指定租户,role指定角色,dept指定部门)
+	TargetIds      field.Field  // 发布对象编号
+	PublishStatus  field.Int16  // 发布状态(-1失败,0草稿,1待发布,2发布中,3已发布,4已过期)
+	PublishTime    field.Field  // 计划发布时间
+	ExpireTime     field.Field  // 过期时间
+	PublishedAt    field.Field  // 实际发布时间
+	RecipientCount field.Int32  // 接收人数
+	CreatedAt      field.Time   // 创建时间
+	UpdatedAt      field.Time   // 更新时间
+	DeletedAt      field.Field  // 删除时间
 
 	fieldMap map[string]field.Expr
 }
@@ -77,6 +91,13 @@ func (s *sysNotice) updateTableName(table string) *sysNotice {
 	s.Title = field.NewString(table, "title")
 	s.Content = field.NewString(table, "content")
 	s.Status = field.NewInt16(table, "status")
+	s.TargetType = field.NewString(table, "target_type")
+	s.TargetIds = field.NewField(table, "target_ids")
+	s.PublishStatus = field.NewInt16(table, "publish_status")
+	s.PublishTime = field.NewField(table, "publish_time")
+	s.ExpireTime = field.NewField(table, "expire_time")
+	s.PublishedAt = field.NewField(table, "published_at")
+	s.RecipientCount = field.NewInt32(table, "recipient_count")
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
@@ -106,13 +127,20 @@ func (s *sysNotice) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysNotice) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 9)
+	s.fieldMap = make(map[string]field.Expr, 16)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["tenant_id"] = s.TenantID
 	s.fieldMap["type"] = s.Type
 	s.fieldMap["title"] = s.Title
 	s.fieldMap["content"] = s.Content
 	s.fieldMap["status"] = s.Status
+	s.fieldMap["target_type"] = s.TargetType
+	s.fieldMap["target_ids"] = s.TargetIds
+	s.fieldMap["publish_status"] = s.PublishStatus
+	s.fieldMap["publish_time"] = s.PublishTime
+	s.fieldMap["expire_time"] = s.ExpireTime
+	s.fieldMap["published_at"] = s.PublishedAt
+	s.fieldMap["recipient_count"] = s.RecipientCount
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
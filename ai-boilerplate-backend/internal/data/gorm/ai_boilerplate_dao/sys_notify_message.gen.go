@@ -37,6 +37,7 @@ func newSysNotifyMessage(db *gorm.DB, opts ...gen.DOOption) sysNotifyMessage {
 	_sysNotifyMessage.SendTime = field.NewString(tableName, "send_time")
 	_sysNotifyMessage.ReadTime = field.NewString(tableName, "read_time")
 	_sysNotifyMessage.Extend = field.NewField(tableName, "extend")
+	_sysNotifyMessage.NoticeID = field.NewString(tableName, "notice_id")
 
 	_sysNotifyMessage.fillFieldMap()
 
@@ -57,6 +58,7 @@ type sysNotifyMessage struct {
 	SendTime field.String // 发送时间
 	ReadTime field.String // 阅读时间
 	Extend   field.Field  // 扩展
+	NoticeID field.String // 公告id
 
 	fieldMap map[string]field.Expr
 }
@@ -83,6 +85,7 @@ func (s *sysNotifyMessage) updateTableName(table string) *sysNotifyMessage {
 	s.SendTime = field.NewString(table, "send_time")
 	s.ReadTime = field.NewString(table, "read_time")
 	s.Extend = field.NewField(table, "extend")
+	s.NoticeID = field.NewString(table, "notice_id")
 
 	s.fillFieldMap()
 
@@ -111,7 +114,7 @@ func (s *sysNotifyMessage) GetFieldByName(fieldName string) (field.OrderExpr, bo
 }
 
 func (s *sysNotifyMessage) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 10)
+	s.fieldMap = make(map[string]field.Expr, 11)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["tenant_id"] = s.TenantID
 	s.fieldMap["type"] = s.Type
@@ -122,6 +125,7 @@ func (s *sysNotifyMessage) fillFieldMap() {
 	s.fieldMap["send_time"] = s.SendTime
 	s.fieldMap["read_time"] = s.ReadTime
 	s.fieldMap["extend"] = s.Extend
+	s.fieldMap["notice_id"] = s.NoticeID
 }
 
 func (s sysNotifyMessage) clone(db *gorm.DB) sysNotifyMessage {
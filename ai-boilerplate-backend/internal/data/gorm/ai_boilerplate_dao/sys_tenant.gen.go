@@ -33,6 +33,7 @@ func newSysTenant(db *gorm.DB, opts ...gen.DOOption) sysTenant {
 	_sysTenant.AdminID = field.NewString(tableName, "admin_id")
 	_sysTenant.ExpireTime = field.NewField(tableName, "expire_time")
 	_sysTenant.MenuIds = field.NewField(tableName, "menuIds")
+	_sysTenant.TwoFactorRoleIds = field.NewField(tableName, "two_factor_role_ids")
 	_sysTenant.Status = field.NewInt16(tableName, "status")
 	_sysTenant.CreatedAt = field.NewTime(tableName, "created_at")
 	_sysTenant.UpdatedAt = field.NewTime(tableName, "updated_at")
@@ -46,17 +47,18 @@ func newSysTenant(db *gorm.DB, opts ...gen.DOOption) sysTenant {
 type sysTenant struct {
 	sysTenantDo sysTenantDo
 
-	ALL        field.Asterisk
-	ID         field.String // id
-	Name       field.String // 名称
-	Remark     field.String // 备注
-	AdminID    field.String // 租户管理员Id
-	ExpireTime field.Field  // 过期时间
-	MenuIds    field.Field  // 菜单
-	Status     field.Int16  // 状态(-1禁用,1开启)
-	CreatedAt  field.Time   // 创建时间
-	UpdatedAt  field.Time   // 更新时间
-	DeletedAt  field.Field  // 删除时间
+	ALL              field.Asterisk
+	ID               field.String // id
+	Name             field.String // 名称
+	Remark           field.String // 备注
+	AdminID          field.String // 租户管理员Id
+	ExpireTime       field.Field  // 过期时间
+	MenuIds          field.Field  // 菜单
+	TwoFactorRoleIds field.Field  // 强制两步验证的角色
+	Status           field.Int16  // 状态(-1禁用,1开启)
+	CreatedAt        field.Time   // 创建时间
+	UpdatedAt        field.Time   // 更新时间
+	DeletedAt        field.Field  // 删除时间
 
 	fieldMap map[string]field.Expr
 }
@@ -79,6 +81,7 @@ func (s *sysTenant) updateTableName(table string) *sysTenant {
 	s.AdminID = field.NewString(table, "admin_id")
 	s.ExpireTime = field.NewField(table, "expire_time")
 	s.MenuIds = field.NewField(table, "menuIds")
+	s.TwoFactorRoleIds = field.NewField(table, "two_factor_role_ids")
 	s.Status = field.NewInt16(table, "status")
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
@@ -109,13 +112,14 @@ func (s *sysTenant) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysTenant) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 10)
+	s.fieldMap = make(map[string]field.Expr, 11)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["remark"] = s.Remark
 	s.fieldMap["admin_id"] = s.AdminID
 	s.fieldMap["expire_time"] = s.ExpireTime
 	s.fieldMap["menuIds"] = s.MenuIds
+	s.fieldMap["two_factor_role_ids"] = s.TwoFactorRoleIds
 	s.fieldMap["status"] = s.Status
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
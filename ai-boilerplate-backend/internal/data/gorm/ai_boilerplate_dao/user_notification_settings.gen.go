@@ -36,6 +36,7 @@ func newUserNotificationSetting(db *gorm.DB, opts ...gen.DOOption) userNotificat
 	_userNotificationSetting.DndEnabled = field.NewBool(tableName, "dnd_enabled")
 	_userNotificationSetting.DndStartTime = field.NewString(tableName, "dnd_start_time")
 	_userNotificationSetting.DndEndTime = field.NewString(tableName, "dnd_end_time")
+	_userNotificationSetting.DndTimeZone = field.NewString(tableName, "dnd_time_zone")
 	_userNotificationSetting.CreatedAt = field.NewTime(tableName, "created_at")
 	_userNotificationSetting.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_userNotificationSetting.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -58,6 +59,7 @@ type userNotificationSetting struct {
 	DndEnabled           field.Bool
 	DndStartTime         field.String
 	DndEndTime           field.String
+	DndTimeZone          field.String
 	CreatedAt            field.Time
 	UpdatedAt            field.Time
 	DeletedAt            field.Field
@@ -86,6 +88,7 @@ func (u *userNotificationSetting) updateTableName(table string) *userNotificatio
 	u.DndEnabled = field.NewBool(table, "dnd_enabled")
 	u.DndStartTime = field.NewString(table, "dnd_start_time")
 	u.DndEndTime = field.NewString(table, "dnd_end_time")
+	u.DndTimeZone = field.NewString(table, "dnd_time_zone")
 	u.CreatedAt = field.NewTime(table, "created_at")
 	u.UpdatedAt = field.NewTime(table, "updated_at")
 	u.DeletedAt = field.NewField(table, "deleted_at")
@@ -117,7 +120,7 @@ func (u *userNotificationSetting) GetFieldByName(fieldName string) (field.OrderE
 }
 
 func (u *userNotificationSetting) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 12)
+	u.fieldMap = make(map[string]field.Expr, 13)
 	u.fieldMap["id"] = u.ID
 	u.fieldMap["user_id"] = u.UserID
 	u.fieldMap["system_notification"] = u.SystemNotification
@@ -127,6 +130,7 @@ func (u *userNotificationSetting) fillFieldMap() {
 	u.fieldMap["dnd_enabled"] = u.DndEnabled
 	u.fieldMap["dnd_start_time"] = u.DndStartTime
 	u.fieldMap["dnd_end_time"] = u.DndEndTime
+	u.fieldMap["dnd_time_zone"] = u.DndTimeZone
 	u.fieldMap["created_at"] = u.CreatedAt
 	u.fieldMap["updated_at"] = u.UpdatedAt
 	u.fieldMap["deleted_at"] = u.DeletedAt
@@ -26,6 +26,7 @@ type AiChatMessage struct {
 	Content        string         `gorm:"column:content;type:text;not null;comment:消息内容" json:"content"`                                 // 消息内容
 	UseContext     bool           `gorm:"column:use_context;type:boolean;not null;comment:是否携带上下文" json:"useContext"`                    // 是否携带上下文
 	SegmentIds     string         `gorm:"column:segment_ids;type:character varying(2048);comment:段落编号数组" json:"segmentIds"`              // 段落编号数组
+	Partial        bool           `gorm:"column:partial;type:boolean;not null;comment:是否为中断的部分回复" json:"partial"`                        // 是否为中断的部分回复
 	CreatedAt      time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"`        // 创建时间
 	UpdatedAt      time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"`        // 更新时间
 	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`                 // 删除时间
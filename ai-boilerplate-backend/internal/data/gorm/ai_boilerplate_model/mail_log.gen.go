@@ -5,6 +5,7 @@
 package ai_boilerplate_model
 
 import (
+	"database/sql"
 	"time"
 
 	"gorm.io/gorm"
@@ -28,6 +29,11 @@ type MailLog struct {
 	SendTime         time.Time      `gorm:"column:send_time;type:timestamp with time zone;not null;comment:发送时间" json:"sendTime"`           // 发送时间
 	SendMessageID    string         `gorm:"column:send_message_id;type:character varying(255);comment:发送返回的消息 ID" json:"sendMessageId"`     // 发送返回的消息 ID
 	SendException    string         `gorm:"column:send_exception;type:character varying(4096);comment:发送异常" json:"sendException"`           // 发送异常
+	OpenCount        int32          `gorm:"column:open_count;type:integer;not null;comment:打开次数" json:"openCount"`                          // 打开次数
+	OpenTime         sql.NullTime   `gorm:"column:open_time;type:timestamp with time zone;comment:首次打开时间" json:"openTime"`                  // 首次打开时间
+	ClickCount       int32          `gorm:"column:click_count;type:integer;not null;comment:点击次数" json:"clickCount"`                        // 点击次数
+	BounceTime       sql.NullTime   `gorm:"column:bounce_time;type:timestamp with time zone;comment:退信时间" json:"bounceTime"`                // 退信时间
+	BounceReason     string         `gorm:"column:bounce_reason;type:character varying(1024);comment:退信原因" json:"bounceReason"`             // 退信原因
 	CreatedAt        time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"`         // 创建时间
 	UpdatedAt        time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"`         // 更新时间
 	DeletedAt        gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`                  // 删除时间
@@ -15,19 +15,21 @@ const TableNameMailTemplate = "mail_template"
 
 // MailTemplate mapped from table <mail_template>
 type MailTemplate struct {
-	ID        string         `gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();comment:id" json:"id"`            // id
-	Name      string         `gorm:"column:name;type:character varying(64);not null;comment:模板名称" json:"name"`                 // 模板名称
-	Code      string         `gorm:"column:code;type:character varying(64);not null;comment:模板编码" json:"code"`                 // 模板编码
-	AccountID string         `gorm:"column:account_id;type:character varying(64);not null;comment:发送的邮箱账号编号" json:"accountId"` // 发送的邮箱账号编号
-	Nickname  string         `gorm:"column:nickname;type:character varying(255);comment:发送人名称" json:"nickname"`                // 发送人名称
-	Title     string         `gorm:"column:title;type:character varying(255);not null;comment:模板标题" json:"title"`              // 模板标题
-	Content   string         `gorm:"column:content;type:text;not null;comment:模板内容" json:"content"`                            // 模板内容
-	Params    datatypes.JSON `gorm:"column:params;type:jsonb;comment:参数数组" json:"params"`                                      // 参数数组
-	Remark    string         `gorm:"column:remark;type:character varying(255);comment:备注" json:"remark"`                       // 备注
-	Status    int32          `gorm:"column:status;type:integer;not null;comment:状态(-1禁用,1开启)" json:"status"`                   // 状态(-1禁用,1开启)
-	CreatedAt time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"`   // 创建时间
-	UpdatedAt time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"`   // 更新时间
-	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`            // 删除时间
+	ID         string         `gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();comment:id" json:"id"`            // id
+	Name       string         `gorm:"column:name;type:character varying(64);not null;comment:模板名称" json:"name"`                 // 模板名称
+	Code       string         `gorm:"column:code;type:character varying(64);not null;comment:模板编码" json:"code"`                 // 模板编码
+	AccountID  string         `gorm:"column:account_id;type:character varying(64);not null;comment:发送的邮箱账号编号" json:"accountId"` // 发送的邮箱账号编号
+	Nickname   string         `gorm:"column:nickname;type:character varying(255);comment:发送人名称" json:"nickname"`                // 发送人名称
+	Title      string         `gorm:"column:title;type:character varying(255);not null;comment:模板标题" json:"title"`              // 模板标题
+	Content    string         `gorm:"column:content;type:text;not null;comment:模板内容" json:"content"`                            // 模板内容
+	Params     datatypes.JSON `gorm:"column:params;type:jsonb;comment:参数数组" json:"params"`                                      // 参数数组
+	Remark     string         `gorm:"column:remark;type:character varying(255);comment:备注" json:"remark"`                       // 备注
+	Status     int32          `gorm:"column:status;type:integer;not null;comment:状态(-1禁用,1开启)" json:"status"`                   // 状态(-1禁用,1开启)
+	TrackOpen  bool           `gorm:"column:track_open;type:boolean;not null;comment:是否开启打开追踪" json:"trackOpen"`                // 是否开启打开追踪
+	TrackClick bool           `gorm:"column:track_click;type:boolean;not null;comment:是否开启点击追踪" json:"trackClick"`              // 是否开启点击追踪
+	CreatedAt  time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"`   // 创建时间
+	UpdatedAt  time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"`   // 更新时间
+	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`            // 删除时间
 }
 
 // TableName MailTemplate's table name
@@ -7,6 +7,7 @@ package ai_boilerplate_model
 import (
 	"time"
 
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -22,6 +23,7 @@ type SmsChannel struct {
 	APISecret   string         `gorm:"column:api_secret;type:character varying(128);comment:短信 API 的秘钥" json:"apiSecret"`      // 短信 API 的秘钥
 	CallbackURL string         `gorm:"column:callback_url;type:character varying(255);comment:短信发送回调 URL" json:"callbackUrl"`  // 短信发送回调 URL
 	Status      int16          `gorm:"column:status;type:smallint;not null;comment:状态(-1禁用,1开启)" json:"status"`                // 状态(-1禁用,1开启)
+	Config      datatypes.JSON `gorm:"column:config;type:jsonb;comment:渠道配置" json:"config"`                                    // 渠道配置
 	CreatedAt   time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"` // 创建时间
 	UpdatedAt   time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"` // 更新时间
 	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`          // 删除时间
@@ -25,6 +25,8 @@ type SmsTemplate struct {
 	Remark          string         `gorm:"column:remark;type:character varying(255);comment:备注" json:"remark"`                                 // 备注
 	APITemplateID   string         `gorm:"column:api_template_id;type:character varying(64);not null;comment:短信供应商的模板编号" json:"apiTemplateId"` // 短信供应商的模板编号
 	Status          int16          `gorm:"column:status;type:smallint;not null;comment:状态(-1禁用,1开启)" json:"status"`                            // 状态(-1禁用,1开启)
+	Priority        int32          `gorm:"column:priority;type:integer;not null;comment:渠道优先级(同模板编码下数值越小越优先)" json:"priority"`                 // 渠道优先级(同模板编码下数值越小越优先)
+	Weight          int32          `gorm:"column:weight;type:integer;not null;comment:渠道权重(同优先级下按权重随机)" json:"weight"`                         // 渠道权重(同优先级下按权重随机)
 	CreatedAt       time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"`             // 创建时间
 	UpdatedAt       time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"`             // 更新时间
 	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`                      // 删除时间
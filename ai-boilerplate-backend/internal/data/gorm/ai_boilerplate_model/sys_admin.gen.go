@@ -7,6 +7,7 @@ package ai_boilerplate_model
 import (
 	"time"
 
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -14,22 +15,25 @@ const TableNameSysAdmin = "sys_admin"
 
 // SysAdmin mapped from table <sys_admin>
 type SysAdmin struct {
-	ID        string         `gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();comment:id" json:"id"`          // id
-	TenantID  string         `gorm:"column:tenant_id;type:character varying;comment:租户Id" json:"tenantId"`                   // 租户Id
-	Username  string         `gorm:"column:username;type:character varying;not null;comment:用户名" json:"username"`            // 用户名
-	Password  string         `gorm:"column:password;type:character varying;not null;comment:密码" json:"password"`             // 密码
-	Nickname  string         `gorm:"column:nickname;type:character varying;comment:昵称" json:"nickname"`                      // 昵称
-	Avatar    string         `gorm:"column:avatar;type:character varying;comment:头像" json:"avatar"`                          // 头像
-	Email     string         `gorm:"column:email;type:character varying;comment:邮件" json:"email"`                            // 邮件
-	Sex       int16          `gorm:"column:sex;type:smallint;comment:性别" json:"sex"`                                         // 性别
-	Mobile    string         `gorm:"column:mobile;type:character varying;comment:手机号" json:"mobile"`                         // 手机号
-	RoleID    string         `gorm:"column:role_id;type:character varying;comment:角色Id" json:"roleId"`                       // 角色Id
-	DeptID    string         `gorm:"column:dept_id;type:character varying;comment:部门" json:"deptId"`                         // 部门
-	PostID    string         `gorm:"column:post_id;type:character varying;comment:岗位" json:"postId"`                         // 岗位
-	Status    int16          `gorm:"column:status;type:smallint;not null;comment:状态(-1禁用,1开启)" json:"status"`                // 状态(-1禁用,1开启)
-	CreatedAt time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"` // 创建时间
-	UpdatedAt time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"` // 更新时间
-	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`          // 删除时间
+	ID                     string         `gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();comment:id" json:"id"`               // id
+	TenantID               string         `gorm:"column:tenant_id;type:character varying;comment:租户Id" json:"tenantId"`                        // 租户Id
+	Username               string         `gorm:"column:username;type:character varying;not null;comment:用户名" json:"username"`                 // 用户名
+	Password               string         `gorm:"column:password;type:character varying;not null;comment:密码" json:"password"`                  // 密码
+	Nickname               string         `gorm:"column:nickname;type:character varying;comment:昵称" json:"nickname"`                           // 昵称
+	Avatar                 string         `gorm:"column:avatar;type:character varying;comment:头像" json:"avatar"`                               // 头像
+	Email                  string         `gorm:"column:email;type:character varying;comment:邮件" json:"email"`                                 // 邮件
+	Sex                    int16          `gorm:"column:sex;type:smallint;comment:性别" json:"sex"`                                              // 性别
+	Mobile                 string         `gorm:"column:mobile;type:character varying;comment:手机号" json:"mobile"`                              // 手机号
+	RoleID                 string         `gorm:"column:role_id;type:character varying;comment:角色Id" json:"roleId"`                            // 角色Id
+	DeptID                 string         `gorm:"column:dept_id;type:character varying;comment:部门" json:"deptId"`                              // 部门
+	PostID                 string         `gorm:"column:post_id;type:character varying;comment:岗位" json:"postId"`                              // 岗位
+	Status                 int16          `gorm:"column:status;type:smallint;not null;comment:状态(-1禁用,1开启)" json:"status"`                     // 状态(-1禁用,1开启)
+	TwoFactorEnabled       bool           `gorm:"column:two_factor_enabled;type:boolean;not null;comment:是否开启两步验证" json:"twoFactorEnabled"`    // 是否开启两步验证
+	TwoFactorSecret        string         `gorm:"column:two_factor_secret;type:character varying(64);comment:两步验证密钥" json:"twoFactorSecret"`   // 两步验证密钥
+	TwoFactorRecoveryCodes datatypes.JSON `gorm:"column:two_factor_recovery_codes;type:jsonb;comment:两步验证恢复码摘要" json:"twoFactorRecoveryCodes"` // 两步验证恢复码摘要
+	CreatedAt              time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"`      // 创建时间
+	UpdatedAt              time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"`      // 更新时间
+	DeletedAt              gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`               // 删除时间
 }
 
 // TableName SysAdmin's table name
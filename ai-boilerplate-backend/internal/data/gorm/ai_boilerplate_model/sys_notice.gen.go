@@ -5,8 +5,10 @@
 package ai_boilerplate_model
 
 import (
+	"database/sql"
 	"time"
 
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -14,15 +16,22 @@ const TableNameSysNotice = "sys_notice"
 
 // SysNotice mapped from table <sys_notice>
 type SysNotice struct {
-	ID        string         `gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();comment:id" json:"id"`          // id
-	TenantID  string         `gorm:"column:tenant_id;type:character varying(64);not null;comment:租户id" json:"tenantId"`      // 租户id
-	Type      string         `gorm:"column:type;type:character varying(64);not null;comment:类型" json:"type"`                 // 类型
-	Title     string         `gorm:"column:title;type:character varying(200);not null;comment:标题" json:"title"`              // 标题
-	Content   string         `gorm:"column:content;type:character varying(200);not null;comment:内容" json:"content"`          // 内容
-	Status    int16          `gorm:"column:status;type:smallint;not null;comment:状态(-1禁用,1开启)" json:"status"`                // 状态(-1禁用,1开启)
-	CreatedAt time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"` // 创建时间
-	UpdatedAt time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"` // 更新时间
-	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`          // 删除时间
+	ID             string         `gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();comment:id" json:"id"`                                                 // id
+	TenantID       string         `gorm:"column:tenant_id;type:character varying(64);not null;comment:租户id" json:"tenantId"`                                             // 租户id
+	Type           string         `gorm:"column:type;type:character varying(64);not null;comment:类型" json:"type"`                                                        // 类型
+	Title          string         `gorm:"column:title;type:character varying(200);not null;comment:标题" json:"title"`                                                     // 标题
+	Content        string         `gorm:"column:content;type:character varying(200);not null;comment:内容" json:"content"`                                                 // 内容
+	Status         int16          `gorm:"column:status;type:smallint;not null;comment:状态(-1禁用,1开启)" json:"status"`                                                       // 状态(-1禁用,1开启)
+	TargetType     string         `gorm:"column:target_type;type:character varying(32);not null;comment:发布对象类型(all全部租户,tenant指定租户,role指定角色,dept指定部门)" json:"targetType"` // 发布对象类型(all全部租户,tenant指定租户,role指定角色,dept指定部门)
+	TargetIds      datatypes.JSON `gorm:"column:target_ids;type:jsonb;comment:发布对象编号" json:"targetIds"`                                                                  // 发布对象编号
+	PublishStatus  int16          `gorm:"column:publish_status;type:smallint;not null;comment:发布状态(-1失败,0草稿,1待发布,2发布中,3已发布,4已过期)" json:"publishStatus"`                  // 发布状态(-1失败,0草稿,1待发布,2发布中,3已发布,4已过期)
+	PublishTime    sql.NullTime   `gorm:"column:publish_time;type:timestamp with time zone;comment:计划发布时间" json:"publishTime"`                                           // 计划发布时间
+	ExpireTime     sql.NullTime   `gorm:"column:expire_time;type:timestamp with time zone;comment:过期时间" json:"expireTime"`                                               // 过期时间
+	PublishedAt    sql.NullTime   `gorm:"column:published_at;type:timestamp with time zone;comment:实际发布时间" json:"publishedAt"`                                           // 实际发布时间
+	RecipientCount int32          `gorm:"column:recipient_count;type:integer;not null;comment:接收人数" json:"recipientCount"`                                               // 接收人数
+	CreatedAt      time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"`                                        // 创建时间
+	UpdatedAt      time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"`                                        // 更新时间
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`                                                 // 删除时间
 }
 
 // TableName SysNotice's table name
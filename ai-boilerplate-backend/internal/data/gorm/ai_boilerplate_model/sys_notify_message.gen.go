@@ -22,6 +22,7 @@ type SysNotifyMessage struct {
 	SendTime string         `gorm:"column:send_time;type:character varying(64);not null;comment:发送时间" json:"sendTime"` // 发送时间
 	ReadTime string         `gorm:"column:read_time;type:character varying(64);comment:阅读时间" json:"readTime"`          // 阅读时间
 	Extend   datatypes.JSON `gorm:"column:extend;type:jsonb;comment:扩展" json:"extend"`                                 // 扩展
+	NoticeID string         `gorm:"column:notice_id;type:character varying(64);comment:公告id" json:"noticeId"`          // 公告id
 }
 
 // TableName SysNotifyMessage's table name
@@ -16,16 +16,17 @@ const TableNameSysTenant = "sys_tenant"
 
 // SysTenant mapped from table <sys_tenant>
 type SysTenant struct {
-	ID         string         `gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();comment:id" json:"id"`          // id
-	Name       string         `gorm:"column:name;type:character varying(50);not null;comment:名称" json:"name"`                 // 名称
-	Remark     string         `gorm:"column:remark;type:character varying(200);comment:备注" json:"remark"`                     // 备注
-	AdminID    string         `gorm:"column:admin_id;type:character varying(50);comment:租户管理员Id" json:"adminId"`              // 租户管理员Id
-	ExpireTime sql.NullTime   `gorm:"column:expire_time;type:timestamp with time zone;comment:过期时间" json:"expireTime"`        // 过期时间
-	MenuIds    datatypes.JSON `gorm:"column:menuIds;type:jsonb;comment:菜单" json:"menuIds"`                                    // 菜单
-	Status     int16          `gorm:"column:status;type:smallint;not null;comment:状态(-1禁用,1开启)" json:"status"`                // 状态(-1禁用,1开启)
-	CreatedAt  time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"` // 创建时间
-	UpdatedAt  time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"` // 更新时间
-	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`          // 删除时间
+	ID               string         `gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid();comment:id" json:"id"`          // id
+	Name             string         `gorm:"column:name;type:character varying(50);not null;comment:名称" json:"name"`                 // 名称
+	Remark           string         `gorm:"column:remark;type:character varying(200);comment:备注" json:"remark"`                     // 备注
+	AdminID          string         `gorm:"column:admin_id;type:character varying(50);comment:租户管理员Id" json:"adminId"`              // 租户管理员Id
+	ExpireTime       sql.NullTime   `gorm:"column:expire_time;type:timestamp with time zone;comment:过期时间" json:"expireTime"`        // 过期时间
+	MenuIds          datatypes.JSON `gorm:"column:menuIds;type:jsonb;comment:菜单" json:"menuIds"`                                    // 菜单
+	TwoFactorRoleIds datatypes.JSON `gorm:"column:two_factor_role_ids;type:jsonb;comment:强制两步验证的角色" json:"twoFactorRoleIds"`        // 强制两步验证的角色
+	Status           int16          `gorm:"column:status;type:smallint;not null;comment:状态(-1禁用,1开启)" json:"status"`                // 状态(-1禁用,1开启)
+	CreatedAt        time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null;comment:创建时间" json:"createdAt"` // 创建时间
+	UpdatedAt        time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;comment:更新时间" json:"updatedAt"` // 更新时间
+	DeletedAt        gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone;comment:删除时间" json:"deletedAt"`          // 删除时间
 }
 
 // TableName SysTenant's table name
@@ -23,6 +23,7 @@ type UserNotificationSetting struct {
 	DndEnabled           bool           `gorm:"column:dnd_enabled;type:boolean;not null" json:"dndEnabled"`
 	DndStartTime         string         `gorm:"column:dnd_start_time;type:character varying(5)" json:"dndStartTime"`
 	DndEndTime           string         `gorm:"column:dnd_end_time;type:character varying(5)" json:"dndEndTime"`
+	DndTimeZone          string         `gorm:"column:dnd_time_zone;type:character varying(64)" json:"dndTimeZone"`
 	CreatedAt            time.Time      `gorm:"column:created_at;type:timestamp with time zone;not null" json:"createdAt"`
 	UpdatedAt            time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null" json:"updatedAt"`
 	DeletedAt            gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp with time zone" json:"deletedAt"`
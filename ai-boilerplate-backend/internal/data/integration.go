@@ -0,0 +1,47 @@
+//go:build integration
+
+package data
+
+import (
+	"os"
+	"testing"
+
+	"github.com/fzf-labs/godb/orm/gen/config"
+	conf "github.com/fzf-labs/kratos-contrib/api/conf/v1"
+	kconfig "github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TestConfigEnv 集成测试配置文件路径的环境变量, 未设置时跳过依赖数据库与 Redis 的测试
+const TestConfigEnv = "AI_BOILERPLATE_TEST_CONFIG"
+
+// NewTestData 根据集成测试配置创建数据层, 供各层的集成测试(go test -tags integration)共用
+func NewTestData(t testing.TB) (*Data, *config.Repo) {
+	t.Helper()
+	path := os.Getenv(TestConfigEnv)
+	if path == "" {
+		t.Skipf("%s is not set", TestConfigEnv)
+	}
+	c := kconfig.New(kconfig.WithSource(file.NewSource(path)))
+	t.Cleanup(func() {
+		_ = c.Close()
+	})
+	if err := c.Load(); err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	bc := &conf.Bootstrap{}
+	if err := c.Scan(bc); err != nil {
+		t.Fatalf("scan config: %v", err)
+	}
+	gorm := NewGorm(bc)
+	rueidis := NewRueidis(bc)
+	t.Cleanup(rueidis.Close)
+	dbCache := NewDBCache(bc, rueidis)
+	d, cleanup, err := NewData(bc, log.DefaultLogger, gorm, rueidis, dbCache, NewAsynqClient(bc, log.DefaultLogger))
+	if err != nil {
+		t.Fatalf("new data: %v", err)
+	}
+	t.Cleanup(cleanup)
+	return d, NewConfigRepo(bc, gorm, dbCache)
+}
@@ -0,0 +1,263 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/rueidis"
+	"gorm.io/datatypes"
+)
+
+// 登录锁定对象
+const (
+	loginLimitTargetUsername = "username" // 账号
+	loginLimitTargetIP       = "ip"       // IP
+)
+
+func NewLoginLimitRepo(
+	logger log.Logger,
+	data *Data,
+	sysOperateLogRepo *ai_boilerplate_repo.SysOperateLogRepo,
+) *LoginLimitRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/loginLimit"))
+	cfg := data.cfg.GetBusiness()["loginLimit"].GetFields()
+	seconds := func(name string, def time.Duration) time.Duration {
+		if v := cfg[name].GetNumberValue(); v > 0 {
+			return time.Duration(v) * time.Second
+		}
+		return def
+	}
+	count := func(name string, def int64) int64 {
+		if v := int64(cfg[name].GetNumberValue()); v > 0 {
+			return v
+		}
+		return def
+	}
+	return &LoginLimitRepo{
+		log:                 l,
+		data:                data,
+		sysOperateLogRepo:   sysOperateLogRepo,
+		usernameMaxFailures: count("usernameMaxFailures", 5),
+		ipMaxFailures:       count("ipMaxFailures", 20),
+		failureWindow:       seconds("failureWindow", constant.LoginFailure.TTL()),
+		lockDuration:        seconds("lockDuration", constant.LoginLock.TTL()),
+		maxLockDuration:     seconds("maxLockDuration", constant.LoginLockCount.TTL()),
+	}
+}
+
+// LoginLimitRepo 登录防暴力破解: 按账号与 IP 统计登录失败次数, 超过阈值后锁定, 锁定时长逐次翻倍
+type LoginLimitRepo struct {
+	log                 *log.Helper
+	data                *Data
+	sysOperateLogRepo   *ai_boilerplate_repo.SysOperateLogRepo
+	usernameMaxFailures int64         // 账号最大失败次数
+	ipMaxFailures       int64         // IP 最大失败次数
+	failureWindow       time.Duration // 失败次数统计窗口
+	lockDuration        time.Duration // 首次锁定时长
+	maxLockDuration     time.Duration // 最大锁定时长
+}
+
+// LoginAttempt 登录尝试
+type LoginAttempt struct {
+	Scene     string // 登录场景
+	Username  string // 账号
+	IP        string // IP
+	UserAgent string // 浏览器 UA
+	URI       string // 请求路径
+	TenantID  string // 租户编号(账号为管理员时)
+	AdminID   string // 管理员编号(账号为管理员时)
+}
+
+// LoginLockout 登录锁定
+type LoginLockout struct {
+	Target      string `json:"target"`      // 锁定对象: username、ip
+	Value       string `json:"value"`       // 账号或 IP
+	Failures    int64  `json:"failures"`    // 失败次数
+	LockCount   int64  `json:"lockCount"`   // 锁定次数
+	Duration    int64  `json:"duration"`    // 锁定时长(秒)
+	LockedUntil int64  `json:"lockedUntil"` // 锁定截止时间
+}
+
+// CheckLock 检查账号与 IP 是否被锁定, 返回剩余锁定秒数, 未锁定时为 0
+func (r *LoginLimitRepo) CheckLock(ctx context.Context, scene, username, ip string) (int64, error) {
+	var remaining int64
+	for _, key := range r.lockKeys(scene, username, ip) {
+		ttl, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Ttl().Key(key).Build()).AsInt64()
+		if err != nil {
+			return 0, err
+		}
+		remaining = max(remaining, ttl)
+	}
+	return remaining, nil
+}
+
+// RecordFailure 记录登录失败, 账号或 IP 的失败次数达到阈值时锁定并写入操作日志
+func (r *LoginLimitRepo) RecordFailure(ctx context.Context, attempt *LoginAttempt) ([]*LoginLockout, error) {
+	lockouts := make([]*LoginLockout, 0)
+	for _, target := range []struct {
+		name        string
+		value       string
+		maxFailures int64
+	}{
+		{name: loginLimitTargetUsername, value: attempt.Username, maxFailures: r.usernameMaxFailures},
+		{name: loginLimitTargetIP, value: attempt.IP, maxFailures: r.ipMaxFailures},
+	} {
+		if target.value == "" {
+			continue
+		}
+		lockout, err := r.recordTargetFailure(ctx, attempt.Scene, target.name, target.value, target.maxFailures)
+		if err != nil {
+			return nil, err
+		}
+		if lockout == nil {
+			continue
+		}
+		lockouts = append(lockouts, lockout)
+		// 操作日志仅用于审计, 写入失败不影响登录流程
+		if logErr := r.createLockoutLog(ctx, attempt, lockout); logErr != nil {
+			r.log.WithContext(ctx).Errorf("failed to create login lockout log %s %s: %v", target.name, target.value, logErr)
+		}
+	}
+	return lockouts, nil
+}
+
+// ResetFailure 登录成功后清除账号的失败次数与锁定次数
+func (r *LoginLimitRepo) ResetFailure(ctx context.Context, scene, username string) error {
+	return r.delKeys(ctx,
+		constant.LoginFailure.Key(scene, loginLimitTargetUsername, username),
+		constant.LoginLockCount.Key(scene, loginLimitTargetUsername, username),
+	)
+}
+
+// Unlock 解除账号(及 IP)的登录锁定
+func (r *LoginLimitRepo) Unlock(ctx context.Context, scene, username, ip string) error {
+	keys := make([]string, 0)
+	for _, target := range []struct {
+		name  string
+		value string
+	}{
+		{name: loginLimitTargetUsername, value: username},
+		{name: loginLimitTargetIP, value: ip},
+	} {
+		if target.value == "" {
+			continue
+		}
+		keys = append(keys,
+			constant.LoginLock.Key(scene, target.name, target.value),
+			constant.LoginFailure.Key(scene, target.name, target.value),
+			constant.LoginLockCount.Key(scene, target.name, target.value),
+		)
+	}
+	return r.delKeys(ctx, keys...)
+}
+
+// delKeys 逐个删除缓存键, 避免集群模式下跨槽位
+func (r *LoginLimitRepo) delKeys(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	cmds := make(rueidis.Commands, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, r.data.rueidis.B().Del().Key(key).Build())
+	}
+	for _, result := range r.data.rueidis.DoMulti(ctx, cmds...) {
+		if result.Error() != nil {
+			return result.Error()
+		}
+	}
+	return nil
+}
+
+// lockKeys 账号与 IP 的锁定缓存键
+func (r *LoginLimitRepo) lockKeys(scene, username, ip string) []string {
+	keys := make([]string, 0, 2)
+	if username != "" {
+		keys = append(keys, constant.LoginLock.Key(scene, loginLimitTargetUsername, username))
+	}
+	if ip != "" {
+		keys = append(keys, constant.LoginLock.Key(scene, loginLimitTargetIP, ip))
+	}
+	return keys
+}
+
+// recordTargetFailure 累加失败次数, 达到阈值时锁定, 锁定时长为 首次锁定时长 * 2^(锁定次数-1), 不超过最大锁定时长
+func (r *LoginLimitRepo) recordTargetFailure(ctx context.Context, scene, target, value string, maxFailures int64) (*LoginLockout, error) {
+	failureKey := constant.LoginFailure.Key(scene, target, value)
+	failures, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Incr().Key(failureKey).Build()).AsInt64()
+	if err != nil {
+		return nil, err
+	}
+	if failures == 1 {
+		err = r.data.rueidis.Do(ctx, r.data.rueidis.B().Expire().Key(failureKey).Seconds(int64(r.failureWindow.Seconds())).Build()).Error()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if failures < maxFailures {
+		return nil, nil
+	}
+	lockCountKey := constant.LoginLockCount.Key(scene, target, value)
+	lockCount, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Incr().Key(lockCountKey).Build()).AsInt64()
+	if err != nil {
+		return nil, err
+	}
+	duration := r.lockDuration
+	for i := int64(1); i < lockCount && duration < r.maxLockDuration; i++ {
+		duration *= 2
+	}
+	duration = min(duration, r.maxLockDuration)
+	results := r.data.rueidis.DoMulti(ctx,
+		r.data.rueidis.B().Expire().Key(lockCountKey).Seconds(int64(constant.LoginLockCount.TTL().Seconds())).Build(),
+		r.data.rueidis.B().Set().Key(constant.LoginLock.Key(scene, target, value)).Value("1").ExSeconds(int64(duration.Seconds())).Build(),
+		r.data.rueidis.B().Del().Key(failureKey).Build(),
+	)
+	for _, result := range results {
+		if result.Error() != nil {
+			return nil, result.Error()
+		}
+	}
+	return &LoginLockout{
+		Target:      target,
+		Value:       value,
+		Failures:    failures,
+		LockCount:   lockCount,
+		Duration:    int64(duration.Seconds()),
+		LockedUntil: time.Now().Add(duration).Unix(),
+	}, nil
+}
+
+// createLockoutLog 登录锁定写入操作日志
+func (r *LoginLimitRepo) createLockoutLog(ctx context.Context, attempt *LoginAttempt, lockout *LoginLockout) error {
+	req, err := jsonutil.Marshal(map[string]any{
+		"scene":    attempt.Scene,
+		"username": attempt.Username,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := jsonutil.Marshal(lockout)
+	if err != nil {
+		return err
+	}
+	adminID := attempt.AdminID
+	if adminID == "" {
+		adminID = constant.SystemOperatorID
+	}
+	useragent := []rune(attempt.UserAgent)
+	if len(useragent) > 255 {
+		useragent = useragent[:255]
+	}
+	operateLog := r.sysOperateLogRepo.NewData()
+	operateLog.TenantID = attempt.TenantID
+	operateLog.AdminID = adminID
+	operateLog.IP = attempt.IP
+	operateLog.URI = attempt.URI
+	operateLog.Useragent = string(useragent)
+	operateLog.Req = datatypes.JSON(req)
+	operateLog.Resp = datatypes.JSON(resp)
+	return r.sysOperateLogRepo.CreateOneCache(ctx, operateLog)
+}
@@ -0,0 +1,237 @@
+package data
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_dao"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+)
+
+var (
+	// ErrMailBounceSignInvalid 退信回调签名校验失败
+	ErrMailBounceSignInvalid = errors.New("mail bounce sign is invalid")
+	// ErrMailBounceInvalid 不是有效的退信报告(DSN)
+	ErrMailBounceInvalid = errors.New("mail bounce is not a delivery status notification")
+)
+
+// MailBounce 退信报告(DSN, RFC 3464)
+type MailBounce struct {
+	MessageID  string                 // 原邮件的 Message-ID
+	Recipients []*MailBounceRecipient // 各收件人的投递结果
+}
+
+// MailBounceRecipient 收件人的投递结果
+type MailBounceRecipient struct {
+	Recipient  string // 收件人地址
+	Action     string // 投递动作: failed/delayed/delivered/relayed/expanded
+	Status     string // 状态码, 如 5.1.1
+	Diagnostic string // 诊断信息
+}
+
+// Hard 是否为硬退信(永久失败)
+func (m *MailBounceRecipient) Hard() bool {
+	return m.Action == "failed" && strings.HasPrefix(m.Status, "5")
+}
+
+// Soft 是否为软退信(临时失败)
+func (m *MailBounceRecipient) Soft() bool {
+	return (m.Action == "failed" || m.Action == "delayed") && strings.HasPrefix(m.Status, "4")
+}
+
+// BounceCallbackPath 邮箱账号的退信回调地址(相对路径), 未配置签名密钥时返回空
+// 拼接服务域名后配置到邮件服务器的退信转发(如 Postfix pipe 或邮件转发 webhook), 请求体为原始退信邮件
+func (r *MailSendRepo) BounceCallbackPath(account *ai_boilerplate_model.MailAccount) string {
+	if r.bounceSecret == "" {
+		return ""
+	}
+	query := url.Values{}
+	query.Set("accountId", account.ID)
+	query.Set("sign", r.bounceSign(account.ID))
+	return "/mail_account/bounce?" + query.Encode()
+}
+
+// bounceSign 退信回调地址签名, 使用独立的服务端密钥, 与邮箱账号密码无关
+func (r *MailSendRepo) bounceSign(accountID string) string {
+	h := hmac.New(sha256.New, []byte(r.bounceSecret))
+	h.Write([]byte(accountID))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// HandleBounce 处理退信回调: 校验签名, 解析退信报告并将对应的邮件日志标记为退信, 返回更新的日志数
+func (r *MailSendRepo) HandleBounce(ctx context.Context, accountID, sign string, raw []byte) (int, error) {
+	if r.bounceSecret == "" || !hmac.Equal([]byte(sign), []byte(r.bounceSign(accountID))) {
+		return 0, ErrMailBounceSignInvalid
+	}
+	account, err := r.mailAccountRepo.FindOneCacheByID(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+	if account == nil || account.ID == "" {
+		return 0, ErrMailAccountUnavailable
+	}
+	bounce, err := ParseMailBounce(raw)
+	if err != nil {
+		return 0, err
+	}
+	dao := ai_boilerplate_dao.Use(r.data.gorm).MailLog
+	list, err := dao.WithContext(ctx).Where(dao.AccountID.Eq(account.ID), dao.SendMessageID.Eq(bounce.MessageID)).Find()
+	if err != nil {
+		return 0, err
+	}
+	updated := 0
+	for _, mailLog := range list {
+		for _, recipient := range bounce.Recipients {
+			if !strings.EqualFold(recipient.Recipient, mailLog.ToMail) {
+				continue
+			}
+			var status constant.MailSendStatus
+			switch {
+			case recipient.Hard():
+				status = constant.MailSendStatusBounced
+			case recipient.Soft():
+				status = constant.MailSendStatusSoftBounced
+			default:
+				continue
+			}
+			// 硬退信不会被之后的软退信覆盖
+			if mailLog.SendStatus == int32(constant.MailSendStatusBounced) {
+				continue
+			}
+			oldData := r.mailLogRepo.DeepCopy(mailLog)
+			mailLog.SendStatus = int32(status)
+			mailLog.BounceTime = sql.NullTime{Time: time.Now(), Valid: true}
+			mailLog.BounceReason = truncateRunes(strings.TrimSpace(recipient.Status+" "+recipient.Diagnostic), 1024)
+			err = r.mailLogRepo.UpdateOneCacheWithZero(ctx, mailLog, oldData)
+			if err != nil {
+				return updated, err
+			}
+			updated++
+			if status == constant.MailSendStatusBounced {
+				r.log.WithContext(ctx).Warnf("mail %s to %s hard bounced: %s", mailLog.ID, mailLog.ToMail, mailLog.BounceReason)
+			}
+		}
+	}
+	return updated, nil
+}
+
+// ParseMailBounce 解析退信邮件(multipart/report; report-type=delivery-status)
+func ParseMailBounce(raw []byte) (*MailBounce, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	bounce := &MailBounce{}
+	err = parseMailBouncePart(textproto.MIMEHeader(msg.Header), msg.Body, bounce)
+	if err != nil {
+		return nil, err
+	}
+	if bounce.MessageID == "" || len(bounce.Recipients) == 0 {
+		return nil, ErrMailBounceInvalid
+	}
+	return bounce, nil
+}
+
+// parseMailBouncePart 递归解析邮件的各个部分, 提取投递状态与原邮件的 Message-ID
+func parseMailBouncePart(header textproto.MIMEHeader, body io.Reader, bounce *MailBounce) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		// 缺少 Content-Type 时按纯文本处理
+		mediaType = "text/plain"
+	}
+	if strings.EqualFold(header.Get("Content-Transfer-Encoding"), "base64") {
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			err = parseMailBouncePart(part.Header, part, bounce)
+			if err != nil {
+				return err
+			}
+		}
+	case mediaType == "message/delivery-status" || mediaType == "message/global-delivery-status":
+		recipients, err := parseMailDeliveryStatus(body)
+		if err != nil {
+			return err
+		}
+		bounce.Recipients = append(bounce.Recipients, recipients...)
+	case mediaType == "message/rfc822" || mediaType == "text/rfc822-headers" || mediaType == "message/global-headers":
+		// 原邮件或原邮件的头部, 只需要其中的 Message-ID
+		original, err := textproto.NewReader(bufio.NewReader(body)).ReadMIMEHeader()
+		if err != nil && len(original) == 0 {
+			return nil
+		}
+		if messageID := strings.TrimSpace(original.Get("Message-Id")); messageID != "" && bounce.MessageID == "" {
+			bounce.MessageID = messageID
+		}
+	}
+	return nil
+}
+
+// parseMailDeliveryStatus 解析 message/delivery-status: 首段为报文级字段, 之后每段对应一个收件人
+func parseMailDeliveryStatus(body io.Reader) ([]*MailBounceRecipient, error) {
+	reader := textproto.NewReader(bufio.NewReader(body))
+	recipients := make([]*MailBounceRecipient, 0)
+	for {
+		fields, err := reader.ReadMIMEHeader()
+		if len(fields) > 0 {
+			if recipient := mailBounceRecipient(fields); recipient != nil {
+				recipients = append(recipients, recipient)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return recipients, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// mailBounceRecipient 解析收件人段, 字段值形如 "rfc822; user@example.com"
+func mailBounceRecipient(fields textproto.MIMEHeader) *MailBounceRecipient {
+	recipient := fields.Get("Final-Recipient")
+	if recipient == "" {
+		recipient = fields.Get("Original-Recipient")
+	}
+	if recipient == "" {
+		return nil
+	}
+	value := func(s string) string {
+		if i := strings.Index(s, ";"); i >= 0 {
+			s = s[i+1:]
+		}
+		return strings.TrimSpace(s)
+	}
+	return &MailBounceRecipient{
+		Recipient:  strings.Trim(value(recipient), "<>"),
+		Action:     strings.ToLower(strings.TrimSpace(fields.Get("Action"))),
+		Status:     strings.TrimSpace(fields.Get("Status")),
+		Diagnostic: value(fields.Get("Diagnostic-Code")),
+	}
+}
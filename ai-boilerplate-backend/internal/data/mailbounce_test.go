@@ -0,0 +1,211 @@
+package data
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testMail 按 CRLF 拼接邮件的各行
+func testMail(lines ...string) []byte {
+	return []byte(strings.Join(lines, "\r\n"))
+}
+
+// testDeliveryStatus 单个收件人硬退信的投递状态
+var testDeliveryStatus = []string{
+	"Reporting-MTA: dns; mx.example.com",
+	"",
+	"Final-Recipient: rfc822; <bad@example.org>",
+	"Action: failed",
+	"Status: 5.1.1",
+	"Diagnostic-Code: smtp; 550 5.1.1 user unknown",
+}
+
+func TestParseMailBounce(t *testing.T) {
+	hardBounce := []*MailBounceRecipient{{
+		Recipient:  "bad@example.org",
+		Action:     "failed",
+		Status:     "5.1.1",
+		Diagnostic: "550 5.1.1 user unknown",
+	}}
+	tests := []struct {
+		name    string
+		raw     []byte
+		want    *MailBounce
+		wantErr error
+	}{
+		{
+			name: "multipart report with rfc822 original",
+			raw: testMail(append(append([]string{
+				"From: MAILER-DAEMON@example.com",
+				"Subject: Undelivered Mail Returned to Sender",
+				`Content-Type: multipart/report; report-type=delivery-status; boundary="b1"`,
+				"",
+				"--b1",
+				"Content-Type: text/plain",
+				"",
+				"Your message could not be delivered.",
+				"--b1",
+				"Content-Type: message/delivery-status",
+				"",
+			}, testDeliveryStatus...),
+				"",
+				"--b1",
+				"Content-Type: message/rfc822",
+				"",
+				"Message-ID: <origin-1@example.com>",
+				"Subject: hello",
+				"",
+				"body",
+				"--b1--",
+			)...),
+			want: &MailBounce{MessageID: "<origin-1@example.com>", Recipients: hardBounce},
+		},
+		{
+			name: "nested multipart",
+			raw: testMail(append(append([]string{
+				`Content-Type: multipart/mixed; boundary="outer"`,
+				"",
+				"--outer",
+				`Content-Type: multipart/report; report-type=delivery-status; boundary="inner"`,
+				"",
+				"--inner",
+				"Content-Type: text/plain",
+				"",
+				"Delivery failed.",
+				"--inner",
+				"Content-Type: message/delivery-status",
+				"",
+			}, testDeliveryStatus...),
+				"",
+				"--inner",
+				"Content-Type: text/rfc822-headers",
+				"",
+				"Message-ID: <origin-2@example.com>",
+				"",
+				"--inner--",
+				"--outer--",
+			)...),
+			want: &MailBounce{MessageID: "<origin-2@example.com>", Recipients: hardBounce},
+		},
+		{
+			name: "base64 parts",
+			raw: testMail(
+				`Content-Type: multipart/report; report-type=delivery-status; boundary="b1"`,
+				"",
+				"--b1",
+				"Content-Type: message/delivery-status",
+				"Content-Transfer-Encoding: base64",
+				"",
+				base64.StdEncoding.EncodeToString([]byte(strings.Join(testDeliveryStatus, "\r\n"))),
+				"--b1",
+				"Content-Type: text/rfc822-headers",
+				"Content-Transfer-Encoding: base64",
+				"",
+				base64.StdEncoding.EncodeToString([]byte("Message-ID: <origin-3@example.com>\r\nSubject: hello\r\n\r\n")),
+				"--b1--",
+			),
+			want: &MailBounce{MessageID: "<origin-3@example.com>", Recipients: hardBounce},
+		},
+		{
+			name: "soft bounce with multiple recipients",
+			raw: testMail(
+				`Content-Type: multipart/report; report-type=delivery-status; boundary="b1"`,
+				"",
+				"--b1",
+				"Content-Type: message/delivery-status",
+				"",
+				"Reporting-MTA: dns; mx.example.com",
+				"",
+				"Original-Recipient: rfc822; full@example.org",
+				"Action: delayed",
+				"Status: 4.2.2",
+				"",
+				"Final-Recipient: rfc822; bad@example.org",
+				"Action: Failed",
+				"Status: 5.1.1",
+				"",
+				"--b1",
+				"Content-Type: text/rfc822-headers",
+				"",
+				"Message-Id: <origin-4@example.com>",
+				"--b1--",
+			),
+			want: &MailBounce{MessageID: "<origin-4@example.com>", Recipients: []*MailBounceRecipient{
+				{Recipient: "full@example.org", Action: "delayed", Status: "4.2.2"},
+				{Recipient: "bad@example.org", Action: "failed", Status: "5.1.1"},
+			}},
+		},
+		{
+			name: "missing message id",
+			raw: testMail(append(append([]string{
+				`Content-Type: multipart/report; report-type=delivery-status; boundary="b1"`,
+				"",
+				"--b1",
+				"Content-Type: message/delivery-status",
+				"",
+			}, testDeliveryStatus...),
+				"",
+				"--b1",
+				"Content-Type: text/rfc822-headers",
+				"",
+				"Subject: hello",
+				"",
+				"--b1--",
+			)...),
+			wantErr: ErrMailBounceInvalid,
+		},
+		{
+			name: "not a delivery status notification",
+			raw: testMail(
+				"Message-ID: <reply@example.com>",
+				"Content-Type: text/plain",
+				"",
+				"I am out of office.",
+			),
+			wantErr: ErrMailBounceInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMailBounce(tt.raw)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseMailBounce() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMailBounce() error = %v", err)
+			}
+			if got.MessageID != tt.want.MessageID {
+				t.Errorf("MessageID = %q, want %q", got.MessageID, tt.want.MessageID)
+			}
+			if !reflect.DeepEqual(got.Recipients, tt.want.Recipients) {
+				t.Errorf("Recipients = %+v, want %+v", got.Recipients, tt.want.Recipients)
+			}
+		})
+	}
+}
+
+func TestMailBounceRecipient_HardSoft(t *testing.T) {
+	tests := []struct {
+		action   string
+		status   string
+		wantHard bool
+		wantSoft bool
+	}{
+		{action: "failed", status: "5.1.1", wantHard: true},
+		{action: "failed", status: "4.4.1", wantSoft: true},
+		{action: "delayed", status: "4.2.2", wantSoft: true},
+		{action: "delivered", status: "2.0.0"},
+	}
+	for _, tt := range tests {
+		r := &MailBounceRecipient{Action: tt.action, Status: tt.status}
+		if r.Hard() != tt.wantHard || r.Soft() != tt.wantSoft {
+			t.Errorf("%s %s: Hard() = %v, Soft() = %v", tt.action, tt.status, r.Hard(), r.Soft())
+		}
+	}
+}
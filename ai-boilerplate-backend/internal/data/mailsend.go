@@ -0,0 +1,426 @@
+package data
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	mailSendMaxRetry = 3                // 邮件发送最大重试次数
+	mailSMTPTimeout  = 30 * time.Second // SMTP 会话超时时间
+)
+
+var (
+	// ErrMailTemplateNotFound 模板编码没有启用的邮件模板
+	ErrMailTemplateNotFound = errors.New("mail template not found")
+	// ErrMailAccountUnavailable 邮箱账号不存在或已禁用
+	ErrMailAccountUnavailable = errors.New("mail account is unavailable")
+	// ErrMailTemplateParamMissing 缺少邮件模板参数
+	ErrMailTemplateParamMissing = errors.New("mail template param is missing")
+	// ErrMailRecipientSuppressed 收件人多次硬退信, 已暂停发送
+	ErrMailRecipientSuppressed = errors.New("mail recipient is suppressed")
+	// ErrMailStartTLSUnsupported 要求 STARTTLS 但 SMTP 服务器不支持
+	ErrMailStartTLSUnsupported = errors.New("smtp server does not support STARTTLS")
+)
+
+// mailTemplateParamRegexp 邮件模板变量, 如 {name}
+var mailTemplateParamRegexp = regexp.MustCompile(`\{(\w+)\}`)
+
+// MailSendMessage 邮件发送任务消息
+type MailSendMessage struct {
+	MailLogID string `json:"mailLogId"` // 邮件日志编号
+}
+
+func NewMailSendRepo(
+	logger log.Logger,
+	data *Data,
+	mailAccountRepo *ai_boilerplate_repo.MailAccountRepo,
+	mailTemplateRepo *ai_boilerplate_repo.MailTemplateRepo,
+	mailLogRepo *ai_boilerplate_repo.MailLogRepo,
+) *MailSendRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/mailSend"))
+	mailCfg := data.cfg.GetBusiness()["mail"].GetFields()
+	trackCfg := mailCfg["track"].GetStructValue().GetFields()
+	bounceCfg := mailCfg["bounce"].GetStructValue().GetFields()
+	suppressionCfg := mailCfg["suppression"].GetStructValue().GetFields()
+	suppression := &mailSuppressionConfig{
+		threshold: int64(suppressionCfg["threshold"].GetNumberValue()),
+		window:    time.Duration(suppressionCfg["window"].GetNumberValue()) * 24 * time.Hour,
+	}
+	if suppression.threshold <= 0 {
+		suppression.threshold = 3
+	}
+	if suppression.window <= 0 {
+		suppression.window = 90 * 24 * time.Hour
+	}
+	return &MailSendRepo{
+		log:              l,
+		data:             data,
+		mailAccountRepo:  mailAccountRepo,
+		mailTemplateRepo: mailTemplateRepo,
+		mailLogRepo:      mailLogRepo,
+		trackBaseURL:     strings.TrimRight(trackCfg["baseUrl"].GetStringValue(), "/"),
+		trackSecret:      trackCfg["secret"].GetStringValue(),
+		bounceSecret:     bounceCfg["secret"].GetStringValue(),
+		suppression:      suppression,
+		smtpOptions: &mailSMTPOptions{
+			requireStartTLS: mailCfg["requireStartTLS"].GetBoolValue(),
+		},
+	}
+}
+
+// MailSendRepo 邮件发送: 渲染模板写入邮件日志并投递发送任务, 由消费者通过 SMTP 发送
+type MailSendRepo struct {
+	log              *log.Helper
+	data             *Data
+	mailAccountRepo  *ai_boilerplate_repo.MailAccountRepo
+	mailTemplateRepo *ai_boilerplate_repo.MailTemplateRepo
+	mailLogRepo      *ai_boilerplate_repo.MailLogRepo
+	trackBaseURL     string                 // 追踪地址的服务域名, 为空时不追踪
+	trackSecret      string                 // 追踪地址签名密钥, 为空时不追踪
+	bounceSecret     string                 // 退信回调地址签名密钥, 为空时不接收退信回调
+	suppression      *mailSuppressionConfig // 退信暂停发送配置
+	smtpOptions      *mailSMTPOptions       // SMTP 连接选项
+}
+
+// mailSuppressionConfig 退信暂停发送配置: 统计窗口内硬退信达到次数后暂停向该地址发送
+type mailSuppressionConfig struct {
+	threshold int64         // 硬退信次数阈值
+	window    time.Duration // 统计窗口
+}
+
+// mailSMTPOptions SMTP 连接选项
+type mailSMTPOptions struct {
+	requireStartTLS bool           // 未开启 SSL 时要求服务器支持 STARTTLS, 不支持时发送失败, 避免明文发送账号密码与邮件
+	rootCAs         *x509.CertPool // 校验服务器证书的根证书, 为空时使用系统根证书
+}
+
+// FindEnableTemplateByCode 根据模板编码查询启用的邮件模板, 存在多个时取最新的一个
+func (r *MailSendRepo) FindEnableTemplateByCode(ctx context.Context, templateCode string) (*ai_boilerplate_model.MailTemplate, error) {
+	param := &condition.Req{
+		Page:     1,
+		PageSize: 1,
+		Query: []*condition.QueryParam{
+			{
+				Field: "code",
+				Value: templateCode,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+			{
+				Field: "status",
+				Value: int32(constant.StatusEnable),
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+		},
+		Order: []*condition.OrderParam{
+			{
+				Field: "created_at",
+				Order: condition.DESC,
+			},
+		},
+	}
+	list, _, err := r.mailTemplateRepo.FindMultiCacheByCondition(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, ErrMailTemplateNotFound
+	}
+	return list[0], nil
+}
+
+// SendByTemplateCode 按模板编码发送邮件
+func (r *MailSendRepo) SendByTemplateCode(ctx context.Context, templateCode string, toMail string, params map[string]string) (*ai_boilerplate_model.MailLog, error) {
+	template, err := r.FindEnableTemplateByCode(ctx, templateCode)
+	if err != nil {
+		return nil, err
+	}
+	return r.Send(ctx, template, toMail, params)
+}
+
+// Send 渲染邮件模板, 写入发送中的邮件日志并投递发送任务
+// 收件人已暂停发送时只写入发送失败的日志, 并返回 ErrMailRecipientSuppressed
+func (r *MailSendRepo) Send(ctx context.Context, template *ai_boilerplate_model.MailTemplate, toMail string, params map[string]string) (*ai_boilerplate_model.MailLog, error) {
+	to, err := mail.ParseAddress(toMail)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mail address %s: %w", toMail, err)
+	}
+	account, err := r.mailAccountRepo.FindOneCacheByID(ctx, template.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil || account.ID == "" || account.Status != int32(constant.StatusEnable) {
+		return nil, ErrMailAccountUnavailable
+	}
+	title, err := renderMailTemplate(template.Title, params, false)
+	if err != nil {
+		return nil, err
+	}
+	content, err := renderMailTemplate(template.Content, params, true)
+	if err != nil {
+		return nil, err
+	}
+	paramsContent, err := jsonutil.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	mailLog := r.mailLogRepo.NewData()
+	mailLog.AccountID = account.ID
+	mailLog.FromMail = account.Mail
+	mailLog.ToMail = to.Address
+	mailLog.TemplateID = template.ID
+	mailLog.TemplateCode = template.Code
+	mailLog.TemplateNickname = template.Nickname
+	mailLog.TemplateTitle = truncateRunes(title, 255)
+	mailLog.TemplateContent = content
+	mailLog.TemplateParams = truncateRunes(string(paramsContent), 255)
+	mailLog.SendStatus = int32(constant.MailSendStatusPending)
+	mailLog.SendTime = time.Now()
+	suppressed, err := r.Suppressed(ctx, mailLog.ToMail)
+	if err != nil {
+		return nil, err
+	}
+	if suppressed {
+		// 暂停发送的收件人不再投递, 只记录发送失败
+		mailLog.SendStatus = int32(constant.MailSendStatusFailed)
+		mailLog.SendException = ErrMailRecipientSuppressed.Error()
+	}
+	err = r.mailLogRepo.CreateOneCache(ctx, mailLog)
+	if err != nil {
+		return nil, err
+	}
+	if suppressed {
+		return mailLog, ErrMailRecipientSuppressed
+	}
+	payload, err := json.Marshal(&MailSendMessage{MailLogID: mailLog.ID})
+	if err != nil {
+		return nil, err
+	}
+	err = r.data.MQClient.SendMessage(ctx, constant.MQMailSend, payload)
+	if err != nil {
+		// 投递失败时记录为发送失败, 避免日志一直处于发送中
+		r.finishLog(ctx, mailLog, "", err)
+		return nil, err
+	}
+	return mailLog, nil
+}
+
+// Deliver 消费发送任务: 通过 SMTP 发送邮件日志对应的邮件并更新发送结果
+// 临时错误返回 error 交由队列重试, 超过最大重试次数或服务器拒绝(5xx)时记录为发送失败
+func (r *MailSendRepo) Deliver(ctx context.Context, mailLogID string) error {
+	mailLog, err := r.mailLogRepo.FindOneCacheByID(ctx, mailLogID)
+	if err != nil {
+		return err
+	}
+	// 已有发送结果的日志不再重复发送
+	if mailLog == nil || mailLog.ID == "" || mailLog.SendStatus != int32(constant.MailSendStatusPending) {
+		return nil
+	}
+	account, err := r.mailAccountRepo.FindOneCacheByID(ctx, mailLog.AccountID)
+	if err != nil {
+		return err
+	}
+	if account == nil || account.ID == "" || account.Status != int32(constant.StatusEnable) {
+		r.finishLog(ctx, mailLog, "", ErrMailAccountUnavailable)
+		return nil
+	}
+	template, err := r.mailTemplateRepo.FindOneCacheByID(ctx, mailLog.TemplateID)
+	if err != nil {
+		return err
+	}
+	// 追踪只加在实际发送的邮件中, 日志保存原始内容, 避免后台查看日志时被记为打开
+	content := mailLog.TemplateContent
+	if template != nil && template.ID != "" {
+		content = r.trackContent(mailLog.ID, content, template.TrackOpen, template.TrackClick)
+	}
+	messageID, sendErr := sendSMTPMail(ctx, account, mailLog, content, r.smtpOptions)
+	if sendErr == nil {
+		r.finishLog(ctx, mailLog, messageID, nil)
+		return nil
+	}
+	// 重试次数同时受队列任务的最大重试次数限制, 保证最后一次尝试会写入发送结果
+	retry, ok := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	if ok && shouldRetryMail(retry, maxRetry, sendErr) {
+		r.log.WithContext(ctx).Warnf("failed to send mail %s, retry %d: %v", mailLog.ID, retry, sendErr)
+		return sendErr
+	}
+	r.finishLog(ctx, mailLog, messageID, sendErr)
+	return nil
+}
+
+// finishLog 更新邮件日志的发送结果
+func (r *MailSendRepo) finishLog(ctx context.Context, mailLog *ai_boilerplate_model.MailLog, messageID string, sendErr error) {
+	oldData := r.mailLogRepo.DeepCopy(mailLog)
+	mailLog.SendTime = time.Now()
+	mailLog.SendMessageID = messageID
+	mailLog.SendStatus = int32(constant.MailSendStatusSuccess)
+	mailLog.SendException = ""
+	if sendErr != nil {
+		mailLog.SendStatus = int32(constant.MailSendStatusFailed)
+		mailLog.SendException = truncateRunes(sendErr.Error(), 4096)
+	}
+	err := r.mailLogRepo.UpdateOneCacheWithZero(ctx, mailLog, oldData)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to update mail log %s: %v", mailLog.ID, err)
+	}
+}
+
+// renderMailTemplate 使用参数替换模板中的 {name} 变量, 正文为 HTML 时转义参数值
+func renderMailTemplate(tpl string, params map[string]string, escape bool) (string, error) {
+	var missing []string
+	result := mailTemplateParamRegexp.ReplaceAllStringFunc(tpl, func(s string) string {
+		name := s[1 : len(s)-1]
+		v, ok := params[name]
+		if !ok {
+			missing = append(missing, name)
+			return s
+		}
+		if escape {
+			return html.EscapeString(v)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrMailTemplateParamMissing, strings.Join(missing, ","))
+	}
+	return result, nil
+}
+
+// shouldRetryMail 发送失败后是否交由队列重试: 未超过重试次数且不是永久失败
+func shouldRetryMail(retry, maxRetry int, err error) bool {
+	return retry < min(mailSendMaxRetry, maxRetry) && !isMailPermanentError(err)
+}
+
+// isMailPermanentError SMTP 服务器返回 5xx 或不支持要求的 STARTTLS 表示永久失败, 重试无意义
+func isMailPermanentError(err error) bool {
+	if errors.Is(err, ErrMailStartTLSUnsupported) {
+		return true
+	}
+	var tpErr *textproto.Error
+	return errors.As(err, &tpErr) && tpErr.Code >= 500
+}
+
+// sendSMTPMail 通过 SMTP 发送邮件, 返回邮件的 Message-ID
+// 开启 SSL 时使用隐式 TLS(一般为 465 端口), 否则服务器支持时升级为 STARTTLS, 要求 STARTTLS 时服务器不支持则发送失败
+func sendSMTPMail(ctx context.Context, account *ai_boilerplate_model.MailAccount, mailLog *ai_boilerplate_model.MailLog, content string, opts *mailSMTPOptions) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, mailSMTPTimeout)
+	defer cancel()
+	if opts == nil {
+		opts = &mailSMTPOptions{}
+	}
+	addr := net.JoinHostPort(account.Host, strconv.Itoa(int(account.Port)))
+	tlsConfig := &tls.Config{ServerName: account.Host, RootCAs: opts.rootCAs, MinVersion: tls.VersionTLS12}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if account.SslEnable {
+		dialer := &tls.Dialer{Config: tlsConfig}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		dialer := &net.Dialer{}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to dial smtp server %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, account.Host)
+	if err != nil {
+		_ = conn.Close()
+		return "", err
+	}
+	defer client.Close()
+	if !account.SslEnable {
+		ok, _ := client.Extension("STARTTLS")
+		if !ok && opts.requireStartTLS {
+			return "", ErrMailStartTLSUnsupported
+		}
+		if ok {
+			if err = client.StartTLS(tlsConfig); err != nil {
+				return "", err
+			}
+		}
+	}
+	if ok, _ := client.Extension("AUTH"); ok && account.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", account.Username, account.Password, account.Host)); err != nil {
+			return "", err
+		}
+	}
+	messageID, msg := buildMailMessage(mailLog, content)
+	if err = client.Mail(mailLog.FromMail); err != nil {
+		return "", err
+	}
+	if err = client.Rcpt(mailLog.ToMail); err != nil {
+		return "", err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return "", err
+	}
+	if _, err = w.Write(msg); err != nil {
+		return "", err
+	}
+	if err = w.Close(); err != nil {
+		return "", err
+	}
+	// 邮件已被服务器接收, QUIT 失败不影响发送结果
+	_ = client.Quit()
+	return messageID, nil
+}
+
+// buildMailMessage 构造 HTML 邮件报文
+func buildMailMessage(mailLog *ai_boilerplate_model.MailLog, content string) (messageID string, msg []byte) {
+	domain := "localhost"
+	if i := strings.LastIndex(mailLog.FromMail, "@"); i >= 0 {
+		domain = mailLog.FromMail[i+1:]
+	}
+	messageID = fmt.Sprintf("<%s@%s>", uuid.NewString(), domain)
+	from := &mail.Address{Name: mailLog.TemplateNickname, Address: mailLog.FromMail}
+	var b strings.Builder
+	b.WriteString("From: " + from.String() + "\r\n")
+	b.WriteString("To: " + (&mail.Address{Address: mailLog.ToMail}).String() + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", mailLog.TemplateTitle) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("Message-ID: " + messageID + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n")
+	b.WriteString("\r\n")
+	body := base64.StdEncoding.EncodeToString([]byte(content))
+	for len(body) > 76 {
+		b.WriteString(body[:76] + "\r\n")
+		body = body[76:]
+	}
+	b.WriteString(body + "\r\n")
+	return messageID, []byte(b.String())
+}
@@ -0,0 +1,270 @@
+package data
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+)
+
+// smtpStub 进程内的 SMTP 服务器, 只实现发送邮件需要的命令
+type smtpStub struct {
+	ln        net.Listener
+	tlsConfig *tls.Config
+	startTLS  bool   // 是否支持 STARTTLS
+	rcptReply string // RCPT 命令的响应, 为空时接收
+	mu        sync.Mutex
+	encrypted bool   // 收到邮件时连接是否已加密
+	data      string // 收到的邮件报文
+}
+
+// newSMTPStub 启动 SMTP 服务器, implicitTLS 为 true 时监听 TLS 端口
+func newSMTPStub(t *testing.T, implicitTLS, startTLS bool, rcptReply string) (*smtpStub, *x509.CertPool) {
+	t.Helper()
+	serverTLS, rootCAs := newTestTLSConfig(t)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	if implicitTLS {
+		ln = tls.NewListener(ln, serverTLS)
+	}
+	s := &smtpStub{
+		ln:        ln,
+		tlsConfig: serverTLS,
+		startTLS:  startTLS,
+		rcptReply: rcptReply,
+	}
+	t.Cleanup(func() {
+		_ = ln.Close()
+	})
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn)
+		}
+	}()
+	return s, rootCAs
+}
+
+// account 连接 SMTP 服务器的邮箱账号
+func (s *smtpStub) account(sslEnable bool) *ai_boilerplate_model.MailAccount {
+	addr := s.ln.Addr().(*net.TCPAddr)
+	return &ai_boilerplate_model.MailAccount{
+		Host:      "127.0.0.1",
+		Port:      int32(addr.Port),
+		SslEnable: sslEnable,
+		Status:    1,
+	}
+}
+
+// received 返回收到的邮件报文与连接是否已加密
+func (s *smtpStub) received() (data string, encrypted bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data, s.encrypted
+}
+
+func (s *smtpStub) handle(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	_, encrypted := conn.(*tls.Conn)
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 stub ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		switch strings.ToUpper(strings.SplitN(line, " ", 2)[0]) {
+		case "EHLO", "HELO":
+			if s.startTLS && !encrypted {
+				_ = tp.PrintfLine("250-stub")
+				_ = tp.PrintfLine("250 STARTTLS")
+			} else {
+				_ = tp.PrintfLine("250 stub")
+			}
+		case "STARTTLS":
+			_ = tp.PrintfLine("220 ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err = tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			encrypted = true
+			tp = textproto.NewConn(conn)
+		case "MAIL", "RSET", "NOOP":
+			_ = tp.PrintfLine("250 OK")
+		case "RCPT":
+			if s.rcptReply != "" {
+				_ = tp.PrintfLine("%s", s.rcptReply)
+			} else {
+				_ = tp.PrintfLine("250 OK")
+			}
+		case "DATA":
+			_ = tp.PrintfLine("354 end with <CR><LF>.<CR><LF>")
+			lines, err := tp.ReadDotLines()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.data = strings.Join(lines, "\n")
+			s.encrypted = encrypted
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250 OK queued")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 command not implemented")
+		}
+	}
+}
+
+// newTestTLSConfig 生成 127.0.0.1 的自签名证书, 返回服务端配置与信任该证书的根证书
+func newTestTLSConfig(t *testing.T) (*tls.Config, *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(cert)
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		MinVersion:   tls.VersionTLS12,
+	}, rootCAs
+}
+
+func newTestMailLog() *ai_boilerplate_model.MailLog {
+	return &ai_boilerplate_model.MailLog{
+		FromMail:         "noreply@example.com",
+		ToMail:           "user@example.com",
+		TemplateNickname: "Example",
+		TemplateTitle:    "测试邮件",
+	}
+}
+
+func TestSendSMTPMail(t *testing.T) {
+	tests := []struct {
+		name            string
+		implicitTLS     bool
+		startTLS        bool
+		requireStartTLS bool
+		wantEncrypted   bool
+	}{
+		{name: "implicit tls", implicitTLS: true, wantEncrypted: true},
+		{name: "starttls upgrade", startTLS: true, requireStartTLS: true, wantEncrypted: true},
+		{name: "plain without starttls", wantEncrypted: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub, rootCAs := newSMTPStub(t, tt.implicitTLS, tt.startTLS, "")
+			messageID, err := sendSMTPMail(context.Background(), stub.account(tt.implicitTLS), newTestMailLog(), "<p>hello</p>", &mailSMTPOptions{
+				requireStartTLS: tt.requireStartTLS,
+				rootCAs:         rootCAs,
+			})
+			if err != nil {
+				t.Fatalf("sendSMTPMail() error = %v", err)
+			}
+			if !strings.HasSuffix(messageID, "@example.com>") {
+				t.Errorf("messageID = %q", messageID)
+			}
+			data, encrypted := stub.received()
+			if encrypted != tt.wantEncrypted {
+				t.Errorf("encrypted = %v, want %v", encrypted, tt.wantEncrypted)
+			}
+			if !strings.Contains(data, "Message-ID: "+messageID) {
+				t.Errorf("message does not contain Message-ID %s:\n%s", messageID, data)
+			}
+		})
+	}
+}
+
+func TestSendSMTPMail_RequireStartTLS(t *testing.T) {
+	stub, rootCAs := newSMTPStub(t, false, false, "")
+	_, err := sendSMTPMail(context.Background(), stub.account(false), newTestMailLog(), "<p>hello</p>", &mailSMTPOptions{
+		requireStartTLS: true,
+		rootCAs:         rootCAs,
+	})
+	if !errors.Is(err, ErrMailStartTLSUnsupported) {
+		t.Fatalf("sendSMTPMail() error = %v, want %v", err, ErrMailStartTLSUnsupported)
+	}
+	if !isMailPermanentError(err) {
+		t.Error("missing STARTTLS should be a permanent error")
+	}
+	if data, _ := stub.received(); data != "" {
+		t.Errorf("mail should not be sent in plain text:\n%s", data)
+	}
+}
+
+func TestSendSMTPMail_Failure(t *testing.T) {
+	tests := []struct {
+		name          string
+		rcptReply     string
+		wantPermanent bool
+		wantRetry     bool
+	}{
+		{name: "5xx permanent failure", rcptReply: "550 mailbox unavailable", wantPermanent: true, wantRetry: false},
+		{name: "4xx temporary failure", rcptReply: "451 try again later", wantPermanent: false, wantRetry: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub, rootCAs := newSMTPStub(t, false, true, tt.rcptReply)
+			_, err := sendSMTPMail(context.Background(), stub.account(false), newTestMailLog(), "<p>hello</p>", &mailSMTPOptions{
+				requireStartTLS: true,
+				rootCAs:         rootCAs,
+			})
+			var tpErr *textproto.Error
+			if !errors.As(err, &tpErr) {
+				t.Fatalf("sendSMTPMail() error = %v, want *textproto.Error", err)
+			}
+			if got := isMailPermanentError(err); got != tt.wantPermanent {
+				t.Errorf("isMailPermanentError() = %v, want %v", got, tt.wantPermanent)
+			}
+			if got := shouldRetryMail(0, mailSendMaxRetry, err); got != tt.wantRetry {
+				t.Errorf("shouldRetryMail() = %v, want %v", got, tt.wantRetry)
+			}
+			// 最后一次尝试不再重试, 由 Deliver 写入发送失败
+			if shouldRetryMail(mailSendMaxRetry, mailSendMaxRetry, err) {
+				t.Error("shouldRetryMail() should be false after the last retry")
+			}
+		})
+	}
+}
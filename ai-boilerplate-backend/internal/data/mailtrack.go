@@ -0,0 +1,138 @@
+package data
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_dao"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+)
+
+// ErrMailTrackSignInvalid 追踪地址签名校验失败
+var ErrMailTrackSignInvalid = errors.New("mail track sign is invalid")
+
+// MailTrackPixel 打开追踪返回的 1x1 透明 GIF
+var MailTrackPixel = []byte{
+	0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x01, 0x00, 0x01, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0x21, 0xf9, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x00, 0x02, 0x02, 0x44, 0x01, 0x00, 0x3b,
+}
+
+// mailLinkRegexp 邮件正文中的 http(s) 链接
+var mailLinkRegexp = regexp.MustCompile(`(?i)(<a\s[^>]*?href\s*=\s*)(["'])(https?://[^"']+)(["'])`)
+
+// trackContent 按模板配置为邮件正文加入打开追踪像素, 并将链接改写为点击追踪地址
+func (r *MailSendRepo) trackContent(mailLogID string, content string, trackOpen, trackClick bool) string {
+	if r.trackBaseURL == "" || r.trackSecret == "" {
+		return content
+	}
+	if trackClick {
+		content = mailLinkRegexp.ReplaceAllStringFunc(content, func(s string) string {
+			m := mailLinkRegexp.FindStringSubmatch(s)
+			// 属性值中的 & 以 &amp; 形式出现, 签名使用还原后的地址
+			target := html.UnescapeString(m[3])
+			query := url.Values{}
+			query.Set("id", mailLogID)
+			query.Set("url", target)
+			query.Set("sign", r.trackSign(mailLogID, target))
+			return m[1] + m[2] + html.EscapeString(r.trackBaseURL+"/mail_log/track/click?"+query.Encode()) + m[4]
+		})
+	}
+	if trackOpen {
+		query := url.Values{}
+		query.Set("id", mailLogID)
+		query.Set("sign", r.trackSign(mailLogID, ""))
+		pixel := `<img src="` + html.EscapeString(r.trackBaseURL+"/mail_log/track/open?"+query.Encode()) + `" width="1" height="1" alt="" style="display:none" />`
+		if i := strings.LastIndex(strings.ToLower(content), "</body>"); i >= 0 {
+			return content[:i] + pixel + content[i:]
+		}
+		content += pixel
+	}
+	return content
+}
+
+// trackSign 追踪地址签名, 点击追踪同时签名跳转地址, 避免被用作任意跳转
+func (r *MailSendRepo) trackSign(mailLogID, target string) string {
+	h := hmac.New(sha256.New, []byte(r.trackSecret))
+	h.Write([]byte(mailLogID + "\n" + target))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// TrackOpen 记录邮件打开: 打开次数加一, 首次打开时记录打开时间
+func (r *MailSendRepo) TrackOpen(ctx context.Context, mailLogID, sign string) error {
+	if r.trackSecret == "" || !hmac.Equal([]byte(sign), []byte(r.trackSign(mailLogID, ""))) {
+		return ErrMailTrackSignInvalid
+	}
+	dao := ai_boilerplate_dao.Use(r.data.gorm).MailLog
+	_, err := dao.WithContext(ctx).Where(dao.ID.Eq(mailLogID)).UpdateSimple(dao.OpenCount.Add(1))
+	if err != nil {
+		return err
+	}
+	_, err = dao.WithContext(ctx).Where(dao.ID.Eq(mailLogID), dao.OpenTime.IsNull()).UpdateSimple(dao.OpenTime.Value(sql.NullTime{Time: time.Now(), Valid: true}))
+	if err != nil {
+		return err
+	}
+	return r.mailLogRepo.DeleteIndexCache(ctx, &ai_boilerplate_model.MailLog{ID: mailLogID})
+}
+
+// TrackClick 记录邮件链接点击, 返回跳转地址
+// 点击也说明邮件已被打开, 未记录打开时间时一并记录
+func (r *MailSendRepo) TrackClick(ctx context.Context, mailLogID, target, sign string) (string, error) {
+	if r.trackSecret == "" || !hmac.Equal([]byte(sign), []byte(r.trackSign(mailLogID, target))) {
+		return "", ErrMailTrackSignInvalid
+	}
+	dao := ai_boilerplate_dao.Use(r.data.gorm).MailLog
+	_, err := dao.WithContext(ctx).Where(dao.ID.Eq(mailLogID)).UpdateSimple(dao.ClickCount.Add(1))
+	if err != nil {
+		return "", err
+	}
+	_, err = dao.WithContext(ctx).Where(dao.ID.Eq(mailLogID), dao.OpenTime.IsNull()).UpdateSimple(dao.OpenTime.Value(sql.NullTime{Time: time.Now(), Valid: true}))
+	if err != nil {
+		return "", err
+	}
+	err = r.mailLogRepo.DeleteIndexCache(ctx, &ai_boilerplate_model.MailLog{ID: mailLogID})
+	if err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+// HardBounceCount 统计收件人在退信统计窗口内(不含解除暂停前)的硬退信次数
+func (r *MailSendRepo) HardBounceCount(ctx context.Context, toMail string) (int64, error) {
+	since := time.Now().Add(-r.suppression.window)
+	lift, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Get().Key(constant.MailSuppressionLift.Key(toMail)).Build()).AsInt64()
+	if err == nil && time.Unix(lift, 0).After(since) {
+		since = time.Unix(lift, 0)
+	}
+	dao := ai_boilerplate_dao.Use(r.data.gorm).MailLog
+	return dao.WithContext(ctx).Where(
+		dao.ToMail.Eq(toMail),
+		dao.SendStatus.Eq(int32(constant.MailSendStatusBounced)),
+		dao.BounceTime.Gte(sql.NullTime{Time: since, Valid: true}),
+	).Count()
+}
+
+// Suppressed 收件人是否因多次硬退信被暂停发送
+func (r *MailSendRepo) Suppressed(ctx context.Context, toMail string) (bool, error) {
+	count, err := r.HardBounceCount(ctx, toMail)
+	if err != nil {
+		return false, err
+	}
+	return count >= r.suppression.threshold, nil
+}
+
+// LiftSuppression 解除收件人的暂停发送, 之前的退信不再计入
+func (r *MailSendRepo) LiftSuppression(ctx context.Context, toMail string) error {
+	return r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(constant.MailSuppressionLift.Key(toMail)).Value(strconv.FormatInt(time.Now().Unix(), 10)).Ex(constant.MailSuppressionLift.TTL()).Build()).Error()
+}
@@ -0,0 +1,426 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ArtisanCloud/PowerWeChat/v3/src/kernel/power"
+	"github.com/ArtisanCloud/PowerWeChat/v3/src/officialAccount/templateMessage/request"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/fzf-labs/goutil/timeutil"
+	"github.com/fzf-labs/goutil/uuidutil"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/datatypes"
+)
+
+var (
+	// ErrNotifyUserNotFound 通知的用户不存在
+	ErrNotifyUserNotFound = errors.New("notify user not found")
+	// ErrNotifyCategoryInvalid 通知类别无效
+	ErrNotifyCategoryInvalid = errors.New("notify category is invalid")
+	// ErrNotifyNoDevice 用户没有可推送的设备
+	ErrNotifyNoDevice = errors.New("user has no push device")
+)
+
+// notifyContentMaxLen 站内信主题与内容的最大长度
+const notifyContentMaxLen = 200
+
+// NotifyPayload 用户通知内容, 各渠道按需取用, 缺少渠道所需的内容时不投递该渠道
+type NotifyPayload struct {
+	Title            string                   `json:"title"`                      // 标题, 用于站内信与推送
+	Content          string                   `json:"content"`                    // 内容, 用于站内信与推送
+	URL              string                   `json:"url,omitempty"`              // 跳转地址, 用于公众号模板消息与推送
+	Params           map[string]string        `json:"params,omitempty"`           // 模板参数, 用于短信、邮件与公众号模板消息
+	SmsTemplateCode  string                   `json:"smsTemplateCode,omitempty"`  // 短信模板编码
+	MailTemplateCode string                   `json:"mailTemplateCode,omitempty"` // 邮件模板编码
+	Mail             string                   `json:"mail,omitempty"`             // 接收邮箱, 用户表没有邮箱, 由调用方提供
+	WxGzhTemplateID  string                   `json:"wxGzhTemplateId,omitempty"`  // 公众号模板消息模板 ID
+	Channels         []constant.NotifyChannel `json:"channels,omitempty"`         // 限定投递渠道, 为空时投递所有可用渠道
+}
+
+// NotifyDispatchMessage 用户通知投递任务消息
+type NotifyDispatchMessage struct {
+	NotifyID string `json:"notifyId"` // 通知编号
+}
+
+func NewNotifyRepo(
+	logger log.Logger,
+	data *Data,
+	userRepo *ai_boilerplate_repo.UserRepo,
+	userNotificationSettingRepo *UserNotificationSettingRepo,
+	userNotifyLogRepo *ai_boilerplate_repo.UserNotifyLogRepo,
+	sysNotifyMessageRepo *ai_boilerplate_repo.SysNotifyMessageRepo,
+	smsSendRepo *SmsSendRepo,
+	mailSendRepo *MailSendRepo,
+	wxGzhAccountRepo *WxGzhAccountRepo,
+	wxGzhUserRepo *ai_boilerplate_repo.WxGzhUserRepo,
+	userBindDeviceRepo *ai_boilerplate_repo.UserBindDeviceRepo,
+	devicePushRepo *DevicePushRepo,
+) *NotifyRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/notify"))
+	return &NotifyRepo{
+		log:                         l,
+		data:                        data,
+		userRepo:                    userRepo,
+		userNotificationSettingRepo: userNotificationSettingRepo,
+		userNotifyLogRepo:           userNotifyLogRepo,
+		sysNotifyMessageRepo:        sysNotifyMessageRepo,
+		smsSendRepo:                 smsSendRepo,
+		mailSendRepo:                mailSendRepo,
+		wxGzhAccountRepo:            wxGzhAccountRepo,
+		wxGzhUserRepo:               wxGzhUserRepo,
+		userBindDeviceRepo:          userBindDeviceRepo,
+		devicePushRepo:              devicePushRepo,
+	}
+}
+
+// NotifyRepo 用户通知: 按用户通知设置筛选渠道, 站内信立即写入, 其余渠道通过投递任务发送, 勿扰时段内延迟到勿扰结束
+type NotifyRepo struct {
+	log                         *log.Helper
+	data                        *Data
+	userRepo                    *ai_boilerplate_repo.UserRepo
+	userNotificationSettingRepo *UserNotificationSettingRepo
+	userNotifyLogRepo           *ai_boilerplate_repo.UserNotifyLogRepo
+	sysNotifyMessageRepo        *ai_boilerplate_repo.SysNotifyMessageRepo
+	smsSendRepo                 *SmsSendRepo
+	mailSendRepo                *MailSendRepo
+	wxGzhAccountRepo            *WxGzhAccountRepo
+	wxGzhUserRepo               *ai_boilerplate_repo.WxGzhUserRepo
+	userBindDeviceRepo          *ai_boilerplate_repo.UserBindDeviceRepo
+	devicePushRepo              *DevicePushRepo
+}
+
+// Notify 向用户发送通知, 返回通知编号与各渠道的投递日志
+// 用户关闭了该类别的通知时不投递任何渠道, 返回空的通知编号
+func (r *NotifyRepo) Notify(ctx context.Context, userID string, category constant.NotifyCategory, payload *NotifyPayload) (string, []*ai_boilerplate_model.UserNotifyLog, error) {
+	if !category.IsValid() {
+		return "", nil, ErrNotifyCategoryInvalid
+	}
+	user, err := r.userRepo.FindOneCacheByID(ctx, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	if user == nil || user.ID == "" {
+		return "", nil, ErrNotifyUserNotFound
+	}
+	setting, err := r.userNotificationSettingRepo.FindOneByUserIDOrDefault(ctx, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	if !CategoryEnabled(setting, category) {
+		return "", nil, nil
+	}
+	notifyID := uuidutil.GenUUID()
+	channels, err := r.notifyChannels(ctx, user, payload)
+	if err != nil {
+		return "", nil, err
+	}
+	payloadContent, err := jsonutil.Marshal(payload)
+	if err != nil {
+		return "", nil, err
+	}
+	now := time.Now()
+	delay := DndDelay(setting, now)
+	logs := make([]*ai_boilerplate_model.UserNotifyLog, 0, len(channels))
+	for _, channel := range channels {
+		notifyLog := r.userNotifyLogRepo.NewData()
+		notifyLog.NotifyID = notifyID
+		notifyLog.UserID = userID
+		notifyLog.Category = category.String()
+		notifyLog.Channel = channel.String()
+		notifyLog.Title = truncateRunes(payload.Title, 255)
+		notifyLog.Content = payload.Content
+		notifyLog.Payload = datatypes.JSON(payloadContent)
+		notifyLog.Status = int32(constant.UserNotifyStatusPending)
+		notifyLog.PlanTime = now
+		// 站内信不打扰用户, 不受勿扰时段限制
+		if delay > 0 && channel != constant.NotifyChannelInApp {
+			notifyLog.Status = int32(constant.UserNotifyStatusDeferred)
+			notifyLog.PlanTime = now.Add(delay)
+		}
+		logs = append(logs, notifyLog)
+	}
+	if len(logs) == 0 {
+		return notifyID, logs, nil
+	}
+	err = r.userNotifyLogRepo.CreateBatchCache(ctx, logs, len(logs))
+	if err != nil {
+		return "", nil, err
+	}
+	pending := false
+	for _, notifyLog := range logs {
+		if notifyLog.Channel == constant.NotifyChannelInApp.String() {
+			r.deliver(ctx, user, payload, notifyLog)
+			continue
+		}
+		pending = true
+	}
+	if pending {
+		err = r.enqueueDispatch(ctx, notifyID, delay)
+		if err != nil {
+			// 投递任务失败时记录为投递失败, 避免日志一直处于待投递
+			for _, notifyLog := range logs {
+				if notifyLog.Channel == constant.NotifyChannelInApp.String() {
+					continue
+				}
+				oldData := r.userNotifyLogRepo.DeepCopy(notifyLog)
+				notifyLog.Status = int32(constant.UserNotifyStatusFailed)
+				notifyLog.ErrorMessage = truncateRunes(err.Error(), 1024)
+				if updateErr := r.userNotifyLogRepo.UpdateOneCacheWithZero(ctx, notifyLog, oldData); updateErr != nil {
+					r.log.WithContext(ctx).Errorf("failed to update notify log %s: %v", notifyLog.ID, updateErr)
+				}
+			}
+			return "", nil, err
+		}
+	}
+	return notifyID, logs, nil
+}
+
+// notifyChannels 按通知内容与用户的联系方式确定投递渠道
+func (r *NotifyRepo) notifyChannels(ctx context.Context, user *ai_boilerplate_model.User, payload *NotifyPayload) ([]constant.NotifyChannel, error) {
+	channels := make([]constant.NotifyChannel, 0)
+	for _, channel := range constant.NotifyChannelValues() {
+		if len(payload.Channels) > 0 && !slices.Contains(payload.Channels, channel) {
+			continue
+		}
+		switch channel {
+		case constant.NotifyChannelInApp:
+			if payload.Title == "" && payload.Content == "" {
+				continue
+			}
+		case constant.NotifyChannelSms:
+			if payload.SmsTemplateCode == "" || user.Phone == "" {
+				continue
+			}
+		case constant.NotifyChannelMail:
+			if payload.MailTemplateCode == "" || payload.Mail == "" {
+				continue
+			}
+		case constant.NotifyChannelWxGzh:
+			if payload.WxGzhTemplateID == "" || user.WxGzhUserID == "" {
+				continue
+			}
+		case constant.NotifyChannelPush:
+			if payload.Title == "" && payload.Content == "" {
+				continue
+			}
+			binds, err := r.userBindDeviceRepo.FindMultiCacheByUserID(ctx, user.ID)
+			if err != nil {
+				return nil, err
+			}
+			if len(binds) == 0 {
+				continue
+			}
+		}
+		channels = append(channels, channel)
+	}
+	return channels, nil
+}
+
+// enqueueDispatch 投递通知任务, 勿扰时段内延迟到勿扰结束时执行
+func (r *NotifyRepo) enqueueDispatch(ctx context.Context, notifyID string, delay time.Duration) error {
+	payload, err := json.Marshal(&NotifyDispatchMessage{NotifyID: notifyID})
+	if err != nil {
+		return err
+	}
+	if delay <= 0 {
+		return r.data.MQClient.SendMessage(ctx, constant.MQNotifyDispatch, payload)
+	}
+	return r.data.SendDelayMessage(ctx, constant.MQNotifyDispatch, payload, delay)
+}
+
+// Dispatch 消费投递任务: 投递通知中待投递的渠道并记录各渠道的投递结果
+// 用户在延迟期间调整了勿扰时段, 仍处于勿扰时段时再次延迟
+func (r *NotifyRepo) Dispatch(ctx context.Context, notifyID string) error {
+	logs, err := r.userNotifyLogRepo.FindMultiByNotifyID(ctx, notifyID)
+	if err != nil {
+		return err
+	}
+	waiting := make([]*ai_boilerplate_model.UserNotifyLog, 0, len(logs))
+	for _, notifyLog := range logs {
+		if notifyLog.Status == int32(constant.UserNotifyStatusPending) || notifyLog.Status == int32(constant.UserNotifyStatusDeferred) {
+			waiting = append(waiting, notifyLog)
+		}
+	}
+	if len(waiting) == 0 {
+		return nil
+	}
+	userID := waiting[0].UserID
+	setting, err := r.userNotificationSettingRepo.FindOneByUserIDOrDefault(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if delay := DndDelay(setting, time.Now()); delay > 0 {
+		for _, notifyLog := range waiting {
+			oldData := r.userNotifyLogRepo.DeepCopy(notifyLog)
+			notifyLog.Status = int32(constant.UserNotifyStatusDeferred)
+			notifyLog.PlanTime = time.Now().Add(delay)
+			err = r.userNotifyLogRepo.UpdateOneCacheWithZero(ctx, notifyLog, oldData)
+			if err != nil {
+				return err
+			}
+		}
+		return r.enqueueDispatch(ctx, notifyID, delay)
+	}
+	user, err := r.userRepo.FindOneCacheByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	payload := &NotifyPayload{}
+	err = jsonutil.Unmarshal(waiting[0].Payload, payload)
+	if err != nil {
+		return err
+	}
+	for _, notifyLog := range waiting {
+		r.deliver(ctx, user, payload, notifyLog)
+	}
+	return nil
+}
+
+// deliver 投递单个渠道并更新投递日志, 渠道错误只记录在日志中, 不影响其它渠道
+func (r *NotifyRepo) deliver(ctx context.Context, user *ai_boilerplate_model.User, payload *NotifyPayload, notifyLog *ai_boilerplate_model.UserNotifyLog) {
+	var bizID string
+	var err error
+	switch constant.NotifyChannel(notifyLog.Channel) {
+	case constant.NotifyChannelInApp:
+		bizID, err = r.deliverInApp(ctx, user, payload, notifyLog)
+	case constant.NotifyChannelSms:
+		bizID, err = r.deliverSms(ctx, user, payload)
+	case constant.NotifyChannelMail:
+		bizID, err = r.deliverMail(ctx, payload)
+	case constant.NotifyChannelWxGzh:
+		bizID, err = r.deliverWxGzh(ctx, user, payload)
+	case constant.NotifyChannelPush:
+		bizID, err = r.deliverPush(ctx, user, payload)
+	default:
+		err = fmt.Errorf("unknown notify channel %s", notifyLog.Channel)
+	}
+	oldData := r.userNotifyLogRepo.DeepCopy(notifyLog)
+	notifyLog.BizID = bizID
+	notifyLog.SendTime = sql.NullTime{Time: time.Now(), Valid: true}
+	notifyLog.Status = int32(constant.UserNotifyStatusSuccess)
+	notifyLog.ErrorMessage = ""
+	if err != nil {
+		notifyLog.Status = int32(constant.UserNotifyStatusFailed)
+		notifyLog.ErrorMessage = truncateRunes(err.Error(), 1024)
+		r.log.WithContext(ctx).Warnf("notify %s to user %s by %s failed: %v", notifyLog.NotifyID, notifyLog.UserID, notifyLog.Channel, err)
+	}
+	updateErr := r.userNotifyLogRepo.UpdateOneCacheWithZero(ctx, notifyLog, oldData)
+	if updateErr != nil {
+		r.log.WithContext(ctx).Errorf("failed to update notify log %s: %v", notifyLog.ID, updateErr)
+	}
+}
+
+// deliverInApp 写入站内信, 消息类型与通知类别一致
+func (r *NotifyRepo) deliverInApp(ctx context.Context, user *ai_boilerplate_model.User, payload *NotifyPayload, notifyLog *ai_boilerplate_model.UserNotifyLog) (string, error) {
+	extend, err := jsonutil.Marshal(map[string]any{
+		"notifyId": notifyLog.NotifyID,
+		"url":      payload.URL,
+	})
+	if err != nil {
+		return "", err
+	}
+	message := r.sysNotifyMessageRepo.NewData()
+	message.Type = notifyLog.Category
+	message.Subject = truncateRunes(payload.Title, notifyContentMaxLen)
+	message.Content = truncateRunes(payload.Content, notifyContentMaxLen)
+	message.Sender = constant.SysNotifyMessageTypeSystem.String()
+	message.Receiver = user.ID
+	message.SendTime = timeutil.RFC3339(time.Now())
+	message.Extend = datatypes.JSON(extend)
+	err = r.sysNotifyMessageRepo.CreateOneCache(ctx, message)
+	if err != nil {
+		return "", err
+	}
+	return message.ID, nil
+}
+
+// deliverSms 按短信模板发送短信
+func (r *NotifyRepo) deliverSms(ctx context.Context, user *ai_boilerplate_model.User, payload *NotifyPayload) (string, error) {
+	smsLog, err := r.smsSendRepo.SendByTemplateCode(ctx, payload.SmsTemplateCode, &SmsMessage{
+		Mobile: user.Phone,
+		UserID: user.ID,
+		Params: payload.Params,
+	})
+	if err != nil {
+		return "", err
+	}
+	return smsLog.ID, nil
+}
+
+// deliverMail 按邮件模板投递邮件发送任务, 实际发送结果以邮件日志为准
+func (r *NotifyRepo) deliverMail(ctx context.Context, payload *NotifyPayload) (string, error) {
+	mailLog, err := r.mailSendRepo.SendByTemplateCode(ctx, payload.MailTemplateCode, payload.Mail, payload.Params)
+	if err != nil {
+		return "", err
+	}
+	return mailLog.ID, nil
+}
+
+// deliverWxGzh 通过默认公众号发送模板消息, 模板参数按 {"key": {"value": "..."}} 组装
+func (r *NotifyRepo) deliverWxGzh(ctx context.Context, user *ai_boilerplate_model.User, payload *NotifyPayload) (string, error) {
+	wxGzhUser, err := r.wxGzhUserRepo.FindOneCacheByID(ctx, user.WxGzhUserID)
+	if err != nil {
+		return "", err
+	}
+	if wxGzhUser == nil || wxGzhUser.Openid == "" {
+		return "", errors.New("wx gzh user not found")
+	}
+	client, err := r.wxGzhAccountRepo.GetDefaultGzhAccountClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	data := power.HashMap{}
+	for k, v := range payload.Params {
+		data[k] = power.HashMap{"value": v}
+	}
+	reply, err := client.TemplateMessage.Send(ctx, &request.RequestTemlateMessage{
+		ToUser:     wxGzhUser.Openid,
+		TemplateID: payload.WxGzhTemplateID,
+		URL:        payload.URL,
+		Data:       &data,
+	})
+	if err != nil {
+		return "", err
+	}
+	if reply.ErrCode != 0 {
+		return "", fmt.Errorf("wx template message send failed: %d %s", reply.ErrCode, reply.ErrMsg)
+	}
+	return strconv.FormatInt(reply.MsgID, 10), nil
+}
+
+// deliverPush 向用户绑定的已启用设备推送通知, 推送任务写入设备推送日志后异步发送, 返回推送日志编号
+func (r *NotifyRepo) deliverPush(ctx context.Context, user *ai_boilerplate_model.User, payload *NotifyPayload) (string, error) {
+	binds, err := r.userBindDeviceRepo.FindMultiCacheByUserID(ctx, user.ID)
+	if err != nil {
+		return "", err
+	}
+	sns := make([]string, 0, len(binds))
+	for _, v := range binds {
+		sns = append(sns, v.Sn)
+	}
+	if len(sns) == 0 {
+		return "", ErrNotifyNoDevice
+	}
+	msg, err := r.devicePushRepo.NewNotification(payload.Title, payload.Content, payload.URL)
+	if err != nil {
+		return "", err
+	}
+	pushLog, err := r.devicePushRepo.PushDevices(ctx, sns, constant.DevicePushMsgTypeNotification, msg)
+	if err != nil {
+		if errors.Is(err, ErrDevicePushNoChannel) {
+			return "", ErrNotifyNoDevice
+		}
+		return "", err
+	}
+	return pushLog.ID, nil
+}
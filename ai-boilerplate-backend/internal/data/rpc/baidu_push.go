@@ -3,7 +3,7 @@ package rpc
 import (
 	"context"
 	"crypto/md5"
-	"errors"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"sort"
@@ -14,6 +14,11 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+const (
+	baiduPushURL       = "https://api.tuisong.baidu.com/rest/3.0"
+	baiduPushUserAgent = "BCCS_SDK/3.0 (Linux) Go"
+)
+
 func NewBaiduPushHTTPRPC(
 	cfg *conf.Bootstrap,
 	logger log.Logger,
@@ -21,20 +26,22 @@ func NewBaiduPushHTTPRPC(
 ) *BaiduPushHTTPRPC {
 	l := log.NewHelper(log.With(logger, "module", "data/baiduPushHttpRpc"))
 	return &BaiduPushHTTPRPC{
-		cfg:    cfg,
-		log:    l,
-		client: client,
-		appKey: cfg.GetBusiness()["baiduPush"].GetFields()["apiKey"].GetStringValue(),
-		secret: cfg.GetBusiness()["baiduPush"].GetFields()["secretKey"].GetStringValue(),
+		cfg:     cfg,
+		log:     l,
+		client:  client,
+		baseURL: baiduPushURL,
+		appKey:  cfg.GetBusiness()["baiduPush"].GetFields()["apiKey"].GetStringValue(),
+		secret:  cfg.GetBusiness()["baiduPush"].GetFields()["secretKey"].GetStringValue(),
 	}
 }
 
 type BaiduPushHTTPRPC struct {
-	cfg    *conf.Bootstrap
-	log    *log.Helper
-	client *httputil.Client
-	appKey string
-	secret string
+	cfg     *conf.Bootstrap
+	log     *log.Helper
+	client  *httputil.Client
+	baseURL string // 百度推送接口地址
+	appKey  string
+	secret  string
 }
 
 type BaiduSingleDevicePushReq struct {
@@ -57,6 +64,21 @@ type NotifyMessage struct {
 	TargetChannelID        string `json:"target_channel_id,omitempty"`        // 可选
 }
 
+type BaiduBatchDevicePushReq struct {
+	ChannelIDs []string `json:"channel_ids"` // 设备的 channel_id 列表
+	MsgType    string   `json:"msg_type"`    // 取值如下：0：消息；1：通知。默认为0
+	Msg        string   `json:"msg"`
+	MsgExpires string   `json:"msg_expires,omitempty"` // 消息过期时间，0~604800(86400*7)，默认为5小时(18000秒)
+	TopicID    string   `json:"topic_id"`              // 分类主题名称, 用于统计
+}
+
+type BaiduTagsPushReq struct {
+	Tag        string `json:"tag"`      // 标签名
+	MsgType    string `json:"msg_type"` // 取值如下：0：消息；1：通知。默认为0
+	Msg        string `json:"msg"`
+	MsgExpires string `json:"msg_expires,omitempty"` // 消息过期时间，0~604800(86400*7)，默认为5小时(18000秒)
+}
+
 type BaiduSingleDevicePushReply struct {
 	RequestID      uint32 `json:"request_id"`
 	ResponseParams struct {
@@ -65,6 +87,30 @@ type BaiduSingleDevicePushReply struct {
 	} `json:"response_params"`
 }
 
+type BaiduBatchDevicePushReply struct {
+	RequestID      uint32 `json:"request_id"`
+	ResponseParams struct {
+		MsgID    string `json:"msg_id"`
+		SendTime int64  `json:"send_time"`
+	} `json:"response_params"`
+}
+
+type BaiduTagsPushReply struct {
+	RequestID      uint32 `json:"request_id"`
+	ResponseParams struct {
+		MsgID    string `json:"msg_id"`
+		SendTime int64  `json:"send_time"`
+		TimerID  string `json:"timer_id"`
+	} `json:"response_params"`
+}
+
+// BaiduPushErrorReply 百度推送错误响应
+type BaiduPushErrorReply struct {
+	RequestID uint32 `json:"request_id"`
+	ErrorCode int64  `json:"error_code"`
+	ErrorMsg  string `json:"error_msg"`
+}
+
 // GetBaiduPushQueryParams 获取百度推送查询参数
 // 参考百度推送API签名算法：
 // 1. 拼接 method + url
@@ -108,19 +154,89 @@ func (h *BaiduPushHTTPRPC) GetBaiduPushQueryParams(method, curl string, params m
 // PushSingleDevice 单设备推送
 func (h *BaiduPushHTTPRPC) PushSingleDevice(ctx context.Context, param *BaiduSingleDevicePushReq) (*BaiduSingleDevicePushReply, error) {
 	reply := &BaiduSingleDevicePushReply{}
-	curl := "https://api.tuisong.baidu.com/rest/3.0/push/single_device"
-	// 构建POST请求的参数（包括请求体的参数）
-	queryParams := make(map[string]string)
-	queryParams["channel_id"] = param.ChannelID
-	queryParams["msg"] = param.Msg
-	// 获取查询参数
-	queryParams = h.GetBaiduPushQueryParams("POST", curl, queryParams)
-	response, err := h.client.R().SetContext(ctx).SetHeader("Content-Type", "application/json").SetQueryParams(queryParams).SetSuccessResult(reply).Post(curl)
+	params := map[string]string{
+		"channel_id": param.ChannelID,
+		"msg":        param.Msg,
+	}
+	if param.MsgType != "" {
+		params["msg_type"] = param.MsgType
+	}
+	if param.MsgExpires != "" {
+		params["msg_expires"] = param.MsgExpires
+	}
+	err := h.post(ctx, h.baseURL+"/push/single_device", params, reply)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// PushBatchDevice 批量设备推送, 单次最多 10000 个设备
+func (h *BaiduPushHTTPRPC) PushBatchDevice(ctx context.Context, param *BaiduBatchDevicePushReq) (*BaiduBatchDevicePushReply, error) {
+	reply := &BaiduBatchDevicePushReply{}
+	channelIDs, err := json.Marshal(param.ChannelIDs)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{
+		"channel_ids": string(channelIDs),
+		"msg":         param.Msg,
+		"topic_id":    param.TopicID,
+	}
+	if param.MsgType != "" {
+		params["msg_type"] = param.MsgType
+	}
+	if param.MsgExpires != "" {
+		params["msg_expires"] = param.MsgExpires
+	}
+	err = h.post(ctx, h.baseURL+"/push/batch_device", params, reply)
 	if err != nil {
 		return nil, err
 	}
-	if response.IsSuccessState() {
-		return nil, errors.New(response.Err.Error())
+	return reply, nil
+}
+
+// PushTags 标签组推送
+func (h *BaiduPushHTTPRPC) PushTags(ctx context.Context, param *BaiduTagsPushReq) (*BaiduTagsPushReply, error) {
+	reply := &BaiduTagsPushReply{}
+	params := map[string]string{
+		"type": "1",
+		"tag":  param.Tag,
+		"msg":  param.Msg,
+	}
+	if param.MsgType != "" {
+		params["msg_type"] = param.MsgType
+	}
+	if param.MsgExpires != "" {
+		params["msg_expires"] = param.MsgExpires
+	}
+	err := h.post(ctx, h.baseURL+"/push/tags", params, reply)
+	if err != nil {
+		return nil, err
 	}
 	return reply, nil
 }
+
+// post 签名后以表单提交请求, 失败时返回百度推送的错误码与错误信息
+func (h *BaiduPushHTTPRPC) post(ctx context.Context, curl string, params map[string]string, reply any) error {
+	errReply := &BaiduPushErrorReply{}
+	params = h.GetBaiduPushQueryParams("POST", curl, params)
+	response, err := h.client.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/x-www-form-urlencoded;charset=utf-8").
+		SetHeader("User-Agent", baiduPushUserAgent).
+		SetFormData(params).
+		SetSuccessResult(reply).
+		SetErrorResult(errReply).
+		Post(curl)
+	if err != nil {
+		return err
+	}
+	if !response.IsSuccessState() {
+		if errReply.ErrorCode != 0 {
+			return fmt.Errorf("baidu push failed: %d %s", errReply.ErrorCode, errReply.ErrorMsg)
+		}
+		return fmt.Errorf("baidu push failed: %s", response.String())
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package rpc
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fzf-labs/goutil/httputil"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	testBaiduPushAPIKey    = "test-api-key"
+	testBaiduPushSecretKey = "test-secret-key"
+)
+
+// baiduPushStub 百度推送服务端, 记录最后一次请求并返回固定响应
+type baiduPushStub struct {
+	srv    *httptest.Server
+	mu     sync.Mutex
+	path   string
+	form   url.Values
+	status int    // 响应状态码
+	body   string // 响应内容
+}
+
+func newBaiduPushStub(t *testing.T, status int, body string) *baiduPushStub {
+	t.Helper()
+	s := &baiduPushStub{status: status, body: body}
+	s.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if err := req.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		s.path = req.URL.Path
+		s.form = req.PostForm
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte(s.body))
+	}))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+// rpc 请求该服务端的百度推送客户端
+func (s *baiduPushStub) rpc() *BaiduPushHTTPRPC {
+	return &BaiduPushHTTPRPC{
+		log:     log.NewHelper(log.DefaultLogger),
+		client:  httputil.NewClient(),
+		baseURL: s.srv.URL,
+		appKey:  testBaiduPushAPIKey,
+		secret:  testBaiduPushSecretKey,
+	}
+}
+
+// received 返回最后一次请求的路径与表单
+func (s *baiduPushStub) received() (string, url.Values) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.path, s.form
+}
+
+// checkSign 按百度推送签名算法校验请求签名
+func (s *baiduPushStub) checkSign(t *testing.T) {
+	t.Helper()
+	path, form := s.received()
+	keys := make([]string, 0, len(form))
+	for k := range form {
+		if k != "sign" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	gather := "POST" + s.srv.URL + path
+	for _, key := range keys {
+		gather += key + "=" + form.Get(key)
+	}
+	gather += testBaiduPushSecretKey
+	want := fmt.Sprintf("%x", md5.Sum([]byte(url.QueryEscape(gather))))
+	if got := form.Get("sign"); got != want {
+		t.Errorf("sign = %q, want %q", got, want)
+	}
+	if got := form.Get("apikey"); got != testBaiduPushAPIKey {
+		t.Errorf("apikey = %q, want %q", got, testBaiduPushAPIKey)
+	}
+	if form.Get("timestamp") == "" {
+		t.Error("timestamp is empty")
+	}
+}
+
+func TestBaiduPushHTTPRPC_PushSingleDevice(t *testing.T) {
+	stub := newBaiduPushStub(t, http.StatusOK, `{"request_id":1,"response_params":{"msg_id":123456,"send_time":1700000000}}`)
+	reply, err := stub.rpc().PushSingleDevice(context.Background(), &BaiduSingleDevicePushReq{
+		ChannelID: "channel-1",
+		MsgType:   "1",
+		Msg:       `{"title":"标题","description":"内容"}`,
+	})
+	if err != nil {
+		t.Fatalf("PushSingleDevice() error = %v", err)
+	}
+	if reply.ResponseParams.MsgID != 123456 {
+		t.Errorf("MsgID = %d, want 123456", reply.ResponseParams.MsgID)
+	}
+	path, form := stub.received()
+	if path != "/push/single_device" {
+		t.Errorf("path = %q", path)
+	}
+	if form.Get("channel_id") != "channel-1" || form.Get("msg_type") != "1" || form.Get("msg") != `{"title":"标题","description":"内容"}` {
+		t.Errorf("unexpected form: %v", form)
+	}
+	if _, ok := form["msg_expires"]; ok {
+		t.Error("msg_expires should be omitted when empty")
+	}
+	stub.checkSign(t)
+}
+
+func TestBaiduPushHTTPRPC_PushBatchDevice(t *testing.T) {
+	stub := newBaiduPushStub(t, http.StatusOK, `{"request_id":2,"response_params":{"msg_id":"batch-1","send_time":1700000000}}`)
+	reply, err := stub.rpc().PushBatchDevice(context.Background(), &BaiduBatchDevicePushReq{
+		ChannelIDs: []string{"channel-1", "channel-2"},
+		MsgType:    "0",
+		Msg:        `{"command":"locate"}`,
+		MsgExpires: "600",
+		TopicID:    "device_push",
+	})
+	if err != nil {
+		t.Fatalf("PushBatchDevice() error = %v", err)
+	}
+	if reply.ResponseParams.MsgID != "batch-1" {
+		t.Errorf("MsgID = %q, want batch-1", reply.ResponseParams.MsgID)
+	}
+	path, form := stub.received()
+	if path != "/push/batch_device" {
+		t.Errorf("path = %q", path)
+	}
+	if form.Get("channel_ids") != `["channel-1","channel-2"]` || form.Get("topic_id") != "device_push" || form.Get("msg_expires") != "600" {
+		t.Errorf("unexpected form: %v", form)
+	}
+	stub.checkSign(t)
+}
+
+func TestBaiduPushHTTPRPC_PushTags(t *testing.T) {
+	stub := newBaiduPushStub(t, http.StatusOK, `{"request_id":3,"response_params":{"msg_id":"tag-1","send_time":1700000000,"timer_id":""}}`)
+	reply, err := stub.rpc().PushTags(context.Background(), &BaiduTagsPushReq{
+		Tag:     "vip",
+		MsgType: "1",
+		Msg:     `{"title":"标题","description":"内容"}`,
+	})
+	if err != nil {
+		t.Fatalf("PushTags() error = %v", err)
+	}
+	if reply.ResponseParams.MsgID != "tag-1" {
+		t.Errorf("MsgID = %q, want tag-1", reply.ResponseParams.MsgID)
+	}
+	path, form := stub.received()
+	if path != "/push/tags" {
+		t.Errorf("path = %q", path)
+	}
+	if form.Get("type") != "1" || form.Get("tag") != "vip" {
+		t.Errorf("unexpected form: %v", form)
+	}
+	stub.checkSign(t)
+}
+
+func TestBaiduPushHTTPRPC_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{name: "error code", status: http.StatusUnauthorized, body: `{"request_id":4,"error_code":30602,"error_msg":"Request Params Not Valid"}`, wantErr: "30602 Request Params Not Valid"},
+		{name: "no error code", status: http.StatusBadGateway, body: `{"request_id":5}`, wantErr: `"request_id":5`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newBaiduPushStub(t, tt.status, tt.body)
+			_, err := stub.rpc().PushSingleDevice(context.Background(), &BaiduSingleDevicePushReq{
+				ChannelID: "channel-1",
+				Msg:       "hello",
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("PushSingleDevice() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
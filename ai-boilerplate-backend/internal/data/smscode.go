@@ -73,6 +73,7 @@ const (
 // 验证码生成相关常量
 const (
 	digitCharset = "0123456789" // 数字字符集
+	maxCheckFail = 5            // 同一验证码最多校验失败次数, 超过后验证码作废
 )
 
 // SmsCodeConfig 短信验证码配置
@@ -251,19 +252,58 @@ func (s *SmsCodeRepo) CheckSmsCode(ctx context.Context, scene SmsCodeScene, code
 	if err != nil {
 		return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
 	}
-	// 检查验证码是否匹配
-	if data.Code != inputCode {
-		return nil, pb.ErrorReasonSmsCodeInvalid(pb.WithFmtMsg("验证码错误"))
-	}
 	// 检查场景是否匹配
 	if data.Scene != scene {
 		return nil, pb.ErrorReasonSmsCodeInvalid(pb.WithFmtMsg("验证码场景不匹配"))
 	}
+	// 检查验证码是否匹配, 失败次数过多时验证码作废, 防止暴力枚举
+	if data.Code != inputCode {
+		if s.recordCheckFail(ctx, scene, codeID) >= maxCheckFail {
+			_ = s.ClearSmsCode(ctx, scene, codeID)
+		}
+		return nil, pb.ErrorReasonSmsCodeInvalid(pb.WithFmtMsg("验证码错误"))
+	}
 	return &data, nil
 }
 
-// ClearSmsCode 清除短信验证码
+// CheckSmsCodeWithPhone 验证短信验证码, 并校验验证码是否发送给该手机号
+func (s *SmsCodeRepo) CheckSmsCodeWithPhone(ctx context.Context, scene SmsCodeScene, codeID string, phone string, inputCode string) (*SmsCodeData, error) {
+	data, err := s.CheckSmsCode(ctx, scene, codeID, inputCode)
+	if err != nil {
+		return nil, err
+	}
+	if data.Phone != phone {
+		return nil, pb.ErrorReasonSmsCodeInvalid(pb.WithFmtMsg("验证码与手机号不匹配"))
+	}
+	return data, nil
+}
+
+// recordCheckFail 记录验证码校验失败次数, 返回累计失败次数
+func (s *SmsCodeRepo) recordCheckFail(ctx context.Context, scene SmsCodeScene, codeID string) int64 {
+	config := s.GetSmsConfig(scene)
+	cacheKey := constant.UserSmsCodeAttempt.Key(string(scene), codeID)
+	resps := s.data.rueidis.DoMulti(ctx,
+		s.data.rueidis.B().Incr().Key(cacheKey).Build(),
+		s.data.rueidis.B().Expire().Key(cacheKey).Seconds(int64(config.CodeTTL.Seconds())).Build(),
+	)
+	count, err := resps[0].AsInt64()
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("failed to record sms code check fail %s: %v", codeID, err)
+		return 0
+	}
+	return count
+}
+
+// ClearSmsCode 清除短信验证码及其校验失败次数
 func (s *SmsCodeRepo) ClearSmsCode(ctx context.Context, scene SmsCodeScene, codeID string) error {
-	cacheKey := constant.UserSmsCode.Key(string(scene), codeID)
-	return s.data.rueidis.Do(ctx, s.data.rueidis.B().Del().Key(cacheKey).Build()).Error()
+	resps := s.data.rueidis.DoMulti(ctx,
+		s.data.rueidis.B().Del().Key(constant.UserSmsCode.Key(string(scene), codeID)).Build(),
+		s.data.rueidis.B().Del().Key(constant.UserSmsCodeAttempt.Key(string(scene), codeID)).Build(),
+	)
+	for _, resp := range resps {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
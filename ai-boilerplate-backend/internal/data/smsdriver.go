@@ -0,0 +1,540 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // 阿里云、七牛云签名算法要求
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var smsTemplateParamRegexp = regexp.MustCompile(`\{([^}]+)\}`)
+
+// smsChannelConfig 解析渠道配置
+func smsChannelConfig(channel *ai_boilerplate_model.SmsChannel) (*pb.SmsChannelConfig, error) {
+	config := &pb.SmsChannelConfig{}
+	if channel.Config.String() != "" {
+		err := jsonutil.Unmarshal(channel.Config, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// smsTemplateParamNames 按模板内容中出现的顺序返回变量名(去重), 用于按位置传参的运营商
+func smsTemplateParamNames(templateContent string) []string {
+	matches := smsTemplateParamRegexp.FindAllStringSubmatch(templateContent, -1)
+	names := make([]string, 0, len(matches))
+	exist := make(map[string]struct{}, len(matches))
+	for _, match := range matches {
+		name := strings.TrimPrefix(match[1], "$")
+		if _, ok := exist[name]; ok {
+			continue
+		}
+		exist[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names
+}
+
+// smsOrderedParams 按模板变量顺序排列参数值
+func smsOrderedParams(template *ai_boilerplate_model.SmsTemplate, params map[string]string) ([]string, error) {
+	names := smsTemplateParamNames(template.TemplateContent)
+	values := make([]string, 0, len(names))
+	for _, name := range names {
+		v, ok := params[name]
+		if !ok {
+			return nil, fmt.Errorf("missing sms template param %s", name)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// smsDoRequest 发送请求并解析 JSON 响应, 非 2xx 且响应体无法解析时返回错误
+func smsDoRequest(client *http.Client, req *http.Request, reply any) (http.Header, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, reply); err != nil {
+		return nil, fmt.Errorf("status %d, body %s: %w", resp.StatusCode, string(body), err)
+	}
+	return resp.Header, nil
+}
+
+func smsHMACSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func smsSHA256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// smsNonce 随机字符串
+func smsNonce() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// smsSendFailed 运营商返回失败时, 带上结果以便写入发送日志
+func smsSendFailed(result *SmsSendResult) (*SmsSendResult, error) {
+	return result, fmt.Errorf("sms send failed: %s %s", result.APISendCode, result.APISendMsg)
+}
+
+// aliyunSmsDriver 阿里云短信, RPC 风格接口, HMAC-SHA1 签名
+// 模板参数以 JSON 对象传递, 变量名与模板中的 ${name} 对应
+type aliyunSmsDriver struct {
+	client *http.Client
+}
+
+func (d *aliyunSmsDriver) Send(ctx context.Context, channel *ai_boilerplate_model.SmsChannel, template *ai_boilerplate_model.SmsTemplate, mobile string, params map[string]string) (*SmsSendResult, error) {
+	config, err := smsChannelConfig(channel)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := config.GetEndpoint()
+	if endpoint == "" {
+		endpoint = "dysmsapi.aliyuncs.com"
+	}
+	region := config.GetRegion()
+	if region == "" {
+		region = "cn-hangzhou"
+	}
+	templateParam, err := jsonutil.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	query := map[string]string{
+		"AccessKeyId":      channel.APIKey,
+		"Action":           "SendSms",
+		"Format":           "JSON",
+		"PhoneNumbers":     mobile,
+		"RegionId":         region,
+		"SignName":         config.GetSignName(),
+		"SignatureMethod":  "HMAC-SHA1",
+		"SignatureNonce":   smsNonce(),
+		"SignatureVersion": "1.0",
+		"TemplateCode":     template.APITemplateID,
+		"TemplateParam":    string(templateParam),
+		"Timestamp":        time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+		"Version":          "2017-05-25",
+	}
+	// 规范化请求字符串: 参数名排序后按 RFC3986 编码拼接
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, aliyunPercentEncode(k)+"="+aliyunPercentEncode(query[k]))
+	}
+	canonicalized := strings.Join(pairs, "&")
+	stringToSign := http.MethodGet + "&" + aliyunPercentEncode("/") + "&" + aliyunPercentEncode(canonicalized)
+	h := hmac.New(sha1.New, []byte(channel.APISecret+"&"))
+	h.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	reqURL := "https://" + endpoint + "/?Signature=" + aliyunPercentEncode(signature) + "&" + canonicalized
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	var reply struct {
+		Code      string `json:"Code"`
+		Message   string `json:"Message"`
+		RequestID string `json:"RequestId"`
+		BizID     string `json:"BizId"`
+	}
+	if _, err = smsDoRequest(d.client, req, &reply); err != nil {
+		return nil, err
+	}
+	result := &SmsSendResult{
+		APISendCode:  reply.Code,
+		APISendMsg:   reply.Message,
+		APIRequestID: reply.RequestID,
+		APISerialNo:  reply.BizID,
+	}
+	if reply.Code != "OK" {
+		return smsSendFailed(result)
+	}
+	return result, nil
+}
+
+func aliyunPercentEncode(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.ReplaceAll(s, "+", "%20")
+	s = strings.ReplaceAll(s, "*", "%2A")
+	s = strings.ReplaceAll(s, "%7E", "~")
+	return s
+}
+
+// tencentSmsDriver 腾讯云短信, TC3-HMAC-SHA256 签名
+// 模板参数按模板中变量出现的顺序以数组传递
+type tencentSmsDriver struct {
+	client *http.Client
+}
+
+func (d *tencentSmsDriver) Send(ctx context.Context, channel *ai_boilerplate_model.SmsChannel, template *ai_boilerplate_model.SmsTemplate, mobile string, params map[string]string) (*SmsSendResult, error) {
+	config, err := smsChannelConfig(channel)
+	if err != nil {
+		return nil, err
+	}
+	host := config.GetEndpoint()
+	if host == "" {
+		host = "sms.tencentcloudapi.com"
+	}
+	region := config.GetRegion()
+	if region == "" {
+		region = "ap-guangzhou"
+	}
+	templateParams, err := smsOrderedParams(template, params)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := jsonutil.Marshal(map[string]any{
+		"PhoneNumberSet":   []string{"+86" + strings.TrimPrefix(mobile, "+86")},
+		"SmsSdkAppId":      config.GetSdkAppId(),
+		"SignName":         config.GetSignName(),
+		"TemplateId":       template.APITemplateID,
+		"TemplateParamSet": templateParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	const (
+		service     = "sms"
+		action      = "SendSms"
+		contentType = "application/json; charset=utf-8"
+	)
+	now := time.Now().UTC()
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	date := now.Format("2006-01-02")
+	// 规范请求
+	signedHeaders := "content-type;host;x-tc-action"
+	canonicalRequest := strings.Join([]string{
+		http.MethodPost,
+		"/",
+		"",
+		"content-type:" + contentType,
+		"host:" + host,
+		"x-tc-action:" + strings.ToLower(action),
+		"",
+		signedHeaders,
+		smsSHA256Hex(payload),
+	}, "\n")
+	// 待签字符串与签名
+	scope := date + "/" + service + "/tc3_request"
+	stringToSign := strings.Join([]string{"TC3-HMAC-SHA256", timestamp, scope, smsSHA256Hex([]byte(canonicalRequest))}, "\n")
+	signingKey := smsHMACSHA256([]byte("TC3"+channel.APISecret), date)
+	signingKey = smsHMACSHA256(signingKey, service)
+	signingKey = smsHMACSHA256(signingKey, "tc3_request")
+	signature := hex.EncodeToString(smsHMACSHA256(signingKey, stringToSign))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+host, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Host", host)
+	req.Header.Set("X-TC-Action", action)
+	req.Header.Set("X-TC-Timestamp", timestamp)
+	req.Header.Set("X-TC-Version", "2021-01-11")
+	req.Header.Set("X-TC-Region", region)
+	req.Header.Set("Authorization", fmt.Sprintf("TC3-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", channel.APIKey, scope, signedHeaders, signature))
+	var reply struct {
+		Response struct {
+			Error *struct {
+				Code    string `json:"Code"`
+				Message string `json:"Message"`
+			} `json:"Error"`
+			SendStatusSet []struct {
+				SerialNo string `json:"SerialNo"`
+				Code     string `json:"Code"`
+				Message  string `json:"Message"`
+			} `json:"SendStatusSet"`
+			RequestID string `json:"RequestId"`
+		} `json:"Response"`
+	}
+	if _, err = smsDoRequest(d.client, req, &reply); err != nil {
+		return nil, err
+	}
+	result := &SmsSendResult{
+		APIRequestID: reply.Response.RequestID,
+	}
+	if reply.Response.Error != nil {
+		result.APISendCode = reply.Response.Error.Code
+		result.APISendMsg = reply.Response.Error.Message
+		return smsSendFailed(result)
+	}
+	if len(reply.Response.SendStatusSet) == 0 {
+		result.APISendMsg = "empty send status"
+		return smsSendFailed(result)
+	}
+	status := reply.Response.SendStatusSet[0]
+	result.APISendCode = status.Code
+	result.APISendMsg = status.Message
+	result.APISerialNo = status.SerialNo
+	if status.Code != "Ok" {
+		return smsSendFailed(result)
+	}
+	return result, nil
+}
+
+// huaweiSmsDriver 华为云短信, WSSE UsernameToken 鉴权
+// 模板参数按模板中变量出现的顺序以数组传递
+type huaweiSmsDriver struct {
+	client *http.Client
+}
+
+func (d *huaweiSmsDriver) Send(ctx context.Context, channel *ai_boilerplate_model.SmsChannel, template *ai_boilerplate_model.SmsTemplate, mobile string, params map[string]string) (*SmsSendResult, error) {
+	config, err := smsChannelConfig(channel)
+	if err != nil {
+		return nil, err
+	}
+	if config.GetEndpoint() == "" || config.GetSender() == "" {
+		return nil, errors.New("huawei sms endpoint or sender is empty")
+	}
+	templateParams, err := smsOrderedParams(template, params)
+	if err != nil {
+		return nil, err
+	}
+	templateParas, err := jsonutil.Marshal(templateParams)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("from", config.GetSender())
+	form.Set("to", "+86"+strings.TrimPrefix(mobile, "+86"))
+	form.Set("templateId", template.APITemplateID)
+	form.Set("templateParas", string(templateParas))
+	if config.GetSignName() != "" {
+		form.Set("signature", config.GetSignName())
+	}
+	if channel.CallbackURL != "" {
+		form.Set("statusCallback", channel.CallbackURL)
+	}
+	endpoint := strings.TrimRight(config.GetEndpoint(), "/")
+	if !strings.HasPrefix(endpoint, "http") {
+		endpoint = "https://" + endpoint
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/sms/batchSendSms/v1", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	// PasswordDigest = Base64(SHA256(Nonce + Created + AppSecret))
+	nonce := smsNonce()
+	created := time.Now().UTC().Format("2006-01-02T15:04:05Z")
+	digest := sha256.Sum256([]byte(nonce + created + channel.APISecret))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", `WSSE realm="SDP",profile="UsernameToken",type="Appkey"`)
+	req.Header.Set("X-WSSE", fmt.Sprintf(`UsernameToken Username="%s",PasswordDigest="%s",Nonce="%s",Created="%s"`,
+		channel.APIKey, base64.StdEncoding.EncodeToString(digest[:]), nonce, created))
+	var reply struct {
+		Code        string `json:"code"`
+		Description string `json:"description"`
+		Result      []struct {
+			SmsMsgID string `json:"smsMsgId"`
+			Status   string `json:"status"`
+		} `json:"result"`
+	}
+	if _, err = smsDoRequest(d.client, req, &reply); err != nil {
+		return nil, err
+	}
+	result := &SmsSendResult{
+		APISendCode: reply.Code,
+		APISendMsg:  reply.Description,
+	}
+	if len(reply.Result) > 0 {
+		result.APISerialNo = reply.Result[0].SmsMsgID
+		result.APIRequestID = reply.Result[0].SmsMsgID
+		if reply.Result[0].Status != "000000" {
+			result.APISendCode = reply.Result[0].Status
+		}
+	}
+	if result.APISendCode != "000000" {
+		return smsSendFailed(result)
+	}
+	return result, nil
+}
+
+// qiniuSmsDriver 七牛云短信, Qiniu 管理凭证(HMAC-SHA1)鉴权
+// 模板参数以 JSON 对象传递, 变量名与模板中的 ${name} 对应
+type qiniuSmsDriver struct {
+	client *http.Client
+}
+
+func (d *qiniuSmsDriver) Send(ctx context.Context, channel *ai_boilerplate_model.SmsChannel, template *ai_boilerplate_model.SmsTemplate, mobile string, params map[string]string) (*SmsSendResult, error) {
+	config, err := smsChannelConfig(channel)
+	if err != nil {
+		return nil, err
+	}
+	host := config.GetEndpoint()
+	if host == "" {
+		host = "sms.qiniuapi.com"
+	}
+	const (
+		path        = "/v1/message/single"
+		contentType = "application/json"
+	)
+	payload, err := jsonutil.Marshal(map[string]any{
+		"template_id": template.APITemplateID,
+		"mobile":      mobile,
+		"parameters":  params,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 待签字符串: Method Path\nHost: host\nContent-Type: type\n\nbody
+	stringToSign := http.MethodPost + " " + path + "\nHost: " + host + "\nContent-Type: " + contentType + "\n\n" + string(payload)
+	h := hmac.New(sha1.New, []byte(channel.APISecret))
+	h.Write([]byte(stringToSign))
+	signature := base64.URLEncoding.EncodeToString(h.Sum(nil))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+host+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Qiniu "+channel.APIKey+":"+signature)
+	var reply struct {
+		MessageID string `json:"message_id"`
+		Error     string `json:"error"`
+		Message   string `json:"message"`
+	}
+	header, err := smsDoRequest(d.client, req, &reply)
+	if err != nil {
+		return nil, err
+	}
+	result := &SmsSendResult{
+		APISendCode:  "OK",
+		APIRequestID: header.Get("X-Reqid"),
+		APISerialNo:  reply.MessageID,
+	}
+	if reply.MessageID == "" {
+		result.APISendCode = reply.Error
+		result.APISendMsg = reply.Message
+		return smsSendFailed(result)
+	}
+	return result, nil
+}
+
+// yunpianSmsDriver 云片短信, 使用 apikey 鉴权
+// 模板参数以 #name#=value 的形式传递
+type yunpianSmsDriver struct {
+	client *http.Client
+}
+
+func (d *yunpianSmsDriver) Send(ctx context.Context, channel *ai_boilerplate_model.SmsChannel, template *ai_boilerplate_model.SmsTemplate, mobile string, params map[string]string) (*SmsSendResult, error) {
+	config, err := smsChannelConfig(channel)
+	if err != nil {
+		return nil, err
+	}
+	host := config.GetEndpoint()
+	if host == "" {
+		host = "sms.yunpian.com"
+	}
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	tplValues := make([]string, 0, len(keys))
+	for _, k := range keys {
+		tplValues = append(tplValues, url.QueryEscape("#"+k+"#")+"="+url.QueryEscape(params[k]))
+	}
+	form := url.Values{}
+	form.Set("apikey", channel.APIKey)
+	form.Set("mobile", mobile)
+	form.Set("tpl_id", template.APITemplateID)
+	form.Set("tpl_value", strings.Join(tplValues, "&"))
+	if channel.CallbackURL != "" {
+		form.Set("callback_url", channel.CallbackURL)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+host+"/v2/sms/tpl_single_send.json", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=utf-8")
+	req.Header.Set("Accept", "application/json;charset=utf-8")
+	var reply struct {
+		Code   int         `json:"code"`
+		Msg    string      `json:"msg"`
+		Detail string      `json:"detail"`
+		Sid    json.Number `json:"sid"`
+	}
+	if _, err = smsDoRequest(d.client, req, &reply); err != nil {
+		return nil, err
+	}
+	result := &SmsSendResult{
+		APISendCode: strconv.Itoa(reply.Code),
+		APISendMsg:  reply.Msg,
+		APISerialNo: reply.Sid.String(),
+	}
+	if reply.Code != 0 {
+		if reply.Detail != "" {
+			result.APISendMsg = reply.Msg + ": " + reply.Detail
+		}
+		return smsSendFailed(result)
+	}
+	return result, nil
+}
+
+// debugSmsMaskMobile 手机号脱敏, 只保留末四位
+func debugSmsMaskMobile(mobile string) string {
+	if len(mobile) <= 4 {
+		return "****"
+	}
+	return strings.Repeat("*", len(mobile)-4) + mobile[len(mobile)-4:]
+}
+
+// debugSmsDriver 调试驱动, 只打印日志不实际发送, 用于本地开发
+type debugSmsDriver struct {
+	log *log.Helper
+}
+
+func (d *debugSmsDriver) Send(ctx context.Context, channel *ai_boilerplate_model.SmsChannel, template *ai_boilerplate_model.SmsTemplate, mobile string, params map[string]string) (*SmsSendResult, error) {
+	// 参数可能包含验证码, 只打印参数名; 手机号只保留末四位
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	d.log.WithContext(ctx).Infof("[sms debug] channel=%s operator=%s template=%s apiTemplateId=%s mobile=%s paramKeys=%v",
+		channel.Name, channel.Operator, template.TemplateCode, template.APITemplateID, debugSmsMaskMobile(mobile), keys)
+	serialNo := uuid.New().String()
+	return &SmsSendResult{
+		APISendCode:  "DEBUG",
+		APISendMsg:   "debug driver, not sent",
+		APIRequestID: serialNo,
+		APISerialNo:  serialNo,
+	}, nil
+}
@@ -1,6 +1,12 @@
 package data
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_dao"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -23,3 +29,65 @@ type SmsLogRepo struct {
 	data *Data
 	*ai_boilerplate_repo.SmsLogRepo
 }
+
+// SmsChannelStatItem 渠道发送统计
+type SmsChannelStatItem struct {
+	SmsChannelID   string // 短信渠道编号
+	Total          int64  // 发送总数
+	SendFailed     int64  // 发送失败数
+	ReceiveSuccess int64  // 接收成功数
+	ReceiveFailed  int64  // 接收失败数(不含发送失败)
+	ReceivePending int64  // 等待回执数
+}
+
+// FailureRate 失败率: (发送失败 + 接收失败) / 发送总数
+func (s *SmsChannelStatItem) FailureRate() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.SendFailed+s.ReceiveFailed) / float64(s.Total)
+}
+
+// GetChannelStats 按渠道统计发送与接收结果
+func (r *SmsLogRepo) GetChannelStats(ctx context.Context, start, end time.Time) ([]*SmsChannelStatItem, error) {
+	dao := ai_boilerplate_dao.Use(r.data.gorm).SmsLog
+	var rows []struct {
+		SmsChannelID  string `gorm:"column:sms_channel_id"`
+		SendStatus    string `gorm:"column:send_status"`
+		ReceiveStatus string `gorm:"column:receive_status"`
+		Count         int64  `gorm:"column:count"`
+	}
+	query := dao.WithContext(ctx).Select(dao.SmsChannelID, dao.SendStatus, dao.ReceiveStatus, dao.ID.Count().As("count"))
+	if !start.IsZero() {
+		query = query.Where(dao.CreatedAt.Gte(start))
+	}
+	if !end.IsZero() {
+		query = query.Where(dao.CreatedAt.Lte(end))
+	}
+	err := query.Group(dao.SmsChannelID, dao.SendStatus, dao.ReceiveStatus).Scan(&rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sms channel stats: %w", err)
+	}
+	result := make([]*SmsChannelStatItem, 0)
+	items := make(map[string]*SmsChannelStatItem)
+	for _, v := range rows {
+		item, ok := items[v.SmsChannelID]
+		if !ok {
+			item = &SmsChannelStatItem{SmsChannelID: v.SmsChannelID}
+			items[v.SmsChannelID] = item
+			result = append(result, item)
+		}
+		item.Total += v.Count
+		switch {
+		case v.SendStatus == constant.SmsSendStatusFailed.String():
+			item.SendFailed += v.Count
+		case v.ReceiveStatus == constant.SmsReceiveStatusSuccess.String():
+			item.ReceiveSuccess += v.Count
+		case v.ReceiveStatus == constant.SmsReceiveStatusFailed.String():
+			item.ReceiveFailed += v.Count
+		default:
+			item.ReceivePending += v.Count
+		}
+	}
+	return result, nil
+}
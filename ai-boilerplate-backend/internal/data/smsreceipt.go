@@ -0,0 +1,371 @@
+package data
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // 仅用于校验七牛云回执的 Qiniu 凭证签名(HMAC-SHA1)
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/godb/orm/condition"
+)
+
+// ErrSmsReceiptSignInvalid 回执回调签名校验失败
+var ErrSmsReceiptSignInvalid = errors.New("sms receipt sign is invalid")
+
+// smsReceiptLocation 国内运营商回执中不带时区的时间均为北京时间
+var smsReceiptLocation = time.FixedZone("CST", 8*3600)
+
+// SmsReceipt 运营商推送的短信回执
+type SmsReceipt struct {
+	SerialNo    string    // 发送序号, 对应发送日志的 APISerialNo
+	RequestID   string    // 请求 ID, 对应发送日志的 APIRequestID
+	Mobile      string    // 手机号
+	Success     bool      // 是否接收成功
+	Code        string    // 接收结果的编码
+	Msg         string    // 接收结果的说明
+	ReceiveTime time.Time // 接收时间
+}
+
+// SmsReceiptParser 短信回执解析, 由各运营商驱动实现
+type SmsReceiptParser interface {
+	// ParseReceipt 校验并解析回执请求
+	ParseReceipt(channel *ai_boilerplate_model.SmsChannel, r *http.Request, body []byte) ([]*SmsReceipt, error)
+	// ReceiptReply 运营商要求的应答内容
+	ReceiptReply() (contentType string, body []byte)
+}
+
+// ReceiptCallbackPath 渠道的回执回调地址(相对路径), 未配置签名密钥时返回空
+// 拼接服务域名后配置到运营商后台或渠道的回调 URL
+func (r *SmsSendRepo) ReceiptCallbackPath(channel *ai_boilerplate_model.SmsChannel) string {
+	if r.receiptSecret == "" {
+		return ""
+	}
+	query := url.Values{}
+	query.Set("channelId", channel.ID)
+	query.Set("sign", r.receiptSign(channel.ID))
+	return "/sms_channel/callback?" + query.Encode()
+}
+
+// receiptSign 回调地址签名, 大部分运营商的回执不带签名, 通过回调地址中的签名校验来源
+// 使用独立的服务端密钥, 与渠道的接口凭证无关
+func (r *SmsSendRepo) receiptSign(channelID string) string {
+	h := hmac.New(sha256.New, []byte(r.receiptSecret))
+	h.Write([]byte(channelID))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// HandleReceipt 处理回执回调: 校验签名, 解析回执并更新发送日志, 返回运营商要求的应答
+func (r *SmsSendRepo) HandleReceipt(ctx context.Context, channelID, sign string, req *http.Request, body []byte) (string, []byte, error) {
+	if r.receiptSecret == "" || !hmac.Equal([]byte(sign), []byte(r.receiptSign(channelID))) {
+		return "", nil, ErrSmsReceiptSignInvalid
+	}
+	channel, err := r.smsChannelRepo.FindOneCacheByID(ctx, channelID)
+	if err != nil {
+		return "", nil, err
+	}
+	if channel == nil || channel.ID == "" {
+		return "", nil, ErrSmsChannelUnavailable
+	}
+	parser, err := r.receiptParser(channel)
+	if err != nil {
+		return "", nil, err
+	}
+	receipts, err := parser.ParseReceipt(channel, req, body)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, receipt := range receipts {
+		if err = r.updateReceipt(ctx, channel.ID, receipt); err != nil {
+			return "", nil, err
+		}
+	}
+	contentType, reply := parser.ReceiptReply()
+	return contentType, reply, nil
+}
+
+// receiptParser 选择渠道运营商对应的回执解析
+func (r *SmsSendRepo) receiptParser(channel *ai_boilerplate_model.SmsChannel) (SmsReceiptParser, error) {
+	if r.debug {
+		return &debugSmsDriver{log: r.log}, nil
+	}
+	operator, err := constant.ParseSmsChannelCode(channel.Operator)
+	if err != nil {
+		return nil, err
+	}
+	driver, ok := r.drivers[operator]
+	if !ok {
+		return nil, fmt.Errorf("sms driver for operator %s is not registered", operator)
+	}
+	parser, ok := driver.(SmsReceiptParser)
+	if !ok {
+		return nil, fmt.Errorf("sms driver for operator %s does not support receipt", operator)
+	}
+	return parser, nil
+}
+
+// updateReceipt 按发送序号(为空时按请求 ID)匹配发送日志并更新接收状态, 匹配不到的回执忽略
+func (r *SmsSendRepo) updateReceipt(ctx context.Context, channelID string, receipt *SmsReceipt) error {
+	matchField, matchValue := "api_serial_no", receipt.SerialNo
+	if matchValue == "" {
+		matchField, matchValue = "api_request_id", receipt.RequestID
+	}
+	if matchValue == "" {
+		return nil
+	}
+	list, _, err := r.smsLogRepo.FindMultiByCondition(ctx, &condition.Req{
+		Page:     1,
+		PageSize: 1,
+		Query: []*condition.QueryParam{
+			{
+				Field: "sms_channel_id",
+				Value: channelID,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+			{
+				Field: matchField,
+				Value: matchValue,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		r.log.WithContext(ctx).Warnf("sms log not found for receipt %s=%s", matchField, matchValue)
+		return nil
+	}
+	smsLog := list[0]
+	oldData := r.smsLogRepo.DeepCopy(smsLog)
+	smsLog.ReceiveStatus = constant.SmsReceiveStatusFailed.String()
+	if receipt.Success {
+		smsLog.ReceiveStatus = constant.SmsReceiveStatusSuccess.String()
+	}
+	receiveTime := receipt.ReceiveTime
+	if receiveTime.IsZero() {
+		receiveTime = time.Now()
+	}
+	smsLog.ReceiveTime = sql.NullTime{Time: receiveTime, Valid: true}
+	smsLog.APIReceiveCode = truncateRunes(receipt.Code, 64)
+	smsLog.APIReceiveMsg = truncateRunes(receipt.Msg, 255)
+	return r.smsLogRepo.UpdateOneCache(ctx, smsLog, oldData)
+}
+
+// smsParseReceiptTime 解析北京时间格式的回执时间, 解析失败返回零值
+func smsParseReceiptTime(layout, value string) time.Time {
+	t, err := time.ParseInLocation(layout, value, smsReceiptLocation)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// ParseReceipt 阿里云 HTTP 批量推送的短信状态报告(SmsReport)
+func (d *aliyunSmsDriver) ParseReceipt(_ *ai_boilerplate_model.SmsChannel, _ *http.Request, body []byte) ([]*SmsReceipt, error) {
+	var items []struct {
+		PhoneNumber string `json:"phone_number"`
+		ReportTime  string `json:"report_time"`
+		Success     bool   `json:"success"`
+		ErrCode     string `json:"err_code"`
+		ErrMsg      string `json:"err_msg"`
+		BizID       string `json:"biz_id"`
+	}
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, err
+	}
+	receipts := make([]*SmsReceipt, 0, len(items))
+	for _, v := range items {
+		receipts = append(receipts, &SmsReceipt{
+			SerialNo:    v.BizID,
+			Mobile:      v.PhoneNumber,
+			Success:     v.Success,
+			Code:        v.ErrCode,
+			Msg:         v.ErrMsg,
+			ReceiveTime: smsParseReceiptTime(time.DateTime, v.ReportTime),
+		})
+	}
+	return receipts, nil
+}
+
+func (d *aliyunSmsDriver) ReceiptReply() (string, []byte) {
+	return "application/json", []byte(`{"code":0,"msg":"成功"}`)
+}
+
+// ParseReceipt 腾讯云短信下发状态回调
+func (d *tencentSmsDriver) ParseReceipt(_ *ai_boilerplate_model.SmsChannel, _ *http.Request, body []byte) ([]*SmsReceipt, error) {
+	var items []struct {
+		UserReceiveTime string `json:"user_receive_time"`
+		Mobile          string `json:"mobile"`
+		ReportStatus    string `json:"report_status"`
+		Errmsg          string `json:"errmsg"`
+		Description     string `json:"description"`
+		Sid             string `json:"sid"`
+	}
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, err
+	}
+	receipts := make([]*SmsReceipt, 0, len(items))
+	for _, v := range items {
+		receipts = append(receipts, &SmsReceipt{
+			SerialNo:    v.Sid,
+			Mobile:      v.Mobile,
+			Success:     v.ReportStatus == "SUCCESS",
+			Code:        v.Errmsg,
+			Msg:         v.Description,
+			ReceiveTime: smsParseReceiptTime(time.DateTime, v.UserReceiveTime),
+		})
+	}
+	return receipts, nil
+}
+
+func (d *tencentSmsDriver) ReceiptReply() (string, []byte) {
+	return "application/json", []byte(`{"result":0,"errmsg":"OK"}`)
+}
+
+// ParseReceipt 华为云短信状态报告, 表单格式
+func (d *huaweiSmsDriver) ParseReceipt(_ *ai_boilerplate_model.SmsChannel, _ *http.Request, body []byte) ([]*SmsReceipt, error) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	receiveTime, _ := time.Parse("2006-01-02T15:04:05Z", form.Get("updateTime"))
+	return []*SmsReceipt{
+		{
+			SerialNo:    form.Get("smsMsgId"),
+			Mobile:      form.Get("to"),
+			Success:     form.Get("status") == "DELIVRD",
+			Code:        form.Get("status"),
+			Msg:         form.Get("orgCode"),
+			ReceiveTime: receiveTime,
+		},
+	}, nil
+}
+
+func (d *huaweiSmsDriver) ReceiptReply() (string, []byte) {
+	return "text/plain", []byte("OK")
+}
+
+// ParseReceipt 七牛云短信回调, 回调请求使用与接口请求相同的 Qiniu 凭证签名
+func (d *qiniuSmsDriver) ParseReceipt(channel *ai_boilerplate_model.SmsChannel, r *http.Request, body []byte) ([]*SmsReceipt, error) {
+	path := r.URL.Path
+	if r.URL.RawQuery != "" {
+		path += "?" + r.URL.RawQuery
+	}
+	stringToSign := r.Method + " " + path + "\nHost: " + r.Host + "\nContent-Type: " + r.Header.Get("Content-Type") + "\n\n" + string(body)
+	h := hmac.New(sha1.New, []byte(channel.APISecret))
+	h.Write([]byte(stringToSign))
+	expected := "Qiniu " + channel.APIKey + ":" + base64.URLEncoding.EncodeToString(h.Sum(nil))
+	if !hmac.Equal([]byte(r.Header.Get("Authorization")), []byte(expected)) {
+		return nil, ErrSmsReceiptSignInvalid
+	}
+	var reply struct {
+		Items []struct {
+			MessageID  string `json:"message_id"`
+			Mobile     string `json:"mobile"`
+			Status     string `json:"status"`
+			Error      string `json:"error"`
+			DelivrdAt  int64  `json:"delivrd_at"`
+			StatusCode string `json:"status_code"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return nil, err
+	}
+	receipts := make([]*SmsReceipt, 0, len(reply.Items))
+	for _, v := range reply.Items {
+		receipt := &SmsReceipt{
+			SerialNo: v.MessageID,
+			Mobile:   v.Mobile,
+			Success:  v.Status == "success",
+			Code:     v.Status,
+			Msg:      v.Error,
+		}
+		if v.StatusCode != "" {
+			receipt.Code = v.StatusCode
+		}
+		if v.DelivrdAt > 0 {
+			receipt.ReceiveTime = time.Unix(v.DelivrdAt, 0)
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+func (d *qiniuSmsDriver) ReceiptReply() (string, []byte) {
+	return "application/json", []byte(`{}`)
+}
+
+// ParseReceipt 云片短信状态报告推送, 表单字段 sms_status 为 JSON 数组
+func (d *yunpianSmsDriver) ParseReceipt(_ *ai_boilerplate_model.SmsChannel, _ *http.Request, body []byte) ([]*SmsReceipt, error) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	var items []struct {
+		Sid             json.Number `json:"sid"`
+		UserReceiveTime string      `json:"user_receive_time"`
+		ErrorMsg        string      `json:"error_msg"`
+		ErrorDetail     string      `json:"error_detail"`
+		Mobile          string      `json:"mobile"`
+		ReportStatus    string      `json:"report_status"`
+	}
+	if err = json.Unmarshal([]byte(form.Get("sms_status")), &items); err != nil {
+		return nil, err
+	}
+	receipts := make([]*SmsReceipt, 0, len(items))
+	for _, v := range items {
+		receipts = append(receipts, &SmsReceipt{
+			SerialNo:    v.Sid.String(),
+			Mobile:      v.Mobile,
+			Success:     v.ReportStatus == "SUCCESS",
+			Code:        v.ErrorMsg,
+			Msg:         v.ErrorDetail,
+			ReceiveTime: smsParseReceiptTime(time.DateTime, v.UserReceiveTime),
+		})
+	}
+	return receipts, nil
+}
+
+func (d *yunpianSmsDriver) ReceiptReply() (string, []byte) {
+	return "text/plain", []byte("SUCCESS")
+}
+
+// ParseReceipt 调试回执, 用于本地模拟运营商推送: [{"serialNo":"","success":true,"code":"","msg":""}]
+func (d *debugSmsDriver) ParseReceipt(_ *ai_boilerplate_model.SmsChannel, _ *http.Request, body []byte) ([]*SmsReceipt, error) {
+	var items []struct {
+		SerialNo string `json:"serialNo"`
+		Success  bool   `json:"success"`
+		Code     string `json:"code"`
+		Msg      string `json:"msg"`
+	}
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, err
+	}
+	receipts := make([]*SmsReceipt, 0, len(items))
+	for _, v := range items {
+		receipts = append(receipts, &SmsReceipt{
+			SerialNo: v.SerialNo,
+			Success:  v.Success,
+			Code:     v.Code,
+			Msg:      v.Msg,
+		})
+	}
+	return receipts, nil
+}
+
+func (d *debugSmsDriver) ReceiptReply() (string, []byte) {
+	return "application/json", []byte(`{"code":0,"msg":"ok"}`)
+}
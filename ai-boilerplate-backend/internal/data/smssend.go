@@ -0,0 +1,438 @@
+package data
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_dao"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrSmsTemplateNotFound 模板编码没有启用的短信模板
+	ErrSmsTemplateNotFound = errors.New("sms template not found")
+	// ErrSmsChannelUnavailable 短信渠道不存在或已禁用
+	ErrSmsChannelUnavailable = errors.New("sms channel is unavailable")
+)
+
+// SmsSendResult 短信供应商返回的发送结果
+type SmsSendResult struct {
+	APISendCode  string // 发送结果的编码
+	APISendMsg   string // 发送失败的提示
+	APIRequestID string // 唯一请求 ID
+	APISerialNo  string // 发送序号
+}
+
+// SmsDriver 短信渠道驱动, 按渠道的运营商选择
+type SmsDriver interface {
+	Send(ctx context.Context, channel *ai_boilerplate_model.SmsChannel, template *ai_boilerplate_model.SmsTemplate, mobile string, params map[string]string) (*SmsSendResult, error)
+}
+
+// SmsMessage 待发送的短信
+type SmsMessage struct {
+	Mobile       string            // 手机号
+	UserID       string            // 用户编号(可选)
+	Params       map[string]string // 模板参数
+	MaskedParams []string          // 写入发送日志时需要脱敏的参数, 如验证码
+}
+
+func NewSmsSendRepo(
+	logger log.Logger,
+	data *Data,
+	smsChannelRepo *ai_boilerplate_repo.SmsChannelRepo,
+	smsTemplateRepo *ai_boilerplate_repo.SmsTemplateRepo,
+	smsLogRepo *ai_boilerplate_repo.SmsLogRepo,
+) *SmsSendRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/smsSend"))
+	client := &http.Client{Timeout: 10 * time.Second}
+	breakerCfg := data.cfg.GetBusiness()["sms"].GetFields()["breaker"].GetStructValue().GetFields()
+	breaker := &smsBreakerConfig{
+		window:      time.Duration(breakerCfg["window"].GetNumberValue()) * time.Second,
+		cooldown:    time.Duration(breakerCfg["cooldown"].GetNumberValue()) * time.Second,
+		minSamples:  int64(breakerCfg["minSamples"].GetNumberValue()),
+		failureRate: breakerCfg["failureRate"].GetNumberValue(),
+	}
+	if breaker.window <= 0 {
+		breaker.window = 10 * time.Minute
+	}
+	if breaker.cooldown <= 0 {
+		breaker.cooldown = constant.SmsChannelBreakerOpen.TTL()
+	}
+	if breaker.minSamples <= 0 {
+		breaker.minSamples = 10
+	}
+	if breaker.failureRate <= 0 {
+		breaker.failureRate = 0.5
+	}
+	receiptCfg := data.cfg.GetBusiness()["sms"].GetFields()["receipt"].GetStructValue().GetFields()
+	return &SmsSendRepo{
+		log:             l,
+		data:            data,
+		smsChannelRepo:  smsChannelRepo,
+		smsTemplateRepo: smsTemplateRepo,
+		smsLogRepo:      smsLogRepo,
+		drivers: map[constant.SmsChannelCode]SmsDriver{
+			constant.SmsChannelCodeALIYUN:  &aliyunSmsDriver{client: client},
+			constant.SmsChannelCodeTENCENT: &tencentSmsDriver{client: client},
+			constant.SmsChannelCodeHUAWEI:  &huaweiSmsDriver{client: client},
+			constant.SmsChannelCodeQINIU:   &qiniuSmsDriver{client: client},
+			constant.SmsChannelCodeYUNPIAN: &yunpianSmsDriver{client: client},
+		},
+		debugDriver:   &debugSmsDriver{log: l},
+		debug:         data.cfg.GetBusiness()["sms"].GetFields()["debug"].GetBoolValue(),
+		breaker:       breaker,
+		receiptSecret: receiptCfg["secret"].GetStringValue(),
+	}
+}
+
+// SmsSendRepo 短信发送: 选择模板与渠道, 通过渠道驱动发送并写入发送日志
+type SmsSendRepo struct {
+	log             *log.Helper
+	data            *Data
+	smsChannelRepo  *ai_boilerplate_repo.SmsChannelRepo
+	smsTemplateRepo *ai_boilerplate_repo.SmsTemplateRepo
+	smsLogRepo      *ai_boilerplate_repo.SmsLogRepo
+	drivers         map[constant.SmsChannelCode]SmsDriver // 运营商 -> 驱动
+	debugDriver     SmsDriver                             // 调试驱动
+	debug           bool                                  // 调试模式下所有渠道都使用调试驱动, 不实际发送
+	breaker         *smsBreakerConfig                     // 渠道熔断配置
+	receiptSecret   string                                // 回执回调地址签名密钥, 为空时不接收回执回调
+}
+
+// smsBreakerConfig 渠道熔断配置: 统计窗口内发送失败率达到阈值时熔断, 冷却后恢复
+type smsBreakerConfig struct {
+	window      time.Duration // 统计窗口
+	cooldown    time.Duration // 熔断时长
+	minSamples  int64         // 最少样本数, 样本不足时不熔断
+	failureRate float64       // 熔断的发送失败率阈值
+}
+
+// FindEnableTemplatesByCode 根据模板编码查询启用的短信模板, 同一模板编码可以对应多个渠道
+func (r *SmsSendRepo) FindEnableTemplatesByCode(ctx context.Context, templateCode string) ([]*ai_boilerplate_model.SmsTemplate, error) {
+	param := &condition.Req{
+		Page:     1,
+		PageSize: 100,
+		Query: []*condition.QueryParam{
+			{
+				Field: "template_code",
+				Value: templateCode,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+			{
+				Field: "status",
+				Value: int32(constant.StatusEnable),
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+		},
+		Order: []*condition.OrderParam{
+			{
+				Field: "priority",
+				Order: condition.ASC,
+			},
+			{
+				Field: "created_at",
+				Order: condition.ASC,
+			},
+		},
+	}
+	list, _, err := r.smsTemplateRepo.FindMultiCacheByCondition(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, ErrSmsTemplateNotFound
+	}
+	return list, nil
+}
+
+// SendByTemplateCode 按模板编码发送短信, 失败时自动切换到下一个渠道
+func (r *SmsSendRepo) SendByTemplateCode(ctx context.Context, templateCode string, msg *SmsMessage) (*ai_boilerplate_model.SmsLog, error) {
+	return r.sendWithFailover(ctx, templateCode, func(*ai_boilerplate_model.SmsTemplate) *SmsMessage {
+		return msg
+	})
+}
+
+// SendCode 按模板编码发送验证码短信, 验证码填入模板中的 code 参数(模板只有一个参数时填入该参数)
+func (r *SmsSendRepo) SendCode(ctx context.Context, templateCode string, mobile string, userID string, code string) (*ai_boilerplate_model.SmsLog, error) {
+	return r.sendWithFailover(ctx, templateCode, func(template *ai_boilerplate_model.SmsTemplate) *SmsMessage {
+		// 不同渠道的模板变量名可能不同, 按模板分别取参数名
+		paramKey := smsCodeParamKey(template)
+		return &SmsMessage{
+			Mobile:       mobile,
+			UserID:       userID,
+			Params:       map[string]string{paramKey: code},
+			MaskedParams: []string{paramKey},
+		}
+	})
+}
+
+// sendWithFailover 按路由顺序依次尝试模板编码下的各个渠道, 直到发送成功
+// 熔断中的渠道排在最后, 全部熔断时仍会尝试, 避免短信完全不可用
+func (r *SmsSendRepo) sendWithFailover(ctx context.Context, templateCode string, buildMsg func(template *ai_boilerplate_model.SmsTemplate) *SmsMessage) (*ai_boilerplate_model.SmsLog, error) {
+	templates, err := r.FindEnableTemplatesByCode(ctx, templateCode)
+	if err != nil {
+		return nil, err
+	}
+	available := make([]*ai_boilerplate_model.SmsTemplate, 0, len(templates))
+	broken := make([]*ai_boilerplate_model.SmsTemplate, 0)
+	for _, template := range routeSmsTemplates(templates) {
+		if r.ChannelBreakerOpen(ctx, template.SmsChannelID) {
+			broken = append(broken, template)
+			continue
+		}
+		available = append(available, template)
+	}
+	var smsLog *ai_boilerplate_model.SmsLog
+	for _, template := range append(available, broken...) {
+		smsLog, err = r.Send(ctx, template, buildMsg(template))
+		if err == nil {
+			return smsLog, nil
+		}
+		r.log.WithContext(ctx).Warnf("failed to send sms %s by channel %s, try next channel: %v", templateCode, template.SmsChannelID, err)
+	}
+	return smsLog, err
+}
+
+// routeSmsTemplates 路由排序: 按优先级升序, 同优先级按权重加权随机, 权重为 0 的仅作备用排在最后
+func routeSmsTemplates(templates []*ai_boilerplate_model.SmsTemplate) []*ai_boilerplate_model.SmsTemplate {
+	sorted := slices.Clone(templates)
+	slices.SortStableFunc(sorted, func(a, b *ai_boilerplate_model.SmsTemplate) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+	result := make([]*ai_boilerplate_model.SmsTemplate, 0, len(sorted))
+	for i := 0; i < len(sorted); {
+		j := i
+		for j < len(sorted) && sorted[j].Priority == sorted[i].Priority {
+			j++
+		}
+		result = append(result, shuffleSmsTemplatesByWeight(sorted[i:j])...)
+		i = j
+	}
+	return result
+}
+
+// shuffleSmsTemplatesByWeight 加权随机排序
+func shuffleSmsTemplatesByWeight(group []*ai_boilerplate_model.SmsTemplate) []*ai_boilerplate_model.SmsTemplate {
+	weighted := make([]*ai_boilerplate_model.SmsTemplate, 0, len(group))
+	backup := make([]*ai_boilerplate_model.SmsTemplate, 0)
+	var total int64
+	for _, v := range group {
+		if v.Weight > 0 {
+			weighted = append(weighted, v)
+			total += int64(v.Weight)
+			continue
+		}
+		backup = append(backup, v)
+	}
+	result := make([]*ai_boilerplate_model.SmsTemplate, 0, len(group))
+	for len(weighted) > 0 {
+		n := rand.Int64N(total)
+		for k, v := range weighted {
+			n -= int64(v.Weight)
+			if n < 0 {
+				result = append(result, v)
+				total -= int64(v.Weight)
+				weighted = slices.Delete(weighted, k, k+1)
+				break
+			}
+		}
+	}
+	return append(result, backup...)
+}
+
+// ChannelBreakerState 读取渠道当前的熔断状态, 只查询熔断标记不统计发送日志, 无副作用, 用于统计展示
+func (r *SmsSendRepo) ChannelBreakerState(ctx context.Context, channelID string) (bool, error) {
+	open, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Exists().Key(constant.SmsChannelBreakerOpen.Key(channelID)).Build()).AsInt64()
+	if err != nil {
+		return false, err
+	}
+	return open > 0, nil
+}
+
+// ChannelBreakerOpen 渠道是否处于熔断中: 统计窗口内(不含上次熔断前)发送失败率达到阈值时熔断
+// 检查结果缓存一小段时间, 避免每次发送都查询发送日志
+func (r *SmsSendRepo) ChannelBreakerOpen(ctx context.Context, channelID string) bool {
+	resps := r.data.rueidis.DoMulti(ctx,
+		r.data.rueidis.B().Exists().Key(constant.SmsChannelBreakerOpen.Key(channelID)).Build(),
+		r.data.rueidis.B().Exists().Key(constant.SmsChannelBreakerChecked.Key(channelID)).Build(),
+		r.data.rueidis.B().Get().Key(constant.SmsChannelBreakerResume.Key(channelID)).Build(),
+	)
+	if open, err := resps[0].AsInt64(); err == nil && open > 0 {
+		return true
+	}
+	if checked, err := resps[1].AsInt64(); err == nil && checked > 0 {
+		return false
+	}
+	// 熔断恢复后只统计恢复之后的发送结果, 避免旧的失败记录再次触发熔断
+	since := time.Now().Add(-r.breaker.window)
+	if resume, err := resps[2].AsInt64(); err == nil && time.Unix(resume, 0).After(since) {
+		since = time.Unix(resume, 0)
+	}
+	total, failed, err := r.countChannelSend(ctx, channelID, since)
+	if err != nil {
+		// 统计失败时不熔断
+		r.log.WithContext(ctx).Errorf("failed to count sms channel send %s: %v", channelID, err)
+		return false
+	}
+	if total >= r.breaker.minSamples && float64(failed)/float64(total) >= r.breaker.failureRate {
+		resume := time.Now().Add(r.breaker.cooldown)
+		for _, resp := range r.data.rueidis.DoMulti(ctx,
+			r.data.rueidis.B().Set().Key(constant.SmsChannelBreakerOpen.Key(channelID)).Value(strconv.FormatInt(time.Now().Unix(), 10)).Ex(r.breaker.cooldown).Build(),
+			r.data.rueidis.B().Set().Key(constant.SmsChannelBreakerResume.Key(channelID)).Value(strconv.FormatInt(resume.Unix(), 10)).Ex(r.breaker.window+r.breaker.cooldown).Build(),
+		) {
+			if err = resp.Error(); err != nil {
+				r.log.WithContext(ctx).Errorf("failed to open sms channel breaker %s: %v", channelID, err)
+			}
+		}
+		r.log.WithContext(ctx).Warnf("sms channel %s breaker open, failed %d of %d since %s", channelID, failed, total, since.Format(time.RFC3339))
+		return true
+	}
+	err = r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(constant.SmsChannelBreakerChecked.Key(channelID)).Value("1").Ex(constant.SmsChannelBreakerChecked.TTL()).Build()).Error()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to set sms channel breaker checked %s: %v", channelID, err)
+	}
+	return false
+}
+
+// countChannelSend 统计渠道自 since 起的发送总数与发送失败数
+func (r *SmsSendRepo) countChannelSend(ctx context.Context, channelID string, since time.Time) (total, failed int64, err error) {
+	dao := ai_boilerplate_dao.Use(r.data.gorm).SmsLog
+	total, err = dao.WithContext(ctx).Where(dao.SmsChannelID.Eq(channelID), dao.CreatedAt.Gte(since)).Count()
+	if err != nil {
+		return 0, 0, err
+	}
+	if total == 0 {
+		return 0, 0, nil
+	}
+	failed, err = dao.WithContext(ctx).Where(dao.SmsChannelID.Eq(channelID), dao.CreatedAt.Gte(since), dao.SendStatus.Eq(constant.SmsSendStatusFailed.String())).Count()
+	if err != nil {
+		return 0, 0, err
+	}
+	return total, failed, nil
+}
+
+// Send 通过模板所属渠道发送短信, 无论成功与否都会写入发送日志
+func (r *SmsSendRepo) Send(ctx context.Context, template *ai_boilerplate_model.SmsTemplate, msg *SmsMessage) (*ai_boilerplate_model.SmsLog, error) {
+	channel, err := r.smsChannelRepo.FindOneCacheByID(ctx, template.SmsChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if channel == nil || channel.ID == "" || channel.Status != int16(constant.StatusEnable) {
+		return nil, ErrSmsChannelUnavailable
+	}
+	result, sendErr := r.sendByDriver(ctx, channel, template, msg)
+	if sendErr != nil {
+		// 发送失败后下一次发送重新检查渠道失败率
+		if err = r.data.rueidis.Do(ctx, r.data.rueidis.B().Del().Key(constant.SmsChannelBreakerChecked.Key(channel.ID)).Build()).Error(); err != nil {
+			r.log.WithContext(ctx).Errorf("failed to reset sms channel breaker checked %s: %v", channel.ID, err)
+		}
+	}
+	smsLog, err := r.createLog(ctx, channel, template, msg, result, sendErr)
+	if err != nil {
+		// 日志写入失败不影响发送结果
+		r.log.WithContext(ctx).Errorf("failed to create sms log %s %s: %v", template.ID, msg.Mobile, err)
+	}
+	if sendErr != nil {
+		return smsLog, sendErr
+	}
+	return smsLog, nil
+}
+
+// sendByDriver 选择渠道运营商对应的驱动发送
+func (r *SmsSendRepo) sendByDriver(ctx context.Context, channel *ai_boilerplate_model.SmsChannel, template *ai_boilerplate_model.SmsTemplate, msg *SmsMessage) (*SmsSendResult, error) {
+	if r.debug {
+		return r.debugDriver.Send(ctx, channel, template, msg.Mobile, msg.Params)
+	}
+	operator, err := constant.ParseSmsChannelCode(channel.Operator)
+	if err != nil {
+		return nil, err
+	}
+	driver, ok := r.drivers[operator]
+	if !ok {
+		return nil, fmt.Errorf("sms driver for operator %s is not registered", operator)
+	}
+	return driver.Send(ctx, channel, template, msg.Mobile, msg.Params)
+}
+
+// createLog 写入短信发送日志
+func (r *SmsSendRepo) createLog(ctx context.Context, channel *ai_boilerplate_model.SmsChannel, template *ai_boilerplate_model.SmsTemplate, msg *SmsMessage, result *SmsSendResult, sendErr error) (*ai_boilerplate_model.SmsLog, error) {
+	params := make(map[string]string, len(msg.Params))
+	for k, v := range msg.Params {
+		params[k] = v
+	}
+	for _, k := range msg.MaskedParams {
+		if _, ok := params[k]; ok {
+			params[k] = "******"
+		}
+	}
+	paramsContent, err := jsonutil.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	smsLog := r.smsLogRepo.NewData()
+	smsLog.SmsChannelID = channel.ID
+	smsLog.SmsTemplateID = template.ID
+	smsLog.SmsParamsContent = string(paramsContent)
+	smsLog.Mobile = msg.Mobile
+	smsLog.UserID = msg.UserID
+	smsLog.SendStatus = constant.SmsSendStatusSuccess.String()
+	smsLog.SendTime = time.Now()
+	smsLog.ReceiveStatus = constant.SmsReceiveStatusPending.String()
+	if result != nil {
+		smsLog.APISendCode = result.APISendCode
+		smsLog.APISendMsg = result.APISendMsg
+		smsLog.APIRequestID = result.APIRequestID
+		smsLog.APISerialNo = result.APISerialNo
+	}
+	if sendErr != nil {
+		smsLog.SendStatus = constant.SmsSendStatusFailed.String()
+		smsLog.ReceiveStatus = constant.SmsReceiveStatusFailed.String()
+		if smsLog.APISendMsg == "" {
+			smsLog.APISendMsg = sendErr.Error()
+		}
+		smsLog.APISendMsg = truncateRunes(smsLog.APISendMsg, 255)
+	}
+	err = r.smsLogRepo.CreateOneCache(ctx, smsLog)
+	if err != nil {
+		return nil, err
+	}
+	return smsLog, nil
+}
+
+// smsCodeParamKey 验证码在模板中对应的参数名
+func smsCodeParamKey(template *ai_boilerplate_model.SmsTemplate) string {
+	templateParams := make(map[string]string)
+	if len(template.TemplateParams) > 0 {
+		_ = jsonutil.Unmarshal(template.TemplateParams, &templateParams)
+	}
+	if _, ok := templateParams["code"]; ok || len(templateParams) != 1 {
+		return "code"
+	}
+	for k := range templateParams {
+		return k
+	}
+	return "code"
+}
+
+// truncateRunes 按字符截断字符串, 适配数据库字段长度
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
@@ -2,10 +2,14 @@ package data
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
 	"github.com/fzf-labs/gopkg/jwt"
+	"github.com/fzf-labs/goutil/uuidutil"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/samber/lo"
 )
@@ -16,35 +20,119 @@ func NewSysAdminRepo(
 	sysAdminRepo *ai_boilerplate_repo.SysAdminRepo,
 ) *SysAdminRepo {
 	l := log.NewHelper(log.With(logger, "module", "data/sysAdmin"))
+	cfg := data.cfg.GetBusiness()["jwt"].GetFields()["admin"].GetStructValue().GetFields()
 	jwt := jwt.NewJwt(&jwt.Config{
-		AccessSecret: data.cfg.GetBusiness()["jwt"].GetFields()["admin"].GetStructValue().GetFields()["accessSecret"].GetStringValue(),
-		AccessExpire: int64(data.cfg.GetBusiness()["jwt"].GetFields()["admin"].GetStructValue().GetFields()["accessExpire"].GetNumberValue()),
-		RefreshAfter: int64(data.cfg.GetBusiness()["jwt"].GetFields()["admin"].GetStructValue().GetFields()["refreshAfter"].GetNumberValue()),
-		Issuer:       data.cfg.GetBusiness()["jwt"].GetFields()["admin"].GetStructValue().GetFields()["issuer"].GetStringValue(),
+		AccessSecret: cfg["accessSecret"].GetStringValue(),
+		AccessExpire: int64(cfg["accessExpire"].GetNumberValue()),
+		RefreshAfter: int64(cfg["refreshAfter"].GetNumberValue()),
+		Issuer:       cfg["issuer"].GetStringValue(),
 	}, jwt.NewRueidisCache(data.rueidis))
+	accessTTL := time.Duration(cfg["accessExpire"].GetNumberValue()) * time.Second
+	if accessTTL <= 0 {
+		accessTTL = constant.SysAdminTokenBlacklist.TTL()
+	}
+	refreshTTL := time.Duration(cfg["refreshExpire"].GetNumberValue()) * time.Second
+	if refreshTTL <= 0 {
+		refreshTTL = constant.SysAdminRefreshToken.TTL()
+	}
 	return &SysAdminRepo{
 		log:          l,
 		data:         data,
 		SysAdminRepo: sysAdminRepo,
 		jwt:          jwt,
+		sessionStore: &tokenSessionStore{
+			data:       data,
+			accessTTL:  accessTTL,
+			refreshTTL: refreshTTL,
+			sessionKey: func(ownerID string) string {
+				return constant.SysAdminSession.Key(ownerID)
+			},
+			refreshKey: func(sessionID string) string {
+				return constant.SysAdminRefreshToken.Key(sessionID)
+			},
+			blacklistKey: func(sessionID string) string {
+				return constant.SysAdminTokenBlacklist.Key(sessionID)
+			},
+		},
 	}
 }
 
 type SysAdminRepo struct {
-	log  *log.Helper
-	jwt  *jwt.Jwt
-	data *Data
+	log          *log.Helper
+	jwt          *jwt.Jwt
+	data         *Data
+	sessionStore *tokenSessionStore
 	*ai_boilerplate_repo.SysAdminRepo
 }
 
-// GenerateToken 生成token
-func (r *SysAdminRepo) GenerateToken(ctx context.Context, sysAdmin *ai_boilerplate_model.SysAdmin) (*jwt.Token, error) {
-	_ = ctx
+// GenerateToken 生成访问 token 与刷新 token, 并保存登录会话
+func (r *SysAdminRepo) GenerateToken(ctx context.Context, sysAdmin *ai_boilerplate_model.SysAdmin, session *TokenSession) (*TokenPair, error) {
+	sessionID := uuidutil.KSUIDByTime()
+	token, err := r.generateAccessToken(sysAdmin, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	session.ID = sessionID
+	session.LoginAt = now.Unix()
+	session.LastSeenAt = now.Unix()
+	session.ExpiredAt = now.Add(r.sessionStore.refreshTTL).Unix()
+	refreshToken, err := r.sessionStore.create(ctx, sysAdmin.ID, session)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:      token.Token,
+		ExpiredAt:        token.ExpiredAt,
+		RefreshAt:        token.RefreshAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiredAt: session.ExpiredAt,
+	}, nil
+}
 
+// RefreshToken 使用刷新 token 换取新的访问 token 与刷新 token, 旧的刷新 token 随即失效
+func (r *SysAdminRepo) RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	adminID, sessionID, newRefreshToken, err := r.sessionStore.rotate(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	sysAdmin, err := r.FindOneCacheByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	// 管理员已删除或被禁用时注销会话
+	if sysAdmin == nil || sysAdmin.ID == "" || sysAdmin.Status != int16(constant.StatusEnable) {
+		err = r.sessionStore.revoke(ctx, adminID, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenInvalid
+	}
+	token, err := r.generateAccessToken(sysAdmin, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	err = r.sessionStore.renew(ctx, adminID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:      token.Token,
+		ExpiredAt:        token.ExpiredAt,
+		RefreshAt:        token.RefreshAt,
+		RefreshToken:     newRefreshToken,
+		RefreshExpiredAt: time.Now().Add(r.sessionStore.refreshTTL).Unix(),
+	}, nil
+}
+
+// generateAccessToken 生成访问 token, sid 为登录会话编号, 同一会话内刷新的 token 共用
+func (r *SysAdminRepo) generateAccessToken(sysAdmin *ai_boilerplate_model.SysAdmin, sessionID string) (*jwt.Token, error) {
 	token, _, err := r.jwt.GenerateToken(map[string]any{
 		"uid":       sysAdmin.ID,
 		"nickname":  sysAdmin.Nickname,
 		"tenant_id": sysAdmin.TenantID,
+		"jti":       uuidutil.KSUIDByTime(),
+		"sid":       sessionID,
 	})
 	if err != nil {
 		return nil, err
@@ -52,17 +140,47 @@ func (r *SysAdminRepo) GenerateToken(ctx context.Context, sysAdmin *ai_boilerpla
 	return token, nil
 }
 
-// CheckToken 检查token
+// CheckToken 检查token, 已注销或被强制下线的会话内的 token 视为无效
 func (r *SysAdminRepo) CheckToken(ctx context.Context, token string) (map[string]any, error) {
-	_ = ctx
-
 	claims, err := r.jwt.ParseToken(token)
 	if err != nil {
 		return nil, err
 	}
+	sessionID, _ := claims["sid"].(string)
+	if sessionID == "" {
+		return nil, errors.New("token sid is empty")
+	}
+	revoked, err := r.sessionStore.revoked(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, errors.New("token is revoked")
+	}
+	if uid, ok := claims["uid"].(string); ok && uid != "" {
+		// 活跃时间仅用于会话展示, 刷新失败不影响鉴权
+		if touchErr := r.sessionStore.touch(ctx, uid, sessionID); touchErr != nil {
+			r.log.WithContext(ctx).Errorf("failed to touch admin session %s: %v", sessionID, touchErr)
+		}
+	}
 	return claims, nil
 }
 
+// GetSessionList 获取管理员的有效登录会话
+func (r *SysAdminRepo) GetSessionList(ctx context.Context, adminID string) ([]*TokenSession, error) {
+	return r.sessionStore.list(ctx, adminID)
+}
+
+// RevokeSession 注销管理员的登录会话, 会话内的访问 token 与刷新 token 全部失效
+func (r *SysAdminRepo) RevokeSession(ctx context.Context, adminID, sessionID string) error {
+	return r.sessionStore.revoke(ctx, adminID, sessionID)
+}
+
+// RevokeAllSessions 注销管理员的全部登录会话, 管理员被禁用或删除时使用
+func (r *SysAdminRepo) RevokeAllSessions(ctx context.Context, adminID string) error {
+	return r.sessionStore.revokeAll(ctx, adminID)
+}
+
 // AdminIdToNickname 根据adminId获取adminName
 func (r *SysAdminRepo) AdminIDToNickname(ctx context.Context, adminIDs []string) (map[string]string, error) {
 	adminIDs = lo.Filter(adminIDs, func(item string, _ int) bool {
@@ -1,8 +1,15 @@
 package data
 
 import (
+	"context"
+	"strings"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_dao"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/goutil/jsonutil"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/rueidis"
 )
 
 func NewSysAPIRepo(
@@ -23,3 +30,55 @@ type SysAPIRepo struct {
 	data *Data
 	*ai_boilerplate_repo.SysAPIRepo
 }
+
+// GetPermissionID 根据请求方法与路径获取接口所需的权限编号, 未配置的接口返回空字符串
+// 路径既可以是 HTTP 路径(/admin/v1/sys_role/create), 也可以是 protobuf operation(/admin.v1.SysRole/CreateSysRole)
+func (r *SysAPIRepo) GetPermissionID(ctx context.Context, method, path string) (string, error) {
+	permissions, err := r.getPermissionMap(ctx)
+	if err != nil {
+		return "", err
+	}
+	return permissions[sysAPIPermissionField(method, path)], nil
+}
+
+// DelPermissionCache 删除接口与权限编号映射缓存, 接口变更后调用
+func (r *SysAPIRepo) DelPermissionCache(ctx context.Context) error {
+	return r.data.rueidis.Do(ctx, r.data.rueidis.B().Del().Key(constant.SysAPIPermission.Key()).Build()).Error()
+}
+
+// getPermissionMap 获取全部接口与权限编号的映射, 缓存不存在时从数据库加载
+func (r *SysAPIRepo) getPermissionMap(ctx context.Context) (map[string]string, error) {
+	cacheKey := constant.SysAPIPermission.Key()
+	permissions := make(map[string]string)
+	cacheValue, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Get().Key(cacheKey).Build()).ToString()
+	if err == nil {
+		err = jsonutil.Unmarshal([]byte(cacheValue), &permissions)
+		if err == nil {
+			return permissions, nil
+		}
+	} else if !rueidis.IsRedisNil(err) {
+		return nil, err
+	}
+	dao := ai_boilerplate_dao.Use(r.data.gorm).SysAPI
+	list, err := dao.WithContext(ctx).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range list {
+		permissions[sysAPIPermissionField(v.Method, v.Path)] = v.PermissionID
+	}
+	value, err := jsonutil.Marshal(permissions)
+	if err != nil {
+		return nil, err
+	}
+	err = r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(cacheKey).Value(string(value)).Ex(constant.SysAPIPermission.TTL()).Build()).Error()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to cache sys api permission: %v", err)
+	}
+	return permissions, nil
+}
+
+// sysAPIPermissionField 接口权限映射的键: 请求方法 + 路径
+func sysAPIPermissionField(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
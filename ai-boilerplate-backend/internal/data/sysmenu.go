@@ -6,6 +6,7 @@ import (
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_dao"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
 	"github.com/go-kratos/kratos/v2/log"
@@ -74,6 +75,16 @@ func (s *SysMenuRepo) TraverseMenuTree(_ context.Context, menus []*ai_boilerplat
 	return menuTree, nil
 }
 
+// EnabledPermissions 全部开启菜单的权限标识, 用于超级管理员角色
+func (s *SysMenuRepo) EnabledPermissions(ctx context.Context) ([]string, error) {
+	dao := ai_boilerplate_dao.Use(s.data.gorm).SysMenu
+	menus, err := dao.WithContext(ctx).Where(dao.Status.Eq(int16(constant.StatusEnable)), dao.Permission.Neq("")).Find()
+	if err != nil {
+		return nil, err
+	}
+	return s.TraversePermissions(ctx, menus)
+}
+
 // TraversePermissions 遍历菜单的权限,获取权限列表
 func (s *SysMenuRepo) TraversePermissions(_ context.Context, menus []*ai_boilerplate_model.SysMenu) ([]string, error) {
 	var permissions []string
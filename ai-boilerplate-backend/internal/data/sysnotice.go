@@ -1,25 +1,282 @@
 package data
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_dao"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/goutil/timeutil"
+	"github.com/fzf-labs/kratos-contrib/pkg/mq"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+)
+
+// 公告发布时每批生成的通知消息数
+const sysNoticePublishBatchSize = 500
+
+var (
+	ErrSysNoticePublishing = errors.New("sys notice is publishing")
 )
 
+// SysNoticeTaskMessage 公告发布与过期任务
+type SysNoticeTaskMessage struct {
+	ID       string `json:"id"`       // 公告编号
+	PlanTime int64  `json:"planTime"` // 计划执行时间(秒级时间戳), 与公告当前的计划时间不一致时说明公告已重新发布, 忽略该任务
+}
+
+// SysNoticeReadStats 公告阅读统计
+type SysNoticeReadStats struct {
+	RecipientCount int64   // 接收人数
+	ReadCount      int64   // 已读人数
+	UnreadCount    int64   // 未读人数
+	ReadRate       float64 // 阅读率(0-1)
+}
+
 func NewSysNoticeRepo(
 	logger log.Logger,
 	data *Data,
 	sysNoticeRepo *ai_boilerplate_repo.SysNoticeRepo,
+	sysNotifyMessageRepo *SysNotifyMessageRepo,
 ) *SysNoticeRepo {
 	l := log.NewHelper(log.With(logger, "module", "data/sysNotice"))
 	return &SysNoticeRepo{
-		log:           l,
-		data:          data,
-		SysNoticeRepo: sysNoticeRepo,
+		log:                  l,
+		data:                 data,
+		SysNoticeRepo:        sysNoticeRepo,
+		sysNotifyMessageRepo: sysNotifyMessageRepo,
 	}
 }
 
+// SysNoticeRepo 系统公告: 定时发布时按发布对象分批生成通知消息, 过期时撤回未读消息
 type SysNoticeRepo struct {
-	log  *log.Helper
-	data *Data
+	log                  *log.Helper
+	data                 *Data
+	sysNotifyMessageRepo *SysNotifyMessageRepo
 	*ai_boilerplate_repo.SysNoticeRepo
 }
+
+// Schedule 计划发布公告, 到达发布时间与过期时间时分别执行发布与过期任务
+// 公告需已保存发布对象、发布时间与过期时间
+func (r *SysNoticeRepo) Schedule(ctx context.Context, notice *ai_boilerplate_model.SysNotice) error {
+	err := r.enqueueTask(ctx, constant.MQSysNoticePublish, notice.ID, notice.PublishTime.Time)
+	if err != nil {
+		return err
+	}
+	if notice.ExpireTime.Valid {
+		return r.enqueueTask(ctx, constant.MQSysNoticeExpire, notice.ID, notice.ExpireTime.Time)
+	}
+	return nil
+}
+
+// enqueueTask 投递延迟任务, 计划时间已过时立即执行
+func (r *SysNoticeRepo) enqueueTask(ctx context.Context, msgConfig *mq.MessageConfig, id string, planTime time.Time) error {
+	payload, err := json.Marshal(&SysNoticeTaskMessage{
+		ID:       id,
+		PlanTime: planTime.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	return r.data.SendDelayMessage(ctx, msgConfig, payload, time.Until(planTime))
+}
+
+// Publish 消费发布任务: 按发布对象分批生成通知消息并推送新消息与未读数量事件
+// 中途失败时保持发布中状态并返回错误由任务重试, 重试时跳过已生成消息的接收人
+func (r *SysNoticeRepo) Publish(ctx context.Context, msg *SysNoticeTaskMessage) error {
+	notice, err := r.FindOneCacheByID(ctx, msg.ID)
+	if err != nil {
+		return err
+	}
+	if notice == nil || notice.ID == "" {
+		return nil
+	}
+	if !notice.PublishTime.Valid || notice.PublishTime.Time.Unix() != msg.PlanTime {
+		return nil
+	}
+	if notice.PublishStatus != int16(constant.SysNoticePublishStatusScheduled) && notice.PublishStatus != int16(constant.SysNoticePublishStatusPublishing) {
+		return nil
+	}
+	if notice.Status != int16(constant.StatusEnable) {
+		return r.updatePublishStatus(ctx, notice, constant.SysNoticePublishStatusFailed)
+	}
+	if notice.ExpireTime.Valid && !notice.ExpireTime.Time.After(time.Now()) {
+		return r.updatePublishStatus(ctx, notice, constant.SysNoticePublishStatusExpired)
+	}
+	err = r.updatePublishStatus(ctx, notice, constant.SysNoticePublishStatusPublishing)
+	if err != nil {
+		return err
+	}
+	count, err := r.createMessages(ctx, notice)
+	if err != nil {
+		return err
+	}
+	oldData := r.DeepCopy(notice)
+	notice.PublishStatus = int16(constant.SysNoticePublishStatusPublished)
+	notice.PublishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	notice.RecipientCount = int32(count)
+	return r.UpdateOneCacheWithZero(ctx, notice, oldData)
+}
+
+// Expire 消费过期任务: 撤回未读的通知消息并推送接收人的未读数量, 已读消息保留用于阅读统计
+// 公告仍在发布中时返回错误由任务重试
+func (r *SysNoticeRepo) Expire(ctx context.Context, msg *SysNoticeTaskMessage) error {
+	notice, err := r.FindOneCacheByID(ctx, msg.ID)
+	if err != nil {
+		return err
+	}
+	if notice == nil || notice.ID == "" {
+		return nil
+	}
+	if !notice.ExpireTime.Valid || notice.ExpireTime.Time.Unix() != msg.PlanTime {
+		return nil
+	}
+	switch constant.SysNoticePublishStatus(notice.PublishStatus) {
+	case constant.SysNoticePublishStatusScheduled:
+		return r.updatePublishStatus(ctx, notice, constant.SysNoticePublishStatusExpired)
+	case constant.SysNoticePublishStatusPublishing:
+		return ErrSysNoticePublishing
+	case constant.SysNoticePublishStatusPublished:
+	default:
+		return nil
+	}
+	dao := ai_boilerplate_dao.Use(r.data.gorm).SysNotifyMessage
+	for {
+		messages, err := dao.WithContext(ctx).Select(dao.ID, dao.Receiver).Where(dao.NoticeID.Eq(notice.ID), dao.ReadTime.Eq("")).Limit(sysNoticePublishBatchSize).Find()
+		if err != nil {
+			return err
+		}
+		if len(messages) == 0 {
+			break
+		}
+		ids := lo.Map(messages, func(item *ai_boilerplate_model.SysNotifyMessage, _ int) string {
+			return item.ID
+		})
+		err = r.sysNotifyMessageRepo.DeleteMultiCacheByIDS(ctx, ids)
+		if err != nil {
+			return err
+		}
+		// 撤回后推送接收人最新的未读数量
+		for _, message := range messages {
+			r.sysNotifyMessageRepo.PublishUnreadCount(ctx, message.Receiver)
+		}
+	}
+	return r.updatePublishStatus(ctx, notice, constant.SysNoticePublishStatusExpired)
+}
+
+// ReadStats 公告阅读统计, 未读人数按接收人数与已读人数计算, 过期撤回的未读消息仍计入未读
+func (r *SysNoticeRepo) ReadStats(ctx context.Context, notice *ai_boilerplate_model.SysNotice) (*SysNoticeReadStats, error) {
+	dao := ai_boilerplate_dao.Use(r.data.gorm).SysNotifyMessage
+	readCount, err := dao.WithContext(ctx).Where(dao.NoticeID.Eq(notice.ID), dao.ReadTime.Neq("")).Count()
+	if err != nil {
+		return nil, err
+	}
+	stats := &SysNoticeReadStats{
+		RecipientCount: int64(notice.RecipientCount),
+		ReadCount:      readCount,
+		UnreadCount:    max(int64(notice.RecipientCount)-readCount, 0),
+	}
+	if stats.RecipientCount > 0 {
+		stats.ReadRate = float64(stats.ReadCount) / float64(stats.RecipientCount)
+	}
+	return stats, nil
+}
+
+// createMessages 按发布对象分批查询启用的管理员并生成通知消息, 返回接收人数
+// 指定角色与部门时只查询公告所属租户的管理员
+func (r *SysNoticeRepo) createMessages(ctx context.Context, notice *ai_boilerplate_model.SysNotice) (int64, error) {
+	targetIDs := make([]string, 0)
+	if len(notice.TargetIds) > 0 {
+		err := json.Unmarshal(notice.TargetIds, &targetIDs)
+		if err != nil {
+			return 0, err
+		}
+	}
+	extend, err := json.Marshal(map[string]any{
+		"noticeId":   notice.ID,
+		"noticeType": notice.Type,
+	})
+	if err != nil {
+		return 0, err
+	}
+	adminDao := ai_boilerplate_dao.Use(r.data.gorm).SysAdmin
+	messageDao := ai_boilerplate_dao.Use(r.data.gorm).SysNotifyMessage
+	sendTime := timeutil.RFC3339(time.Now())
+	var total int64
+	var lastID string
+	for {
+		query := adminDao.WithContext(ctx).Where(adminDao.Status.Eq(int16(constant.StatusEnable)))
+		switch constant.SysNoticeTargetType(notice.TargetType) {
+		case constant.SysNoticeTargetTypeTenant:
+			query = query.Where(adminDao.TenantID.In(targetIDs...))
+		case constant.SysNoticeTargetTypeRole:
+			query = query.Where(adminDao.TenantID.Eq(notice.TenantID), adminDao.RoleID.In(targetIDs...))
+		case constant.SysNoticeTargetTypeDept:
+			query = query.Where(adminDao.TenantID.Eq(notice.TenantID), adminDao.DeptID.In(targetIDs...))
+		}
+		if lastID != "" {
+			query = query.Where(adminDao.ID.Gt(lastID))
+		}
+		admins, err := query.Order(adminDao.ID).Limit(sysNoticePublishBatchSize).Find()
+		if err != nil {
+			return 0, err
+		}
+		if len(admins) == 0 {
+			break
+		}
+		lastID = admins[len(admins)-1].ID
+		total += int64(len(admins))
+		receivers := lo.Map(admins, func(item *ai_boilerplate_model.SysAdmin, _ int) string {
+			return item.ID
+		})
+		var existed []string
+		err = messageDao.WithContext(ctx).Where(messageDao.NoticeID.Eq(notice.ID), messageDao.Receiver.In(receivers...)).Pluck(messageDao.Receiver, &existed)
+		if err != nil {
+			return 0, err
+		}
+		messages := make([]*ai_boilerplate_model.SysNotifyMessage, 0, len(admins))
+		for _, admin := range admins {
+			if lo.Contains(existed, admin.ID) {
+				continue
+			}
+			message := r.sysNotifyMessageRepo.NewData()
+			message.TenantID = admin.TenantID
+			message.Type = constant.SysNotifyMessageTypeNotice.String()
+			message.Subject = notice.Title
+			message.Content = notice.Content
+			message.Sender = constant.SysNotifyMessageTypeSystem.String()
+			message.Receiver = admin.ID
+			message.SendTime = sendTime
+			message.Extend = datatypes.JSON(extend)
+			message.NoticeID = notice.ID
+			messages = append(messages, message)
+		}
+		if len(messages) > 0 {
+			err = r.sysNotifyMessageRepo.CreateBatchCache(ctx, messages, sysNoticePublishBatchSize)
+			if err != nil {
+				return 0, err
+			}
+			for _, message := range messages {
+				r.sysNotifyMessageRepo.PublishMessage(ctx, message)
+				r.sysNotifyMessageRepo.PublishUnreadCount(ctx, message.Receiver)
+			}
+		}
+		if len(admins) < sysNoticePublishBatchSize {
+			break
+		}
+	}
+	return total, nil
+}
+
+// updatePublishStatus 更新公告发布状态
+func (r *SysNoticeRepo) updatePublishStatus(ctx context.Context, notice *ai_boilerplate_model.SysNotice, status constant.SysNoticePublishStatus) error {
+	oldData := r.DeepCopy(notice)
+	notice.PublishStatus = int16(status)
+	return r.UpdateOneCacheWithZero(ctx, notice, oldData)
+}
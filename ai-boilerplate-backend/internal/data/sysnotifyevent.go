@@ -0,0 +1,295 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/redis/rueidis"
+)
+
+const (
+	// 每个管理员事件流保留的最大事件数, 超出后丢弃最早的事件
+	sysNotifyEventStreamMaxLen = 200
+	// 单次断线续传补发的最大事件数
+	sysNotifyEventReplayCount = 200
+	// 每个连接的事件缓冲数, 缓冲写满视为消费过慢, 断开连接由客户端续传
+	sysNotifyEventBufferSize = 64
+	// 订阅断开后的重试间隔
+	sysNotifyEventResubscribeInterval = time.Second
+)
+
+var (
+	// 事件编号格式, 与 Redis Stream 的条目编号一致
+	sysNotifyEventIDRegexp = regexp.MustCompile(`^\d+-\d+$`)
+
+	ErrSysNotifyEventIDInvalid = errors.New("sys notify event id is invalid")
+)
+
+// SysNotifyEvent 管理员实时事件
+type SysNotifyEvent struct {
+	ID      string          `json:"id"`      // 事件编号(Redis Stream 条目编号)
+	AdminID string          `json:"adminId"` // 接收管理员
+	Type    string          `json:"type"`    // 事件类型
+	Data    json.RawMessage `json:"data"`    // 事件内容
+}
+
+// SysNotifyEventMessage 新通知消息事件内容
+type SysNotifyEventMessage struct {
+	ID       string          `json:"id"`       // 消息编号
+	Type     string          `json:"type"`     // 消息类型
+	Subject  string          `json:"subject"`  // 主题
+	Content  string          `json:"content"`  // 内容
+	Sender   string          `json:"sender"`   // 发送人
+	SendTime string          `json:"sendTime"` // 发送时间
+	Extend   json.RawMessage `json:"extend"`   // 扩展信息
+}
+
+// SysNotifyEventUnreadCount 未读数量变更事件内容
+type SysNotifyEventUnreadCount struct {
+	Count int32 `json:"count"` // 未读数量
+}
+
+// SysNotifyEventAiJob AI 任务完成事件内容
+type SysNotifyEventAiJob struct {
+	JobType      string `json:"jobType"`      // 任务类型
+	ID           string `json:"id"`           // 记录编号
+	Status       int32  `json:"status"`       // 任务状态
+	ErrorMessage string `json:"errorMessage"` // 错误信息
+}
+
+// sysNotifyEventHub 本实例的事件订阅中心
+// 每个实例只订阅一次发布频道, 再按管理员分发到本实例的各个连接
+type sysNotifyEventHub struct {
+	once        sync.Once
+	mu          sync.RWMutex
+	subscribers map[string]map[chan *SysNotifyEvent]struct{}
+}
+
+func newSysNotifyEventHub() *sysNotifyEventHub {
+	return &sysNotifyEventHub{
+		subscribers: make(map[string]map[chan *SysNotifyEvent]struct{}),
+	}
+}
+
+// PublishEvent 发布管理员实时事件
+// 事件先写入管理员的事件流用于断线续传, 再通过发布频道推送到所有实例
+func (r *SysNotifyMessageRepo) PublishEvent(ctx context.Context, adminID string, eventType constant.SysNotifyEventType, data any) (*SysNotifyEvent, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	streamKey := constant.SysNotifyEventStream.Key(adminID)
+	id, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Xadd().Key(streamKey).Maxlen().Almost().Threshold(strconv.Itoa(sysNotifyEventStreamMaxLen)).Id("*").FieldValue().FieldValue("type", eventType.String()).FieldValue("data", string(body)).Build()).ToString()
+	if err != nil {
+		return nil, err
+	}
+	event := &SysNotifyEvent{
+		ID:      id,
+		AdminID: adminID,
+		Type:    eventType.String(),
+		Data:    body,
+	}
+	message, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	results := r.data.rueidis.DoMulti(ctx,
+		r.data.rueidis.B().Expire().Key(streamKey).Seconds(int64(constant.SysNotifyEventStream.TTL().Seconds())).Build(),
+		r.data.rueidis.B().Publish().Channel(constant.SysNotifyEventChannel.Key()).Message(string(message)).Build(),
+	)
+	for _, result := range results {
+		if err := result.Error(); err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+// ReplayEvents 断线续传, 返回指定事件编号之后的事件
+func (r *SysNotifyMessageRepo) ReplayEvents(ctx context.Context, adminID, lastEventID string) ([]*SysNotifyEvent, error) {
+	if lastEventID == "" {
+		return nil, nil
+	}
+	if !sysNotifyEventIDRegexp.MatchString(lastEventID) {
+		return nil, ErrSysNotifyEventIDInvalid
+	}
+	entries, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Xrange().Key(constant.SysNotifyEventStream.Key(adminID)).Start("("+lastEventID).End("+").Count(sysNotifyEventReplayCount).Build()).AsXRange()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	events := make([]*SysNotifyEvent, 0, len(entries))
+	for _, entry := range entries {
+		events = append(events, &SysNotifyEvent{
+			ID:      entry.ID,
+			AdminID: adminID,
+			Type:    entry.FieldValues["type"],
+			Data:    json.RawMessage(entry.FieldValues["data"]),
+		})
+	}
+	return events, nil
+}
+
+// SubscribeEvents 订阅管理员实时事件, 返回事件通道与取消订阅函数
+// 事件通道被关闭说明连接消费过慢, 调用方应断开连接由客户端续传
+func (r *SysNotifyMessageRepo) SubscribeEvents(adminID string) (<-chan *SysNotifyEvent, func()) {
+	r.hub.once.Do(func() {
+		go r.receiveEvents()
+	})
+	ch := make(chan *SysNotifyEvent, sysNotifyEventBufferSize)
+	r.hub.mu.Lock()
+	if r.hub.subscribers[adminID] == nil {
+		r.hub.subscribers[adminID] = make(map[chan *SysNotifyEvent]struct{})
+	}
+	r.hub.subscribers[adminID][ch] = struct{}{}
+	r.hub.mu.Unlock()
+	return ch, func() {
+		r.removeSubscriber(adminID, ch)
+	}
+}
+
+// receiveEvents 订阅发布频道并分发事件, 订阅断开后自动重试
+func (r *SysNotifyMessageRepo) receiveEvents() {
+	ctx := context.Background()
+	for {
+		err := r.data.rueidis.Receive(ctx, r.data.rueidis.B().Subscribe().Channel(constant.SysNotifyEventChannel.Key()).Build(), func(msg rueidis.PubSubMessage) {
+			r.dispatchEvent(msg.Message)
+		})
+		r.log.Errorf("receive sys notify event failed: %v", err)
+		time.Sleep(sysNotifyEventResubscribeInterval)
+	}
+}
+
+// dispatchEvent 将事件分发到接收管理员在本实例的连接
+func (r *SysNotifyMessageRepo) dispatchEvent(message string) {
+	event := &SysNotifyEvent{}
+	err := json.Unmarshal([]byte(message), event)
+	if err != nil {
+		r.log.Errorf("unmarshal sys notify event failed: %v", err)
+		return
+	}
+	slow := make([]chan *SysNotifyEvent, 0)
+	r.hub.mu.RLock()
+	for ch := range r.hub.subscribers[event.AdminID] {
+		select {
+		case ch <- event:
+		default:
+			slow = append(slow, ch)
+		}
+	}
+	r.hub.mu.RUnlock()
+	for _, ch := range slow {
+		r.removeSubscriber(event.AdminID, ch)
+	}
+}
+
+// removeSubscriber 移除连接并关闭事件通道
+func (r *SysNotifyMessageRepo) removeSubscriber(adminID string, ch chan *SysNotifyEvent) {
+	r.hub.mu.Lock()
+	defer r.hub.mu.Unlock()
+	subscribers, ok := r.hub.subscribers[adminID]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[ch]; !ok {
+		return
+	}
+	delete(subscribers, ch)
+	close(ch)
+	if len(subscribers) == 0 {
+		delete(r.hub.subscribers, adminID)
+	}
+}
+
+// CreateOneCacheAndPublish 创建通知消息并推送新消息与未读数量事件
+// 推送失败只记录日志, 客户端可通过未读数量接口兜底
+func (r *SysNotifyMessageRepo) CreateOneCacheAndPublish(ctx context.Context, message *ai_boilerplate_model.SysNotifyMessage) error {
+	err := r.CreateOneCache(ctx, message)
+	if err != nil {
+		return err
+	}
+	r.PublishMessage(ctx, message)
+	r.PublishUnreadCount(ctx, message.Receiver)
+	return nil
+}
+
+// PublishMessage 推送新消息事件, 失败只记录日志
+func (r *SysNotifyMessageRepo) PublishMessage(ctx context.Context, message *ai_boilerplate_model.SysNotifyMessage) {
+	var extend json.RawMessage
+	if len(message.Extend) > 0 {
+		extend = json.RawMessage(message.Extend)
+	}
+	_, err := r.PublishEvent(ctx, message.Receiver, constant.SysNotifyEventTypeMessage, &SysNotifyEventMessage{
+		ID:       message.ID,
+		Type:     message.Type,
+		Subject:  message.Subject,
+		Content:  message.Content,
+		Sender:   message.Sender,
+		SendTime: message.SendTime,
+		Extend:   extend,
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("publish sys notify message event failed: %v", err)
+	}
+}
+
+// PublishUnreadCount 推送管理员当前的未读数量, 失败只记录日志
+func (r *SysNotifyMessageRepo) PublishUnreadCount(ctx context.Context, adminID string) {
+	count, err := r.UnreadCount(ctx, adminID)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("get sys notify unread count failed: %v", err)
+		return
+	}
+	_, err = r.PublishEvent(ctx, adminID, constant.SysNotifyEventTypeUnreadCount, &SysNotifyEventUnreadCount{Count: count})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("publish sys notify unread count event failed: %v", err)
+	}
+}
+
+// PublishAiJob 推送 AI 任务完成事件, 失败只记录日志
+func (r *SysNotifyMessageRepo) PublishAiJob(ctx context.Context, adminID string, job *SysNotifyEventAiJob) {
+	_, err := r.PublishEvent(ctx, adminID, constant.SysNotifyEventTypeAiJob, job)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("publish ai job event failed: %v", err)
+	}
+}
+
+// UnreadCount 管理员未读消息数量
+func (r *SysNotifyMessageRepo) UnreadCount(ctx context.Context, adminID string) (int32, error) {
+	list, err := r.FindMultiCacheByReceiverReadTime(ctx, adminID, "")
+	if err != nil {
+		return 0, err
+	}
+	return int32(len(list)), nil
+}
+
+// SysNotifyEventIDAfter 事件编号 a 是否在 b 之后, b 为空时视为在之后
+func SysNotifyEventIDAfter(a, b string) bool {
+	if b == "" {
+		return true
+	}
+	aMs, aSeq := parseSysNotifyEventID(a)
+	bMs, bSeq := parseSysNotifyEventID(b)
+	if aMs != bMs {
+		return aMs > bMs
+	}
+	return aSeq > bSeq
+}
+
+// parseSysNotifyEventID 解析事件编号的毫秒时间与序号
+func parseSysNotifyEventID(id string) (ms, seq uint64) {
+	msStr, seqStr, _ := strings.Cut(id, "-")
+	ms, _ = strconv.ParseUint(msStr, 10, 64)
+	seq, _ = strconv.ParseUint(seqStr, 10, 64)
+	return ms, seq
+}
@@ -15,11 +15,13 @@ func NewSysNotifyMessageRepo(
 		log:                  l,
 		data:                 data,
 		SysNotifyMessageRepo: sysNotifyMessageRepo,
+		hub:                  newSysNotifyEventHub(),
 	}
 }
 
 type SysNotifyMessageRepo struct {
 	log  *log.Helper
 	data *Data
+	hub  *sysNotifyEventHub
 	*ai_boilerplate_repo.SysNotifyMessageRepo
 }
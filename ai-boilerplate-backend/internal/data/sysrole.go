@@ -3,8 +3,11 @@ package data
 import (
 	"context"
 
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/goutil/jsonutil"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/rueidis"
 	"github.com/samber/lo"
 )
 
@@ -17,16 +20,23 @@ func NewSysRoleRepo(
 	return &SysRoleRepo{
 		log:         l,
 		data:        data,
+		superRoleID: data.cfg.GetBusiness()["tenant"].GetStructValue().GetFields()["superRoleId"].GetStringValue(),
 		SysRoleRepo: sysRoleRepo,
 	}
 }
 
 type SysRoleRepo struct {
-	log  *log.Helper
-	data *Data
+	log         *log.Helper
+	data        *Data
+	superRoleID string // 超级管理员角色编号, 未配置时没有角色跳过接口权限校验
 	*ai_boilerplate_repo.SysRoleRepo
 }
 
+// IsSuperRole 是否为超级管理员角色, 超级管理员跳过接口权限校验并拥有全部按钮权限
+func (r *SysRoleRepo) IsSuperRole(roleID string) bool {
+	return r.superRoleID != "" && roleID == r.superRoleID
+}
+
 func (r *SysRoleRepo) RoleIDToName(ctx context.Context, roleIDs []string) (map[string]string, error) {
 	roleIDs = lo.Filter(roleIDs, func(item string, _ int) bool {
 		return item != ""
@@ -45,3 +55,48 @@ func (r *SysRoleRepo) RoleIDToName(ctx context.Context, roleIDs []string) (map[s
 	}
 	return roleNameMap, nil
 }
+
+// GetPermissionCache 获取角色权限标识集合缓存, 缓存不存在时 ok 为 false
+func (r *SysRoleRepo) GetPermissionCache(ctx context.Context, roleID string) (permissions []string, ok bool, err error) {
+	cacheValue, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Hget().Key(constant.SysRolePermission.Key()).Field(roleID).Build()).ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	permissions = make([]string, 0)
+	err = jsonutil.Unmarshal([]byte(cacheValue), &permissions)
+	if err != nil {
+		return nil, false, err
+	}
+	return permissions, true, nil
+}
+
+// SetPermissionCache 缓存角色权限标识集合
+func (r *SysRoleRepo) SetPermissionCache(ctx context.Context, roleID string, permissions []string) error {
+	cacheKey := constant.SysRolePermission.Key()
+	value, err := jsonutil.Marshal(permissions)
+	if err != nil {
+		return err
+	}
+	results := r.data.rueidis.DoMulti(ctx,
+		r.data.rueidis.B().Hset().Key(cacheKey).FieldValue().FieldValue(roleID, string(value)).Build(),
+		r.data.rueidis.B().Expire().Key(cacheKey).Seconds(int64(constant.SysRolePermission.TTL().Seconds())).Build(),
+	)
+	for _, result := range results {
+		if result.Error() != nil {
+			return result.Error()
+		}
+	}
+	return nil
+}
+
+// DelPermissionCache 删除角色权限标识集合缓存, 未指定角色时删除全部角色的缓存(菜单变更影响所有角色)
+func (r *SysRoleRepo) DelPermissionCache(ctx context.Context, roleIDs ...string) error {
+	cacheKey := constant.SysRolePermission.Key()
+	if len(roleIDs) == 0 {
+		return r.data.rueidis.Do(ctx, r.data.rueidis.B().Del().Key(cacheKey).Build()).Error()
+	}
+	return r.data.rueidis.Do(ctx, r.data.rueidis.B().Hdel().Key(cacheKey).Field(roleIDs...).Build()).Error()
+}
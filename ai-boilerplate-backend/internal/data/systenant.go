@@ -12,14 +12,21 @@ func NewSysTenantRepo(
 ) *SysTenantRepo {
 	l := log.NewHelper(log.With(logger, "module", "data/sysTenant"))
 	return &SysTenantRepo{
-		log:           l,
-		data:          data,
-		SysTenantRepo: sysTenantRepo,
+		log:              l,
+		data:             data,
+		platformTenantID: data.cfg.GetBusiness()["tenant"].GetStructValue().GetFields()["platformId"].GetStringValue(),
+		SysTenantRepo:    sysTenantRepo,
 	}
 }
 
 type SysTenantRepo struct {
-	log  *log.Helper
-	data *Data
+	log              *log.Helper
+	data             *Data
+	platformTenantID string // 平台租户编号, 未配置时没有租户可以跨租户访问
 	*ai_boilerplate_repo.SysTenantRepo
 }
+
+// IsPlatformTenant 是否为平台租户
+func (r *SysTenantRepo) IsPlatformTenant(tenantID string) bool {
+	return r.platformTenantID != "" && tenantID == r.platformTenantID
+}
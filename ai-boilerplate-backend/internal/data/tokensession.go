@@ -0,0 +1,297 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/redis/rueidis"
+)
+
+// 会话最后活跃时间的刷新间隔, 避免每次请求都写缓存
+const tokenSessionTouchInterval = time.Minute
+
+var (
+	// ErrRefreshTokenInvalid 刷新 token 无效或已过期
+	ErrRefreshTokenInvalid = errors.New("refresh token is invalid")
+	// ErrRefreshTokenReused 刷新 token 被重复使用, 整个会话已被注销
+	ErrRefreshTokenReused = errors.New("refresh token is reused")
+)
+
+// refreshTokenRotateScript 校验并轮换刷新 token
+// 返回 0 表示会话不存在, -1 表示 token 与会话当前 token 不一致(已被使用过), 1 表示轮换成功
+var refreshTokenRotateScript = rueidis.NewLuaScript(`
+local current = redis.call('HGET', KEYS[1], 'hash')
+if not current then
+	return 0
+end
+if current ~= ARGV[1] then
+	return -1
+end
+redis.call('HSET', KEYS[1], 'hash', ARGV[2])
+redis.call('EXPIRE', KEYS[1], ARGV[3])
+return 1
+`)
+
+// TokenSession 登录会话, 同一会话内轮换的 token 属于同一个 token 家族
+type TokenSession struct {
+	ID         string `json:"id"`         // 会话编号
+	Device     string `json:"device"`     // 设备
+	IP         string `json:"ip"`         // IP
+	UserAgent  string `json:"userAgent"`  // 浏览器 UA
+	LoginAt    int64  `json:"loginAt"`    // 登录时间
+	LastSeenAt int64  `json:"lastSeenAt"` // 最后活跃时间
+	ExpiredAt  int64  `json:"expiredAt"`  // 过期时间(刷新 token 过期时间)
+}
+
+// TokenPair 访问 token 与刷新 token
+type TokenPair struct {
+	AccessToken      string // 访问 token
+	ExpiredAt        int64  // 访问 token 过期时间
+	RefreshAt        int64  // 建议刷新时间
+	RefreshToken     string // 刷新 token
+	RefreshExpiredAt int64  // 刷新 token 过期时间
+}
+
+// tokenSessionStore 登录会话、刷新 token 与 token 黑名单
+// 会话按账号存储在 hash 中(field 为会话编号); 刷新 token 按会话存储当前 token 的摘要, 每次刷新轮换;
+// 黑名单按会话编号(sid)而不是单个访问 token 的 jti 存储: 退出登录、强制下线与刷新 token 重用都需要让会话内
+// 刷新过的全部访问 token 失效; 会话注销时刷新 token 一并删除, 不会再签发新的访问 token,
+// 因此黑名单保留一个访问 token 有效期即可覆盖会话内所有未过期的 token
+type tokenSessionStore struct {
+	data         *Data
+	accessTTL    time.Duration                 // 访问 token 有效期
+	refreshTTL   time.Duration                 // 刷新 token 有效期(会话有效期)
+	sessionKey   func(ownerID string) string   // 账号的会话集合
+	refreshKey   func(sessionID string) string // 会话的刷新 token
+	blacklistKey func(sessionID string) string // 会话的黑名单
+}
+
+// newRefreshToken 生成刷新 token, 格式为 会话编号.随机串
+func (s *tokenSessionStore) newRefreshToken(sessionID string) (token, hash string, err error) {
+	b := make([]byte, 32)
+	_, err = rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+	token = sessionID + "." + hex.EncodeToString(b)
+	return token, s.hashRefreshToken(token), nil
+}
+
+// hashRefreshToken 刷新 token 摘要, 缓存中不保存明文
+func (s *tokenSessionStore) hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// create 保存会话并生成刷新 token
+func (s *tokenSessionStore) create(ctx context.Context, ownerID string, session *TokenSession) (string, error) {
+	refreshToken, hash, err := s.newRefreshToken(session.ID)
+	if err != nil {
+		return "", err
+	}
+	value, err := jsonutil.Marshal(session)
+	if err != nil {
+		return "", err
+	}
+	sessionKey := s.sessionKey(ownerID)
+	refreshKey := s.refreshKey(session.ID)
+	ttl := int64(s.refreshTTL.Seconds())
+	results := s.data.rueidis.DoMulti(ctx,
+		s.data.rueidis.B().Hset().Key(sessionKey).FieldValue().FieldValue(session.ID, string(value)).Build(),
+		s.data.rueidis.B().Expire().Key(sessionKey).Seconds(ttl).Build(),
+		s.data.rueidis.B().Hset().Key(refreshKey).FieldValue().FieldValue("hash", hash).FieldValue("owner", ownerID).Build(),
+		s.data.rueidis.B().Expire().Key(refreshKey).Seconds(ttl).Build(),
+	)
+	for _, result := range results {
+		if result.Error() != nil {
+			return "", result.Error()
+		}
+	}
+	return refreshToken, nil
+}
+
+// rotate 校验刷新 token 并轮换, 返回会话所属账号、会话编号与新的刷新 token
+// 已使用过的刷新 token 再次出现说明 token 可能泄露, 注销整个会话
+func (s *tokenSessionStore) rotate(ctx context.Context, refreshToken string) (ownerID, sessionID, newRefreshToken string, err error) {
+	sessionID, _, ok := strings.Cut(refreshToken, ".")
+	if !ok || sessionID == "" {
+		return "", "", "", ErrRefreshTokenInvalid
+	}
+	refreshKey := s.refreshKey(sessionID)
+	ownerID, err = s.data.rueidis.Do(ctx, s.data.rueidis.B().Hget().Key(refreshKey).Field("owner").Build()).ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return "", "", "", ErrRefreshTokenInvalid
+		}
+		return "", "", "", err
+	}
+	newRefreshToken, newHash, err := s.newRefreshToken(sessionID)
+	if err != nil {
+		return "", "", "", err
+	}
+	result, err := refreshTokenRotateScript.Exec(ctx, s.data.rueidis,
+		[]string{refreshKey},
+		[]string{s.hashRefreshToken(refreshToken), newHash, strconv.FormatInt(int64(s.refreshTTL.Seconds()), 10)},
+	).AsInt64()
+	if err != nil {
+		return "", "", "", err
+	}
+	switch result {
+	case 1:
+		return ownerID, sessionID, newRefreshToken, nil
+	case -1:
+		err = s.revoke(ctx, ownerID, sessionID)
+		if err != nil {
+			return "", "", "", err
+		}
+		return "", "", "", ErrRefreshTokenReused
+	default:
+		return "", "", "", ErrRefreshTokenInvalid
+	}
+}
+
+// renew 刷新后延长会话有效期
+func (s *tokenSessionStore) renew(ctx context.Context, ownerID, sessionID string) error {
+	sessionKey := s.sessionKey(ownerID)
+	value, err := s.data.rueidis.Do(ctx, s.data.rueidis.B().Hget().Key(sessionKey).Field(sessionID).Build()).ToString()
+	if err != nil && !rueidis.IsRedisNil(err) {
+		return err
+	}
+	session := &TokenSession{ID: sessionID}
+	if value != "" {
+		err = jsonutil.Unmarshal([]byte(value), session)
+		if err != nil {
+			return err
+		}
+	}
+	now := time.Now()
+	session.LastSeenAt = now.Unix()
+	session.ExpiredAt = now.Add(s.refreshTTL).Unix()
+	newValue, err := jsonutil.Marshal(session)
+	if err != nil {
+		return err
+	}
+	results := s.data.rueidis.DoMulti(ctx,
+		s.data.rueidis.B().Hset().Key(sessionKey).FieldValue().FieldValue(sessionID, string(newValue)).Build(),
+		s.data.rueidis.B().Expire().Key(sessionKey).Seconds(int64(s.refreshTTL.Seconds())).Build(),
+	)
+	for _, result := range results {
+		if result.Error() != nil {
+			return result.Error()
+		}
+	}
+	return nil
+}
+
+// list 获取有效会话, 按最后活跃时间倒序, 顺带清理已过期的会话
+func (s *tokenSessionStore) list(ctx context.Context, ownerID string) ([]*TokenSession, error) {
+	sessionKey := s.sessionKey(ownerID)
+	values, err := s.data.rueidis.Do(ctx, s.data.rueidis.B().Hgetall().Key(sessionKey).Build()).AsStrMap()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	sessions := make([]*TokenSession, 0, len(values))
+	expired := make([]string, 0)
+	for id, value := range values {
+		session := &TokenSession{}
+		err = jsonutil.Unmarshal([]byte(value), session)
+		if err != nil || (session.ExpiredAt > 0 && session.ExpiredAt < now) {
+			expired = append(expired, id)
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	if len(expired) > 0 {
+		err = s.data.rueidis.Do(ctx, s.data.rueidis.B().Hdel().Key(sessionKey).Field(expired...).Build()).Error()
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt > sessions[j].LastSeenAt
+	})
+	return sessions, nil
+}
+
+// touch 刷新会话最后活跃时间, 会话不存在时忽略
+func (s *tokenSessionStore) touch(ctx context.Context, ownerID, sessionID string) error {
+	sessionKey := s.sessionKey(ownerID)
+	value, err := s.data.rueidis.Do(ctx, s.data.rueidis.B().Hget().Key(sessionKey).Field(sessionID).Build()).ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil
+		}
+		return err
+	}
+	session := &TokenSession{}
+	err = jsonutil.Unmarshal([]byte(value), session)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if now.Sub(time.Unix(session.LastSeenAt, 0)) < tokenSessionTouchInterval {
+		return nil
+	}
+	session.LastSeenAt = now.Unix()
+	newValue, err := jsonutil.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return s.data.rueidis.Do(ctx, s.data.rueidis.B().Hset().Key(sessionKey).FieldValue().FieldValue(sessionID, string(newValue)).Build()).Error()
+}
+
+// revoke 注销会话: 会话加入黑名单, 删除会话与刷新 token, 会话内所有 token 失效
+func (s *tokenSessionStore) revoke(ctx context.Context, ownerID, sessionID string) error {
+	results := s.data.rueidis.DoMulti(ctx,
+		s.data.rueidis.B().Set().Key(s.blacklistKey(sessionID)).Value("1").ExSeconds(int64(s.accessTTL.Seconds())).Build(),
+		s.data.rueidis.B().Hdel().Key(s.sessionKey(ownerID)).Field(sessionID).Build(),
+		s.data.rueidis.B().Del().Key(s.refreshKey(sessionID)).Build(),
+	)
+	for _, result := range results {
+		if result.Error() != nil {
+			return result.Error()
+		}
+	}
+	return nil
+}
+
+// revokeAll 注销账号的全部会话, 账号被禁用或删除时使用
+func (s *tokenSessionStore) revokeAll(ctx context.Context, ownerID string) error {
+	sessionKey := s.sessionKey(ownerID)
+	sessionIDs, err := s.data.rueidis.Do(ctx, s.data.rueidis.B().Hkeys().Key(sessionKey).Build()).AsStrSlice()
+	if err != nil {
+		return err
+	}
+	cmds := make(rueidis.Commands, 0, len(sessionIDs)*2+1)
+	for _, sessionID := range sessionIDs {
+		cmds = append(cmds,
+			s.data.rueidis.B().Set().Key(s.blacklistKey(sessionID)).Value("1").ExSeconds(int64(s.accessTTL.Seconds())).Build(),
+			s.data.rueidis.B().Del().Key(s.refreshKey(sessionID)).Build(),
+		)
+	}
+	cmds = append(cmds, s.data.rueidis.B().Del().Key(sessionKey).Build())
+	for _, result := range s.data.rueidis.DoMulti(ctx, cmds...) {
+		if result.Error() != nil {
+			return result.Error()
+		}
+	}
+	return nil
+}
+
+// revoked 会话是否已注销
+func (s *tokenSessionStore) revoked(ctx context.Context, sessionID string) (bool, error) {
+	exists, err := s.data.rueidis.Do(ctx, s.data.rueidis.B().Exists().Key(s.blacklistKey(sessionID)).Build()).AsInt64()
+	if err != nil {
+		return false, err
+	}
+	return exists > 0, nil
+}
@@ -0,0 +1,129 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// newTestTokenSessionStore 使用独立前缀的会话存储, 测试结束后删除测试数据
+func newTestTokenSessionStore(t *testing.T) *tokenSessionStore {
+	t.Helper()
+	d, _ := NewTestData(t)
+	prefix := "test_token_session:" + uuid.New().String() + ":"
+	s := &tokenSessionStore{
+		data:       d,
+		accessTTL:  time.Minute,
+		refreshTTL: time.Hour,
+		sessionKey: func(ownerID string) string {
+			return prefix + "session:" + ownerID
+		},
+		refreshKey: func(sessionID string) string {
+			return prefix + "refresh:" + sessionID
+		},
+		blacklistKey: func(sessionID string) string {
+			return prefix + "blacklist:" + sessionID
+		},
+	}
+	t.Cleanup(func() {
+		ctx := context.Background()
+		keys, err := d.rueidis.Do(ctx, d.rueidis.B().Keys().Pattern(prefix+"*").Build()).AsStrSlice()
+		if err == nil && len(keys) > 0 {
+			_ = d.rueidis.Do(ctx, d.rueidis.B().Del().Key(keys...).Build()).Error()
+		}
+	})
+	return s
+}
+
+func TestTokenSessionStore_RotateReuseRevoke(t *testing.T) {
+	s := newTestTokenSessionStore(t)
+	ctx := context.Background()
+	ownerID := "owner-1"
+	session := &TokenSession{ID: uuid.New().String(), LoginAt: time.Now().Unix()}
+	refreshToken, err := s.create(ctx, ownerID, session)
+	if err != nil {
+		t.Fatalf("create() error = %v", err)
+	}
+	// 轮换成功后旧 token 失效, 返回新 token
+	gotOwnerID, gotSessionID, newRefreshToken, err := s.rotate(ctx, refreshToken)
+	if err != nil {
+		t.Fatalf("rotate() error = %v", err)
+	}
+	if gotOwnerID != ownerID || gotSessionID != session.ID || newRefreshToken == "" || newRefreshToken == refreshToken {
+		t.Fatalf("rotate() = %q, %q, %q", gotOwnerID, gotSessionID, newRefreshToken)
+	}
+	revoked, err := s.revoked(ctx, session.ID)
+	if err != nil || revoked {
+		t.Fatalf("revoked() = %v, %v, want false", revoked, err)
+	}
+	// 已使用过的 token 再次刷新视为泄露, 注销整个会话
+	if _, _, _, err = s.rotate(ctx, refreshToken); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("rotate() reused error = %v, want %v", err, ErrRefreshTokenReused)
+	}
+	revoked, err = s.revoked(ctx, session.ID)
+	if err != nil || !revoked {
+		t.Fatalf("revoked() = %v, %v, want true", revoked, err)
+	}
+	sessions, err := s.list(ctx, ownerID)
+	if err != nil {
+		t.Fatalf("list() error = %v", err)
+	}
+	if len(sessions) != 0 {
+		t.Errorf("sessions = %d, want 0", len(sessions))
+	}
+	// 会话注销后最新的 token 同样失效
+	if _, _, _, err = s.rotate(ctx, newRefreshToken); !errors.Is(err, ErrRefreshTokenInvalid) {
+		t.Fatalf("rotate() after revoke error = %v, want %v", err, ErrRefreshTokenInvalid)
+	}
+}
+
+func TestTokenSessionStore_RotateInvalid(t *testing.T) {
+	s := newTestTokenSessionStore(t)
+	ctx := context.Background()
+	for _, token := range []string{"", "no-separator", ".secret", uuid.New().String() + ".secret"} {
+		if _, _, _, err := s.rotate(ctx, token); !errors.Is(err, ErrRefreshTokenInvalid) {
+			t.Errorf("rotate(%q) error = %v, want %v", token, err, ErrRefreshTokenInvalid)
+		}
+	}
+}
+
+func TestTokenSessionStore_RevokeAll(t *testing.T) {
+	s := newTestTokenSessionStore(t)
+	ctx := context.Background()
+	ownerID := "owner-2"
+	tokens := make(map[string]string)
+	for range 2 {
+		session := &TokenSession{ID: uuid.New().String(), LoginAt: time.Now().Unix()}
+		refreshToken, err := s.create(ctx, ownerID, session)
+		if err != nil {
+			t.Fatalf("create() error = %v", err)
+		}
+		tokens[session.ID] = refreshToken
+	}
+	// 账号禁用或删除时注销全部会话
+	if err := s.revokeAll(ctx, ownerID); err != nil {
+		t.Fatalf("revokeAll() error = %v", err)
+	}
+	for sessionID, refreshToken := range tokens {
+		revoked, err := s.revoked(ctx, sessionID)
+		if err != nil || !revoked {
+			t.Errorf("revoked(%s) = %v, %v, want true", sessionID, revoked, err)
+		}
+		if _, _, _, err = s.rotate(ctx, refreshToken); !errors.Is(err, ErrRefreshTokenInvalid) {
+			t.Errorf("rotate() error = %v, want %v", err, ErrRefreshTokenInvalid)
+		}
+	}
+	sessions, err := s.list(ctx, ownerID)
+	if err != nil || len(sessions) != 0 {
+		t.Errorf("list() = %d, %v, want 0", len(sessions), err)
+	}
+	// 没有会话时注销全部会话不报错
+	if err = s.revokeAll(ctx, ownerID); err != nil {
+		t.Errorf("revokeAll() empty error = %v", err)
+	}
+}
@@ -0,0 +1,379 @@
+package data
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 默认算法, 主流验证器应用仅支持 SHA1
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/rueidis"
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+)
+
+// TOTP 参数, 与 Google Authenticator 等验证器应用的默认值保持一致
+const (
+	totpDigits = 6                // 验证码位数
+	totpPeriod = 30 * time.Second // 时间步长
+	totpSkew   = 1                // 允许前后偏移的时间步数
+)
+
+// 两步验证参数
+const (
+	twoFactorSecretSize          = 20 // 密钥字节数
+	twoFactorRecoveryCodeCount   = 10 // 恢复码数量
+	twoFactorRecoveryCodeLength  = 10 // 恢复码长度(不含分隔符)
+	twoFactorChallengeMaxAttempt = 5  // 单个登录挑战允许的最大失败次数
+)
+
+// 恢复码字符集, 去掉易混淆的 0、1、l、o
+const twoFactorRecoveryCodeAlphabet = "23456789abcdefghijkmnpqrstuvwxyz"
+
+// ErrTwoFactorChallengeInvalid 两步验证登录挑战不存在、已过期或失败次数过多
+var ErrTwoFactorChallengeInvalid = errors.New("two factor challenge is invalid")
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func NewTwoFactorRepo(
+	logger log.Logger,
+	data *Data,
+	sysAdminRepo *ai_boilerplate_repo.SysAdminRepo,
+	sysTenantRepo *ai_boilerplate_repo.SysTenantRepo,
+) *TwoFactorRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/twoFactor"))
+	issuer := data.cfg.GetBusiness()["twoFactor"].GetFields()["issuer"].GetStringValue()
+	if issuer == "" {
+		issuer = "AI Boilerplate"
+	}
+	return &TwoFactorRepo{
+		log:           l,
+		data:          data,
+		sysAdminRepo:  sysAdminRepo,
+		sysTenantRepo: sysTenantRepo,
+		issuer:        issuer,
+	}
+}
+
+// TwoFactorRepo 管理员 TOTP 两步验证: 密钥绑定、验证码校验、恢复码与登录挑战
+type TwoFactorRepo struct {
+	log           *log.Helper
+	data          *Data
+	sysAdminRepo  *ai_boilerplate_repo.SysAdminRepo
+	sysTenantRepo *ai_boilerplate_repo.SysTenantRepo
+	issuer        string // 验证器应用中显示的签发方
+}
+
+// Required 租户策略是否要求管理员所属角色开启两步验证
+func (r *TwoFactorRepo) Required(ctx context.Context, admin *ai_boilerplate_model.SysAdmin) (bool, error) {
+	if admin.TenantID == "" || admin.RoleID == "" {
+		return false, nil
+	}
+	tenant, err := r.sysTenantRepo.FindOneCacheByID(ctx, admin.TenantID)
+	if err != nil {
+		return false, err
+	}
+	if tenant == nil || tenant.ID == "" || tenant.TwoFactorRoleIds.String() == "" {
+		return false, nil
+	}
+	roleIDs := make([]string, 0)
+	err = jsonutil.Unmarshal(tenant.TwoFactorRoleIds, &roleIDs)
+	if err != nil {
+		return false, err
+	}
+	return lo.Contains(roleIDs, admin.RoleID), nil
+}
+
+// Setup 生成待绑定的密钥, 返回密钥与验证器应用扫码使用的 otpauth 地址
+// 密钥在开启前只保存在缓存中, 验证通过后才写入管理员记录
+func (r *TwoFactorRepo) Setup(ctx context.Context, admin *ai_boilerplate_model.SysAdmin) (secret, provisioningURI string, err error) {
+	b := make([]byte, twoFactorSecretSize)
+	_, err = rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+	secret = totpEncoding.EncodeToString(b)
+	err = r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(constant.TwoFactorSetup.Key(admin.ID)).Value(secret).ExSeconds(int64(constant.TwoFactorSetup.TTL().Seconds())).Build()).Error()
+	if err != nil {
+		return "", "", err
+	}
+	return secret, r.provisioningURI(secret, admin.Username), nil
+}
+
+// Enable 校验待绑定密钥的验证码, 通过后开启两步验证并生成恢复码; 验证码错误时返回 false
+func (r *TwoFactorRepo) Enable(ctx context.Context, admin *ai_boilerplate_model.SysAdmin, code string) (bool, []string, error) {
+	setupKey := constant.TwoFactorSetup.Key(admin.ID)
+	secret, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Get().Key(setupKey).Build()).ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	ok, err := r.verifyTOTP(ctx, admin.ID, secret, code)
+	if err != nil || !ok {
+		return false, nil, err
+	}
+	codes, hashes, err := r.generateRecoveryCodes()
+	if err != nil {
+		return false, nil, err
+	}
+	oldData := r.sysAdminRepo.DeepCopy(admin)
+	admin.TwoFactorEnabled = true
+	admin.TwoFactorSecret = secret
+	admin.TwoFactorRecoveryCodes = hashes
+	err = r.sysAdminRepo.UpdateOneCacheWithZero(ctx, admin, oldData)
+	if err != nil {
+		return false, nil, err
+	}
+	err = r.data.rueidis.Do(ctx, r.data.rueidis.B().Del().Key(setupKey).Build()).Error()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to delete two factor setup %s: %v", admin.ID, err)
+	}
+	return true, codes, nil
+}
+
+// Disable 关闭两步验证, 清除密钥与恢复码
+func (r *TwoFactorRepo) Disable(ctx context.Context, admin *ai_boilerplate_model.SysAdmin) error {
+	oldData := r.sysAdminRepo.DeepCopy(admin)
+	admin.TwoFactorEnabled = false
+	admin.TwoFactorSecret = ""
+	admin.TwoFactorRecoveryCodes = nil
+	return r.sysAdminRepo.UpdateOneCacheWithZero(ctx, admin, oldData)
+}
+
+// Verify 校验验证码, 依次尝试 TOTP 验证码与恢复码, 恢复码使用后作废
+func (r *TwoFactorRepo) Verify(ctx context.Context, admin *ai_boilerplate_model.SysAdmin, code string) (bool, error) {
+	if !admin.TwoFactorEnabled || admin.TwoFactorSecret == "" {
+		return false, nil
+	}
+	ok, err := r.verifyTOTP(ctx, admin.ID, admin.TwoFactorSecret, code)
+	if err != nil || ok {
+		return ok, err
+	}
+	return r.useRecoveryCode(ctx, admin, code)
+}
+
+// VerifyTOTP 仅校验 TOTP 验证码, 用于重新生成恢复码等不接受恢复码的场景
+func (r *TwoFactorRepo) VerifyTOTP(ctx context.Context, admin *ai_boilerplate_model.SysAdmin, code string) (bool, error) {
+	if !admin.TwoFactorEnabled || admin.TwoFactorSecret == "" {
+		return false, nil
+	}
+	return r.verifyTOTP(ctx, admin.ID, admin.TwoFactorSecret, code)
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码, 旧恢复码全部作废
+func (r *TwoFactorRepo) RegenerateRecoveryCodes(ctx context.Context, admin *ai_boilerplate_model.SysAdmin) ([]string, error) {
+	codes, hashes, err := r.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	oldData := r.sysAdminRepo.DeepCopy(admin)
+	admin.TwoFactorRecoveryCodes = hashes
+	err = r.sysAdminRepo.UpdateOneCacheWithZero(ctx, admin, oldData)
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// CreateChallenge 密码校验通过后创建登录挑战, 客户端凭挑战提交验证码完成登录
+func (r *TwoFactorRepo) CreateChallenge(ctx context.Context, adminID string) (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	challenge := hex.EncodeToString(b)
+	key := constant.TwoFactorChallenge.Key(challenge)
+	results := r.data.rueidis.DoMulti(ctx,
+		r.data.rueidis.B().Hset().Key(key).FieldValue().FieldValue("admin_id", adminID).FieldValue("attempts", "0").Build(),
+		r.data.rueidis.B().Expire().Key(key).Seconds(int64(constant.TwoFactorChallenge.TTL().Seconds())).Build(),
+	)
+	for _, result := range results {
+		if result.Error() != nil {
+			return "", result.Error()
+		}
+	}
+	return challenge, nil
+}
+
+// GetChallenge 获取登录挑战对应的管理员编号
+func (r *TwoFactorRepo) GetChallenge(ctx context.Context, challenge string) (string, error) {
+	adminID, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Hget().Key(constant.TwoFactorChallenge.Key(challenge)).Field("admin_id").Build()).ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return "", ErrTwoFactorChallengeInvalid
+		}
+		return "", err
+	}
+	return adminID, nil
+}
+
+// RecordChallengeFailure 记录登录挑战的验证失败, 失败次数过多时挑战作废, 需重新输入密码
+func (r *TwoFactorRepo) RecordChallengeFailure(ctx context.Context, challenge string) error {
+	key := constant.TwoFactorChallenge.Key(challenge)
+	attempts, err := r.data.rueidis.Do(ctx, r.data.rueidis.B().Hincrby().Key(key).Field("attempts").Increment(1).Build()).AsInt64()
+	if err != nil {
+		return err
+	}
+	if attempts < twoFactorChallengeMaxAttempt {
+		return nil
+	}
+	return r.DeleteChallenge(ctx, challenge)
+}
+
+// DeleteChallenge 删除登录挑战
+func (r *TwoFactorRepo) DeleteChallenge(ctx context.Context, challenge string) error {
+	return r.data.rueidis.Do(ctx, r.data.rueidis.B().Del().Key(constant.TwoFactorChallenge.Key(challenge)).Build()).Error()
+}
+
+// provisioningURI 验证器应用扫码使用的 otpauth 地址
+func (r *TwoFactorRepo) provisioningURI(secret, account string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", r.issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(totpDigits))
+	query.Set("period", strconv.Itoa(int(totpPeriod.Seconds())))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + r.issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+// verifyTOTP 校验 TOTP 验证码, 允许前后各偏移一个时间步; 同一时间步的验证码只能使用一次, 防止重放
+func (r *TwoFactorRepo) verifyTOTP(ctx context.Context, adminID, secret, code string) (bool, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return false, err
+	}
+	counter, ok := matchTOTP(key, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	err = r.data.rueidis.Do(ctx, r.data.rueidis.B().Set().Key(constant.TwoFactorUsedCode.Key(adminID, strconv.FormatInt(counter, 10))).Value("1").Nx().ExSeconds(int64(constant.TwoFactorUsedCode.TTL().Seconds())).Build()).Error()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// matchTOTP 在当前时间步前后各 totpSkew 个时间步内匹配验证码, 返回匹配的时间步
+func matchTOTP(key []byte, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	for i := -totpSkew; i <= totpSkew; i++ {
+		counter := current + int64(i)
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, uint64(counter))), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+// useRecoveryCode 校验恢复码, 命中后从管理员记录中移除
+func (r *TwoFactorRepo) useRecoveryCode(ctx context.Context, admin *ai_boilerplate_model.SysAdmin, code string) (bool, error) {
+	if admin.TwoFactorRecoveryCodes.String() == "" {
+		return false, nil
+	}
+	hashes := make([]string, 0)
+	err := jsonutil.Unmarshal(admin.TwoFactorRecoveryCodes, &hashes)
+	if err != nil {
+		return false, err
+	}
+	hash := hashRecoveryCode(code)
+	index := -1
+	for i, v := range hashes {
+		if subtle.ConstantTimeCompare([]byte(v), []byte(hash)) == 1 {
+			index = i
+		}
+	}
+	if index < 0 {
+		return false, nil
+	}
+	remaining, err := jsonutil.Marshal(append(hashes[:index:index], hashes[index+1:]...))
+	if err != nil {
+		return false, err
+	}
+	oldData := r.sysAdminRepo.DeepCopy(admin)
+	admin.TwoFactorRecoveryCodes = remaining
+	err = r.sysAdminRepo.UpdateOneCacheWithZero(ctx, admin, oldData)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// generateRecoveryCodes 生成恢复码, 返回明文(仅展示一次)与摘要(保存到管理员记录)
+func (r *TwoFactorRepo) generateRecoveryCodes() ([]string, datatypes.JSON, error) {
+	codes := make([]string, 0, twoFactorRecoveryCodeCount)
+	hashes := make([]string, 0, twoFactorRecoveryCodeCount)
+	b := make([]byte, twoFactorRecoveryCodeLength)
+	for range twoFactorRecoveryCodeCount {
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		chars := make([]byte, twoFactorRecoveryCodeLength)
+		for i, v := range b {
+			chars[i] = twoFactorRecoveryCodeAlphabet[int(v)%len(twoFactorRecoveryCodeAlphabet)]
+		}
+		half := twoFactorRecoveryCodeLength / 2
+		code := string(chars[:half]) + "-" + string(chars[half:])
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	value, err := jsonutil.Marshal(hashes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return codes, value, nil
+}
+
+// hashRecoveryCode 恢复码摘要, 忽略大小写与分隔符
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// totpCode 按 RFC 6238 计算指定时间步的验证码
+func totpCode(key []byte, counter uint64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for range totpDigits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
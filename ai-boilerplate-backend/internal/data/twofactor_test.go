@@ -0,0 +1,62 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+// testTOTPKey RFC 6238 附录 B 的 SHA1 测试密钥
+var testTOTPKey = []byte("12345678901234567890")
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 附录 B 的测试向量取后 6 位
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		counter := uint64(tt.unix / int64(totpPeriod.Seconds()))
+		if got := totpCode(testTOTPKey, counter); got != tt.want {
+			t.Errorf("totpCode(%d) = %q, want %q", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestMatchTOTP(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	codeAt := func(step int64) string {
+		return totpCode(testTOTPKey, uint64(current+step))
+	}
+	tests := []struct {
+		name        string
+		code        string
+		wantCounter int64
+		wantOK      bool
+	}{
+		{name: "current step", code: codeAt(0), wantCounter: current, wantOK: true},
+		{name: "previous step", code: codeAt(-1), wantCounter: current - 1, wantOK: true},
+		{name: "next step", code: codeAt(1), wantCounter: current + 1, wantOK: true},
+		{name: "with spaces", code: " " + codeAt(0) + " ", wantCounter: current, wantOK: true},
+		{name: "two steps before", code: codeAt(-2)},
+		{name: "two steps after", code: codeAt(2)},
+		{name: "too short", code: codeAt(0)[:5]},
+		{name: "too long", code: codeAt(0) + "0"},
+		{name: "empty", code: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter, ok := matchTOTP(testTOTPKey, tt.code, now)
+			if ok != tt.wantOK || counter != tt.wantCounter {
+				t.Errorf("matchTOTP(%q) = %d, %v, want %d, %v", tt.code, counter, ok, tt.wantCounter, tt.wantOK)
+			}
+		})
+	}
+}
@@ -2,8 +2,11 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
 	"github.com/fzf-labs/gopkg/jwt"
 	"github.com/fzf-labs/goutil/cryptutil"
@@ -18,24 +21,48 @@ func NewUserRepo(
 	userRepo *ai_boilerplate_repo.UserRepo,
 ) *UserRepo {
 	l := log.NewHelper(log.With(logger, "module", "data/user"))
+	cfg := data.cfg.GetBusiness()["jwt"].GetFields()["parent"].GetStructValue().GetFields()
 	jwt := jwt.NewJwt(&jwt.Config{
-		AccessSecret: data.cfg.GetBusiness()["jwt"].GetFields()["parent"].GetStructValue().GetFields()["accessSecret"].GetStringValue(),
-		AccessExpire: int64(data.cfg.GetBusiness()["jwt"].GetFields()["parent"].GetStructValue().GetFields()["accessExpire"].GetNumberValue()),
-		RefreshAfter: int64(data.cfg.GetBusiness()["jwt"].GetFields()["parent"].GetStructValue().GetFields()["refreshAfter"].GetNumberValue()),
-		Issuer:       data.cfg.GetBusiness()["jwt"].GetFields()["parent"].GetStructValue().GetFields()["issuer"].GetStringValue(),
+		AccessSecret: cfg["accessSecret"].GetStringValue(),
+		AccessExpire: int64(cfg["accessExpire"].GetNumberValue()),
+		RefreshAfter: int64(cfg["refreshAfter"].GetNumberValue()),
+		Issuer:       cfg["issuer"].GetStringValue(),
 	}, jwt.NewRueidisCache(data.rueidis))
+	accessTTL := time.Duration(cfg["accessExpire"].GetNumberValue()) * time.Second
+	if accessTTL <= 0 {
+		accessTTL = constant.UserTokenBlacklist.TTL()
+	}
+	refreshTTL := time.Duration(cfg["refreshExpire"].GetNumberValue()) * time.Second
+	if refreshTTL <= 0 {
+		refreshTTL = constant.UserRefreshToken.TTL()
+	}
 	return &UserRepo{
 		log:      l,
 		data:     data,
 		UserRepo: userRepo,
 		jwt:      jwt,
+		sessionStore: &tokenSessionStore{
+			data:       data,
+			accessTTL:  accessTTL,
+			refreshTTL: refreshTTL,
+			sessionKey: func(ownerID string) string {
+				return constant.UserSession.Key(ownerID)
+			},
+			refreshKey: func(sessionID string) string {
+				return constant.UserRefreshToken.Key(sessionID)
+			},
+			blacklistKey: func(sessionID string) string {
+				return constant.UserTokenBlacklist.Key(sessionID)
+			},
+		},
 	}
 }
 
 type UserRepo struct {
-	log  *log.Helper
-	data *Data
-	jwt  *jwt.Jwt
+	log          *log.Helper
+	data         *Data
+	jwt          *jwt.Jwt
+	sessionStore *tokenSessionStore
 	*ai_boilerplate_repo.UserRepo
 }
 
@@ -63,14 +90,74 @@ func (u *UserRepo) VerifyPassword(salt string, password string, hash string) boo
 	return cryptutil.Compare(hash, salt+password) == nil
 }
 
-// GenerateToken 生成token
-func (u *UserRepo) GenerateToken(ctx context.Context, userID string, wxGzhUserID string, wxGzhXcxID string) (*jwt.Token, error) {
-	_ = ctx
+// GenerateToken 生成访问 token 与刷新 token, 并保存登录会话
+func (u *UserRepo) GenerateToken(ctx context.Context, userID string, wxGzhUserID string, wxGzhXcxID string, session *TokenSession) (*TokenPair, error) {
+	sessionID := uuidutil.KSUIDByTime()
+	token, err := u.generateAccessToken(userID, wxGzhUserID, wxGzhXcxID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	session.ID = sessionID
+	session.LoginAt = now.Unix()
+	session.LastSeenAt = now.Unix()
+	session.ExpiredAt = now.Add(u.sessionStore.refreshTTL).Unix()
+	refreshToken, err := u.sessionStore.create(ctx, userID, session)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:      token.Token,
+		ExpiredAt:        token.ExpiredAt,
+		RefreshAt:        token.RefreshAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiredAt: session.ExpiredAt,
+	}, nil
+}
 
+// RefreshToken 使用刷新 token 换取新的访问 token 与刷新 token, 旧的刷新 token 随即失效
+func (u *UserRepo) RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	userID, sessionID, newRefreshToken, err := u.sessionStore.rotate(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.FindOneCacheByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// 用户已注销或被禁用时注销会话
+	if user == nil || user.ID == "" || user.Status != int32(constant.StatusEnable) {
+		err = u.sessionStore.revoke(ctx, userID, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenInvalid
+	}
+	token, err := u.generateAccessToken(user.ID, user.WxGzhUserID, user.WxGzhXcxID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	err = u.sessionStore.renew(ctx, userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:      token.Token,
+		ExpiredAt:        token.ExpiredAt,
+		RefreshAt:        token.RefreshAt,
+		RefreshToken:     newRefreshToken,
+		RefreshExpiredAt: time.Now().Add(u.sessionStore.refreshTTL).Unix(),
+	}, nil
+}
+
+// generateAccessToken 生成访问 token, sid 为登录会话编号, 同一会话内刷新的 token 共用
+func (u *UserRepo) generateAccessToken(userID, wxGzhUserID, wxGzhXcxID, sessionID string) (*jwt.Token, error) {
 	token, _, err := u.jwt.GenerateToken(map[string]any{
 		"uid":         userID,
 		"wxGzhUserId": wxGzhUserID,
 		"wxGzhXcxId":  wxGzhXcxID,
+		"jti":         uuidutil.KSUIDByTime(),
+		"sid":         sessionID,
 	})
 	if err != nil {
 		return nil, err
@@ -78,17 +165,47 @@ func (u *UserRepo) GenerateToken(ctx context.Context, userID string, wxGzhUserID
 	return token, nil
 }
 
-// CheckToken 检查token
+// CheckToken 检查token, 已注销或被强制下线的会话内的 token 视为无效
 func (u *UserRepo) CheckToken(ctx context.Context, token string) (map[string]any, error) {
-	_ = ctx
-
 	claims, err := u.jwt.ParseToken(token)
 	if err != nil {
 		return nil, err
 	}
+	sessionID, _ := claims["sid"].(string)
+	if sessionID == "" {
+		return nil, errors.New("token sid is empty")
+	}
+	revoked, err := u.sessionStore.revoked(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, errors.New("token is revoked")
+	}
+	if uid, ok := claims["uid"].(string); ok && uid != "" {
+		// 活跃时间仅用于会话展示, 刷新失败不影响鉴权
+		if touchErr := u.sessionStore.touch(ctx, uid, sessionID); touchErr != nil {
+			u.log.WithContext(ctx).Errorf("failed to touch user session %s: %v", sessionID, touchErr)
+		}
+	}
 	return claims, nil
 }
 
+// GetSessionList 获取用户的有效登录会话
+func (u *UserRepo) GetSessionList(ctx context.Context, userID string) ([]*TokenSession, error) {
+	return u.sessionStore.list(ctx, userID)
+}
+
+// RevokeSession 注销用户的登录会话, 会话内的访问 token 与刷新 token 全部失效
+func (u *UserRepo) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	return u.sessionStore.revoke(ctx, userID, sessionID)
+}
+
+// RevokeAllSessions 注销用户的全部登录会话, 用户被禁用或删除时使用
+func (u *UserRepo) RevokeAllSessions(ctx context.Context, userID string) error {
+	return u.sessionStore.revokeAll(ctx, userID)
+}
+
 // UserIdToNickname 根据userIds查询用户昵称
 func (u *UserRepo) UserIDToNickname(ctx context.Context, userIDs []string) (map[string]string, error) {
 	resp := make(map[string]string)
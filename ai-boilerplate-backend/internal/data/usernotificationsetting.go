@@ -1,7 +1,13 @@
 package data
 
 import (
+	"context"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/fzf-labs/godb/orm/condition"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -23,3 +29,90 @@ type UserNotificationSettingRepo struct {
 	data *Data
 	*ai_boilerplate_repo.UserNotificationSettingRepo
 }
+
+// DefaultSetting 用户未保存通知设置时使用的默认设置
+func (r *UserNotificationSettingRepo) DefaultSetting(userID string) *ai_boilerplate_model.UserNotificationSetting {
+	setting := r.NewData()
+	setting.UserID = userID
+	setting.SystemNotification = true
+	setting.ActivityNotification = true
+	setting.OrderNotification = true
+	setting.MessageNotification = true
+	setting.DndStartTime = "22:00"
+	setting.DndEndTime = "08:00"
+	return setting
+}
+
+// FindOneByUserIDOrDefault 查询用户的通知设置, 不存在时返回默认设置(不写入)
+func (r *UserNotificationSettingRepo) FindOneByUserIDOrDefault(ctx context.Context, userID string) (*ai_boilerplate_model.UserNotificationSetting, error) {
+	settings, _, err := r.FindMultiByCondition(ctx, &condition.Req{
+		Query: []*condition.QueryParam{
+			{
+				Field: "user_id",
+				Value: userID,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(settings) == 0 {
+		return r.DefaultSetting(userID), nil
+	}
+	return settings[0], nil
+}
+
+// CategoryEnabled 用户是否接收该类别的通知
+func CategoryEnabled(setting *ai_boilerplate_model.UserNotificationSetting, category constant.NotifyCategory) bool {
+	switch category {
+	case constant.NotifyCategorySystem:
+		return setting.SystemNotification
+	case constant.NotifyCategoryActivity:
+		return setting.ActivityNotification
+	case constant.NotifyCategoryOrder:
+		return setting.OrderNotification
+	case constant.NotifyCategoryMessage:
+		return setting.MessageNotification
+	}
+	return false
+}
+
+// DndDelay 处于勿扰时段时返回距勿扰结束的时长, 否则返回 0
+// 开始时间晚于结束时间表示跨天, 如 22:00 - 08:00
+// 勿扰时段按用户设置的时区比较, 未设置或时区无效时按 now 所在时区(服务器时区)比较
+func DndDelay(setting *ai_boilerplate_model.UserNotificationSetting, now time.Time) time.Duration {
+	if !setting.DndEnabled {
+		return 0
+	}
+	if setting.DndTimeZone != "" {
+		if loc, err := time.LoadLocation(setting.DndTimeZone); err == nil {
+			now = now.In(loc)
+		}
+	}
+	start, err := time.Parse("15:04", setting.DndStartTime)
+	if err != nil {
+		return 0
+	}
+	end, err := time.Parse("15:04", setting.DndEndTime)
+	if err != nil {
+		return 0
+	}
+	startAt := time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute
+	endAt := time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute
+	if startAt == endAt {
+		return 0
+	}
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	current := now.Sub(midnight)
+	switch {
+	case startAt < endAt && current >= startAt && current < endAt:
+		return endAt - current
+	case startAt > endAt && current >= startAt:
+		return 24*time.Hour - current + endAt
+	case startAt > endAt && current < endAt:
+		return endAt - current
+	}
+	return 0
+}
@@ -0,0 +1,54 @@
+package data
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // 测试环境可能没有时区数据
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+)
+
+func TestDndDelay(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 1, 2, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name     string
+		disabled bool
+		start    string
+		end      string
+		timeZone string
+		now      time.Time
+		want     time.Duration
+	}{
+		{name: "same day inside", start: "12:00", end: "14:00", now: at(13, 30), want: 30 * time.Minute},
+		{name: "same day start boundary", start: "12:00", end: "14:00", now: at(12, 0), want: 2 * time.Hour},
+		{name: "same day end boundary", start: "12:00", end: "14:00", now: at(14, 0), want: 0},
+		{name: "same day outside", start: "12:00", end: "14:00", now: at(9, 0), want: 0},
+		{name: "cross midnight before midnight", start: "22:00", end: "08:00", now: at(23, 0), want: 9 * time.Hour},
+		{name: "cross midnight after midnight", start: "22:00", end: "08:00", now: at(3, 0), want: 5 * time.Hour},
+		{name: "cross midnight start boundary", start: "22:00", end: "08:00", now: at(22, 0), want: 10 * time.Hour},
+		{name: "cross midnight end boundary", start: "22:00", end: "08:00", now: at(8, 0), want: 0},
+		{name: "cross midnight outside", start: "22:00", end: "08:00", now: at(12, 0), want: 0},
+		{name: "start equals end", start: "08:00", end: "08:00", now: at(8, 0), want: 0},
+		{name: "disabled", disabled: true, start: "22:00", end: "08:00", now: at(23, 0), want: 0},
+		{name: "invalid start time", start: "25:00", end: "08:00", now: at(23, 0), want: 0},
+		{name: "empty end time", start: "22:00", end: "", now: at(23, 0), want: 0},
+		{name: "user time zone", start: "22:00", end: "08:00", timeZone: "Asia/Shanghai", now: at(15, 0), want: 9 * time.Hour},
+		{name: "user time zone outside", start: "22:00", end: "08:00", timeZone: "Asia/Shanghai", now: at(12, 0), want: 0},
+		{name: "invalid time zone uses now location", start: "22:00", end: "08:00", timeZone: "Mars/Olympus_Mons", now: at(23, 0), want: 9 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setting := &ai_boilerplate_model.UserNotificationSetting{
+				DndEnabled:   !tt.disabled,
+				DndStartTime: tt.start,
+				DndEndTime:   tt.end,
+				DndTimeZone:  tt.timeZone,
+			}
+			if got := DndDelay(setting, tt.now); got != tt.want {
+				t.Errorf("DndDelay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
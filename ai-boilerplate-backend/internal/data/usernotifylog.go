@@ -0,0 +1,25 @@
+package data
+
+import (
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewUserNotifyLogRepo(
+	logger log.Logger,
+	data *Data,
+	userNotifyLogRepo *ai_boilerplate_repo.UserNotifyLogRepo,
+) *UserNotifyLogRepo {
+	l := log.NewHelper(log.With(logger, "module", "data/userNotifyLog"))
+	return &UserNotifyLogRepo{
+		log:               l,
+		data:              data,
+		UserNotifyLogRepo: userNotifyLogRepo,
+	}
+}
+
+type UserNotifyLogRepo struct {
+	log  *log.Helper
+	data *Data
+	*ai_boilerplate_repo.UserNotifyLogRepo
+}
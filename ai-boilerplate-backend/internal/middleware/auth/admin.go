@@ -12,7 +12,9 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -20,10 +22,33 @@ var AdminPrefixPathToWhiteList = map[string][]string{
 	// protobuf 路由 (operation 格式: /admin.v1.ServiceName/MethodName)
 	"/admin.": {
 		pb.OperationSysAuthSysAuthLogin,
-		pb.OperationSysAuthSysAuthLogout,
+		pb.OperationSysAuthSysAuthRefreshToken,
+		pb.OperationSysAuthSysAuthTwoFactorLogin,
+		pb.OperationSysAuthSysAuthTwoFactorLoginSetup,
 	},
 }
 
+// AdminAPIPermissionWhiteList 登录即可访问、不校验接口权限的 operation, 仅限操作自身数据的接口
+// 其余 /admin. 接口必须在 sys_api 中配置权限, 未配置的接口默认拒绝
+var AdminAPIPermissionWhiteList = []string{
+	pb.OperationSysAuthSysAuthAdminInfo,
+	pb.OperationSysAuthSysAuthLogout,
+	pb.OperationSysAuthSysAuthMenu,
+	pb.OperationSysAuthSysAuthPermission,
+	pb.OperationSysAuthSysAuthUpdateAdminInfo,
+	pb.OperationSysAuthSysAuthUpdateAdminPassword,
+	pb.OperationSysAuthSysAuthTwoFactorSetup,
+	pb.OperationSysAuthSysAuthTwoFactorEnable,
+	pb.OperationSysAuthSysAuthTwoFactorDisable,
+	pb.OperationSysAuthSysAuthTwoFactorRecoveryCodes,
+	pb.OperationSysNotifyMessageGetSysNotifyMessageMyList,
+	pb.OperationSysNotifyMessageGetSysNotifyMessageMyUnreadCount,
+	service.OperationSysNotifyMessageStream,
+	pb.OperationSysNotifyMessageGetSysNotifyMessageMyUnreadList,
+	pb.OperationSysNotifyMessageUpdateSysNotifyMessageRead,
+	pb.OperationSysNotifyMessageUpdateSysNotifyMessageAllRead,
+}
+
 // AdminAuthSelectorMiddleware 创建路由中间件
 func AdminAuthSelectorMiddleware(
 	adminV1SysAuthService *service.AdminV1SysAuthService,
@@ -65,6 +90,7 @@ func AdminAuth(
 				// 将JwtUID参数写进context中
 				ctx = meta.SetMetadata(ctx, constant.XMdAdminID, checkToken.AdminId)
 				ctx = meta.SetMetadata(ctx, constant.XMdTenantID, checkToken.TenantId)
+				ctx = meta.SetMetadata(ctx, constant.XMdSessionID, checkToken.SessionId)
 				// 获取真实IP，使用自定义函数
 				ip := GetClientIP(tr)
 				defer func() {
@@ -95,6 +121,17 @@ func AdminAuth(
 						})
 					}
 				}()
+				// 接口权限校验, 依次按 HTTP 路径与 operation 匹配接口配置
+				var operation string
+				if info, ok := transport.FromServerContext(ctx); ok {
+					operation = info.Operation()
+				}
+				if !lo.Contains(AdminAPIPermissionWhiteList, operation) {
+					err = adminV1SysAuthService.SysAuthCheckAPIPermission(ctx, checkToken.AdminId, tr.Method, tr.URL.Path, operation)
+					if err != nil {
+						return nil, err
+					}
+				}
 			}
 			return handler(ctx, req)
 		}
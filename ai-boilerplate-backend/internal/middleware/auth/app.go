@@ -17,6 +17,8 @@ var AppPrefixPathToWhiteList = map[string][]string{
 	// protobuf 路由 (operation 格式: /app.v1.ServiceName/MethodName)
 	"/app.": {
 		pb.OperationUserLogin,
+		pb.OperationUserSmsLogin,
+		pb.OperationUserRefreshToken,
 		pb.OperationUserSendVerifyCode,
 	},
 }
@@ -60,6 +62,7 @@ func AppAuth(
 				ctx = meta.SetMetadata(ctx, constant.XMdUserID, checkToken.UserId)
 				ctx = meta.SetMetadata(ctx, constant.XMdWxGzhUserID, checkToken.WxGzhUserId)
 				ctx = meta.SetMetadata(ctx, constant.XMdWxXcxUserID, checkToken.WxGzhXcxId)
+				ctx = meta.SetMetadata(ctx, constant.XMdSessionID, checkToken.SessionId)
 			}
 			return handler(ctx, req)
 		}
@@ -5,7 +5,10 @@ import (
 	"net"
 	"strings"
 
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/goutil/iputil"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/samber/lo"
@@ -23,6 +26,20 @@ func whiteListMatcher(whiteList map[string][]string) selector.MatchFunc {
 	}
 }
 
+// ClientInfo 将客户端 IP、UA 与设备写进context中, 登录等白名单接口同样可用
+func ClientInfo() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := http.RequestFromServerContext(ctx); ok {
+				ctx = meta.SetMetadata(ctx, constant.XMdIP, GetClientIP(tr))
+				ctx = meta.SetMetadata(ctx, constant.XMdUseragent, tr.UserAgent())
+				ctx = meta.SetMetadata(ctx, constant.XMdDevice, tr.Header.Get("X-Device"))
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
 // GetClientIP 获取客户端真实IP，优先从 X-Forwarded-For 和 X-Real-IP 获取，都不存在则从 RemoteAddr 获取
 func GetClientIP(r *http.Request) string {
 	// 尝试从 X-Forwarded-For 获取
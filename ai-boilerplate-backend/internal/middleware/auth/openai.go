@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/service"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+var OpenAIPrefixPathToWhiteList = map[string][]string{
+	// OpenAI 兼容网关路由 (operation 格式: /openai.v1.ServiceName/MethodName)
+	"/openai.": {},
+}
+
+// OpenAIAuthSelectorMiddleware 创建路由中间件
+func OpenAIAuthSelectorMiddleware(
+	openAIV1ChatService *service.OpenAIV1ChatService,
+) middleware.Middleware {
+	return selector.Server(
+		OpenAIAuth(openAIV1ChatService),
+	).Match(whiteListMatcher(OpenAIPrefixPathToWhiteList)).Build()
+}
+
+// OpenAIAuth 接口密钥校验
+func OpenAIAuth(
+	openAIV1ChatService *service.OpenAIV1ChatService,
+) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			if tr, ok := http.RequestFromServerContext(ctx); ok {
+				// 获取header头部中的Authorization的值
+				authorization := tr.Header.Get("Authorization")
+				// 不存在则报错
+				if authorization == "" {
+					return nil, pb.ErrorReasonTokenNotRequest()
+				}
+				// 密钥截取
+				var key string
+				_, err = fmt.Sscanf(authorization, "Bearer %s", &key)
+				if err != nil {
+					return nil, pb.ErrorReasonTokenFormatErr()
+				}
+				// 密钥校验
+				apiKey, err := openAIV1ChatService.CheckAPIKey(ctx, key)
+				if err != nil {
+					return nil, err
+				}
+				// 将密钥所属租户及创建人写进context中
+				ctx = meta.SetMetadata(ctx, constant.XMdAPIKeyID, apiKey.ID)
+				ctx = meta.SetMetadata(ctx, constant.XMdTenantID, apiKey.TenantID)
+				ctx = meta.SetMetadata(ctx, constant.XMdAdminID, apiKey.AdminID)
+				ctx = meta.SetMetadata(ctx, constant.XMdIP, GetClientIP(tr))
+			}
+			return handler(ctx, req)
+		}
+	}
+}
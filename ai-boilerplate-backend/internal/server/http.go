@@ -50,6 +50,7 @@ func NewHTTPServer(
 	adminV1SensitiveWordService *service.AdminV1SensitiveWordService,
 	adminV1UserService *service.AdminV1UserService,
 	adminV1UserMembershipService *service.AdminV1UserMembershipService,
+	adminV1UserNotifyLogService *service.AdminV1UserNotifyLogService,
 	adminV1MembershipService *service.AdminV1MembershipService,
 	adminV1MembershipBenefitService *service.AdminV1MembershipBenefitService,
 	adminV1SelfAppService *service.AdminV1SelfAppService,
@@ -70,18 +71,31 @@ func NewHTTPServer(
 	adminV1AiWriteRecordService *service.AdminV1AiWriteRecordService,
 	adminV1AiIndexPromptService *service.AdminV1AiIndexPromptService,
 	adminV1AiIndexChatService *service.AdminV1AiIndexChatService,
+	adminV1AiIndexImageService *service.AdminV1AiIndexImageService,
+	adminV1AiIndexVideoService *service.AdminV1AiIndexVideoService,
+	adminV1AiIndexAudioService *service.AdminV1AiIndexAudioService,
+	adminV1AiIndexWriteService *service.AdminV1AiIndexWriteService,
+	adminV1AiAPIKeyService *service.AdminV1AiAPIKeyService,
+	adminV1AiAPICallLogService *service.AdminV1AiAPICallLogService,
+	adminV1AiTokenUsageService *service.AdminV1AiTokenUsageService,
+	adminV1AiTokenQuotaService *service.AdminV1AiTokenQuotaService,
+	// OpenAI 兼容网关
+	openAIV1ChatService *service.OpenAIV1ChatService,
 	// App
 	appV1HomeService *service.AppV1HomeService,
 	appV1UserService *service.AppV1UserService,
 	appV1HelpFeedbackService *service.AppV1HelpFeedbackService,
 	appV1HelpFaqService *service.AppV1HelpFaqService,
 	appV1HelpCategoryService *service.AppV1HelpCategoryService,
+	appV1UserNotificationSettingService *service.AppV1UserNotificationSettingService,
 ) *http.Server {
 	srv := bootstrap.NewHTTPServer(
 		c,
 		logger,
+		auth.ClientInfo(),
 		auth.AdminAuthSelectorMiddleware(adminV1SysAuthService, adminV1SysOperateLogService),
 		auth.AppAuthSelectorMiddleware(appV1UserService),
+		auth.OpenAIAuthSelectorMiddleware(openAIV1ChatService),
 	)
 	// Admin v1 服务注册
 	adminv1.RegisterSysAuthHTTPServer(srv, adminV1SysAuthService)
@@ -118,6 +132,7 @@ func NewHTTPServer(
 	adminv1.RegisterSensitiveWordHTTPServer(srv, adminV1SensitiveWordService)
 	adminv1.RegisterUserHTTPServer(srv, adminV1UserService)
 	adminv1.RegisterUserMembershipHTTPServer(srv, adminV1UserMembershipService)
+	adminv1.RegisterUserNotifyLogHTTPServer(srv, adminV1UserNotifyLogService)
 	adminv1.RegisterMembershipHTTPServer(srv, adminV1MembershipService)
 	adminv1.RegisterMembershipBenefitHTTPServer(srv, adminV1MembershipBenefitService)
 	adminv1.RegisterSelfAppHTTPServer(srv, adminV1SelfAppService)
@@ -137,17 +152,34 @@ func NewHTTPServer(
 	adminv1.RegisterMallPaymentRecordHTTPServer(srv, adminV1MallPaymentRecordService)
 	adminv1.RegisterMallProductHTTPServer(srv, adminV1MallProductService)
 	adminv1.RegisterAiIndexChatHTTPServer(srv, adminV1AiIndexChatService)
+	adminv1.RegisterAiIndexImageHTTPServer(srv, adminV1AiIndexImageService)
+	adminv1.RegisterAiIndexVideoHTTPServer(srv, adminV1AiIndexVideoService)
+	adminv1.RegisterAiIndexAudioHTTPServer(srv, adminV1AiIndexAudioService)
+	adminv1.RegisterAiIndexWriteHTTPServer(srv, adminV1AiIndexWriteService)
+	adminv1.RegisterAiAPIKeyHTTPServer(srv, adminV1AiAPIKeyService)
+	adminv1.RegisterAiAPICallLogHTTPServer(srv, adminV1AiAPICallLogService)
+	adminv1.RegisterAiTokenUsageHTTPServer(srv, adminV1AiTokenUsageService)
+	adminv1.RegisterAiTokenQuotaHTTPServer(srv, adminV1AiTokenQuotaService)
 	// App v1 服务注册
 	appv1.RegisterHomeHTTPServer(srv, appV1HomeService)
 	appv1.RegisterUserHTTPServer(srv, appV1UserService)
 	appv1.RegisterHelpFeedbackHTTPServer(srv, appV1HelpFeedbackService)
 	appv1.RegisterHelpFaqHTTPServer(srv, appV1HelpFaqService)
 	appv1.RegisterHelpCategoryHTTPServer(srv, appV1HelpCategoryService)
+	appv1.RegisterUserNotificationSettingHTTPServer(srv, appV1UserNotificationSettingService)
 	// 自定义路由
 	adminRoute := srv.Route("/admin")
-	adminRoute.POST("/v1/ai_index_chat/completions", adminV1AiIndexChatService.AiIndexChatCompletionsHandler) // AI 聊天-聊天 ChatCompletions格式 (SSE 流式返回)
-	adminRoute.POST("/v1/wx_gzh_material/upload", adminV1WxGzhMaterialService.UploadWxGzhMaterialHandler)     // 上传素材
-	srv.HandleFunc("/wx_gzh_account/callback", adminV1WxGzhAccountService.OfficialAccountCallback)            // 公众号回调
+	adminRoute.POST("/v1/ai_index_chat/completions", adminV1AiIndexChatService.AiIndexChatCompletionsHandler)     // AI 聊天-聊天 ChatCompletions格式 (SSE 流式返回)
+	adminRoute.POST("/v1/ai_index_write/generate", adminV1AiIndexWriteService.AiIndexWriteGenerateHandler)        // AI 写作-生成 (SSE 流式返回)
+	adminRoute.POST("/v1/ai_index_write/regenerate", adminV1AiIndexWriteService.AiIndexWriteRegenerateHandler)    // AI 写作-重新生成 (SSE 流式返回)
+	adminRoute.POST("/v1/wx_gzh_material/upload", adminV1WxGzhMaterialService.UploadWxGzhMaterialHandler)         // 上传素材
+	adminRoute.GET("/v1/sys_notify_message/stream", adminV1SysNotifyMessageService.SysNotifyMessageStreamHandler) // 通知消息-实时事件 (SSE 长连接)
+	srv.Route("/v1").POST("/chat/completions", openAIV1ChatService.ChatCompletionsHandler)                        // OpenAI 兼容网关-对话补全
+	srv.HandleFunc("/wx_gzh_account/callback", adminV1WxGzhAccountService.OfficialAccountCallback)                // 公众号回调
+	srv.HandleFunc("/sms_channel/callback", adminV1SmsChannelService.SmsReceiptCallback)                          // 短信回执回调
+	srv.HandleFunc("/mail_account/bounce", adminV1MailAccountService.MailBounceCallback)                          // 邮件退信回调
+	srv.HandleFunc("/mail_log/track/open", adminV1MailLogService.MailTrackOpen)                                   // 邮件打开追踪
+	srv.HandleFunc("/mail_log/track/click", adminV1MailLogService.MailTrackClick)                                 // 邮件点击追踪
 
 	return srv
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/dromara/carbon/v2"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/service"
 	conf "github.com/fzf-labs/kratos-contrib/api/conf/v1"
 	"github.com/fzf-labs/kratos-contrib/pkg/mq"
 	"github.com/go-kratos/kratos/v2/log"
@@ -15,6 +16,13 @@ import (
 func NewMQServer(
 	c *conf.Bootstrap,
 	logger log.Logger,
+	adminV1AiIndexImageService *service.AdminV1AiIndexImageService,
+	adminV1AiIndexVideoService *service.AdminV1AiIndexVideoService,
+	adminV1AiIndexAudioService *service.AdminV1AiIndexAudioService,
+	adminV1MailTemplateService *service.AdminV1MailTemplateService,
+	adminV1UserNotifyLogService *service.AdminV1UserNotifyLogService,
+	adminV1SysNoticeService *service.AdminV1SysNoticeService,
+	adminV1DeviceService *service.AdminV1DeviceService,
 ) mq.Server {
 	redisClientOpt := asynq.RedisClientOpt{
 		Addr:     c.Data.Redis.Addr,
@@ -23,6 +31,14 @@ func NewMQServer(
 	}
 	srv := mq.NewAsynqServer(logger, redisClientOpt, mq.NwDefaultAsynqConfig(), mq.NewDefaultSchedulerOpts(logger))
 	srv.ConsumerCronRegister(constant.MQTest, test, "@every 5s") // 每5秒执行一次
+	srv.ConsumerRegister(constant.MQAiImageGenerate, adminV1AiIndexImageService.GenerateAiIndexImage)
+	srv.ConsumerCronRegister(constant.MQAiVideoTask, adminV1AiIndexVideoService.ProcessAiIndexVideoTask, "@every 10s") // 每10秒提交与轮询视频任务
+	srv.ConsumerRegister(constant.MQAiAudioGenerate, adminV1AiIndexAudioService.GenerateAiIndexAudio)
+	srv.ConsumerRegister(constant.MQMailSend, adminV1MailTemplateService.ConsumeMailSend)
+	srv.ConsumerRegister(constant.MQNotifyDispatch, adminV1UserNotifyLogService.ConsumeNotifyDispatch)
+	srv.ConsumerRegister(constant.MQSysNoticePublish, adminV1SysNoticeService.ConsumeSysNoticePublish)
+	srv.ConsumerRegister(constant.MQSysNoticeExpire, adminV1SysNoticeService.ConsumeSysNoticeExpire)
+	srv.ConsumerRegister(constant.MQDevicePush, adminV1DeviceService.ConsumeDevicePush)
 	return srv
 }
 
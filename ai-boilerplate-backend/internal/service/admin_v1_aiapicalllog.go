@@ -0,0 +1,24 @@
+package service
+
+import (
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewAdminV1AiAPICallLogService(
+	logger log.Logger,
+	aiAPICallLogRepo *data.AiAPICallLogRepo,
+) *AdminV1AiAPICallLogService {
+	l := log.NewHelper(log.With(logger, "module", "service/aiAPICallLog"))
+	return &AdminV1AiAPICallLogService{
+		log:              l,
+		aiAPICallLogRepo: aiAPICallLogRepo,
+	}
+}
+
+type AdminV1AiAPICallLogService struct {
+	pb.UnimplementedAiAPICallLogServer
+	log              *log.Helper
+	aiAPICallLogRepo *data.AiAPICallLogRepo
+}
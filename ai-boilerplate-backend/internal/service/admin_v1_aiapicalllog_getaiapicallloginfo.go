@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// GetAiAPICallLogInfo AI 接口调用日志表-单条数据查询
+func (a *AdminV1AiAPICallLogService) GetAiAPICallLogInfo(ctx context.Context, req *pb.GetAiAPICallLogInfoReq) (*pb.GetAiAPICallLogInfoReply, error) {
+	resp := &pb.GetAiAPICallLogInfoReply{}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	data, err := a.aiAPICallLogRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前租户的日志
+	if data.TenantID != tenantID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	resp.Info = &pb.AiAPICallLogInfo{
+		Id:               data.ID,
+		ApiKeyId:         data.APIKeyID,
+		Model:            data.Model,
+		ModelId:          data.ModelID,
+		Stream:           data.Stream,
+		Success:          data.Success,
+		ErrorMessage:     data.ErrorMessage,
+		PromptTokens:     data.PromptTokens,
+		CompletionTokens: data.CompletionTokens,
+		TotalTokens:      data.TotalTokens,
+		Duration:         data.Duration,
+		IP:               data.IP,
+		CreatedAt:        data.CreatedAt.Format(time.RFC3339),
+	}
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// GetAiAPICallLogList AI 接口调用日志表-列表数据查询
+func (a *AdminV1AiAPICallLogService) GetAiAPICallLogList(ctx context.Context, req *pb.GetAiAPICallLogListReq) (*pb.GetAiAPICallLogListReply, error) {
+	resp := &pb.GetAiAPICallLogListReply{
+		Total: 0,
+		List:  []*pb.AiAPICallLogInfo{},
+	}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	param := &condition.Req{
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+		Query: []*condition.QueryParam{
+			{
+				Field: "tenant_id",
+				Value: tenantID,
+			},
+		},
+		Order: []*condition.OrderParam{
+			{
+				Field: "created_at",
+				Order: condition.DESC,
+			},
+		},
+	}
+	if req.GetApiKeyId() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "api_key_id",
+			Value: req.GetApiKeyId(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if req.GetModel() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "model",
+			Value: req.GetModel(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if len(req.GetCreatedAt()) > 1 {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "created_at",
+			Value: req.GetCreatedAt()[0],
+			Exp:   condition.GTE,
+			Logic: condition.AND,
+		})
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "created_at",
+			Value: req.GetCreatedAt()[1],
+			Exp:   condition.LTE,
+			Logic: condition.AND,
+		})
+	}
+	list, p, err := a.aiAPICallLogRepo.FindMultiCacheByCondition(ctx, param)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.Total = p.Total
+	if len(list) > 0 {
+		for _, v := range list {
+			resp.List = append(resp.List, &pb.AiAPICallLogInfo{
+				Id:               v.ID,
+				ApiKeyId:         v.APIKeyID,
+				Model:            v.Model,
+				ModelId:          v.ModelID,
+				Stream:           v.Stream,
+				Success:          v.Success,
+				ErrorMessage:     v.ErrorMessage,
+				PromptTokens:     v.PromptTokens,
+				CompletionTokens: v.CompletionTokens,
+				TotalTokens:      v.TotalTokens,
+				Duration:         v.Duration,
+				IP:               v.IP,
+				CreatedAt:        v.CreatedAt.Format(time.RFC3339),
+			})
+		}
+	}
+	return resp, nil
+}
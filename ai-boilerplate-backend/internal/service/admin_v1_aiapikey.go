@@ -0,0 +1,27 @@
+package service
+
+import (
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewAdminV1AiAPIKeyService(
+	logger log.Logger,
+	aiAPIKeyRepo *data.AiAPIKeyRepo,
+	dataScopeRepo *data.DataScopeRepo,
+) *AdminV1AiAPIKeyService {
+	l := log.NewHelper(log.With(logger, "module", "service/aiAPIKey"))
+	return &AdminV1AiAPIKeyService{
+		log:           l,
+		aiAPIKeyRepo:  aiAPIKeyRepo,
+		dataScopeRepo: dataScopeRepo,
+	}
+}
+
+type AdminV1AiAPIKeyService struct {
+	pb.UnimplementedAiAPIKeyServer
+	log           *log.Helper
+	aiAPIKeyRepo  *data.AiAPIKeyRepo
+	dataScopeRepo *data.DataScopeRepo
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dromara/carbon/v2"
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/goutil/timeutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// CreateAiAPIKey AI 接口密钥表-创建一条数据
+func (a *AdminV1AiAPIKeyService) CreateAiAPIKey(ctx context.Context, req *pb.CreateAiAPIKeyReq) (*pb.CreateAiAPIKeyReply, error) {
+	resp := &pb.CreateAiAPIKeyReply{}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	key, keyPrefix, keyHash, err := a.aiAPIKeyRepo.GenerateKey()
+	if err != nil {
+		return nil, pb.ErrorReasonAPIInternalErr(pb.WithError(err))
+	}
+	data := a.aiAPIKeyRepo.NewData()
+	data.TenantID = tenantID
+	data.AdminID = adminID
+	data.Name = req.GetName()
+	data.KeyPrefix = keyPrefix
+	data.KeyHash = keyHash
+	data.Status = req.GetStatus()
+	data.ExpiredAt = timeutil.TimeToSQLNullTime(carbon.Parse(req.GetExpiredAt()).StdTime())
+	err = a.aiAPIKeyRepo.CreateOneCache(ctx, data)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.Id = data.ID
+	// 明文密钥只在创建时返回一次
+	resp.Key = key
+	return resp, nil
+}
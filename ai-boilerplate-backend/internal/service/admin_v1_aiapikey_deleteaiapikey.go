@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// DeleteAiAPIKey AI 接口密钥表-删除一条数据
+func (a *AdminV1AiAPIKeyService) DeleteAiAPIKey(ctx context.Context, req *pb.DeleteAiAPIKeyReq) (*pb.DeleteAiAPIKeyReply, error) {
+	resp := &pb.DeleteAiAPIKeyReply{}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	data, err := a.aiAPIKeyRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前租户的密钥
+	if data.TenantID != tenantID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	err = a.aiAPIKeyRepo.DeleteOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	return resp, nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/goutil/timeutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// GetAiAPIKeyInfo AI 接口密钥表-单条数据查询
+func (a *AdminV1AiAPIKeyService) GetAiAPIKeyInfo(ctx context.Context, req *pb.GetAiAPIKeyInfoReq) (*pb.GetAiAPIKeyInfoReply, error) {
+	resp := &pb.GetAiAPIKeyInfoReply{}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	data, err := a.aiAPIKeyRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前租户的密钥
+	if data.TenantID != tenantID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	resp.Info = &pb.AiAPIKeyInfo{
+		Id:         data.ID,
+		AdminId:    data.AdminID,
+		Name:       data.Name,
+		KeyPrefix:  data.KeyPrefix,
+		Status:     data.Status,
+		ExpiredAt:  timeutil.RFC3339(data.ExpiredAt.Time),
+		LastUsedAt: timeutil.RFC3339(data.LastUsedAt.Time),
+		CreatedAt:  data.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  data.UpdatedAt.Format(time.RFC3339),
+	}
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/goutil/timeutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// GetAiAPIKeyList AI 接口密钥表-列表数据查询
+func (a *AdminV1AiAPIKeyService) GetAiAPIKeyList(ctx context.Context, req *pb.GetAiAPIKeyListReq) (*pb.GetAiAPIKeyListReply, error) {
+	resp := &pb.GetAiAPIKeyListReply{
+		Total: 0,
+		List:  []*pb.AiAPIKeyInfo{},
+	}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	param := &condition.Req{
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+		Query: []*condition.QueryParam{
+			{
+				Field: "tenant_id",
+				Value: tenantID,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+		},
+		Order: []*condition.OrderParam{
+			{
+				Field: "created_at",
+				Order: condition.DESC,
+			},
+		},
+	}
+	if req.GetName() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "name",
+			Value: "%" + req.GetName() + "%",
+			Exp:   condition.LIKE,
+			Logic: condition.AND,
+		})
+	}
+	if req.GetStatus() != 0 {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "status",
+			Value: req.GetStatus(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	// 按角色数据范围过滤
+	dataScope, err := a.dataScopeRepo.GetDataScope(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID))
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	param.Query = append(param.Query, dataScope.AdminQueryParams("admin_id")...)
+	list, p, err := a.aiAPIKeyRepo.FindMultiCacheByCondition(ctx, param)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.Total = p.Total
+	if len(list) > 0 {
+		for _, v := range list {
+			resp.List = append(resp.List, &pb.AiAPIKeyInfo{
+				Id:         v.ID,
+				AdminId:    v.AdminID,
+				Name:       v.Name,
+				KeyPrefix:  v.KeyPrefix,
+				Status:     v.Status,
+				ExpiredAt:  timeutil.RFC3339(v.ExpiredAt.Time),
+				LastUsedAt: timeutil.RFC3339(v.LastUsedAt.Time),
+				CreatedAt:  v.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:  v.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dromara/carbon/v2"
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/goutil/timeutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// UpdateAiAPIKey AI 接口密钥表-更新一条数据
+func (a *AdminV1AiAPIKeyService) UpdateAiAPIKey(ctx context.Context, req *pb.UpdateAiAPIKeyReq) (*pb.UpdateAiAPIKeyReply, error) {
+	resp := &pb.UpdateAiAPIKeyReply{}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	data, err := a.aiAPIKeyRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前租户的密钥
+	if data.TenantID != tenantID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	oldData := a.aiAPIKeyRepo.DeepCopy(data)
+	data.Name = req.GetName()
+	data.Status = req.GetStatus()
+	data.ExpiredAt = timeutil.TimeToSQLNullTime(carbon.Parse(req.GetExpiredAt()).StdTime())
+	err = a.aiAPIKeyRepo.UpdateOneCacheWithZero(ctx, data, oldData)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	return resp, nil
+}
@@ -9,11 +9,13 @@ import (
 func NewAdminV1AiAudioRecordService(
 	logger log.Logger,
 	aiAudioRecordRepo *data.AiAudioRecordRepo,
+	dataScopeRepo *data.DataScopeRepo,
 ) *AdminV1AiAudioRecordService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiAudioRecord"))
 	return &AdminV1AiAudioRecordService{
 		log:               l,
 		aiAudioRecordRepo: aiAudioRecordRepo,
+		dataScopeRepo:     dataScopeRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1AiAudioRecordService struct {
 	pb.UnimplementedAiAudioRecordServer
 	log               *log.Helper
 	aiAudioRecordRepo *data.AiAudioRecordRepo
+	dataScopeRepo     *data.DataScopeRepo
 }
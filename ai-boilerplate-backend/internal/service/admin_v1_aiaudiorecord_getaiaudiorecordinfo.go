@@ -5,6 +5,8 @@ import (
 	"time"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // GetAiAudioRecordInfo AI 音乐表-单条数据查询
@@ -14,6 +16,17 @@ func (a *AdminV1AiAudioRecordService) GetAiAudioRecordInfo(ctx context.Context,
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	resp.Info = &pb.AiAudioRecordInfo{
 		Id:           data.ID,
 		TenantId:     data.TenantID,
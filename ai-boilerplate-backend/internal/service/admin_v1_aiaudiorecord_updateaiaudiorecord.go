@@ -4,6 +4,8 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // UpdateAiAudioRecord AI 音乐表-更新一条数据
@@ -16,6 +18,14 @@ func (a *AdminV1AiAudioRecordService) UpdateAiAudioRecord(ctx context.Context, r
 	if data == nil || data.ID == "" {
 		return nil, pb.ErrorReasonDataRecordNotFound()
 	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	oldData := a.aiAudioRecordRepo.DeepCopy(data)
 	data.TenantID = req.GetTenantId()
 	data.AdminID = req.GetAdminId()
@@ -9,11 +9,13 @@ import (
 func NewAdminV1AiChatConversationService(
 	logger log.Logger,
 	aiChatConversationRepo *data.AiChatConversationRepo,
+	dataScopeRepo *data.DataScopeRepo,
 ) *AdminV1AiChatConversationService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiChatConversation"))
 	return &AdminV1AiChatConversationService{
 		log:                    l,
 		aiChatConversationRepo: aiChatConversationRepo,
+		dataScopeRepo:          dataScopeRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1AiChatConversationService struct {
 	pb.UnimplementedAiChatConversationServer
 	log                    *log.Helper
 	aiChatConversationRepo *data.AiChatConversationRepo
+	dataScopeRepo          *data.DataScopeRepo
 }
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // GetAiChatConversationInfo AI 聊天对话表-单条数据查询
@@ -15,6 +17,17 @@ func (a *AdminV1AiChatConversationService) GetAiChatConversationInfo(ctx context
 	if err != nil {
 		return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
 	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	promptSetting := &pb.AiChatConversationInfo_PromptSetting{}
 	err = jsonutil.Unmarshal(data.PromptSetting, promptSetting)
 	if err != nil {
@@ -5,8 +5,10 @@ import (
 	"time"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/godb/orm/condition"
 	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // GetAiChatConversationList AI 聊天对话表-列表数据查询
@@ -26,6 +28,12 @@ func (a *AdminV1AiChatConversationService) GetAiChatConversationList(ctx context
 			},
 		},
 	}
+	// 按角色数据范围过滤
+	dataScope, err := a.dataScopeRepo.GetDataScope(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID))
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	param.Query = append(param.Query, dataScope.AdminQueryParams("admin_id")...)
 	list, p, err := a.aiChatConversationRepo.FindMultiCacheByCondition(ctx, param)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
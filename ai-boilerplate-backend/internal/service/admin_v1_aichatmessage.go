@@ -9,11 +9,13 @@ import (
 func NewAdminV1AiChatMessageService(
 	logger log.Logger,
 	aiChatMessageRepo *data.AiChatMessageRepo,
+	dataScopeRepo *data.DataScopeRepo,
 ) *AdminV1AiChatMessageService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiChatMessage"))
 	return &AdminV1AiChatMessageService{
 		log:               l,
 		aiChatMessageRepo: aiChatMessageRepo,
+		dataScopeRepo:     dataScopeRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1AiChatMessageService struct {
 	pb.UnimplementedAiChatMessageServer
 	log               *log.Helper
 	aiChatMessageRepo *data.AiChatMessageRepo
+	dataScopeRepo     *data.DataScopeRepo
 }
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // GetAiChatMessageList AI 聊天消息表-列表数据查询
@@ -25,6 +27,12 @@ func (a *AdminV1AiChatMessageService) GetAiChatMessageList(ctx context.Context,
 			},
 		},
 	}
+	// 按角色数据范围过滤
+	dataScope, err := a.dataScopeRepo.GetDataScope(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID))
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	param.Query = append(param.Query, dataScope.AdminQueryParams("admin_id")...)
 	list, p, err := a.aiChatMessageRepo.FindMultiCacheByCondition(ctx, param)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
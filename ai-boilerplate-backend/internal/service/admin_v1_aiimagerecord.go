@@ -9,11 +9,13 @@ import (
 func NewAdminV1AiImageRecordService(
 	logger log.Logger,
 	aiImageRecordRepo *data.AiImageRecordRepo,
+	dataScopeRepo *data.DataScopeRepo,
 ) *AdminV1AiImageRecordService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiImageRecord"))
 	return &AdminV1AiImageRecordService{
 		log:               l,
 		aiImageRecordRepo: aiImageRecordRepo,
+		dataScopeRepo:     dataScopeRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1AiImageRecordService struct {
 	pb.UnimplementedAiImageRecordServer
 	log               *log.Helper
 	aiImageRecordRepo *data.AiImageRecordRepo
+	dataScopeRepo     *data.DataScopeRepo
 }
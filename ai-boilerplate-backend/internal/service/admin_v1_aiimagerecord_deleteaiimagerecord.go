@@ -4,12 +4,29 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // DeleteAiImageRecord AI 绘画表-删除一条数据
 func (a *AdminV1AiImageRecordService) DeleteAiImageRecord(ctx context.Context, req *pb.DeleteAiImageRecordReq) (*pb.DeleteAiImageRecordReply, error) {
 	resp := &pb.DeleteAiImageRecordReply{}
-	err := a.aiImageRecordRepo.DeleteOneCacheByID(ctx, req.GetId())
+	data, err := a.aiImageRecordRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	err = a.aiImageRecordRepo.DeleteOneCacheByID(ctx, req.GetId())
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
@@ -22,6 +22,14 @@ func (a *AdminV1AiImageRecordService) UpdateAiImageRecord(ctx context.Context, r
 	if data == nil || data.ID == "" {
 		return nil, pb.ErrorReasonDataRecordNotFound()
 	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	oldData := a.aiImageRecordRepo.DeepCopy(data)
 	data.TenantID = tenantID
 	data.AdminID = req.GetAdminId()
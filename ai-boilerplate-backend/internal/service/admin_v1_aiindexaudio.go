@@ -1,24 +1,55 @@
 package service
 
 import (
+	"context"
+
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/kratos-contrib/meta"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 func NewAdminV1AiIndexAudioService(
 	logger log.Logger,
 	aiAudioRecordRepo *data.AiAudioRecordRepo,
+	aiProviderModelRepo *data.AiProviderModelRepo,
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo,
+	sysNotifyMessageRepo *data.SysNotifyMessageRepo,
 ) *AdminV1AiIndexAudioService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiIndexAudio"))
 	return &AdminV1AiIndexAudioService{
-		log:               l,
-		aiAudioRecordRepo: aiAudioRecordRepo,
+		log:                    l,
+		aiAudioRecordRepo:      aiAudioRecordRepo,
+		aiProviderModelRepo:    aiProviderModelRepo,
+		aiProviderPlatformRepo: aiProviderPlatformRepo,
+		sysNotifyMessageRepo:   sysNotifyMessageRepo,
 	}
 }
 
 type AdminV1AiIndexAudioService struct {
 	pb.UnimplementedAiIndexAudioServer
-	log               *log.Helper
-	aiAudioRecordRepo *data.AiAudioRecordRepo
+	log                    *log.Helper
+	aiAudioRecordRepo      *data.AiAudioRecordRepo
+	aiProviderModelRepo    *data.AiProviderModelRepo
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo
+	sysNotifyMessageRepo   *data.SysNotifyMessageRepo
+}
+
+// getAiIndexAudioRecord 获取当前用户的音乐记录
+func (a *AdminV1AiIndexAudioService) getAiIndexAudioRecord(ctx context.Context, id string) (*ai_boilerplate_model.AiAudioRecord, error) {
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	data, err := a.aiAudioRecordRepo.FindOneCacheByID(ctx, id)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前用户的音乐
+	if data.AdminID != adminID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	return data, nil
 }
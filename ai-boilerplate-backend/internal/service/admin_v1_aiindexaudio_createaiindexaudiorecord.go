@@ -2,13 +2,76 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // CreateAiIndexAudioRecord AI 音乐表-创建一条数据
-func (a *AdminV1AiIndexAudioService) CreateAiIndexAudioRecord(_ context.Context, _ *pb.CreateAiIndexAudioRecordReq) (*pb.CreateAiIndexAudioRecordReply, error) {
+// 创建排队中的音乐记录并投递生成任务, 由 GenerateAiIndexAudio 异步生成
+func (a *AdminV1AiIndexAudioService) CreateAiIndexAudioRecord(ctx context.Context, req *pb.CreateAiIndexAudioRecordReq) (*pb.CreateAiIndexAudioRecordReply, error) {
 	resp := &pb.CreateAiIndexAudioRecordReply{}
-	// TODO
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	prompt := ""
+	switch constant.AiAudioGenerateMode(req.GetGenerateMode()) {
+	case constant.AiAudioGenerateModeDescription:
+		if req.GetDescription() == "" {
+			return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("description is required")))
+		}
+		prompt = req.GetDescription()
+	case constant.AiAudioGenerateModeLyric:
+		if req.GetLyric() == "" && !req.GetInstrumental() {
+			return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("lyric is required")))
+		}
+		prompt = req.GetLyric()
+	default:
+		return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("generate mode is not supported")))
+	}
+	providerModel, err := a.aiProviderModelRepo.FindOneCacheByID(ctx, req.GetModelId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if providerModel == nil || providerModel.ID == "" || providerModel.Status != int32(constant.StatusEnable) {
+		return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("model is not found")))
+	}
+	platform, err := a.aiProviderPlatformRepo.FindOneCacheByID(ctx, providerModel.PlatformID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if platform == nil || platform.ID == "" || platform.Status != int32(constant.StatusEnable) {
+		return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("platform is not found")))
+	}
+	data := a.aiAudioRecordRepo.NewData()
+	data.TenantID = tenantID
+	data.AdminID = adminID
+	data.Title = req.GetTitle()
+	data.Description = req.GetDescription()
+	data.Lyric = req.GetLyric()
+	data.Tags = req.GetTags()
+	data.Prompt = prompt
+	data.Platform = platform.Platform
+	data.ModelID = providerModel.ID
+	data.Model = providerModel.ModelID
+	data.GenerateMode = req.GetGenerateMode()
+	data.Status = int32(constant.AiAudioStatusPending)
+	err = a.aiAudioRecordRepo.CreateOneCache(ctx, data)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	err = a.aiAudioRecordRepo.SendGenerateTask(ctx, data.ID, req.GetInstrumental())
+	if err != nil {
+		// 任务投递失败时标记记录失败, 避免记录一直处于排队中
+		oldData := a.aiAudioRecordRepo.DeepCopy(data)
+		data.Status = int32(constant.AiAudioStatusFailed)
+		data.ErrorMessage = "任务投递失败"
+		if updateErr := a.aiAudioRecordRepo.UpdateOneCacheWithZero(ctx, data, oldData); updateErr != nil {
+			a.log.WithContext(ctx).Errorf("failed to update audio record %s: %v", data.ID, updateErr)
+		}
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	resp.Id = data.ID
 	return resp, nil
 }
@@ -7,8 +7,15 @@ import (
 )
 
 // DeleteAiIndexAudioRecord AI 音乐表-删除一条数据
-func (a *AdminV1AiIndexAudioService) DeleteAiIndexAudioRecord(_ context.Context, _ *pb.DeleteAiIndexAudioRecordReq) (*pb.DeleteAiIndexAudioRecordReply, error) {
+func (a *AdminV1AiIndexAudioService) DeleteAiIndexAudioRecord(ctx context.Context, req *pb.DeleteAiIndexAudioRecordReq) (*pb.DeleteAiIndexAudioRecordReply, error) {
 	resp := &pb.DeleteAiIndexAudioRecordReply{}
-	// TODO
+	_, err := a.getAiIndexAudioRecord(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	err = a.aiAudioRecordRepo.DeleteOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
 	return resp, nil
 }
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+)
+
+const (
+	// 音乐任务轮询间隔
+	aiIndexAudioPollInterval = 5 * time.Second
+	// 音乐任务超时时间
+	aiIndexAudioTimeout = 10 * time.Minute
+	// 音乐错误信息最大长度(字段长度 1024)
+	aiIndexAudioErrorMessageMaxLen = 1000
+)
+
+// GenerateAiIndexAudio AI 音乐-消费生成任务
+// 提交任务到音乐平台并轮询结果, 回填音乐名称、歌词、封面、音频地址与时长
+// 生成失败记录在音乐记录上, 不再重试, 避免重复调用音乐平台
+func (a *AdminV1AiIndexAudioService) GenerateAiIndexAudio(ctx context.Context, payload []byte) error {
+	msg := &data.AiAudioGenerateMessage{}
+	err := json.Unmarshal(payload, msg)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to unmarshal audio generate message: %v", err)
+		return nil
+	}
+	record, err := a.aiAudioRecordRepo.FindOneCacheByID(ctx, msg.ID)
+	if err != nil {
+		return err
+	}
+	if record == nil || record.ID == "" {
+		return nil
+	}
+	// 生成中的记录说明上次消费被中断, 已提交的任务继续轮询
+	if record.Status != int32(constant.AiAudioStatusPending) && record.Status != int32(constant.AiAudioStatusRunning) {
+		return nil
+	}
+	result, genErr := a.generateAiIndexAudio(ctx, record, msg.Instrumental)
+	oldData := a.aiAudioRecordRepo.DeepCopy(record)
+	if genErr != nil {
+		a.log.WithContext(ctx).Errorf("failed to generate audio %s: %v", record.ID, genErr)
+		errorMessage := []rune(genErr.Error())
+		if len(errorMessage) > aiIndexAudioErrorMessageMaxLen {
+			errorMessage = errorMessage[:aiIndexAudioErrorMessageMaxLen]
+		}
+		record.Status = int32(constant.AiAudioStatusFailed)
+		record.ErrorMessage = string(errorMessage)
+	} else {
+		record.Status = int32(constant.AiAudioStatusSucceeded)
+		record.ErrorMessage = ""
+		record.AudioURL = result.AudioURL
+		record.ImageURL = result.ImageURL
+		record.Duration = result.Duration
+		if result.Title != "" {
+			record.Title = result.Title
+		}
+		if result.Lyric != "" {
+			record.Lyric = result.Lyric
+		}
+		if result.Tags != "" {
+			record.Tags = result.Tags
+		}
+	}
+	err = a.aiAudioRecordRepo.UpdateOneCacheWithZero(ctx, record, oldData)
+	if err != nil {
+		return err
+	}
+	a.sysNotifyMessageRepo.PublishAiJob(ctx, record.AdminID, &data.SysNotifyEventAiJob{
+		JobType:      constant.AiJobTypeAudio.String(),
+		ID:           record.ID,
+		Status:       record.Status,
+		ErrorMessage: record.ErrorMessage,
+	})
+	return nil
+}
+
+// generateAiIndexAudio 提交任务并轮询至完成, 任务编号提交后立即保存, 消费中断后可继续轮询
+func (a *AdminV1AiIndexAudioService) generateAiIndexAudio(ctx context.Context, record *ai_boilerplate_model.AiAudioRecord, instrumental bool) (*data.AiAudioGenerateResult, error) {
+	providerModel, err := a.aiProviderModelRepo.FindOneCacheByID(ctx, record.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	if providerModel == nil || providerModel.ID == "" {
+		return nil, errors.New("model is not found")
+	}
+	platform, err := a.aiProviderPlatformRepo.FindOneCacheByID(ctx, providerModel.PlatformID)
+	if err != nil {
+		return nil, err
+	}
+	if platform == nil || platform.ID == "" || platform.Status != int32(constant.StatusEnable) {
+		return nil, errors.New("platform is not found")
+	}
+	provider := a.aiAudioRecordRepo.GetProvider(platform.Platform)
+	if record.TaskID == "" {
+		taskID, err := provider.Submit(ctx, platform, &data.AiAudioGenerateReq{
+			Model:        record.Model,
+			Mode:         constant.AiAudioGenerateMode(record.GenerateMode),
+			Title:        record.Title,
+			Description:  record.Description,
+			Lyric:        record.Lyric,
+			Tags:         record.Tags,
+			Instrumental: instrumental,
+		})
+		if err != nil {
+			return nil, err
+		}
+		oldData := a.aiAudioRecordRepo.DeepCopy(record)
+		record.Status = int32(constant.AiAudioStatusRunning)
+		record.TaskID = taskID
+		err = a.aiAudioRecordRepo.UpdateOneCacheWithZero(ctx, record, oldData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, aiIndexAudioTimeout)
+	defer cancel()
+	ticker := time.NewTicker(aiIndexAudioPollInterval)
+	defer ticker.Stop()
+	for {
+		result, err := provider.Query(ctx, platform, record.TaskID)
+		if err != nil {
+			// 查询失败视为临时错误, 等待下次轮询
+			a.log.WithContext(ctx).Warnf("failed to query audio task %s: %v", record.TaskID, err)
+		} else {
+			switch result.Status {
+			case constant.AiAudioStatusSucceeded:
+				return result, nil
+			case constant.AiAudioStatusFailed:
+				return nil, errors.New(result.ErrorMessage)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.New("任务超时")
+		case <-ticker.C:
+		}
+	}
+}
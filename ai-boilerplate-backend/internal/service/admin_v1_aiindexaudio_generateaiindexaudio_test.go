@@ -0,0 +1,129 @@
+//go:build integration
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_repo"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestAdminV1AiIndexAudioService_GenerateAiIndexAudio(t *testing.T) {
+	d, cfg := data.NewTestData(t)
+	ctx := context.Background()
+	logger := log.DefaultLogger
+	aiAudioRecordRepo := data.NewAiAudioRecordRepo(logger, d, ai_boilerplate_repo.NewAiAudioRecordRepo(cfg))
+	aiProviderModelRepo := data.NewAiProviderModelRepo(logger, d, ai_boilerplate_repo.NewAiProviderModelRepo(cfg))
+	aiProviderPlatformRepo := data.NewAiProviderPlatformRepo(logger, d, ai_boilerplate_repo.NewAiProviderPlatformRepo(cfg))
+	sysNotifyMessageRepo := data.NewSysNotifyMessageRepo(logger, d, ai_boilerplate_repo.NewSysNotifyMessageRepo(cfg))
+	svc := NewAdminV1AiIndexAudioService(logger, aiAudioRecordRepo, aiProviderModelRepo, aiProviderPlatformRepo, sysNotifyMessageRepo)
+
+	platform := &ai_boilerplate_model.AiProviderPlatform{
+		Platform: "fake-audio-test",
+		Name:     "Fake Audio",
+		Status:   int32(constant.StatusEnable),
+	}
+	if err := aiProviderPlatformRepo.CreateOneCache(ctx, platform); err != nil {
+		t.Fatalf("create platform: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = aiProviderPlatformRepo.DeleteOneUnscopedCacheByID(ctx, platform.ID)
+	})
+	providerModel := &ai_boilerplate_model.AiProviderModel{
+		PlatformID: platform.ID,
+		ModelType:  "audio",
+		ModelID:    "fake-audio-model",
+		ModelName:  "Fake Audio Model",
+		Status:     int32(constant.StatusEnable),
+	}
+	if err := aiProviderModelRepo.CreateOneCache(ctx, providerModel); err != nil {
+		t.Fatalf("create model: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = aiProviderModelRepo.DeleteOneUnscopedCacheByID(ctx, providerModel.ID)
+	})
+	aiAudioRecordRepo.SetProvider(platform.Platform, data.NewAiAudioFakeProvider())
+
+	tests := []struct {
+		name      string
+		record    *ai_boilerplate_model.AiAudioRecord
+		wantTitle string
+		wantLyric string
+	}{
+		{
+			name: "description",
+			record: &ai_boilerplate_model.AiAudioRecord{
+				Description:  "一首关于夏天的歌",
+				GenerateMode: int32(constant.AiAudioGenerateModeDescription),
+			},
+			wantTitle: "Fake Song",
+			wantLyric: "一首关于夏天的歌",
+		},
+		{
+			name: "lyric",
+			record: &ai_boilerplate_model.AiAudioRecord{
+				Title:        "夏天",
+				Lyric:        "蝉鸣的午后",
+				Tags:         "pop",
+				GenerateMode: int32(constant.AiAudioGenerateModeLyric),
+			},
+			wantTitle: "夏天",
+			wantLyric: "蝉鸣的午后",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := tt.record
+			record.AdminID = "test-admin"
+			record.Status = int32(constant.AiAudioStatusPending)
+			record.Platform = platform.Platform
+			record.ModelID = providerModel.ID
+			record.Model = providerModel.ModelID
+			if err := aiAudioRecordRepo.CreateOneCache(ctx, record); err != nil {
+				t.Fatalf("create record: %v", err)
+			}
+			t.Cleanup(func() {
+				_ = aiAudioRecordRepo.DeleteOneUnscopedCacheByID(ctx, record.ID)
+			})
+			payload, err := json.Marshal(&data.AiAudioGenerateMessage{ID: record.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := svc.GenerateAiIndexAudio(ctx, payload); err != nil {
+				t.Fatalf("GenerateAiIndexAudio() error = %v", err)
+			}
+			got, err := aiAudioRecordRepo.FindOneByID(ctx, record.ID)
+			if err != nil {
+				t.Fatalf("find record: %v", err)
+			}
+			if got.Status != int32(constant.AiAudioStatusSucceeded) {
+				t.Fatalf("Status = %d, error message = %q", got.Status, got.ErrorMessage)
+			}
+			if !strings.HasPrefix(got.AudioURL, "https://example.com/fake-") || !strings.HasSuffix(got.AudioURL, ".mp3") {
+				t.Errorf("AudioURL = %q", got.AudioURL)
+			}
+			if !strings.HasPrefix(got.ImageURL, "https://example.com/fake-") || !strings.HasSuffix(got.ImageURL, ".png") {
+				t.Errorf("ImageURL = %q", got.ImageURL)
+			}
+			if got.Duration != 30 {
+				t.Errorf("Duration = %v, want 30", got.Duration)
+			}
+			if got.TaskID == "" {
+				t.Error("TaskID is empty")
+			}
+			if got.Title != tt.wantTitle {
+				t.Errorf("Title = %q, want %q", got.Title, tt.wantTitle)
+			}
+			if got.Lyric != tt.wantLyric {
+				t.Errorf("Lyric = %q, want %q", got.Lyric, tt.wantLyric)
+			}
+		})
+	}
+}
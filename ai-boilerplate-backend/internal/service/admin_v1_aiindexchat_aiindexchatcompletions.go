@@ -1,17 +1,28 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"io"
+	"slices"
+	"strings"
+	"time"
 
-	"github.com/cloudwego/eino-ext/components/model/ark"
-	"github.com/cloudwego/eino/components/prompt"
+	"github.com/cloudwego/eino/components/model"
 	"github.com/cloudwego/eino/schema"
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
 	"github.com/fzf-labs/kratos-contrib/pkg/sse"
 	"github.com/go-kratos/kratos/v2/transport/http"
-	"golang.org/x/net/context"
 )
 
+// 对话未设置上下文条数时默认携带的历史消息条数
+const defaultAiIndexChatHistorySize = 20
+
 // AiIndexChatCompletionsHandler AI 聊天-聊天 ChatCompletions格式 (SSE 流式返回)
 func (a *AdminV1AiIndexChatService) AiIndexChatCompletionsHandler(ctx http.Context) error {
 	var in pb.AiIndexChatCompletionsReq
@@ -20,81 +31,90 @@ func (a *AdminV1AiIndexChatService) AiIndexChatCompletionsHandler(ctx http.Conte
 	}
 	http.SetOperation(ctx, "/admin.v1.AiIndexChat/AiIndexChatCompletions")
 	h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
-		// 创建 SSE Writer
-		sseWriter, streamCtx, err := sse.NewWriter(ctx)
+		// 获取对话
+		conversation, err := a.getAiIndexChatConversation(ctx, in.GetConversationId())
 		if err != nil {
-			a.log.Errorf("create sse writer failed: %v", err)
 			return nil, err
 		}
-
-		// TODO: 从请求中获取实际的对话信息
-		// 1. 获取或创建对话
-		// conversationID := req.ConversationId
-		// conversation, err := a.aiChatConversationRepo.Get(ctx, conversationID)
-
-		// 2. 构建历史消息
-		// messages, err := a.buildChatMessages(ctx, conversation, req)
-
-		// 临时示例：构建简单的提示模板
-		template := prompt.FromMessages(schema.FString,
-			schema.SystemMessage("你是一个{role}。你需要用{style}的语气回答问题。你的目标是帮助程序员保持积极乐观的心态，提供技术建议的同时也要关注他们的心理健康。"),
-			schema.MessagesPlaceholder("chat_history", true),
-			schema.UserMessage("问题: {question}"),
-		)
-
-		// 使用模板生成消息
-		messages, err := template.Format(streamCtx, map[string]any{
-			"role":     "程序员鼓励师",
-			"style":    "积极、温暖且专业",
-			"question": "我的代码一直报错，感觉好沮丧，该怎么办？",
-			"chat_history": []*schema.Message{
-				schema.UserMessage("你好"),
-				schema.AssistantMessage("嘿！我是你的程序员鼓励师！记住，每个优秀的程序员都是从 Debug 中成长起来的。有什么我可以帮你的吗？", nil),
-				schema.UserMessage("我觉得自己写的代码太烂了"),
-				schema.AssistantMessage("每个程序员都经历过这个阶段！重要的是你在不断学习和进步。让我们一起看看代码，我相信通过重构和优化，它会变得更好。记住，Rome wasn't built in a day，代码质量是通过持续改进来提升的。", nil),
-			},
-		})
+		modelSetting := &pb.AiIndexChatConversationItem_ModelSetting{}
+		err = jsonutil.Unmarshal(conversation.ModelSetting, modelSetting)
+		if err != nil {
+			return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
+		}
+		promptSetting := &pb.AiIndexChatConversationItem_PromptSetting{}
+		err = jsonutil.Unmarshal(conversation.PromptSetting, promptSetting)
+		if err != nil {
+			return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
+		}
+		userMessage := buildChatUserMessage(in.GetMessages())
+		if userMessage == nil {
+			return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("messages is empty")))
+		}
+		// 校验租户 Token 配额
+		if err := a.aiTokenUsageRepo.CheckTokenQuota(ctx, conversation.TenantID); err != nil {
+			return nil, err
+		}
+		// 根据对话的模型设置创建模型
+		chatModel, opts, providerModel, err := a.newAiIndexChatModel(ctx, modelSetting)
+		if err != nil {
+			return nil, err
+		}
+		// 构建历史消息
+		messages, err := a.buildChatMessages(ctx, conversation, modelSetting, promptSetting, userMessage)
+		if err != nil {
+			return nil, err
+		}
+		// 保存用户消息
+		question, err := a.createAiIndexChatMessage(ctx, conversation, providerModel, "", userMessage.Role, chatUserMessageContent(in.GetMessages()), false)
 		if err != nil {
-			a.log.Errorf("format template failed: %v", err)
-			_ = sseWriter.WriteError(err)
 			return nil, err
 		}
 
-		// TODO: 从配置或数据库中获取模型配置
-		// modelConfig := conversation.ModelSetting
-		// 临时使用硬编码配置
-		model, err := ark.NewChatModel(streamCtx, &ark.ChatModelConfig{
-			Model:  "doubao-seed-1-6-251015",
-			APIKey: "cb3074a7-058c-4a86-a1c2-3f3e2b570768",
-		})
+		// 创建 SSE Writer
+		sseWriter, streamCtx, err := sse.NewWriter(ctx)
 		if err != nil {
-			a.log.Errorf("create model failed: %v", err)
-			_ = sseWriter.WriteError(err)
+			a.log.Errorf("create sse writer failed: %v", err)
 			return nil, err
 		}
 
+		// 回复内容在流结束、出错或客户端断开时都会落库,未正常结束的回复标记为部分回复
+		// 模型调用失败或未收到任何内容就中断时没有回复, 不保存空的部分回复
+		var fullContent strings.Builder
+		var usage *schema.TokenUsage
+		completed := false
+		defer func() {
+			saveCtx := context.WithoutCancel(ctx)
+			replyID := ""
+			if completed || fullContent.Len() > 0 {
+				reply, saveErr := a.createAiIndexChatMessage(saveCtx, conversation, providerModel, question.ID, schema.Assistant, fullContent.String(), !completed)
+				if saveErr != nil {
+					a.log.Errorf("save reply message failed: %v", saveErr)
+				}
+				if reply != nil {
+					replyID = reply.ID
+				}
+			}
+			// 记录 Token 用量
+			usageErr := a.aiTokenUsageRepo.RecordUsage(saveCtx, conversation.TenantID, conversation.AdminID, constant.AiTokenUsageSceneChat, providerModel, replyID, usage)
+			if usageErr != nil {
+				a.log.Errorf("record token usage failed: %v", usageErr)
+			}
+		}()
+
 		// 流式生成回答
-		streamResult, err := model.Stream(streamCtx, messages)
+		streamResult, err := chatModel.Stream(streamCtx, messages, opts...)
 		if err != nil {
 			a.log.Errorf("generate response failed: %v", err)
 			_ = sseWriter.WriteError(err)
 			return nil, err
 		}
-
-		// TODO: 准备保存消息到数据库
-		// var fullContent strings.Builder
+		defer streamResult.Close()
 
 		// 流式发送每个 chunk (SSE 格式)
 		for {
 			chunk, err := streamResult.Recv()
 			if err == io.EOF {
-				// TODO: 保存完整的消息到数据库
-				// a.aiChatMessageRepo.Create(ctx, &model.AiChatMessage{
-				//     ConversationId: conversationID,
-				//     Content: fullContent.String(),
-				//     ...
-				// })
-
+				completed = true
 				// 发送结束标记
 				if writeErr := sseWriter.WriteDone(); writeErr != nil {
 					a.log.Errorf("write done failed: %v", writeErr)
@@ -107,8 +127,11 @@ func (a *AdminV1AiIndexChatService) AiIndexChatCompletionsHandler(ctx http.Conte
 				return nil, err
 			}
 
-			// TODO: 累积完整内容
-			// fullContent.WriteString(chunk.Content)
+			// 累积完整内容, 用量通常在最后一个 chunk 中返回
+			fullContent.WriteString(chunk.Content)
+			if chunk.ResponseMeta != nil && chunk.ResponseMeta.Usage != nil {
+				usage = chunk.ResponseMeta.Usage
+			}
 
 			// 构造 SSE 响应数据并发送
 			reply := &pb.AiIndexChatCompletionsReply{
@@ -126,3 +149,194 @@ func (a *AdminV1AiIndexChatService) AiIndexChatCompletionsHandler(ctx http.Conte
 	}
 	return nil
 }
+
+// getAiIndexChatConversation 获取当前管理员的对话
+func (a *AdminV1AiIndexChatService) getAiIndexChatConversation(ctx context.Context, conversationID string) (*ai_boilerplate_model.AiChatConversation, error) {
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	conversation, err := a.aiChatConversationRepo.FindOneCacheByID(ctx, conversationID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if conversation == nil || conversation.ID == "" || conversation.AdminID != adminID {
+		return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("conversation is not found")))
+	}
+	return conversation, nil
+}
+
+// newAiIndexChatModel 根据模型设置解析模型与平台,获取聊天模型及调用参数
+func (a *AdminV1AiIndexChatService) newAiIndexChatModel(ctx context.Context, modelSetting *pb.AiIndexChatConversationItem_ModelSetting) (model.ToolCallingChatModel, []model.Option, *ai_boilerplate_model.AiProviderModel, error) {
+	providerModel, err := a.aiProviderModelRepo.FindOneCacheByID(ctx, modelSetting.GetModelId())
+	if err != nil {
+		return nil, nil, nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if providerModel == nil || providerModel.ID == "" || providerModel.Status != int32(constant.StatusEnable) {
+		return nil, nil, nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("model is not found")))
+	}
+	platform, err := a.aiProviderPlatformRepo.FindOneCacheByID(ctx, providerModel.PlatformID)
+	if err != nil {
+		return nil, nil, nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if platform == nil || platform.ID == "" || platform.Status != int32(constant.StatusEnable) {
+		return nil, nil, nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("platform is not found")))
+	}
+	chatModel, err := a.aiProviderPlatformRepo.GetChatModel(ctx, platform)
+	if err != nil {
+		return nil, nil, nil, pb.ErrorReasonAPIThirdErr(pb.WithError(err))
+	}
+	// 模型配置作为默认参数,对话的模型设置优先
+	opts, err := a.aiProviderModelRepo.ChatModelOptions(providerModel)
+	if err != nil {
+		return nil, nil, nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
+	}
+	if modelSetting.GetTemperature() > 0 {
+		opts = append(opts, model.WithTemperature(float32(modelSetting.GetTemperature())))
+	}
+	if modelSetting.GetTopP() > 0 {
+		opts = append(opts, model.WithTopP(float32(modelSetting.GetTopP())))
+	}
+	if modelSetting.GetMaxTokens() > 0 {
+		opts = append(opts, model.WithMaxTokens(int(modelSetting.GetMaxTokens())))
+	}
+	return chatModel, opts, providerModel, nil
+}
+
+// buildChatMessages 构建发送给模型的消息: 系统提示词 + 历史消息 + 本次用户消息
+func (a *AdminV1AiIndexChatService) buildChatMessages(
+	ctx context.Context,
+	conversation *ai_boilerplate_model.AiChatConversation,
+	modelSetting *pb.AiIndexChatConversationItem_ModelSetting,
+	promptSetting *pb.AiIndexChatConversationItem_PromptSetting,
+	userMessage *schema.Message,
+) ([]*schema.Message, error) {
+	messages := make([]*schema.Message, 0)
+	if promptSetting.GetPrompt() != "" {
+		messages = append(messages, schema.SystemMessage(promptSetting.GetPrompt()))
+	}
+	historySize := int32(defaultAiIndexChatHistorySize)
+	if modelSetting.GetMaxContexts() > 0 {
+		historySize = modelSetting.GetMaxContexts()
+	}
+	param := &condition.Req{
+		Page:     1,
+		PageSize: historySize,
+		Query: []*condition.QueryParam{
+			{
+				Field: "conversation_id",
+				Value: conversation.ID,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+		},
+		Order: []*condition.OrderParam{
+			{
+				Field: "created_at",
+				Order: condition.DESC,
+			},
+		},
+	}
+	history, _, err := a.aiChatMessageRepo.FindMultiByCondition(ctx, param)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	// 按时间正序回放历史消息
+	slices.Reverse(history)
+	for _, v := range history {
+		if v.Content == "" {
+			continue
+		}
+		switch v.Type {
+		case string(schema.User):
+			messages = append(messages, schema.UserMessage(v.Content))
+		case string(schema.Assistant):
+			messages = append(messages, schema.AssistantMessage(v.Content, nil))
+		}
+	}
+	messages = append(messages, userMessage)
+	return messages, nil
+}
+
+// createAiIndexChatMessage 保存一条对话消息并刷新对话的更新时间
+func (a *AdminV1AiIndexChatService) createAiIndexChatMessage(
+	ctx context.Context,
+	conversation *ai_boilerplate_model.AiChatConversation,
+	providerModel *ai_boilerplate_model.AiProviderModel,
+	replyID string,
+	role schema.RoleType,
+	content string,
+	partial bool,
+) (*ai_boilerplate_model.AiChatMessage, error) {
+	data := a.aiChatMessageRepo.NewData()
+	data.TenantID = conversation.TenantID
+	data.ConversationID = conversation.ID
+	data.ReplyID = replyID
+	data.AdminID = conversation.AdminID
+	data.Type = string(role)
+	data.Model = providerModel.ModelID
+	data.ModelID = providerModel.ID
+	data.Content = content
+	data.UseContext = true
+	data.Partial = partial
+	err := a.aiChatMessageRepo.CreateOneCache(ctx, data)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	oldConversation := a.aiChatConversationRepo.DeepCopy(conversation)
+	conversation.UpdatedAt = time.Now()
+	err = a.aiChatConversationRepo.UpdateOneCache(ctx, conversation, oldConversation)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	return data, nil
+}
+
+// chatUserMessageContent 提取请求中的文本作为用户消息内容
+func chatUserMessageContent(items []*pb.AiIndexChatCompletionsReq_Message) string {
+	texts := make([]string, 0)
+	for _, v := range items {
+		if v.GetText() != "" {
+			texts = append(texts, v.GetText())
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// buildChatUserMessage 将请求中的消息片段转换为用户消息
+func buildChatUserMessage(items []*pb.AiIndexChatCompletionsReq_Message) *schema.Message {
+	texts := make([]string, 0)
+	parts := make([]schema.MessageInputPart, 0)
+	multimodal := false
+	for _, v := range items {
+		switch {
+		case v.GetImageUrl() != "":
+			imageURL := v.GetImageUrl()
+			parts = append(parts, schema.MessageInputPart{
+				Type:  schema.ChatMessagePartTypeImageURL,
+				Image: &schema.MessageInputImage{MessagePartCommon: schema.MessagePartCommon{URL: &imageURL}},
+			})
+			multimodal = true
+		case v.GetVideoUrl() != "":
+			videoURL := v.GetVideoUrl()
+			parts = append(parts, schema.MessageInputPart{
+				Type:  schema.ChatMessagePartTypeVideoURL,
+				Video: &schema.MessageInputVideo{MessagePartCommon: schema.MessagePartCommon{URL: &videoURL}},
+			})
+			multimodal = true
+		case v.GetText() != "":
+			texts = append(texts, v.GetText())
+			parts = append(parts, schema.MessageInputPart{
+				Type: schema.ChatMessagePartTypeText,
+				Text: v.GetText(),
+			})
+		}
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+	if !multimodal {
+		return schema.UserMessage(strings.Join(texts, "\n"))
+	}
+	return &schema.Message{
+		Role:                  schema.User,
+		UserInputMultiContent: parts,
+	}
+}
@@ -14,10 +14,21 @@ func (a *AdminV1AiIndexChatService) GetAiIndexChatMessageList(ctx context.Contex
 		Total: 0,
 		List:  []*pb.AiIndexChatMessageItem{},
 	}
+	_, err := a.getAiIndexChatConversation(ctx, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
 	param := &condition.Req{
 		Page:     req.GetPage(),
 		PageSize: req.GetPageSize(),
-		Query:    []*condition.QueryParam{},
+		Query: []*condition.QueryParam{
+			{
+				Field: "conversation_id",
+				Value: req.GetConversationId(),
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+		},
 		Order: []*condition.OrderParam{
 			{
 				Field: "created_at",
@@ -46,6 +57,7 @@ func (a *AdminV1AiIndexChatService) GetAiIndexChatMessageList(ctx context.Contex
 				SegmentIds:     v.SegmentIds,
 				CreatedAt:      v.CreatedAt.Format(time.RFC3339),
 				UpdatedAt:      v.UpdatedAt.Format(time.RFC3339),
+				Partial:        v.Partial,
 			})
 		}
 	}
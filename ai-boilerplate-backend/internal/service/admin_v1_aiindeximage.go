@@ -9,16 +9,34 @@ import (
 func NewAdminV1AiIndexImageService(
 	logger log.Logger,
 	aiImageRecordRepo *data.AiImageRecordRepo,
+	aiProviderModelRepo *data.AiProviderModelRepo,
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo,
+	aiTokenUsageRepo *data.AiTokenUsageRepo,
+	fileConfigRepo *data.FileConfigRepo,
+	fileDatumRepo *data.FileDatumRepo,
+	sysNotifyMessageRepo *data.SysNotifyMessageRepo,
 ) *AdminV1AiIndexImageService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiIndexImage"))
 	return &AdminV1AiIndexImageService{
-		log:               l,
-		aiImageRecordRepo: aiImageRecordRepo,
+		log:                    l,
+		aiImageRecordRepo:      aiImageRecordRepo,
+		aiProviderModelRepo:    aiProviderModelRepo,
+		aiProviderPlatformRepo: aiProviderPlatformRepo,
+		aiTokenUsageRepo:       aiTokenUsageRepo,
+		fileConfigRepo:         fileConfigRepo,
+		fileDatumRepo:          fileDatumRepo,
+		sysNotifyMessageRepo:   sysNotifyMessageRepo,
 	}
 }
 
 type AdminV1AiIndexImageService struct {
 	pb.UnimplementedAiIndexImageServer
-	log               *log.Helper
-	aiImageRecordRepo *data.AiImageRecordRepo
+	log                    *log.Helper
+	aiImageRecordRepo      *data.AiImageRecordRepo
+	aiProviderModelRepo    *data.AiProviderModelRepo
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo
+	aiTokenUsageRepo       *data.AiTokenUsageRepo
+	fileConfigRepo         *data.FileConfigRepo
+	fileDatumRepo          *data.FileDatumRepo
+	sysNotifyMessageRepo   *data.SysNotifyMessageRepo
 }
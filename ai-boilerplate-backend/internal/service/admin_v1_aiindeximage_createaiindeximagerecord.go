@@ -2,13 +2,72 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"gorm.io/datatypes"
 )
 
 // CreateAiIndexImageRecord AI 绘画表-创建一条数据
-func (a *AdminV1AiIndexImageService) CreateAiIndexImageRecord(_ context.Context, _ *pb.CreateAiIndexImageRecordReq) (*pb.CreateAiIndexImageRecordReply, error) {
+// 创建排队中的绘画记录并投递生成任务, 由 GenerateAiIndexImage 异步生成
+func (a *AdminV1AiIndexImageService) CreateAiIndexImageRecord(ctx context.Context, req *pb.CreateAiIndexImageRecordReq) (*pb.CreateAiIndexImageRecordReply, error) {
 	resp := &pb.CreateAiIndexImageRecordReply{}
-	// TODO
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	options := "{}"
+	if req.GetOptions() != "" {
+		if !json.Valid([]byte(req.GetOptions())) {
+			return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("options is not a valid json")))
+		}
+		options = req.GetOptions()
+	}
+	providerModel, err := a.aiProviderModelRepo.FindOneCacheByID(ctx, req.GetModelId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if providerModel == nil || providerModel.ID == "" || providerModel.Status != int32(constant.StatusEnable) {
+		return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("model is not found")))
+	}
+	platform, err := a.aiProviderPlatformRepo.FindOneCacheByID(ctx, providerModel.PlatformID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if platform == nil || platform.ID == "" || platform.Status != int32(constant.StatusEnable) {
+		return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("platform is not found")))
+	}
+	err = a.aiTokenUsageRepo.CheckTokenQuota(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	data := a.aiImageRecordRepo.NewData()
+	data.TenantID = tenantID
+	data.AdminID = adminID
+	data.Prompt = req.GetPrompt()
+	data.Platform = platform.Platform
+	data.ModelID = providerModel.ID
+	data.Model = providerModel.ModelID
+	data.Width = req.GetWidth()
+	data.Height = req.GetHeight()
+	data.Status = int32(constant.AiImageStatusPending)
+	data.Options = datatypes.JSON(options)
+	err = a.aiImageRecordRepo.CreateOneCache(ctx, data)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	err = a.aiImageRecordRepo.SendGenerateTask(ctx, data.ID)
+	if err != nil {
+		// 任务投递失败时标记记录失败, 避免记录一直处于排队中
+		oldData := a.aiImageRecordRepo.DeepCopy(data)
+		data.Status = int32(constant.AiImageStatusFailed)
+		data.ErrorMessage = "任务投递失败"
+		if updateErr := a.aiImageRecordRepo.UpdateOneCacheWithZero(ctx, data, oldData); updateErr != nil {
+			a.log.WithContext(ctx).Errorf("failed to update image record %s: %v", data.ID, updateErr)
+		}
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	resp.Id = data.ID
 	return resp, nil
 }
@@ -4,11 +4,28 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // DeleteAiIndexImageRecord AI 绘画表-删除一条数据
-func (a *AdminV1AiIndexImageService) DeleteAiIndexImageRecord(_ context.Context, _ *pb.DeleteAiIndexImageRecordReq) (*pb.DeleteAiIndexImageRecordReply, error) {
+func (a *AdminV1AiIndexImageService) DeleteAiIndexImageRecord(ctx context.Context, req *pb.DeleteAiIndexImageRecordReq) (*pb.DeleteAiIndexImageRecordReply, error) {
 	resp := &pb.DeleteAiIndexImageRecordReply{}
-	// TODO
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	data, err := a.aiImageRecordRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前用户的绘画
+	if data.AdminID != adminID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	err = a.aiImageRecordRepo.DeleteOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
 	return resp, nil
 }
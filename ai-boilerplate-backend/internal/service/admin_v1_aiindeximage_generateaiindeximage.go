@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/goutil/fileutil"
+)
+
+// 绘画错误信息最大长度(字段长度 1024)
+const aiIndexImageErrorMessageMaxLen = 1000
+
+// GenerateAiIndexImage AI 绘画-消费生成任务
+// 调用模型平台生成图片, 转存到主配置存储, 并更新绘画记录状态
+// 生成失败记录在绘画记录上, 不再重试, 避免重复调用模型平台
+func (a *AdminV1AiIndexImageService) GenerateAiIndexImage(ctx context.Context, payload []byte) error {
+	msg := &data.AiImageGenerateMessage{}
+	err := json.Unmarshal(payload, msg)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to unmarshal image generate message: %v", err)
+		return nil
+	}
+	record, err := a.aiImageRecordRepo.FindOneCacheByID(ctx, msg.ID)
+	if err != nil {
+		return err
+	}
+	if record == nil || record.ID == "" {
+		return nil
+	}
+	// 生成中的记录说明上次消费被中断, 重新生成
+	if record.Status != int32(constant.AiImageStatusPending) && record.Status != int32(constant.AiImageStatusRunning) {
+		return nil
+	}
+	oldData := a.aiImageRecordRepo.DeepCopy(record)
+	record.Status = int32(constant.AiImageStatusRunning)
+	err = a.aiImageRecordRepo.UpdateOneCacheWithZero(ctx, record, oldData)
+	if err != nil {
+		return err
+	}
+	picURL, taskID, genErr := a.generateAiIndexImage(ctx, record)
+	oldData = a.aiImageRecordRepo.DeepCopy(record)
+	record.FinishTime = sql.NullTime{Time: carbon.Now().StdTime(), Valid: true}
+	record.TaskID = taskID
+	if genErr != nil {
+		a.log.WithContext(ctx).Errorf("failed to generate image %s: %v", record.ID, genErr)
+		errorMessage := []rune(genErr.Error())
+		if len(errorMessage) > aiIndexImageErrorMessageMaxLen {
+			errorMessage = errorMessage[:aiIndexImageErrorMessageMaxLen]
+		}
+		record.Status = int32(constant.AiImageStatusFailed)
+		record.ErrorMessage = string(errorMessage)
+	} else {
+		record.Status = int32(constant.AiImageStatusSucceeded)
+		record.ErrorMessage = ""
+		record.PicURL = picURL
+	}
+	err = a.aiImageRecordRepo.UpdateOneCacheWithZero(ctx, record, oldData)
+	if err != nil {
+		return err
+	}
+	a.sysNotifyMessageRepo.PublishAiJob(ctx, record.AdminID, &data.SysNotifyEventAiJob{
+		JobType:      constant.AiJobTypeImage.String(),
+		ID:           record.ID,
+		Status:       record.Status,
+		ErrorMessage: record.ErrorMessage,
+	})
+	return nil
+}
+
+// generateAiIndexImage 生成图片并转存, 返回图片地址与平台任务编号
+func (a *AdminV1AiIndexImageService) generateAiIndexImage(ctx context.Context, record *ai_boilerplate_model.AiImageRecord) (picURL, taskID string, err error) {
+	providerModel, err := a.aiProviderModelRepo.FindOneCacheByID(ctx, record.ModelID)
+	if err != nil {
+		return "", "", err
+	}
+	if providerModel == nil || providerModel.ID == "" || providerModel.Status != int32(constant.StatusEnable) {
+		return "", "", errors.New("model is not found")
+	}
+	platform, err := a.aiProviderPlatformRepo.FindOneCacheByID(ctx, providerModel.PlatformID)
+	if err != nil {
+		return "", "", err
+	}
+	if platform == nil || platform.ID == "" || platform.Status != int32(constant.StatusEnable) {
+		return "", "", errors.New("platform is not found")
+	}
+	options := make(map[string]any)
+	if record.Options.String() != "" {
+		err = json.Unmarshal(record.Options, &options)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to unmarshal options: %w", err)
+		}
+	}
+	result, err := a.aiImageRecordRepo.GenerateImage(ctx, platform, &data.AiImageGenerateReq{
+		Model:   record.Model,
+		Prompt:  record.Prompt,
+		Width:   record.Width,
+		Height:  record.Height,
+		Options: options,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	err = a.aiTokenUsageRepo.RecordUsage(ctx, record.TenantID, record.AdminID, constant.AiTokenUsageSceneImage, providerModel, record.ID, result.Usage)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to record image token usage %s: %v", record.ID, err)
+	}
+	// 平台返回的图片地址有时效, 转存到主配置存储
+	path := fmt.Sprintf("ai/image/%s/%s%s", carbon.Now().Format("Ymd"), record.ID, aiIndexImageExt(result.ContentType))
+	fileConfig, fileURL, err := a.fileConfigRepo.UploadToMaster(ctx, path, result.ContentType, result.Image)
+	if err != nil {
+		return "", result.TaskID, err
+	}
+	fileDatum := &ai_boilerplate_model.FileDatum{
+		ConfigID: fileConfig.ID,
+		Name:     record.ID + aiIndexImageExt(result.ContentType),
+		Path:     path,
+		URL:      fileURL,
+		Ext:      fileutil.Ext(path),
+		Size:     int32(len(result.Image)),
+		Status:   2,
+	}
+	err = a.fileDatumRepo.CreateOneCache(ctx, fileDatum)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to create file datum %s: %v", path, err)
+	}
+	return fileURL, result.TaskID, nil
+}
+
+// aiIndexImageExt 根据图片类型获取文件后缀
+func aiIndexImageExt(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/webp":
+		return ".webp"
+	case "image/gif":
+		return ".gif"
+	default:
+		return ".png"
+	}
+}
@@ -1,24 +1,64 @@
 package service
 
 import (
+	"context"
+
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/kratos-contrib/meta"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 func NewAdminV1AiIndexVideoService(
 	logger log.Logger,
 	aiVideoRecordRepo *data.AiVideoRecordRepo,
+	aiProviderModelRepo *data.AiProviderModelRepo,
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo,
+	aiTokenUsageRepo *data.AiTokenUsageRepo,
+	fileConfigRepo *data.FileConfigRepo,
+	fileDatumRepo *data.FileDatumRepo,
+	sysNotifyMessageRepo *data.SysNotifyMessageRepo,
 ) *AdminV1AiIndexVideoService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiIndexVideo"))
 	return &AdminV1AiIndexVideoService{
-		log:               l,
-		aiVideoRecordRepo: aiVideoRecordRepo,
+		log:                    l,
+		aiVideoRecordRepo:      aiVideoRecordRepo,
+		aiProviderModelRepo:    aiProviderModelRepo,
+		aiProviderPlatformRepo: aiProviderPlatformRepo,
+		aiTokenUsageRepo:       aiTokenUsageRepo,
+		fileConfigRepo:         fileConfigRepo,
+		fileDatumRepo:          fileDatumRepo,
+		sysNotifyMessageRepo:   sysNotifyMessageRepo,
 	}
 }
 
 type AdminV1AiIndexVideoService struct {
 	pb.UnimplementedAiIndexVideoServer
-	log               *log.Helper
-	aiVideoRecordRepo *data.AiVideoRecordRepo
+	log                    *log.Helper
+	aiVideoRecordRepo      *data.AiVideoRecordRepo
+	aiProviderModelRepo    *data.AiProviderModelRepo
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo
+	aiTokenUsageRepo       *data.AiTokenUsageRepo
+	fileConfigRepo         *data.FileConfigRepo
+	fileDatumRepo          *data.FileDatumRepo
+	sysNotifyMessageRepo   *data.SysNotifyMessageRepo
+}
+
+// getAiIndexVideoRecord 获取当前用户的视频记录
+func (a *AdminV1AiIndexVideoService) getAiIndexVideoRecord(ctx context.Context, id string) (*ai_boilerplate_model.AiVideoRecord, error) {
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	data, err := a.aiVideoRecordRepo.FindOneCacheByID(ctx, id)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前用户的视频
+	if data.AdminID != adminID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	return data, nil
 }
@@ -2,13 +2,59 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"gorm.io/datatypes"
 )
 
 // CreateAiIndexVideoRecord AI 视频表-创建一条数据
-func (a *AdminV1AiIndexVideoService) CreateAiIndexVideoRecord(_ context.Context, _ *pb.CreateAiIndexVideoRecordReq) (*pb.CreateAiIndexVideoRecordReply, error) {
+// 创建排队中的视频记录, 由 ProcessAiIndexVideoTask 定时提交到平台并轮询结果
+func (a *AdminV1AiIndexVideoService) CreateAiIndexVideoRecord(ctx context.Context, req *pb.CreateAiIndexVideoRecordReq) (*pb.CreateAiIndexVideoRecordReply, error) {
 	resp := &pb.CreateAiIndexVideoRecordReply{}
-	// TODO
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	options := "{}"
+	if req.GetOptions() != "" {
+		if !json.Valid([]byte(req.GetOptions())) {
+			return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("options is not a valid json")))
+		}
+		options = req.GetOptions()
+	}
+	providerModel, err := a.aiProviderModelRepo.FindOneCacheByID(ctx, req.GetModelId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if providerModel == nil || providerModel.ID == "" || providerModel.Status != int32(constant.StatusEnable) {
+		return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("model is not found")))
+	}
+	platform, err := a.aiProviderPlatformRepo.FindOneCacheByID(ctx, providerModel.PlatformID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if platform == nil || platform.ID == "" || platform.Status != int32(constant.StatusEnable) {
+		return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("platform is not found")))
+	}
+	err = a.aiTokenUsageRepo.CheckTokenQuota(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	data := a.aiVideoRecordRepo.NewData()
+	data.TenantID = tenantID
+	data.AdminID = adminID
+	data.Prompt = req.GetPrompt()
+	data.Platform = platform.Platform
+	data.ModelID = providerModel.ID
+	data.Model = providerModel.ModelID
+	data.Status = int32(constant.AiVideoStatusPending)
+	data.Options = datatypes.JSON(options)
+	err = a.aiVideoRecordRepo.CreateOneCache(ctx, data)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.Id = data.ID
 	return resp, nil
 }
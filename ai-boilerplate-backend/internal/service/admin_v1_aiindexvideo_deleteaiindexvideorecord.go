@@ -7,8 +7,15 @@ import (
 )
 
 // DeleteAiIndexVideoRecord AI 视频表-删除一条数据
-func (a *AdminV1AiIndexVideoService) DeleteAiIndexVideoRecord(_ context.Context, _ *pb.DeleteAiIndexVideoRecordReq) (*pb.DeleteAiIndexVideoRecordReply, error) {
+func (a *AdminV1AiIndexVideoService) DeleteAiIndexVideoRecord(ctx context.Context, req *pb.DeleteAiIndexVideoRecordReq) (*pb.DeleteAiIndexVideoRecordReply, error) {
 	resp := &pb.DeleteAiIndexVideoRecordReply{}
-	// TODO
+	_, err := a.getAiIndexVideoRecord(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	err = a.aiVideoRecordRepo.DeleteOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
 	return resp, nil
 }
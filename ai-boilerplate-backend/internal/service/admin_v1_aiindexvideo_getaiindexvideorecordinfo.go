@@ -2,13 +2,34 @@ package service
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 )
 
 // GetAiIndexVideoRecordInfo AI 视频表-单条数据查询
-func (a *AdminV1AiIndexVideoService) GetAiIndexVideoRecordInfo(_ context.Context, _ *pb.GetAiIndexVideoRecordInfoReq) (*pb.GetAiIndexVideoRecordInfoReply, error) {
+func (a *AdminV1AiIndexVideoService) GetAiIndexVideoRecordInfo(ctx context.Context, req *pb.GetAiIndexVideoRecordInfoReq) (*pb.GetAiIndexVideoRecordInfoReply, error) {
 	resp := &pb.GetAiIndexVideoRecordInfoReply{}
-	// TODO
+	data, err := a.getAiIndexVideoRecord(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	resp.Info = &pb.AiIndexVideoRecordInfo{
+		Id:           data.ID,
+		AdminId:      data.AdminID,
+		Prompt:       data.Prompt,
+		Platform:     data.Platform,
+		ModelId:      data.ModelID,
+		Model:        data.Model,
+		Status:       data.Status,
+		FinishTime:   data.FinishTime.Time.Format(time.RFC3339),
+		ErrorMessage: data.ErrorMessage,
+		PublicStatus: data.PublicStatus,
+		VideoURL:     data.VideoURL,
+		Options:      data.Options.String(),
+		TaskId:       data.TaskID,
+		CreatedAt:    data.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    data.UpdatedAt.Format(time.RFC3339),
+	}
 	return resp, nil
 }
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/goutil/fileutil"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/fzf-labs/goutil/timeutil"
+	"gorm.io/datatypes"
+)
+
+const (
+	// 每次调度处理的未完成任务数
+	aiIndexVideoBatchSize = 100
+	// 任务超时时间, 超时未完成的任务标记为失败
+	aiIndexVideoTimeout = 30 * time.Minute
+	// 轮询间隔随任务时长退避: 任务时长的 1/10, 限制在最小与最大间隔之间
+	aiIndexVideoPollMinInterval = 10 * time.Second
+	aiIndexVideoPollMaxInterval = 2 * time.Minute
+	// 视频错误信息最大长度(字段长度 1024)
+	aiIndexVideoErrorMessageMaxLen = 1000
+	// 通知内容最大长度(字段长度 200)
+	aiIndexVideoNotifyContentMaxLen = 200
+)
+
+// ProcessAiIndexVideoTask AI 视频-定时处理未完成的任务
+// 排队中的任务提交到平台, 生成中的任务按退避间隔轮询结果, 完成后转存视频并通知创建人
+func (a *AdminV1AiIndexVideoService) ProcessAiIndexVideoTask(ctx context.Context, _ []byte) error {
+	list, err := a.aiVideoRecordRepo.FindUnfinished(ctx, aiIndexVideoBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, v := range list {
+		err = a.processAiIndexVideoRecord(ctx, v)
+		if err != nil {
+			a.log.WithContext(ctx).Errorf("failed to process video record %s: %v", v.ID, err)
+		}
+	}
+	return nil
+}
+
+// processAiIndexVideoRecord 处理单条未完成的视频记录
+func (a *AdminV1AiIndexVideoService) processAiIndexVideoRecord(ctx context.Context, record *ai_boilerplate_model.AiVideoRecord) error {
+	age := time.Since(record.CreatedAt)
+	if age > aiIndexVideoTimeout {
+		return a.finishAiIndexVideoRecord(ctx, record.ID, constant.AiVideoStatusFailed, "", "任务超时")
+	}
+	interval := min(max(age/10, aiIndexVideoPollMinInterval), aiIndexVideoPollMaxInterval)
+	ok, err := a.aiVideoRecordRepo.AcquirePoll(ctx, record.ID, interval)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	providerModel, err := a.aiProviderModelRepo.FindOneCacheByID(ctx, record.ModelID)
+	if err != nil {
+		return err
+	}
+	if providerModel == nil || providerModel.ID == "" {
+		return a.finishAiIndexVideoRecord(ctx, record.ID, constant.AiVideoStatusFailed, "", "模型不存在")
+	}
+	platform, err := a.aiProviderPlatformRepo.FindOneCacheByID(ctx, providerModel.PlatformID)
+	if err != nil {
+		return err
+	}
+	if platform == nil || platform.ID == "" || platform.Status != int32(constant.StatusEnable) {
+		return a.finishAiIndexVideoRecord(ctx, record.ID, constant.AiVideoStatusFailed, "", "平台不存在或已禁用")
+	}
+	if record.Status == int32(constant.AiVideoStatusPending) {
+		return a.submitAiIndexVideoRecord(ctx, record, platform)
+	}
+	result, err := a.aiVideoRecordRepo.GetVideoTask(ctx, platform, record.TaskID)
+	if err != nil {
+		// 查询失败视为临时错误, 等待下次轮询
+		return err
+	}
+	switch result.Status {
+	case constant.AiVideoStatusSucceeded:
+		videoURL, err := a.saveAiIndexVideo(ctx, record, platform, result)
+		if err != nil {
+			return a.finishAiIndexVideoRecord(ctx, record.ID, constant.AiVideoStatusFailed, "", err.Error())
+		}
+		err = a.aiTokenUsageRepo.RecordUsage(ctx, record.TenantID, record.AdminID, constant.AiTokenUsageSceneVideo, providerModel, record.ID, result.Usage)
+		if err != nil {
+			a.log.WithContext(ctx).Errorf("failed to record video token usage %s: %v", record.ID, err)
+		}
+		return a.finishAiIndexVideoRecord(ctx, record.ID, constant.AiVideoStatusSucceeded, videoURL, "")
+	case constant.AiVideoStatusFailed:
+		return a.finishAiIndexVideoRecord(ctx, record.ID, constant.AiVideoStatusFailed, "", result.ErrorMessage)
+	default:
+		return nil
+	}
+}
+
+// submitAiIndexVideoRecord 提交排队中的任务到平台
+func (a *AdminV1AiIndexVideoService) submitAiIndexVideoRecord(ctx context.Context, record *ai_boilerplate_model.AiVideoRecord, platform *ai_boilerplate_model.AiProviderPlatform) error {
+	options := make(map[string]any)
+	if record.Options.String() != "" {
+		err := json.Unmarshal(record.Options, &options)
+		if err != nil {
+			return a.finishAiIndexVideoRecord(ctx, record.ID, constant.AiVideoStatusFailed, "", "生成参数格式错误")
+		}
+	}
+	taskID, err := a.aiVideoRecordRepo.SubmitVideoTask(ctx, platform, &data.AiVideoTaskReq{
+		Model:   record.Model,
+		Prompt:  record.Prompt,
+		Options: options,
+	})
+	if err != nil {
+		return a.finishAiIndexVideoRecord(ctx, record.ID, constant.AiVideoStatusFailed, "", err.Error())
+	}
+	current, err := a.aiVideoRecordRepo.FindOneCacheByID(ctx, record.ID)
+	if err != nil {
+		return err
+	}
+	// 提交期间任务可能已被取消
+	if current == nil || current.ID == "" || current.Status != int32(constant.AiVideoStatusPending) {
+		return nil
+	}
+	oldData := a.aiVideoRecordRepo.DeepCopy(current)
+	current.Status = int32(constant.AiVideoStatusRunning)
+	current.TaskID = taskID
+	return a.aiVideoRecordRepo.UpdateOneCacheWithZero(ctx, current, oldData)
+}
+
+// saveAiIndexVideo 下载平台生成的视频并转存到主配置存储, 平台视频地址有时效
+func (a *AdminV1AiIndexVideoService) saveAiIndexVideo(ctx context.Context, record *ai_boilerplate_model.AiVideoRecord, platform *ai_boilerplate_model.AiProviderPlatform, result *data.AiVideoTaskResult) (string, error) {
+	video, contentType, err := a.aiVideoRecordRepo.DownloadVideo(ctx, platform, result)
+	if err != nil {
+		return "", err
+	}
+	path := fmt.Sprintf("ai/video/%s/%s.mp4", carbon.Now().Format("Ymd"), record.ID)
+	fileConfig, fileURL, err := a.fileConfigRepo.UploadToMaster(ctx, path, contentType, video)
+	if err != nil {
+		return "", err
+	}
+	fileDatum := &ai_boilerplate_model.FileDatum{
+		ConfigID: fileConfig.ID,
+		Name:     record.ID + ".mp4",
+		Path:     path,
+		URL:      fileURL,
+		Ext:      fileutil.Ext(path),
+		Size:     int32(len(video)),
+		Status:   2,
+	}
+	err = a.fileDatumRepo.CreateOneCache(ctx, fileDatum)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to create file datum %s: %v", path, err)
+	}
+	return fileURL, nil
+}
+
+// finishAiIndexVideoRecord 结束任务并通知创建人, 已结束(含已取消)的任务不再处理
+func (a *AdminV1AiIndexVideoService) finishAiIndexVideoRecord(ctx context.Context, id string, status constant.AiVideoStatus, videoURL, errorMessage string) error {
+	record, err := a.aiVideoRecordRepo.FindOneCacheByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if record == nil || record.ID == "" {
+		return errors.New("video record is not found")
+	}
+	if record.Status != int32(constant.AiVideoStatusPending) && record.Status != int32(constant.AiVideoStatusRunning) {
+		return nil
+	}
+	if msg := []rune(errorMessage); len(msg) > aiIndexVideoErrorMessageMaxLen {
+		errorMessage = string(msg[:aiIndexVideoErrorMessageMaxLen])
+	}
+	oldData := a.aiVideoRecordRepo.DeepCopy(record)
+	record.Status = int32(status)
+	record.VideoURL = videoURL
+	record.ErrorMessage = errorMessage
+	record.FinishTime = sql.NullTime{Time: carbon.Now().StdTime(), Valid: true}
+	err = a.aiVideoRecordRepo.UpdateOneCacheWithZero(ctx, record, oldData)
+	if err != nil {
+		return err
+	}
+	return a.notifyAiIndexVideoRecord(ctx, record)
+}
+
+// notifyAiIndexVideoRecord 通知创建人任务已完成
+func (a *AdminV1AiIndexVideoService) notifyAiIndexVideoRecord(ctx context.Context, record *ai_boilerplate_model.AiVideoRecord) error {
+	subject := "AI 视频生成成功"
+	content := []rune(record.Prompt)
+	if record.Status != int32(constant.AiVideoStatusSucceeded) {
+		subject = "AI 视频生成失败"
+		content = []rune(record.ErrorMessage)
+	}
+	if len(content) > aiIndexVideoNotifyContentMaxLen {
+		content = content[:aiIndexVideoNotifyContentMaxLen]
+	}
+	extend, err := jsonutil.Marshal(map[string]any{
+		"id":       record.ID,
+		"status":   record.Status,
+		"videoUrl": record.VideoURL,
+	})
+	if err != nil {
+		return err
+	}
+	message := a.sysNotifyMessageRepo.NewData()
+	message.TenantID = record.TenantID
+	message.Type = constant.SysNotifyMessageTypeAiVideo.String()
+	message.Subject = subject
+	message.Content = string(content)
+	message.Sender = constant.SysNotifyMessageTypeSystem.String()
+	message.Receiver = record.AdminID
+	message.SendTime = timeutil.RFC3339(time.Now())
+	message.Extend = datatypes.JSON(extend)
+	a.sysNotifyMessageRepo.PublishAiJob(ctx, record.AdminID, &data.SysNotifyEventAiJob{
+		JobType:      constant.AiJobTypeVideo.String(),
+		ID:           record.ID,
+		Status:       record.Status,
+		ErrorMessage: record.ErrorMessage,
+	})
+	return a.sysNotifyMessageRepo.CreateOneCacheAndPublish(ctx, message)
+}
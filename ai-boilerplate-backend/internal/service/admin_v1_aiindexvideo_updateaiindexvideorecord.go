@@ -7,8 +7,17 @@ import (
 )
 
 // UpdateAiIndexVideoRecord AI 视频表-更新一条数据
-func (a *AdminV1AiIndexVideoService) UpdateAiIndexVideoRecord(_ context.Context, _ *pb.UpdateAiIndexVideoRecordReq) (*pb.UpdateAiIndexVideoRecordReply, error) {
+func (a *AdminV1AiIndexVideoService) UpdateAiIndexVideoRecord(ctx context.Context, req *pb.UpdateAiIndexVideoRecordReq) (*pb.UpdateAiIndexVideoRecordReply, error) {
 	resp := &pb.UpdateAiIndexVideoRecordReply{}
-	// TODO
+	data, err := a.getAiIndexVideoRecord(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	oldData := a.aiVideoRecordRepo.DeepCopy(data)
+	data.PublicStatus = req.GetPublicStatus()
+	err = a.aiVideoRecordRepo.UpdateOneCacheWithZero(ctx, data, oldData)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
 	return resp, nil
 }
@@ -2,13 +2,31 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
+	"github.com/dromara/carbon/v2"
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 )
 
 // UpdateAiIndexVideoRecordStatus AI 视频表-更新状态
-func (a *AdminV1AiIndexVideoService) UpdateAiIndexVideoRecordStatus(_ context.Context, _ *pb.UpdateAiIndexVideoRecordStatusReq) (*pb.UpdateAiIndexVideoRecordStatusReply, error) {
+// 仅支持取消排队中或生成中的任务
+func (a *AdminV1AiIndexVideoService) UpdateAiIndexVideoRecordStatus(ctx context.Context, req *pb.UpdateAiIndexVideoRecordStatusReq) (*pb.UpdateAiIndexVideoRecordStatusReply, error) {
 	resp := &pb.UpdateAiIndexVideoRecordStatusReply{}
-	// TODO
+	data, err := a.getAiIndexVideoRecord(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if data.Status != int32(constant.AiVideoStatusPending) && data.Status != int32(constant.AiVideoStatusRunning) {
+		return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("video task is finished")))
+	}
+	oldData := a.aiVideoRecordRepo.DeepCopy(data)
+	data.Status = int32(constant.AiVideoStatusCanceled)
+	data.FinishTime = sql.NullTime{Time: carbon.Now().StdTime(), Valid: true}
+	err = a.aiVideoRecordRepo.UpdateOneCacheWithZero(ctx, data, oldData)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
 	return resp, nil
 }
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewAdminV1AiIndexWriteService(
+	logger log.Logger,
+	aiWriteRecordRepo *data.AiWriteRecordRepo,
+	aiProviderModelRepo *data.AiProviderModelRepo,
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo,
+	aiTokenUsageRepo *data.AiTokenUsageRepo,
+	dictDatumRepo *data.DictDatumRepo,
+) *AdminV1AiIndexWriteService {
+	l := log.NewHelper(log.With(logger, "module", "service/aiIndexWrite"))
+	return &AdminV1AiIndexWriteService{
+		log:                    l,
+		aiWriteRecordRepo:      aiWriteRecordRepo,
+		aiProviderModelRepo:    aiProviderModelRepo,
+		aiProviderPlatformRepo: aiProviderPlatformRepo,
+		aiTokenUsageRepo:       aiTokenUsageRepo,
+		dictDatumRepo:          dictDatumRepo,
+	}
+}
+
+type AdminV1AiIndexWriteService struct {
+	pb.UnimplementedAiIndexWriteServer
+	log                    *log.Helper
+	aiWriteRecordRepo      *data.AiWriteRecordRepo
+	aiProviderModelRepo    *data.AiProviderModelRepo
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo
+	aiTokenUsageRepo       *data.AiTokenUsageRepo
+	dictDatumRepo          *data.DictDatumRepo
+}
+
+// getAiIndexWriteRecord 获取当前用户的写作记录
+func (a *AdminV1AiIndexWriteService) getAiIndexWriteRecord(ctx context.Context, id string) (*ai_boilerplate_model.AiWriteRecord, error) {
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	data, err := a.aiWriteRecordRepo.FindOneCacheByID(ctx, id)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前用户的写作
+	if data.AdminID != adminID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	return data, nil
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/fzf-labs/kratos-contrib/pkg/sse"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const (
+	// 写作错误信息最大长度(字段长度 255)
+	aiIndexWriteErrorMessageMaxLen = 255
+	// 写作系统提示词
+	aiIndexWriteSystemPrompt = "你是一名专业的写作助手。请严格按照用户给出的要求写作, 直接输出正文内容, 不要输出额外的解释或说明。"
+)
+
+// AiIndexWriteGenerateHandler AI 写作-生成 (SSE 流式返回)
+func (a *AdminV1AiIndexWriteService) AiIndexWriteGenerateHandler(ctx http.Context) error {
+	var in pb.AiIndexWriteGenerateReq
+	if err := ctx.Bind(&in); err != nil {
+		return err
+	}
+	http.SetOperation(ctx, "/admin.v1.AiIndexWrite/AiIndexWriteGenerate")
+	h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if in.GetType() == int32(constant.AiWriteTypeReply) && in.GetOriginalContent() == "" {
+			return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("original content is empty")))
+		}
+		tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+		adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+		// 校验租户 Token 配额
+		if err := a.aiTokenUsageRepo.CheckTokenQuota(ctx, tenantID); err != nil {
+			return nil, err
+		}
+		chatModel, opts, providerModel, platform, err := a.newAiIndexWriteModel(ctx, in.GetModelId())
+		if err != nil {
+			return nil, err
+		}
+		data := a.aiWriteRecordRepo.NewData()
+		data.TenantID = tenantID
+		data.AdminID = adminID
+		data.Type = in.GetType()
+		data.Platform = platform.Platform
+		data.ModelID = providerModel.ID
+		data.Model = providerModel.ModelID
+		data.Prompt = in.GetPrompt()
+		data.OriginalContent = in.GetOriginalContent()
+		data.Length = in.GetLength()
+		data.Format = in.GetFormat()
+		data.Tone = in.GetTone()
+		data.Language = in.GetLanguage()
+		err = a.aiWriteRecordRepo.CreateOneCache(ctx, data)
+		if err != nil {
+			return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+		return nil, a.streamAiIndexWrite(ctx, data, providerModel, chatModel, opts)
+	})
+	_, err := h(ctx, &in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// AiIndexWriteRegenerateHandler AI 写作-按已有记录的参数重新生成 (SSE 流式返回)
+func (a *AdminV1AiIndexWriteService) AiIndexWriteRegenerateHandler(ctx http.Context) error {
+	var in pb.AiIndexWriteRegenerateReq
+	if err := ctx.Bind(&in); err != nil {
+		return err
+	}
+	http.SetOperation(ctx, "/admin.v1.AiIndexWrite/AiIndexWriteRegenerate")
+	h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		data, err := a.getAiIndexWriteRecord(ctx, in.GetId())
+		if err != nil {
+			return nil, err
+		}
+		// 校验租户 Token 配额
+		if err := a.aiTokenUsageRepo.CheckTokenQuota(ctx, data.TenantID); err != nil {
+			return nil, err
+		}
+		chatModel, opts, providerModel, _, err := a.newAiIndexWriteModel(ctx, data.ModelID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, a.streamAiIndexWrite(ctx, data, providerModel, chatModel, opts)
+	})
+	_, err := h(ctx, &in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// streamAiIndexWrite 流式生成写作内容, 生成内容与错误信息在流结束、出错或客户端断开时落库
+func (a *AdminV1AiIndexWriteService) streamAiIndexWrite(
+	ctx context.Context,
+	record *ai_boilerplate_model.AiWriteRecord,
+	providerModel *ai_boilerplate_model.AiProviderModel,
+	chatModel model.ToolCallingChatModel,
+	opts []model.Option,
+) error {
+	messages, err := a.buildAiIndexWriteMessages(ctx, record)
+	if err != nil {
+		return err
+	}
+
+	// 创建 SSE Writer
+	sseWriter, streamCtx, err := sse.NewWriter(ctx)
+	if err != nil {
+		a.log.Errorf("create sse writer failed: %v", err)
+		return err
+	}
+
+	var fullContent strings.Builder
+	var usage *schema.TokenUsage
+	var streamErr error
+	defer func() {
+		saveCtx := context.WithoutCancel(ctx)
+		errorMessage := ""
+		if streamErr != nil {
+			errorMessage = streamErr.Error()
+			if msg := []rune(errorMessage); len(msg) > aiIndexWriteErrorMessageMaxLen {
+				errorMessage = string(msg[:aiIndexWriteErrorMessageMaxLen])
+			}
+		}
+		oldData := a.aiWriteRecordRepo.DeepCopy(record)
+		record.GeneratedContent = fullContent.String()
+		record.ErrorMessage = errorMessage
+		saveErr := a.aiWriteRecordRepo.UpdateOneCacheWithZero(saveCtx, record, oldData)
+		if saveErr != nil {
+			a.log.Errorf("save write record failed: %v", saveErr)
+		}
+		// 记录 Token 用量
+		usageErr := a.aiTokenUsageRepo.RecordUsage(saveCtx, record.TenantID, record.AdminID, constant.AiTokenUsageSceneWrite, providerModel, record.ID, usage)
+		if usageErr != nil {
+			a.log.Errorf("record token usage failed: %v", usageErr)
+		}
+	}()
+
+	// 流式生成内容
+	streamResult, err := chatModel.Stream(streamCtx, messages, opts...)
+	if err != nil {
+		a.log.Errorf("generate write failed: %v", err)
+		streamErr = err
+		_ = sseWriter.WriteError(err)
+		return err
+	}
+	defer streamResult.Close()
+
+	// 流式发送每个 chunk (SSE 格式)
+	for {
+		chunk, err := streamResult.Recv()
+		if err == io.EOF {
+			// 发送结束标记
+			if writeErr := sseWriter.WriteDone(); writeErr != nil {
+				a.log.Errorf("write done failed: %v", writeErr)
+			}
+			return nil
+		}
+		if err != nil {
+			a.log.Errorf("receive write failed: %v", err)
+			streamErr = err
+			_ = sseWriter.WriteError(err)
+			return err
+		}
+
+		// 累积完整内容, 用量通常在最后一个 chunk 中返回
+		fullContent.WriteString(chunk.Content)
+		if chunk.ResponseMeta != nil && chunk.ResponseMeta.Usage != nil {
+			usage = chunk.ResponseMeta.Usage
+		}
+
+		reply := &pb.AiIndexWriteGenerateReply{
+			Id:      record.ID,
+			Content: chunk.Content,
+		}
+		if err := sseWriter.WriteEvent(reply); err != nil {
+			a.log.Errorf("write event failed: %v", err)
+			streamErr = err
+			return err
+		}
+	}
+}
+
+// newAiIndexWriteModel 根据模型编号解析模型与平台, 获取聊天模型及调用参数
+func (a *AdminV1AiIndexWriteService) newAiIndexWriteModel(ctx context.Context, modelID string) (model.ToolCallingChatModel, []model.Option, *ai_boilerplate_model.AiProviderModel, *ai_boilerplate_model.AiProviderPlatform, error) {
+	providerModel, err := a.aiProviderModelRepo.FindOneCacheByID(ctx, modelID)
+	if err != nil {
+		return nil, nil, nil, nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if providerModel == nil || providerModel.ID == "" || providerModel.Status != int32(constant.StatusEnable) {
+		return nil, nil, nil, nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("model is not found")))
+	}
+	platform, err := a.aiProviderPlatformRepo.FindOneCacheByID(ctx, providerModel.PlatformID)
+	if err != nil {
+		return nil, nil, nil, nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if platform == nil || platform.ID == "" || platform.Status != int32(constant.StatusEnable) {
+		return nil, nil, nil, nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("platform is not found")))
+	}
+	chatModel, err := a.aiProviderPlatformRepo.GetChatModel(ctx, platform)
+	if err != nil {
+		return nil, nil, nil, nil, pb.ErrorReasonAPIThirdErr(pb.WithError(err))
+	}
+	opts, err := a.aiProviderModelRepo.ChatModelOptions(providerModel)
+	if err != nil {
+		return nil, nil, nil, nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
+	}
+	return chatModel, opts, providerModel, platform, nil
+}
+
+// buildAiIndexWriteMessages 构建发送给模型的消息, 长度、格式、语气、语言按字典标签拼接为写作要求
+func (a *AdminV1AiIndexWriteService) buildAiIndexWriteMessages(ctx context.Context, record *ai_boilerplate_model.AiWriteRecord) ([]*schema.Message, error) {
+	requirements := make([]string, 0)
+	for _, v := range []struct {
+		name     string
+		dictType string
+		value    int32
+	}{
+		{name: "长度", dictType: constant.DictTypeAiWriteLength, value: record.Length},
+		{name: "格式", dictType: constant.DictTypeAiWriteFormat, value: record.Format},
+		{name: "语气", dictType: constant.DictTypeAiWriteTone, value: record.Tone},
+		{name: "语言", dictType: constant.DictTypeAiWriteLanguage, value: record.Language},
+	} {
+		if v.value <= 0 {
+			continue
+		}
+		label, err := a.dictDatumRepo.GetLabelByValue(ctx, v.dictType, strconv.Itoa(int(v.value)))
+		if err != nil {
+			return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+		if label == "" {
+			continue
+		}
+		requirements = append(requirements, fmt.Sprintf("%s: %s", v.name, label))
+	}
+	var content strings.Builder
+	switch constant.AiWriteType(record.Type) {
+	case constant.AiWriteTypeReply:
+		content.WriteString("请针对以下原文撰写一篇回复。\n")
+		content.WriteString("原文:\n")
+		content.WriteString(record.OriginalContent)
+		content.WriteString("\n回复要点: ")
+		content.WriteString(record.Prompt)
+	default:
+		content.WriteString("请撰写一篇文章。\n")
+		content.WriteString("写作内容: ")
+		content.WriteString(record.Prompt)
+	}
+	if len(requirements) > 0 {
+		content.WriteString("\n写作要求:\n")
+		content.WriteString(strings.Join(requirements, "\n"))
+	}
+	return []*schema.Message{
+		schema.SystemMessage(aiIndexWriteSystemPrompt),
+		schema.UserMessage(content.String()),
+	}, nil
+}
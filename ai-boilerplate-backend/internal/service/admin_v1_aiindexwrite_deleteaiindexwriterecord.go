@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+)
+
+// DeleteAiIndexWriteRecord AI 写作表-删除一条数据
+func (a *AdminV1AiIndexWriteService) DeleteAiIndexWriteRecord(ctx context.Context, req *pb.DeleteAiIndexWriteRecordReq) (*pb.DeleteAiIndexWriteRecordReply, error) {
+	resp := &pb.DeleteAiIndexWriteRecordReply{}
+	_, err := a.getAiIndexWriteRecord(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	err = a.aiWriteRecordRepo.DeleteOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	return resp, nil
+}
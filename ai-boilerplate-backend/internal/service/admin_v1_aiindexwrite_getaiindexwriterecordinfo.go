@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+)
+
+// GetAiIndexWriteRecordInfo AI 写作表-单条数据查询
+func (a *AdminV1AiIndexWriteService) GetAiIndexWriteRecordInfo(ctx context.Context, req *pb.GetAiIndexWriteRecordInfoReq) (*pb.GetAiIndexWriteRecordInfoReply, error) {
+	resp := &pb.GetAiIndexWriteRecordInfoReply{}
+	data, err := a.getAiIndexWriteRecord(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	resp.Info = &pb.AiIndexWriteRecordInfo{
+		Id:               data.ID,
+		TenantId:         data.TenantID,
+		AdminId:          data.AdminID,
+		Type:             data.Type,
+		Platform:         data.Platform,
+		ModelId:          data.ModelID,
+		Model:            data.Model,
+		Prompt:           data.Prompt,
+		GeneratedContent: data.GeneratedContent,
+		OriginalContent:  data.OriginalContent,
+		Length:           data.Length,
+		Format:           data.Format,
+		Tone:             data.Tone,
+		Language:         data.Language,
+		ErrorMessage:     data.ErrorMessage,
+		CreatedAt:        data.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        data.UpdatedAt.Format(time.RFC3339),
+	}
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// GetAiIndexWriteRecordList AI 写作表-列表数据查询
+func (a *AdminV1AiIndexWriteService) GetAiIndexWriteRecordList(ctx context.Context, req *pb.GetAiIndexWriteRecordListReq) (*pb.GetAiIndexWriteRecordListReply, error) {
+	resp := &pb.GetAiIndexWriteRecordListReply{
+		Total: 0,
+		List:  []*pb.AiIndexWriteRecordInfo{},
+	}
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	param := &condition.Req{
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+		Query: []*condition.QueryParam{
+			{
+				Field: "admin_id",
+				Value: adminID,
+				Exp:   condition.EQ,
+				Logic: condition.AND,
+			},
+		},
+		Order: []*condition.OrderParam{
+			{
+				Field: "created_at",
+				Order: condition.DESC,
+			},
+		},
+	}
+	if req.GetType() > 0 {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "type",
+			Value: req.GetType(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	list, p, err := a.aiWriteRecordRepo.FindMultiCacheByCondition(ctx, param)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.Total = p.Total
+	if len(list) > 0 {
+		for _, v := range list {
+			resp.List = append(resp.List, &pb.AiIndexWriteRecordInfo{
+				Id:               v.ID,
+				TenantId:         v.TenantID,
+				AdminId:          v.AdminID,
+				Type:             v.Type,
+				Platform:         v.Platform,
+				ModelId:          v.ModelID,
+				Model:            v.Model,
+				Prompt:           v.Prompt,
+				GeneratedContent: v.GeneratedContent,
+				OriginalContent:  v.OriginalContent,
+				Length:           v.Length,
+				Format:           v.Format,
+				Tone:             v.Tone,
+				Language:         v.Language,
+				ErrorMessage:     v.ErrorMessage,
+				CreatedAt:        v.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:        v.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+	}
+	return resp, nil
+}
@@ -9,16 +9,19 @@ import (
 func NewAdminV1AiPromptService(
 	logger log.Logger,
 	aiPromptRepo *data.AiPromptRepo,
+	dataScopeRepo *data.DataScopeRepo,
 ) *AdminV1AiPromptService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiPrompt"))
 	return &AdminV1AiPromptService{
-		log:          l,
-		aiPromptRepo: aiPromptRepo,
+		log:           l,
+		aiPromptRepo:  aiPromptRepo,
+		dataScopeRepo: dataScopeRepo,
 	}
 }
 
 type AdminV1AiPromptService struct {
 	pb.UnimplementedAiPromptServer
-	log          *log.Helper
-	aiPromptRepo *data.AiPromptRepo
+	log           *log.Helper
+	aiPromptRepo  *data.AiPromptRepo
+	dataScopeRepo *data.DataScopeRepo
 }
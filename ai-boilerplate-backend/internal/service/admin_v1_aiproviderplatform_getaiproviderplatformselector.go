@@ -30,6 +30,14 @@ func (a *AdminV1AiProviderPlatformService) GetAiProviderPlatformSelector(_ conte
 				Label: "火山引擎",
 				Value: "volcengine",
 			},
+			{
+				Label: "DeepSeek",
+				Value: "deepseek",
+			},
+			{
+				Label: "Ollama",
+				Value: "ollama",
+			},
 		},
 	}
 	return resp, nil
@@ -22,5 +22,7 @@ func (a *AdminV1AiProviderPlatformService) UpdateAiProviderPlatformStatus(ctx co
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	// 平台配置变更后移除缓存的聊天模型客户端,下次调用时重建
+	a.aiProviderPlatformRepo.RemoveChatModel(req.GetId())
 	return resp, nil
 }
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewAdminV1AiTokenQuotaService(
+	logger log.Logger,
+	aiTokenQuotaRepo *data.AiTokenQuotaRepo,
+	aiTokenUsageRepo *data.AiTokenUsageRepo,
+	sysTenantRepo *data.SysTenantRepo,
+) *AdminV1AiTokenQuotaService {
+	l := log.NewHelper(log.With(logger, "module", "service/aiTokenQuota"))
+	return &AdminV1AiTokenQuotaService{
+		log:              l,
+		aiTokenQuotaRepo: aiTokenQuotaRepo,
+		aiTokenUsageRepo: aiTokenUsageRepo,
+		sysTenantRepo:    sysTenantRepo,
+	}
+}
+
+type AdminV1AiTokenQuotaService struct {
+	pb.UnimplementedAiTokenQuotaServer
+	log              *log.Helper
+	aiTokenQuotaRepo *data.AiTokenQuotaRepo
+	aiTokenUsageRepo *data.AiTokenUsageRepo
+	sysTenantRepo    *data.SysTenantRepo
+}
+
+// quotaTenantID 配额所属租户, 未指定时为当前租户, 只有平台租户可以指定其他租户
+func (a *AdminV1AiTokenQuotaService) quotaTenantID(ctx context.Context, tenantID string) (string, error) {
+	currentTenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	if tenantID == "" || tenantID == currentTenantID {
+		return currentTenantID, nil
+	}
+	if !a.sysTenantRepo.IsPlatformTenant(currentTenantID) {
+		return "", pb.ErrorReasonAccountNoDataPermission()
+	}
+	return tenantID, nil
+}
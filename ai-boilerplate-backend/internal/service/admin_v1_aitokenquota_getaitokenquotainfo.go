@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+)
+
+// GetAiTokenQuotaInfo AI Token 配额表-单条数据查询
+func (a *AdminV1AiTokenQuotaService) GetAiTokenQuotaInfo(ctx context.Context, req *pb.GetAiTokenQuotaInfoReq) (*pb.GetAiTokenQuotaInfoReply, error) {
+	resp := &pb.GetAiTokenQuotaInfoReply{}
+	tenantID, err := a.quotaTenantID(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	// 未配置配额时返回不限制
+	resp.Info = &pb.AiTokenQuotaInfo{
+		TenantId: tenantID,
+		Status:   int32(constant.StatusDisable),
+	}
+	data, err := a.aiTokenQuotaRepo.FindOneCacheByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data != nil && data.ID != "" {
+		resp.Info.DailyLimit = data.DailyLimit
+		resp.Info.MonthlyLimit = data.MonthlyLimit
+		resp.Info.Status = data.Status
+	}
+	resp.Info.DailyUsed, resp.Info.MonthlyUsed, err = a.aiTokenUsageRepo.GetUsedTokens(ctx, tenantID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	return resp, nil
+}
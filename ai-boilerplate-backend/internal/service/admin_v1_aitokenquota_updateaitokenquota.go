@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+)
+
+// UpdateAiTokenQuota AI Token 配额表-设置配额, 租户未配置时新建
+func (a *AdminV1AiTokenQuotaService) UpdateAiTokenQuota(ctx context.Context, req *pb.UpdateAiTokenQuotaReq) (*pb.UpdateAiTokenQuotaReply, error) {
+	resp := &pb.UpdateAiTokenQuotaReply{}
+	tenantID, err := a.quotaTenantID(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	data, err := a.aiTokenQuotaRepo.FindOneCacheByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		data = a.aiTokenQuotaRepo.NewData()
+		data.TenantID = tenantID
+		data.DailyLimit = req.GetDailyLimit()
+		data.MonthlyLimit = req.GetMonthlyLimit()
+		data.Status = req.GetStatus()
+		err = a.aiTokenQuotaRepo.CreateOneCache(ctx, data)
+		if err != nil {
+			return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+		return resp, nil
+	}
+	oldData := a.aiTokenQuotaRepo.DeepCopy(data)
+	data.DailyLimit = req.GetDailyLimit()
+	data.MonthlyLimit = req.GetMonthlyLimit()
+	data.Status = req.GetStatus()
+	err = a.aiTokenQuotaRepo.UpdateOneCacheWithZero(ctx, data, oldData)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	return resp, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewAdminV1AiTokenUsageService(
+	logger log.Logger,
+	aiTokenUsageRepo *data.AiTokenUsageRepo,
+	aiProviderModelRepo *data.AiProviderModelRepo,
+	sysAdminRepo *data.SysAdminRepo,
+	sysTenantRepo *data.SysTenantRepo,
+	dataScopeRepo *data.DataScopeRepo,
+) *AdminV1AiTokenUsageService {
+	l := log.NewHelper(log.With(logger, "module", "service/aiTokenUsage"))
+	return &AdminV1AiTokenUsageService{
+		log:                 l,
+		aiTokenUsageRepo:    aiTokenUsageRepo,
+		aiProviderModelRepo: aiProviderModelRepo,
+		sysAdminRepo:        sysAdminRepo,
+		sysTenantRepo:       sysTenantRepo,
+		dataScopeRepo:       dataScopeRepo,
+	}
+}
+
+type AdminV1AiTokenUsageService struct {
+	pb.UnimplementedAiTokenUsageServer
+	log                 *log.Helper
+	aiTokenUsageRepo    *data.AiTokenUsageRepo
+	aiProviderModelRepo *data.AiProviderModelRepo
+	sysAdminRepo        *data.SysAdminRepo
+	sysTenantRepo       *data.SysTenantRepo
+	dataScopeRepo       *data.DataScopeRepo
+}
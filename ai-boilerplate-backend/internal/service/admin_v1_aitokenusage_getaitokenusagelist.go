@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// GetAiTokenUsageList AI Token 用量表-列表数据查询
+func (a *AdminV1AiTokenUsageService) GetAiTokenUsageList(ctx context.Context, req *pb.GetAiTokenUsageListReq) (*pb.GetAiTokenUsageListReply, error) {
+	resp := &pb.GetAiTokenUsageListReply{
+		Total: 0,
+		List:  []*pb.AiTokenUsageInfo{},
+	}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	param := &condition.Req{
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+		Query: []*condition.QueryParam{
+			{
+				Field: "tenant_id",
+				Value: tenantID,
+			},
+		},
+		Order: []*condition.OrderParam{
+			{
+				Field: "created_at",
+				Order: condition.DESC,
+			},
+		},
+	}
+	if req.GetAdminId() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "admin_id",
+			Value: req.GetAdminId(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if req.GetModelId() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "model_id",
+			Value: req.GetModelId(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if req.GetScene() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "scene",
+			Value: req.GetScene(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if len(req.GetCreatedAt()) > 1 {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "created_at",
+			Value: req.GetCreatedAt()[0],
+			Exp:   condition.GTE,
+			Logic: condition.AND,
+		})
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "created_at",
+			Value: req.GetCreatedAt()[1],
+			Exp:   condition.LTE,
+			Logic: condition.AND,
+		})
+	}
+	// 按角色数据范围过滤
+	dataScope, err := a.dataScopeRepo.GetDataScope(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID))
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	param.Query = append(param.Query, dataScope.AdminQueryParams("admin_id")...)
+	list, p, err := a.aiTokenUsageRepo.FindMultiCacheByCondition(ctx, param)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.Total = p.Total
+	if len(list) > 0 {
+		for _, v := range list {
+			resp.List = append(resp.List, &pb.AiTokenUsageInfo{
+				Id:               v.ID,
+				AdminId:          v.AdminID,
+				ModelId:          v.ModelID,
+				Model:            v.Model,
+				Scene:            v.Scene,
+				BizId:            v.BizID,
+				PromptTokens:     v.PromptTokens,
+				CompletionTokens: v.CompletionTokens,
+				TotalTokens:      v.TotalTokens,
+				CreatedAt:        v.CreatedAt.Format(time.RFC3339),
+			})
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/samber/lo"
+)
+
+// GetAiTokenUsageReport AI Token 用量表-用量统计报表
+// 平台租户按租户统计时汇总全部租户, 其余情况只汇总当前租户
+func (a *AdminV1AiTokenUsageService) GetAiTokenUsageReport(ctx context.Context, req *pb.GetAiTokenUsageReportReq) (*pb.GetAiTokenUsageReportReply, error) {
+	resp := &pb.GetAiTokenUsageReportReply{
+		List: []*pb.AiTokenUsageReportItem{},
+	}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	if req.GetGroupBy() == "tenant" && a.sysTenantRepo.IsPlatformTenant(tenantID) {
+		tenantID = ""
+	}
+	var start, end time.Time
+	if len(req.GetCreatedAt()) > 1 {
+		start = carbon.Parse(req.GetCreatedAt()[0]).StdTime()
+		end = carbon.Parse(req.GetCreatedAt()[1]).StdTime()
+	}
+	list, err := a.aiTokenUsageRepo.GetUsageReport(ctx, req.GetGroupBy(), tenantID, req.GetScene(), start, end)
+	if err != nil {
+		if errors.Is(err, data.ErrAiTokenUsageGroupByInvalid) {
+			return nil, pb.ErrorReasonParamError(pb.WithError(err))
+		}
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if len(list) == 0 {
+		return resp, nil
+	}
+	nameMap, err := a.getAiTokenUsageReportNames(ctx, req.GetGroupBy(), list)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	for _, v := range list {
+		resp.List = append(resp.List, &pb.AiTokenUsageReportItem{
+			Id:               v.ID,
+			Name:             nameMap[v.ID],
+			PromptTokens:     v.PromptTokens,
+			CompletionTokens: v.CompletionTokens,
+			TotalTokens:      v.TotalTokens,
+			Count:            v.Count,
+		})
+	}
+	return resp, nil
+}
+
+// getAiTokenUsageReportNames 获取统计维度编号对应的名称
+func (a *AdminV1AiTokenUsageService) getAiTokenUsageReportNames(ctx context.Context, groupBy string, list []*data.AiTokenUsageReportItem) (map[string]string, error) {
+	ids := lo.Uniq(lo.Filter(lo.Map(list, func(item *data.AiTokenUsageReportItem, _ int) string {
+		return item.ID
+	}), func(item string, _ int) bool {
+		return item != ""
+	}))
+	nameMap := make(map[string]string)
+	if len(ids) == 0 {
+		return nameMap, nil
+	}
+	switch groupBy {
+	case "tenant":
+		tenants, err := a.sysTenantRepo.FindMultiCacheByIDS(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range tenants {
+			nameMap[v.ID] = v.Name
+		}
+	case "admin":
+		return a.sysAdminRepo.AdminIDToNickname(ctx, ids)
+	case "model":
+		models, err := a.aiProviderModelRepo.FindMultiCacheByIDS(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range models {
+			nameMap[v.ID] = v.ModelName
+		}
+	}
+	return nameMap, nil
+}
@@ -9,11 +9,13 @@ import (
 func NewAdminV1AiVideoRecordService(
 	logger log.Logger,
 	aiVideoRecordRepo *data.AiVideoRecordRepo,
+	dataScopeRepo *data.DataScopeRepo,
 ) *AdminV1AiVideoRecordService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiVideoRecord"))
 	return &AdminV1AiVideoRecordService{
 		log:               l,
 		aiVideoRecordRepo: aiVideoRecordRepo,
+		dataScopeRepo:     dataScopeRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1AiVideoRecordService struct {
 	pb.UnimplementedAiVideoRecordServer
 	log               *log.Helper
 	aiVideoRecordRepo *data.AiVideoRecordRepo
+	dataScopeRepo     *data.DataScopeRepo
 }
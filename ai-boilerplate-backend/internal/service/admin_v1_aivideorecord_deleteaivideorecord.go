@@ -4,12 +4,29 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // DeleteAiVideoRecord AI 视频表-删除一条数据
 func (a *AdminV1AiVideoRecordService) DeleteAiVideoRecord(ctx context.Context, req *pb.DeleteAiVideoRecordReq) (*pb.DeleteAiVideoRecordReply, error) {
 	resp := &pb.DeleteAiVideoRecordReply{}
-	err := a.aiVideoRecordRepo.DeleteOneCacheByID(ctx, req.GetId())
+	data, err := a.aiVideoRecordRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	err = a.aiVideoRecordRepo.DeleteOneCacheByID(ctx, req.GetId())
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/dromara/carbon/v2"
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/goutil/timeutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
 	"gorm.io/datatypes"
 )
 
@@ -19,6 +21,14 @@ func (a *AdminV1AiVideoRecordService) UpdateAiVideoRecord(ctx context.Context, r
 	if data == nil || data.ID == "" {
 		return nil, pb.ErrorReasonDataRecordNotFound()
 	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	oldData := a.aiVideoRecordRepo.DeepCopy(data)
 	data.AdminID = req.GetAdminId()
 	data.Prompt = req.GetPrompt()
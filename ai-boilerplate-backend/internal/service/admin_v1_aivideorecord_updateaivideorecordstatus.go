@@ -4,6 +4,8 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // UpdateAiVideoRecordStatus AI 视频表-更新状态
@@ -16,6 +18,14 @@ func (a *AdminV1AiVideoRecordService) UpdateAiVideoRecordStatus(ctx context.Cont
 	if data == nil || data.ID == "" {
 		return nil, pb.ErrorReasonDataRecordNotFound()
 	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	oldData := a.aiVideoRecordRepo.DeepCopy(data)
 	data.Status = req.GetStatus()
 	err = a.aiVideoRecordRepo.UpdateOneCacheWithZero(ctx, data, oldData)
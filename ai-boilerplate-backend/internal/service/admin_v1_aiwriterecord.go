@@ -9,11 +9,13 @@ import (
 func NewAdminV1AiWriteRecordService(
 	logger log.Logger,
 	aiWriteRecordRepo *data.AiWriteRecordRepo,
+	dataScopeRepo *data.DataScopeRepo,
 ) *AdminV1AiWriteRecordService {
 	l := log.NewHelper(log.With(logger, "module", "service/aiWriteRecord"))
 	return &AdminV1AiWriteRecordService{
 		log:               l,
 		aiWriteRecordRepo: aiWriteRecordRepo,
+		dataScopeRepo:     dataScopeRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1AiWriteRecordService struct {
 	pb.UnimplementedAiWriteRecordServer
 	log               *log.Helper
 	aiWriteRecordRepo *data.AiWriteRecordRepo
+	dataScopeRepo     *data.DataScopeRepo
 }
@@ -19,6 +19,14 @@ func (a *AdminV1AiWriteRecordService) UpdateAiWriteRecord(ctx context.Context, r
 	if data == nil || data.ID == "" {
 		return nil, pb.ErrorReasonDataRecordNotFound()
 	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckAdmin(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.AdminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	oldData := a.aiWriteRecordRepo.DeepCopy(data)
 	data.TenantID = tenantID
 	data.AdminID = req.GetAdminId()
@@ -1,8 +1,11 @@
 package service
 
 import (
+	"errors"
+
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -10,12 +13,14 @@ func NewAdminV1DeviceService(
 	logger log.Logger,
 	deviceRepo *data.DeviceRepo,
 	deviceHeartbeatRepo *data.DeviceHeartbeatRepo,
+	devicePushRepo *data.DevicePushRepo,
 ) *AdminV1DeviceService {
 	l := log.NewHelper(log.With(logger, "module", "service/device"))
 	return &AdminV1DeviceService{
 		log:                 l,
 		deviceRepo:          deviceRepo,
 		deviceHeartbeatRepo: deviceHeartbeatRepo,
+		devicePushRepo:      devicePushRepo,
 	}
 }
 
@@ -24,4 +29,35 @@ type AdminV1DeviceService struct {
 	log                 *log.Helper
 	deviceRepo          *data.DeviceRepo
 	deviceHeartbeatRepo *data.DeviceHeartbeatRepo
+	devicePushRepo      *data.DevicePushRepo
+}
+
+// devicePushMsg 将推送内容转换为推送消息, 通知需有标题, 透传消息直接使用内容
+func (a *AdminV1DeviceService) devicePushMsg(content *pb.DevicePushContent) (constant.DevicePushMsgType, string, error) {
+	msgType := constant.DevicePushMsgType(content.GetMsgType())
+	if msgType != constant.DevicePushMsgTypeNotification {
+		return msgType, content.GetContent(), nil
+	}
+	if content.GetTitle() == "" {
+		return msgType, "", pb.ErrorReasonParamError(pb.WithError(errors.New("title is required")))
+	}
+	msg, err := a.devicePushRepo.NewNotification(content.GetTitle(), content.GetContent(), content.GetUrl())
+	if err != nil {
+		return msgType, "", pb.ErrorReasonDataFormattingError(pb.WithError(err))
+	}
+	return msgType, msg, nil
+}
+
+// devicePushError 转换设备推送错误
+func devicePushError(err error) error {
+	switch {
+	case errors.Is(err, data.ErrDevicePushNoChannel):
+		return pb.ErrorReasonDevicePushNoChannel()
+	case errors.Is(err, data.ErrDeviceCommandInProgress):
+		return pb.ErrorReasonDeviceCommandInProgress()
+	case errors.Is(err, data.ErrDeviceCommandInvalid):
+		return pb.ErrorReasonParamError(pb.WithError(err))
+	default:
+		return pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
 }
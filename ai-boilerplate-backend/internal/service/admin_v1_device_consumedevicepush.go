@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+)
+
+// ConsumeDevicePush 设备表-消费设备推送任务
+func (a *AdminV1DeviceService) ConsumeDevicePush(ctx context.Context, payload []byte) error {
+	msg := &data.DevicePushMessage{}
+	err := json.Unmarshal(payload, msg)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to unmarshal device push message: %v", err)
+		return nil
+	}
+	return a.devicePushRepo.Deliver(ctx, msg.PushLogID)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/fzf-labs/goutil/timeutil"
+)
+
+// GetDevicePushLogList 设备表-推送日志列表
+func (a *AdminV1DeviceService) GetDevicePushLogList(ctx context.Context, req *pb.GetDevicePushLogListReq) (*pb.GetDevicePushLogListReply, error) {
+	resp := &pb.GetDevicePushLogListReply{
+		Total: 0,
+		List:  []*pb.DevicePushLogInfo{},
+	}
+	param := &condition.Req{
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+		Query:    []*condition.QueryParam{},
+		Order: []*condition.OrderParam{
+			{
+				Field: "created_at",
+				Order: condition.DESC,
+			},
+		},
+	}
+	if req.GetPushType() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "push_type",
+			Value: req.GetPushType(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if req.GetCommand() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "command",
+			Value: req.GetCommand(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if len(req.GetStatus()) > 0 {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "status",
+			Value: req.GetStatus(),
+			Exp:   condition.IN,
+			Logic: condition.AND,
+		})
+	}
+	if len(req.GetCreatedAt()) == 2 {
+		param.Query = append(param.Query,
+			&condition.QueryParam{
+				Field: "created_at",
+				Value: req.GetCreatedAt()[0],
+				Exp:   condition.GTE,
+				Logic: condition.AND,
+			},
+			&condition.QueryParam{
+				Field: "created_at",
+				Value: req.GetCreatedAt()[1],
+				Exp:   condition.LTE,
+				Logic: condition.AND,
+			},
+		)
+	}
+	list, p, err := a.devicePushRepo.FindMultiCacheByCondition(ctx, param)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.Total = p.Total
+	for _, v := range list {
+		sns := make([]string, 0)
+		if v.Sns.String() != "" {
+			if err := jsonutil.Unmarshal(v.Sns, &sns); err != nil {
+				return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
+			}
+		}
+		resp.List = append(resp.List, &pb.DevicePushLogInfo{
+			Id:           v.ID,
+			PushType:     v.PushType,
+			MsgType:      v.MsgType,
+			Sns:          sns,
+			Tag:          v.Tag,
+			Command:      v.Command,
+			Msg:          v.Msg,
+			Status:       v.Status,
+			MsgId:        v.MsgID,
+			Attempts:     v.Attempts,
+			ErrorMessage: v.ErrorMessage,
+			SendTime:     timeutil.RFC3339(v.SendTime.Time),
+			CreatedAt:    v.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+)
+
+// PushDevice 设备表-单设备推送
+func (a *AdminV1DeviceService) PushDevice(ctx context.Context, req *pb.PushDeviceReq) (*pb.PushDeviceReply, error) {
+	resp := &pb.PushDeviceReply{}
+	msgType, msg, err := a.devicePushMsg(req.GetContent())
+	if err != nil {
+		return nil, err
+	}
+	pushLog, err := a.devicePushRepo.PushDevices(ctx, []string{req.GetSn()}, msgType, msg)
+	if err != nil {
+		return nil, devicePushError(err)
+	}
+	resp.Id = pushLog.ID
+	return resp, nil
+}
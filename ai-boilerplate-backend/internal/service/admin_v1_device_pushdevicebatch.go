@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+)
+
+// PushDeviceBatch 设备表-批量设备推送
+func (a *AdminV1DeviceService) PushDeviceBatch(ctx context.Context, req *pb.PushDeviceBatchReq) (*pb.PushDeviceBatchReply, error) {
+	resp := &pb.PushDeviceBatchReply{}
+	msgType, msg, err := a.devicePushMsg(req.GetContent())
+	if err != nil {
+		return nil, err
+	}
+	pushLog, err := a.devicePushRepo.PushDevices(ctx, req.GetSns(), msgType, msg)
+	if err != nil {
+		return nil, devicePushError(err)
+	}
+	resp.Id = pushLog.ID
+	return resp, nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+)
+
+// PushDeviceTag 设备表-标签推送
+func (a *AdminV1DeviceService) PushDeviceTag(ctx context.Context, req *pb.PushDeviceTagReq) (*pb.PushDeviceTagReply, error) {
+	resp := &pb.PushDeviceTagReply{}
+	msgType, msg, err := a.devicePushMsg(req.GetContent())
+	if err != nil {
+		return nil, err
+	}
+	pushLog, err := a.devicePushRepo.PushTag(ctx, req.GetTag(), msgType, msg)
+	if err != nil {
+		return nil, devicePushError(err)
+	}
+	resp.Id = pushLog.ID
+	return resp, nil
+}
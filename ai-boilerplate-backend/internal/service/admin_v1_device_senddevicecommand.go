@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+)
+
+// SendDeviceCommand 设备表-下发远程指令
+func (a *AdminV1DeviceService) SendDeviceCommand(ctx context.Context, req *pb.SendDeviceCommandReq) (*pb.SendDeviceCommandReply, error) {
+	resp := &pb.SendDeviceCommandReply{}
+	command, err := constant.ParseDeviceCommand(req.GetCommand())
+	if err != nil {
+		return nil, pb.ErrorReasonParamError(pb.WithError(err))
+	}
+	pushLog, requestID, err := a.devicePushRepo.SendCommand(ctx, req.GetSn(), command, req.GetParams())
+	if err != nil {
+		return nil, devicePushError(err)
+	}
+	resp.Id = pushLog.ID
+	resp.RequestId = requestID
+	return resp, nil
+}
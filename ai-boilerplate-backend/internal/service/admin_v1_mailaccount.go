@@ -9,11 +9,13 @@ import (
 func NewAdminV1MailAccountService(
 	logger log.Logger,
 	mailAccountRepo *data.MailAccountRepo,
+	mailSendRepo *data.MailSendRepo,
 ) *AdminV1MailAccountService {
 	l := log.NewHelper(log.With(logger, "module", "service/mailAccount"))
 	return &AdminV1MailAccountService{
 		log:             l,
 		mailAccountRepo: mailAccountRepo,
+		mailSendRepo:    mailSendRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1MailAccountService struct {
 	pb.UnimplementedMailAccountServer
 	log             *log.Helper
 	mailAccountRepo *data.MailAccountRepo
+	mailSendRepo    *data.MailSendRepo
 }
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"io"
+	"net/http"
+)
+
+// MailBounceCallback 邮件退信回调, 请求体为原始退信邮件, 地址中的 accountId 与 sign 由 BounceCallbackPath 生成
+func (a *AdminV1MailAccountService) MailBounceCallback(hw http.ResponseWriter, r *http.Request) {
+	accountID := r.URL.Query().Get("accountId")
+	sign := r.URL.Query().Get("sign")
+	if accountID == "" || sign == "" {
+		a.log.WithContext(r.Context()).Errorf("mailBounceCallback accountId or sign is empty")
+		hw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, 10<<20))
+	if err != nil {
+		a.log.WithContext(r.Context()).Errorf("mailBounceCallback read body err: %v", err)
+		hw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	updated, err := a.mailSendRepo.HandleBounce(r.Context(), accountID, sign, body)
+	if err != nil {
+		a.log.WithContext(r.Context()).Errorf("mailBounceCallback account %s err: %v", accountID, err)
+		hw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	a.log.WithContext(r.Context()).Infof("mailBounceCallback account %s updated %d mail logs", accountID, updated)
+	hw.WriteHeader(http.StatusOK)
+}
@@ -15,17 +15,18 @@ func (a *AdminV1MailAccountService) GetMailAccountInfo(ctx context.Context, req
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
 	resp.Info = &pb.MailAccountInfo{
-		Id:        data.ID,
-		Mail:      data.Mail,
-		Username:  data.Username,
-		Password:  data.Password,
-		Host:      data.Host,
-		Port:      data.Port,
-		SslEnable: data.SslEnable,
-		Status:    data.Status,
-		Remark:    data.Remark,
-		CreatedAt: timeutil.RFC3339(data.CreatedAt),
-		UpdatedAt: timeutil.RFC3339(data.UpdatedAt),
+		Id:                 data.ID,
+		Mail:               data.Mail,
+		Username:           data.Username,
+		Password:           data.Password,
+		Host:               data.Host,
+		Port:               data.Port,
+		SslEnable:          data.SslEnable,
+		Status:             data.Status,
+		Remark:             data.Remark,
+		CreatedAt:          timeutil.RFC3339(data.CreatedAt),
+		UpdatedAt:          timeutil.RFC3339(data.UpdatedAt),
+		BounceCallbackPath: a.mailSendRepo.BounceCallbackPath(data),
 	}
 	return resp, nil
 }
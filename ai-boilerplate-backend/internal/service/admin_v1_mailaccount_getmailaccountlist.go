@@ -49,17 +49,18 @@ func (a *AdminV1MailAccountService) GetMailAccountList(ctx context.Context, req
 	if len(list) > 0 {
 		for _, v := range list {
 			resp.List = append(resp.List, &pb.MailAccountInfo{
-				Id:        v.ID,
-				Mail:      v.Mail,
-				Username:  v.Username,
-				Password:  v.Password,
-				Host:      v.Host,
-				Port:      v.Port,
-				SslEnable: v.SslEnable,
-				Status:    v.Status,
-				Remark:    v.Remark,
-				CreatedAt: v.CreatedAt.Format(time.RFC3339),
-				UpdatedAt: v.UpdatedAt.Format(time.RFC3339),
+				Id:                 v.ID,
+				Mail:               v.Mail,
+				Username:           v.Username,
+				Password:           v.Password,
+				Host:               v.Host,
+				Port:               v.Port,
+				SslEnable:          v.SslEnable,
+				Status:             v.Status,
+				Remark:             v.Remark,
+				CreatedAt:          v.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:          v.UpdatedAt.Format(time.RFC3339),
+				BounceCallbackPath: a.mailSendRepo.BounceCallbackPath(v),
 			})
 		}
 	}
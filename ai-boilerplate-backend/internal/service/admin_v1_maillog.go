@@ -9,16 +9,19 @@ import (
 func NewAdminV1MailLogService(
 	logger log.Logger,
 	mailLogRepo *data.MailLogRepo,
+	mailSendRepo *data.MailSendRepo,
 ) *AdminV1MailLogService {
 	l := log.NewHelper(log.With(logger, "module", "service/mailLog"))
 	return &AdminV1MailLogService{
-		log:         l,
-		mailLogRepo: mailLogRepo,
+		log:          l,
+		mailLogRepo:  mailLogRepo,
+		mailSendRepo: mailSendRepo,
 	}
 }
 
 type AdminV1MailLogService struct {
 	pb.UnimplementedMailLogServer
-	log         *log.Helper
-	mailLogRepo *data.MailLogRepo
+	log          *log.Helper
+	mailLogRepo  *data.MailLogRepo
+	mailSendRepo *data.MailSendRepo
 }
@@ -31,6 +31,11 @@ func (a *AdminV1MailLogService) GetMailLogInfo(ctx context.Context, req *pb.GetM
 		SendException:    data.SendException,
 		CreatedAt:        timeutil.RFC3339(data.CreatedAt),
 		UpdatedAt:        timeutil.RFC3339(data.UpdatedAt),
+		OpenCount:        data.OpenCount,
+		OpenTime:         timeutil.RFC3339(data.OpenTime.Time),
+		ClickCount:       data.ClickCount,
+		BounceTime:       timeutil.RFC3339(data.BounceTime.Time),
+		BounceReason:     data.BounceReason,
 	}
 	return resp, nil
 }
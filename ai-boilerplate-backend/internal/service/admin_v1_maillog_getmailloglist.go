@@ -50,6 +50,11 @@ func (a *AdminV1MailLogService) GetMailLogList(ctx context.Context, req *pb.GetM
 				SendException:    v.SendException,
 				CreatedAt:        v.CreatedAt.Format(time.RFC3339),
 				UpdatedAt:        v.UpdatedAt.Format(time.RFC3339),
+				OpenCount:        v.OpenCount,
+				OpenTime:         timeutil.RFC3339(v.OpenTime.Time),
+				ClickCount:       v.ClickCount,
+				BounceTime:       timeutil.RFC3339(v.BounceTime.Time),
+				BounceReason:     v.BounceReason,
 			})
 		}
 	}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+)
+
+// GetMailSuppression 邮件日志表-收件人退信暂停状态
+func (a *AdminV1MailLogService) GetMailSuppression(ctx context.Context, req *pb.GetMailSuppressionReq) (*pb.GetMailSuppressionReply, error) {
+	resp := &pb.GetMailSuppressionReply{}
+	count, err := a.mailSendRepo.HardBounceCount(ctx, req.GetToMail())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	suppressed, err := a.mailSendRepo.Suppressed(ctx, req.GetToMail())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.HardBounceCount = count
+	resp.Suppressed = suppressed
+	return resp, nil
+}
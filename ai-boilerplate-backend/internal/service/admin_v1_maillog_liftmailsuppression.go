@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+)
+
+// LiftMailSuppression 邮件日志表-解除收件人退信暂停
+func (a *AdminV1MailLogService) LiftMailSuppression(ctx context.Context, req *pb.LiftMailSuppressionReq) (*pb.LiftMailSuppressionReply, error) {
+	resp := &pb.LiftMailSuppressionReply{}
+	err := a.mailSendRepo.LiftSuppression(ctx, req.GetToMail())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+)
+
+// MailTrackOpen 邮件打开追踪, 无论是否记录成功都返回透明像素, 避免影响邮件展示
+func (a *AdminV1MailLogService) MailTrackOpen(hw http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	err := a.mailSendRepo.TrackOpen(r.Context(), id, r.URL.Query().Get("sign"))
+	if err != nil {
+		a.log.WithContext(r.Context()).Errorf("mailTrackOpen %s err: %v", id, err)
+	}
+	hw.Header().Set("Content-Type", "image/gif")
+	hw.Header().Set("Cache-Control", "no-store, no-cache, must-revalidate")
+	hw.WriteHeader(http.StatusOK)
+	_, _ = hw.Write(data.MailTrackPixel)
+}
+
+// MailTrackClick 邮件点击追踪, 记录后跳转到原链接
+func (a *AdminV1MailLogService) MailTrackClick(hw http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	target, err := a.mailSendRepo.TrackClick(r.Context(), id, r.URL.Query().Get("url"), r.URL.Query().Get("sign"))
+	if err != nil {
+		a.log.WithContext(r.Context()).Errorf("mailTrackClick %s err: %v", id, err)
+		if errors.Is(err, data.ErrMailTrackSignInvalid) {
+			hw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// 记录失败时仍然跳转, 签名已校验通过
+		target = r.URL.Query().Get("url")
+	}
+	http.Redirect(hw, r, target, http.StatusFound)
+}
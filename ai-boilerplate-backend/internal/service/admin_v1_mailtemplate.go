@@ -9,11 +9,13 @@ import (
 func NewAdminV1MailTemplateService(
 	logger log.Logger,
 	mailTemplateRepo *data.MailTemplateRepo,
+	mailSendRepo *data.MailSendRepo,
 ) *AdminV1MailTemplateService {
 	l := log.NewHelper(log.With(logger, "module", "service/mailTemplate"))
 	return &AdminV1MailTemplateService{
 		log:              l,
 		mailTemplateRepo: mailTemplateRepo,
+		mailSendRepo:     mailSendRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1MailTemplateService struct {
 	pb.UnimplementedMailTemplateServer
 	log              *log.Helper
 	mailTemplateRepo *data.MailTemplateRepo
+	mailSendRepo     *data.MailSendRepo
 }
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+)
+
+// ConsumeMailSend 邮件模版表-消费邮件发送任务
+func (a *AdminV1MailTemplateService) ConsumeMailSend(ctx context.Context, payload []byte) error {
+	msg := &data.MailSendMessage{}
+	err := json.Unmarshal(payload, msg)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to unmarshal mail send message: %v", err)
+		return nil
+	}
+	return a.mailSendRepo.Deliver(ctx, msg.MailLogID)
+}
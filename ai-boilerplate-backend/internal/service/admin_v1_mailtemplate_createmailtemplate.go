@@ -20,6 +20,8 @@ func (a *AdminV1MailTemplateService) CreateMailTemplate(ctx context.Context, req
 	data.Params = datatypes.JSON(req.GetParams())
 	data.Remark = req.GetRemark()
 	data.Status = req.GetStatus()
+	data.TrackOpen = req.GetTrackOpen()
+	data.TrackClick = req.GetTrackClick()
 	err := a.mailTemplateRepo.CreateOneCache(ctx, data)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
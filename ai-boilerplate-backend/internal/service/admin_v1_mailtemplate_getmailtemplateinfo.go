@@ -15,18 +15,20 @@ func (a *AdminV1MailTemplateService) GetMailTemplateInfo(ctx context.Context, re
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
 	resp.Info = &pb.MailTemplateInfo{
-		Id:        data.ID,
-		Name:      data.Name,
-		Code:      data.Code,
-		AccountId: data.AccountID,
-		Nickname:  data.Nickname,
-		Title:     data.Title,
-		Content:   data.Content,
-		Params:    string(data.Params),
-		Remark:    data.Remark,
-		Status:    data.Status,
-		CreatedAt: timeutil.RFC3339(data.CreatedAt),
-		UpdatedAt: timeutil.RFC3339(data.UpdatedAt),
+		Id:         data.ID,
+		Name:       data.Name,
+		Code:       data.Code,
+		AccountId:  data.AccountID,
+		Nickname:   data.Nickname,
+		Title:      data.Title,
+		Content:    data.Content,
+		Params:     string(data.Params),
+		Remark:     data.Remark,
+		Status:     data.Status,
+		CreatedAt:  timeutil.RFC3339(data.CreatedAt),
+		UpdatedAt:  timeutil.RFC3339(data.UpdatedAt),
+		TrackOpen:  data.TrackOpen,
+		TrackClick: data.TrackClick,
 	}
 	return resp, nil
 }
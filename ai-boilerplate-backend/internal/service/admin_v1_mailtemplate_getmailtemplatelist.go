@@ -79,18 +79,20 @@ func (a *AdminV1MailTemplateService) GetMailTemplateList(ctx context.Context, re
 	if len(list) > 0 {
 		for _, v := range list {
 			resp.List = append(resp.List, &pb.MailTemplateInfo{
-				Id:        v.ID,
-				Name:      v.Name,
-				Code:      v.Code,
-				AccountId: v.AccountID,
-				Nickname:  v.Nickname,
-				Title:     v.Title,
-				Content:   v.Content,
-				Params:    string(v.Params),
-				Remark:    v.Remark,
-				Status:    v.Status,
-				CreatedAt: v.CreatedAt.Format(time.RFC3339),
-				UpdatedAt: v.UpdatedAt.Format(time.RFC3339),
+				Id:         v.ID,
+				Name:       v.Name,
+				Code:       v.Code,
+				AccountId:  v.AccountID,
+				Nickname:   v.Nickname,
+				Title:      v.Title,
+				Content:    v.Content,
+				Params:     string(v.Params),
+				Remark:     v.Remark,
+				Status:     v.Status,
+				CreatedAt:  v.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:  v.UpdatedAt.Format(time.RFC3339),
+				TrackOpen:  v.TrackOpen,
+				TrackClick: v.TrackClick,
 			})
 		}
 	}
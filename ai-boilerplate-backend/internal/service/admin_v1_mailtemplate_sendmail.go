@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+)
+
+// SendMail 邮件模版表-发送邮件
+// 写入发送中的邮件日志并投递发送任务, 发送结果以邮件日志为准
+func (a *AdminV1MailTemplateService) SendMail(ctx context.Context, req *pb.SendMailReq) (*pb.SendMailReply, error) {
+	resp := &pb.SendMailReply{}
+	mailLog, err := a.mailSendRepo.SendByTemplateCode(ctx, req.GetTemplateCode(), req.GetToMail(), req.GetParams())
+	if err != nil {
+		switch {
+		case errors.Is(err, data.ErrMailRecipientSuppressed):
+			return nil, pb.ErrorReasonMailRecipientSuppressed(pb.WithError(err))
+		case errors.Is(err, data.ErrMailTemplateNotFound), errors.Is(err, data.ErrMailAccountUnavailable):
+			return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(err))
+		case errors.Is(err, data.ErrMailTemplateParamMissing):
+			return nil, pb.ErrorReasonParamError(pb.WithError(err))
+		}
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.MailLogId = mailLog.ID
+	return resp, nil
+}
@@ -27,6 +27,8 @@ func (a *AdminV1MailTemplateService) UpdateMailTemplate(ctx context.Context, req
 	data.Params = datatypes.JSON(req.GetParams())
 	data.Remark = req.GetRemark()
 	data.Status = req.GetStatus()
+	data.TrackOpen = req.GetTrackOpen()
+	data.TrackClick = req.GetTrackClick()
 	err = a.mailTemplateRepo.UpdateOneCacheWithZero(ctx, data, oldData)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
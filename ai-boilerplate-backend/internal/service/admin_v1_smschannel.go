@@ -9,11 +9,13 @@ import (
 func NewAdminV1SmsChannelService(
 	logger log.Logger,
 	smsChannelRepo *data.SmsChannelRepo,
+	smsSendRepo *data.SmsSendRepo,
 ) *AdminV1SmsChannelService {
 	l := log.NewHelper(log.With(logger, "module", "service/smsChannel"))
 	return &AdminV1SmsChannelService{
 		log:            l,
 		smsChannelRepo: smsChannelRepo,
+		smsSendRepo:    smsSendRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1SmsChannelService struct {
 	pb.UnimplementedSmsChannelServer
 	log            *log.Helper
 	smsChannelRepo *data.SmsChannelRepo
+	smsSendRepo    *data.SmsSendRepo
 }
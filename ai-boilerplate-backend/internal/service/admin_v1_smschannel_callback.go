@@ -0,0 +1,32 @@
+package service
+
+import (
+	"io"
+	"net/http"
+)
+
+// SmsReceiptCallback 短信回执回调, 地址中的 channelId 与 sign 由 ReceiptCallbackPath 生成
+func (a *AdminV1SmsChannelService) SmsReceiptCallback(hw http.ResponseWriter, r *http.Request) {
+	channelID := r.URL.Query().Get("channelId")
+	sign := r.URL.Query().Get("sign")
+	if channelID == "" || sign == "" {
+		a.log.WithContext(r.Context()).Errorf("smsReceiptCallback channelId or sign is empty")
+		hw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		a.log.WithContext(r.Context()).Errorf("smsReceiptCallback read body err: %v", err)
+		hw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	contentType, reply, err := a.smsSendRepo.HandleReceipt(r.Context(), channelID, sign, r, body)
+	if err != nil {
+		a.log.WithContext(r.Context()).Errorf("smsReceiptCallback channel %s err: %v", channelID, err)
+		hw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	hw.Header().Set("Content-Type", contentType)
+	hw.WriteHeader(http.StatusOK)
+	_, _ = hw.Write(reply)
+}
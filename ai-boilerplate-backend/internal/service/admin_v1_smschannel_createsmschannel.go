@@ -4,11 +4,16 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/goutil/jsonutil"
 )
 
 // CreateSmsChannel 短信渠道-创建一条数据
 func (a *AdminV1SmsChannelService) CreateSmsChannel(ctx context.Context, req *pb.CreateSmsChannelReq) (*pb.CreateSmsChannelReply, error) {
 	resp := &pb.CreateSmsChannelReply{}
+	configJSON, err := jsonutil.Marshal(req.GetConfig())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
 	data := a.smsChannelRepo.NewData()
 	data.Name = req.GetName()
 	data.Operator = req.GetOperator()
@@ -17,7 +22,8 @@ func (a *AdminV1SmsChannelService) CreateSmsChannel(ctx context.Context, req *pb
 	data.APISecret = req.GetAPISecret()
 	data.CallbackURL = req.GetCallbackURL()
 	data.Status = int16(req.GetStatus())
-	err := a.smsChannelRepo.CreateOneCache(ctx, data)
+	data.Config = configJSON
+	err = a.smsChannelRepo.CreateOneCache(ctx, data)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
@@ -5,6 +5,7 @@ import (
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/goutil/jsonutil"
 	"github.com/fzf-labs/goutil/timeutil"
 )
 
@@ -15,18 +16,27 @@ func (a *AdminV1SmsChannelService) GetSmsChannelInfo(ctx context.Context, req *p
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	config := &pb.SmsChannelConfig{}
+	if data.Config.String() != "" {
+		err = jsonutil.Unmarshal(data.Config, config)
+		if err != nil {
+			return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+	}
 	resp.Info = &pb.SmsChannelInfo{
-		Id:           data.ID,
-		Name:         data.Name,
-		Operator:     data.Operator,
-		Remark:       data.Remark,
-		APIKey:       data.APIKey,
-		APISecret:    data.APISecret,
-		CallbackURL:  data.CallbackURL,
-		Status:       int32(data.Status),
-		CreatedAt:    timeutil.RFC3339(data.CreatedAt),
-		UpdatedAt:    timeutil.RFC3339(data.UpdatedAt),
-		OperatorName: constant.SmsChannelCodeToName[data.Operator],
+		Id:                  data.ID,
+		Name:                data.Name,
+		Operator:            data.Operator,
+		Remark:              data.Remark,
+		APIKey:              data.APIKey,
+		APISecret:           data.APISecret,
+		CallbackURL:         data.CallbackURL,
+		Status:              int32(data.Status),
+		CreatedAt:           timeutil.RFC3339(data.CreatedAt),
+		UpdatedAt:           timeutil.RFC3339(data.UpdatedAt),
+		OperatorName:        constant.SmsChannelCodeToName[data.Operator],
+		Config:              config,
+		ReceiptCallbackPath: a.smsSendRepo.ReceiptCallbackPath(data),
 	}
 	return resp, nil
 }
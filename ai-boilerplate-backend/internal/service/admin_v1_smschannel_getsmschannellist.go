@@ -7,6 +7,7 @@ import (
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/goutil/jsonutil"
 )
 
 // GetSmsChannelList 短信渠道-列表数据查询
@@ -71,18 +72,27 @@ func (a *AdminV1SmsChannelService) GetSmsChannelList(ctx context.Context, req *p
 	resp.Total = p.Total
 	if len(list) > 0 {
 		for _, v := range list {
+			config := &pb.SmsChannelConfig{}
+			if v.Config.String() != "" {
+				err = jsonutil.Unmarshal(v.Config, config)
+				if err != nil {
+					return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+				}
+			}
 			resp.List = append(resp.List, &pb.SmsChannelInfo{
-				Id:           v.ID,
-				Name:         v.Name,
-				Operator:     v.Operator,
-				Remark:       v.Remark,
-				APIKey:       v.APIKey,
-				APISecret:    v.APISecret,
-				CallbackURL:  v.CallbackURL,
-				Status:       int32(v.Status),
-				CreatedAt:    v.CreatedAt.Format(time.RFC3339),
-				UpdatedAt:    v.UpdatedAt.Format(time.RFC3339),
-				OperatorName: constant.SmsChannelCodeToName[v.Operator],
+				Id:                  v.ID,
+				Name:                v.Name,
+				Operator:            v.Operator,
+				Remark:              v.Remark,
+				APIKey:              v.APIKey,
+				APISecret:           v.APISecret,
+				CallbackURL:         v.CallbackURL,
+				Status:              int32(v.Status),
+				CreatedAt:           v.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:           v.UpdatedAt.Format(time.RFC3339),
+				OperatorName:        constant.SmsChannelCodeToName[v.Operator],
+				Config:              config,
+				ReceiptCallbackPath: a.smsSendRepo.ReceiptCallbackPath(v),
 			})
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/goutil/jsonutil"
 )
 
 // UpdateSmsChannel 短信渠道-更新一条数据
@@ -16,6 +17,10 @@ func (a *AdminV1SmsChannelService) UpdateSmsChannel(ctx context.Context, req *pb
 	if data == nil || data.ID == "" {
 		return nil, pb.ErrorReasonDataRecordNotFound()
 	}
+	configJSON, err := jsonutil.Marshal(req.GetConfig())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
 	oldData := a.smsChannelRepo.DeepCopy(data)
 	data.Name = req.GetName()
 	data.Operator = req.GetOperator()
@@ -24,6 +29,7 @@ func (a *AdminV1SmsChannelService) UpdateSmsChannel(ctx context.Context, req *pb
 	data.APISecret = req.GetAPISecret()
 	data.CallbackURL = req.GetCallbackURL()
 	data.Status = int16(req.GetStatus())
+	data.Config = configJSON
 	err = a.smsChannelRepo.UpdateOneCacheWithZero(ctx, data, oldData)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
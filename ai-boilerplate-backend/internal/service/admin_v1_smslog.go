@@ -9,16 +9,22 @@ import (
 func NewAdminV1SmsLogService(
 	logger log.Logger,
 	smsLogRepo *data.SmsLogRepo,
+	smsChannelRepo *data.SmsChannelRepo,
+	smsSendRepo *data.SmsSendRepo,
 ) *AdminV1SmsLogService {
 	l := log.NewHelper(log.With(logger, "module", "service/smsLog"))
 	return &AdminV1SmsLogService{
-		log:        l,
-		smsLogRepo: smsLogRepo,
+		log:            l,
+		smsLogRepo:     smsLogRepo,
+		smsChannelRepo: smsChannelRepo,
+		smsSendRepo:    smsSendRepo,
 	}
 }
 
 type AdminV1SmsLogService struct {
 	pb.UnimplementedSmsLogServer
-	log        *log.Helper
-	smsLogRepo *data.SmsLogRepo
+	log            *log.Helper
+	smsLogRepo     *data.SmsLogRepo
+	smsChannelRepo *data.SmsChannelRepo
+	smsSendRepo    *data.SmsSendRepo
 }
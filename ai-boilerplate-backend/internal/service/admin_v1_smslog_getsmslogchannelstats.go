@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/samber/lo"
+)
+
+// GetSmsLogChannelStats 短信日志-渠道发送统计
+func (a *AdminV1SmsLogService) GetSmsLogChannelStats(ctx context.Context, req *pb.GetSmsLogChannelStatsReq) (*pb.GetSmsLogChannelStatsReply, error) {
+	resp := &pb.GetSmsLogChannelStatsReply{
+		List: []*pb.SmsLogChannelStatsItem{},
+	}
+	var start, end time.Time
+	if len(req.GetCreatedAt()) > 1 {
+		start = carbon.Parse(req.GetCreatedAt()[0]).StdTime()
+		end = carbon.Parse(req.GetCreatedAt()[1]).StdTime()
+	}
+	list, err := a.smsLogRepo.GetChannelStats(ctx, start, end)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if len(list) == 0 {
+		return resp, nil
+	}
+	channelIDToName, err := a.smsChannelRepo.IDToName(ctx, lo.Map(list, func(item *data.SmsChannelStatItem, _ int) string {
+		return item.SmsChannelID
+	}))
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	for _, v := range list {
+		breakerOpen, err := a.smsSendRepo.ChannelBreakerState(ctx, v.SmsChannelID)
+		if err != nil {
+			return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+		}
+		resp.List = append(resp.List, &pb.SmsLogChannelStatsItem{
+			SmsChannelId:   v.SmsChannelID,
+			SmsChannelName: channelIDToName[v.SmsChannelID],
+			Total:          v.Total,
+			SendFailed:     v.SendFailed,
+			ReceiveSuccess: v.ReceiveSuccess,
+			ReceiveFailed:  v.ReceiveFailed,
+			ReceivePending: v.ReceivePending,
+			FailureRate:    v.FailureRate(),
+			BreakerOpen:    breakerOpen,
+		})
+	}
+	return resp, nil
+}
@@ -25,6 +25,8 @@ func (a *AdminV1SmsTemplateService) GetSmsTemplateInfo(ctx context.Context, req
 		Remark:          data.Remark,
 		ApiTemplateId:   data.APITemplateID,
 		Status:          int32(data.Status),
+		Priority:        data.Priority,
+		Weight:          data.Weight,
 		CreatedAt:       timeutil.RFC3339(data.CreatedAt),
 		UpdatedAt:       timeutil.RFC3339(data.UpdatedAt),
 	}
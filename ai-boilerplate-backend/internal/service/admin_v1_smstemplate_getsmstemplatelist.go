@@ -54,6 +54,8 @@ func (a *AdminV1SmsTemplateService) GetSmsTemplateList(ctx context.Context, req
 				CreatedAt:       v.CreatedAt.Format(time.RFC3339),
 				UpdatedAt:       v.UpdatedAt.Format(time.RFC3339),
 				SmsChannelName:  smsChannelIDToName[v.SmsChannelID],
+				Priority:        v.Priority,
+				Weight:          v.Weight,
 			})
 		}
 	}
@@ -2,13 +2,34 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
 )
 
 // SendSmsTemplateMsg 短信模板-发送短信
-func (a *AdminV1SmsTemplateService) SendSmsTemplateMsg(_ context.Context, _ *pb.SendSmsTemplateMsgReq) (*pb.SendSmsTemplateMsgReply, error) {
+func (a *AdminV1SmsTemplateService) SendSmsTemplateMsg(ctx context.Context, req *pb.SendSmsTemplateMsgReq) (*pb.SendSmsTemplateMsgReply, error) {
 	resp := &pb.SendSmsTemplateMsgReply{}
-	// TODO
+	template, err := a.smsTemplateRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if template == nil || template.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	smsLog, err := a.smsSendRepo.Send(ctx, template, &data.SmsMessage{
+		Mobile: req.GetPhone(),
+		Params: req.GetParams(),
+	})
+	if smsLog != nil {
+		resp.SmsLogId = smsLog.ID
+	}
+	if err != nil {
+		if errors.Is(err, data.ErrSmsChannelUnavailable) {
+			return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(err))
+		}
+		return nil, pb.ErrorReasonAPIThirdErr(pb.WithError(err))
+	}
 	return resp, nil
 }
@@ -32,6 +32,8 @@ func (a *AdminV1SmsTemplateService) UpdateSmsTemplate(ctx context.Context, req *
 	data.APITemplateID = req.GetApiTemplateId()
 	data.Remark = req.GetRemark()
 	data.Status = int16(req.GetStatus())
+	data.Priority = req.GetPriority()
+	data.Weight = req.GetWeight()
 	err = a.smsTemplateRepo.UpdateOneCacheWithZero(ctx, data, oldData)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
@@ -12,22 +12,31 @@ func NewAdminV1SysAdminService(
 	sysRoleRepo *data.SysRoleRepo,
 	sysDeptRepo *data.SysDeptRepo,
 	sysPostRepo *data.SysPostRepo,
+	dataScopeRepo *data.DataScopeRepo,
+	loginLimitRepo *data.LoginLimitRepo,
+	twoFactorRepo *data.TwoFactorRepo,
 ) *AdminV1SysAdminService {
 	l := log.NewHelper(log.With(logger, "module", "service/sysAdmin"))
 	return &AdminV1SysAdminService{
-		log:          l,
-		sysAdminRepo: sysAdminRepo,
-		sysRoleRepo:  sysRoleRepo,
-		sysDeptRepo:  sysDeptRepo,
-		sysPostRepo:  sysPostRepo,
+		log:            l,
+		sysAdminRepo:   sysAdminRepo,
+		sysRoleRepo:    sysRoleRepo,
+		sysDeptRepo:    sysDeptRepo,
+		sysPostRepo:    sysPostRepo,
+		dataScopeRepo:  dataScopeRepo,
+		loginLimitRepo: loginLimitRepo,
+		twoFactorRepo:  twoFactorRepo,
 	}
 }
 
 type AdminV1SysAdminService struct {
 	pb.UnimplementedSysAdminServer
-	log          *log.Helper
-	sysAdminRepo *data.SysAdminRepo
-	sysRoleRepo  *data.SysRoleRepo
-	sysDeptRepo  *data.SysDeptRepo
-	sysPostRepo  *data.SysPostRepo
+	log            *log.Helper
+	sysAdminRepo   *data.SysAdminRepo
+	sysRoleRepo    *data.SysRoleRepo
+	sysDeptRepo    *data.SysDeptRepo
+	sysPostRepo    *data.SysPostRepo
+	dataScopeRepo  *data.DataScopeRepo
+	loginLimitRepo *data.LoginLimitRepo
+	twoFactorRepo  *data.TwoFactorRepo
 }
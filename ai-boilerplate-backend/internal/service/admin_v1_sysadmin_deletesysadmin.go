@@ -4,14 +4,36 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // DeleteSysAdmin 系统-用户-删除一条数据
 func (a *AdminV1SysAdminService) DeleteSysAdmin(ctx context.Context, req *pb.DeleteSysAdminReq) (*pb.DeleteSysAdminReply, error) {
 	resp := &pb.DeleteSysAdminReply{}
-	err := a.sysAdminRepo.DeleteOneCacheByID(ctx, req.GetId())
+	data, err := a.sysAdminRepo.FindOneCacheByID(ctx, req.GetId())
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckDept(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.DeptID, data.ID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	err = a.sysAdminRepo.DeleteOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	// 删除后注销管理员的全部登录会话
+	err = a.sysAdminRepo.RevokeAllSessions(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
 	return resp, nil
 }
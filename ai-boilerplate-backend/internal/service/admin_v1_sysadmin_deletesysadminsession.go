@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// DeleteSysAdminSession 系统-用户-强制下线登录会话
+func (a *AdminV1SysAdminService) DeleteSysAdminSession(ctx context.Context, req *pb.DeleteSysAdminSessionReq) (*pb.DeleteSysAdminSessionReply, error) {
+	resp := &pb.DeleteSysAdminSessionReply{}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	admin, err := a.sysAdminRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if admin == nil || admin.ID == "" {
+		return nil, pb.ErrorReasonAccountNotFound()
+	}
+	// 判断是否是当前租户的管理员
+	if admin.TenantID != tenantID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckDept(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), admin.DeptID, admin.ID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	err = a.sysAdminRepo.RevokeSession(ctx, admin.ID, req.GetSessionId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	return resp, nil
+}
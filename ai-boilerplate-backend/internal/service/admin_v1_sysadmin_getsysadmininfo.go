@@ -4,7 +4,9 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/goutil/timeutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // GetSysAdminInfo 系统-用户-单条数据查询
@@ -14,6 +16,17 @@ func (a *AdminV1SysAdminService) GetSysAdminInfo(ctx context.Context, req *pb.Ge
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckDept(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.DeptID, data.ID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	roleNameMap, err := a.sysRoleRepo.RoleIDToName(ctx, []string{data.RoleID})
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
@@ -27,22 +40,23 @@ func (a *AdminV1SysAdminService) GetSysAdminInfo(ctx context.Context, req *pb.Ge
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
 	resp.Info = &pb.SysAdminInfo{
-		Id:        data.ID,
-		Username:  data.Username,
-		Nickname:  data.Nickname,
-		Avatar:    data.Avatar,
-		Sex:       int32(data.Sex),
-		Email:     data.Email,
-		Mobile:    data.Mobile,
-		RoleId:    data.RoleID,
-		PostId:    data.PostID,
-		DeptId:    data.DeptID,
-		Status:    int32(data.Status),
-		CreatedAt: timeutil.RFC3339(data.CreatedAt),
-		UpdatedAt: timeutil.RFC3339(data.UpdatedAt),
-		RoleName:  roleNameMap[data.RoleID],
-		DeptName:  deptNameMap[data.DeptID],
-		PostName:  postNameMap[data.PostID],
+		Id:               data.ID,
+		Username:         data.Username,
+		Nickname:         data.Nickname,
+		Avatar:           data.Avatar,
+		Sex:              int32(data.Sex),
+		Email:            data.Email,
+		Mobile:           data.Mobile,
+		RoleId:           data.RoleID,
+		PostId:           data.PostID,
+		DeptId:           data.DeptID,
+		Status:           int32(data.Status),
+		CreatedAt:        timeutil.RFC3339(data.CreatedAt),
+		UpdatedAt:        timeutil.RFC3339(data.UpdatedAt),
+		RoleName:         roleNameMap[data.RoleID],
+		DeptName:         deptNameMap[data.DeptID],
+		PostName:         postNameMap[data.PostID],
+		TwoFactorEnabled: data.TwoFactorEnabled,
 	}
 	return resp, nil
 }
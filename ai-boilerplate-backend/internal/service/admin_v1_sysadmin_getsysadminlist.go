@@ -79,6 +79,12 @@ func (a *AdminV1SysAdminService) GetSysAdminList(ctx context.Context, req *pb.Ge
 			Logic: condition.AND,
 		})
 	}
+	// 按角色数据范围过滤
+	dataScope, err := a.dataScopeRepo.GetDataScope(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID))
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	param.Query = append(param.Query, dataScope.DeptQueryParams("dept_id", "id")...)
 	list, p, err := a.sysAdminRepo.FindMultiCacheByCondition(ctx, param)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
@@ -107,22 +113,23 @@ func (a *AdminV1SysAdminService) GetSysAdminList(ctx context.Context, req *pb.Ge
 		}
 		for _, v := range list {
 			resp.List = append(resp.List, &pb.SysAdminInfo{
-				Id:        v.ID,
-				Username:  v.Username,
-				Nickname:  v.Nickname,
-				Avatar:    v.Avatar,
-				Sex:       int32(v.Sex),
-				Email:     v.Email,
-				Mobile:    v.Mobile,
-				RoleId:    v.RoleID,
-				DeptId:    v.DeptID,
-				PostId:    v.PostID,
-				Status:    int32(v.Status),
-				CreatedAt: v.CreatedAt.Format(time.RFC3339),
-				UpdatedAt: v.UpdatedAt.Format(time.RFC3339),
-				RoleName:  roleNameMap[v.RoleID],
-				DeptName:  deptNameMap[v.DeptID],
-				PostName:  postNameMap[v.PostID],
+				Id:               v.ID,
+				Username:         v.Username,
+				Nickname:         v.Nickname,
+				Avatar:           v.Avatar,
+				Sex:              int32(v.Sex),
+				Email:            v.Email,
+				Mobile:           v.Mobile,
+				RoleId:           v.RoleID,
+				DeptId:           v.DeptID,
+				PostId:           v.PostID,
+				Status:           int32(v.Status),
+				CreatedAt:        v.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:        v.UpdatedAt.Format(time.RFC3339),
+				RoleName:         roleNameMap[v.RoleID],
+				DeptName:         deptNameMap[v.DeptID],
+				PostName:         postNameMap[v.PostID],
+				TwoFactorEnabled: v.TwoFactorEnabled,
 			})
 		}
 	}
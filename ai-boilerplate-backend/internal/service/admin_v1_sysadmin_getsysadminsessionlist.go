@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/goutil/timeutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// GetSysAdminSessionList 系统-用户-登录会话列表
+func (a *AdminV1SysAdminService) GetSysAdminSessionList(ctx context.Context, req *pb.GetSysAdminSessionListReq) (*pb.GetSysAdminSessionListReply, error) {
+	resp := &pb.GetSysAdminSessionListReply{
+		List: []*pb.SysAdminSessionInfo{},
+	}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	admin, err := a.sysAdminRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if admin == nil || admin.ID == "" {
+		return nil, pb.ErrorReasonAccountNotFound()
+	}
+	// 判断是否是当前租户的管理员
+	if admin.TenantID != tenantID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckDept(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), admin.DeptID, admin.ID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	list, err := a.sysAdminRepo.GetSessionList(ctx, admin.ID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	for _, v := range list {
+		resp.List = append(resp.List, &pb.SysAdminSessionInfo{
+			Id:         v.ID,
+			Device:     v.Device,
+			Ip:         v.IP,
+			UserAgent:  v.UserAgent,
+			LoginAt:    timeutil.RFC3339(time.Unix(v.LoginAt, 0)),
+			LastSeenAt: timeutil.RFC3339(time.Unix(v.LastSeenAt, 0)),
+			ExpiredAt:  timeutil.RFC3339(time.Unix(v.ExpiredAt, 0)),
+		})
+	}
+	return resp, nil
+}
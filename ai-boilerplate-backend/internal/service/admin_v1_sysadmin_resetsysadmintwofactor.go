@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// ResetSysAdminTwoFactor 系统-用户-重置两步验证
+// 用于管理员丢失验证器且恢复码用尽的情况, 重置后如角色要求开启, 下次登录时重新绑定
+func (a *AdminV1SysAdminService) ResetSysAdminTwoFactor(ctx context.Context, req *pb.ResetSysAdminTwoFactorReq) (*pb.ResetSysAdminTwoFactorReply, error) {
+	resp := &pb.ResetSysAdminTwoFactorReply{}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	admin, err := a.sysAdminRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if admin == nil || admin.ID == "" {
+		return nil, pb.ErrorReasonAccountNotFound()
+	}
+	// 判断是否是当前租户的管理员
+	if admin.TenantID != tenantID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckDept(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), admin.DeptID, admin.ID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	if !admin.TwoFactorEnabled {
+		return nil, pb.ErrorReasonTwoFactorNotEnabled()
+	}
+	err = a.twoFactorRepo.Disable(ctx, admin)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	return resp, nil
+}
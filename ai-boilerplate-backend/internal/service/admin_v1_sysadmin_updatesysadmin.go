@@ -4,6 +4,8 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // UpdateSysAdmin 系统-用户-更新一条数据
@@ -16,6 +18,14 @@ func (a *AdminV1SysAdminService) UpdateSysAdmin(ctx context.Context, req *pb.Upd
 	if data == nil || data.ID == "" {
 		return nil, pb.ErrorReasonDataRecordNotFound()
 	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckDept(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.DeptID, data.ID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	oldData := a.sysAdminRepo.DeepCopy(data)
 	data.Username = req.GetUsername()
 	data.Nickname = req.GetNickname()
@@ -31,5 +41,12 @@ func (a *AdminV1SysAdminService) UpdateSysAdmin(ctx context.Context, req *pb.Upd
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	// 禁用后注销管理员的全部登录会话
+	if data.Status != int16(constant.StatusEnable) {
+		err = a.sysAdminRepo.RevokeAllSessions(ctx, data.ID)
+		if err != nil {
+			return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+		}
+	}
 	return resp, nil
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/goutil/cryptutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // UpdateSysAdminPassword 系统-用户-重置密码
@@ -21,6 +23,14 @@ func (a *AdminV1SysAdminService) UpdateSysAdminPassword(ctx context.Context, req
 	if data == nil || data.ID == "" {
 		return nil, pb.ErrorReasonDataRecordNotFound()
 	}
+	// 按角色数据范围校验
+	ok, err := a.dataScopeRepo.CheckDept(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID), data.DeptID, data.ID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
 	oldData := a.sysAdminRepo.DeepCopy(data)
 	data.Password = password
 	err = a.sysAdminRepo.UpdateOneCacheWithZero(ctx, data, oldData)
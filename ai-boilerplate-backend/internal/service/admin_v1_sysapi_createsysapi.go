@@ -2,19 +2,28 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 )
 
 // CreateSysAPI 系统-接口-创建一条数据
-func (a *AdminV1SysAPIService) CreateSysAPI(ctx context.Context, _ *pb.CreateSysAPIReq) (*pb.CreateSysAPIReply, error) {
+func (a *AdminV1SysAPIService) CreateSysAPI(ctx context.Context, req *pb.CreateSysAPIReq) (*pb.CreateSysAPIReply, error) {
 	resp := &pb.CreateSysAPIReply{}
 	data := a.sysAPIRepo.NewData()
-	// TODO
+	data.PermissionID = req.GetPermissionId()
+	data.Method = strings.ToUpper(req.GetMethod())
+	data.Path = req.GetPath()
+	data.Desc = req.GetDesc()
 	err := a.sysAPIRepo.CreateOneCache(ctx, data)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
 	resp.Id = data.ID
+	// 删除接口权限映射缓存
+	err = a.sysAPIRepo.DelPermissionCache(ctx)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
 	return resp, nil
 }
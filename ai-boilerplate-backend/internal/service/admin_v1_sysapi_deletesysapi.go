@@ -13,5 +13,10 @@ func (a *AdminV1SysAPIService) DeleteSysAPI(ctx context.Context, req *pb.DeleteS
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	// 删除接口权限映射缓存
+	err = a.sysAPIRepo.DelPermissionCache(ctx)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
 	return resp, nil
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 )
@@ -17,10 +18,18 @@ func (a *AdminV1SysAPIService) UpdateSysAPI(ctx context.Context, req *pb.UpdateS
 		return nil, pb.ErrorReasonDataRecordNotFound()
 	}
 	oldData := a.sysAPIRepo.DeepCopy(data)
-	// TODO
+	data.PermissionID = req.GetPermissionId()
+	data.Method = strings.ToUpper(req.GetMethod())
+	data.Path = req.GetPath()
+	data.Desc = req.GetDesc()
 	err = a.sysAPIRepo.UpdateOneCacheWithZero(ctx, data, oldData)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	// 删除接口权限映射缓存
+	err = a.sysAPIRepo.DelPermissionCache(ctx)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
 	return resp, nil
 }
@@ -13,24 +13,33 @@ func NewAdminV1SysAuthService(
 	sysRoleRepo *data.SysRoleRepo,
 	sysDeptRepo *data.SysDeptRepo,
 	sysPostRepo *data.SysPostRepo,
+	sysAPIRepo *data.SysAPIRepo,
+	loginLimitRepo *data.LoginLimitRepo,
+	twoFactorRepo *data.TwoFactorRepo,
 ) *AdminV1SysAuthService {
 	l := log.NewHelper(log.With(logger, "module", "service/sysAuth"))
 	return &AdminV1SysAuthService{
-		log:          l,
-		sysAdminRepo: sysAdminRepo,
-		sysMenuRepo:  sysMenuRepo,
-		sysRoleRepo:  sysRoleRepo,
-		sysDeptRepo:  sysDeptRepo,
-		sysPostRepo:  sysPostRepo,
+		log:            l,
+		sysAdminRepo:   sysAdminRepo,
+		sysMenuRepo:    sysMenuRepo,
+		sysRoleRepo:    sysRoleRepo,
+		sysDeptRepo:    sysDeptRepo,
+		sysPostRepo:    sysPostRepo,
+		sysAPIRepo:     sysAPIRepo,
+		loginLimitRepo: loginLimitRepo,
+		twoFactorRepo:  twoFactorRepo,
 	}
 }
 
 type AdminV1SysAuthService struct {
 	pb.UnimplementedSysAuthServer
-	log          *log.Helper
-	sysAdminRepo *data.SysAdminRepo
-	sysMenuRepo  *data.SysMenuRepo
-	sysRoleRepo  *data.SysRoleRepo
-	sysDeptRepo  *data.SysDeptRepo
-	sysPostRepo  *data.SysPostRepo
+	log            *log.Helper
+	sysAdminRepo   *data.SysAdminRepo
+	sysMenuRepo    *data.SysMenuRepo
+	sysRoleRepo    *data.SysRoleRepo
+	sysDeptRepo    *data.SysDeptRepo
+	sysPostRepo    *data.SysPostRepo
+	sysAPIRepo     *data.SysAPIRepo
+	loginLimitRepo *data.LoginLimitRepo
+	twoFactorRepo  *data.TwoFactorRepo
 }
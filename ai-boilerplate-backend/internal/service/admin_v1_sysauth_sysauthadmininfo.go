@@ -34,22 +34,23 @@ func (a *AdminV1SysAuthService) SysAuthAdminInfo(ctx context.Context, req *pb.Sy
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
 	resp.Info = &pb.SysAdminInfo{
-		Id:        admin.ID,
-		Username:  admin.Username,
-		Nickname:  admin.Nickname,
-		Avatar:    admin.Avatar,
-		Sex:       int32(admin.Sex),
-		Email:     admin.Email,
-		Mobile:    admin.Mobile,
-		RoleId:    admin.RoleID,
-		PostId:    admin.PostID,
-		DeptId:    admin.DeptID,
-		Status:    int32(admin.Status),
-		CreatedAt: timeutil.RFC3339(admin.CreatedAt),
-		UpdatedAt: timeutil.RFC3339(admin.UpdatedAt),
-		RoleName:  roleNameMap[admin.RoleID],
-		DeptName:  deptNameMap[admin.DeptID],
-		PostName:  postNameMap[admin.PostID],
+		Id:               admin.ID,
+		Username:         admin.Username,
+		Nickname:         admin.Nickname,
+		Avatar:           admin.Avatar,
+		Sex:              int32(admin.Sex),
+		Email:            admin.Email,
+		Mobile:           admin.Mobile,
+		RoleId:           admin.RoleID,
+		PostId:           admin.PostID,
+		DeptId:           admin.DeptID,
+		Status:           int32(admin.Status),
+		CreatedAt:        timeutil.RFC3339(admin.CreatedAt),
+		UpdatedAt:        timeutil.RFC3339(admin.UpdatedAt),
+		RoleName:         roleNameMap[admin.RoleID],
+		DeptName:         deptNameMap[admin.DeptID],
+		PostName:         postNameMap[admin.PostID],
+		TwoFactorEnabled: admin.TwoFactorEnabled,
 	}
 	return resp, nil
 }
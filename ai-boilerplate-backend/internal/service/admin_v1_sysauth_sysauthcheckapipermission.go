@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/samber/lo"
+)
+
+// sysAPIOperationPrefix 管理后台 protobuf 接口 operation 前缀
+const sysAPIOperationPrefix = "/admin."
+
+// SysAuthCheckAPIPermission Auth-校验接口权限
+// 按请求方法依次用 HTTP 路径与 operation 匹配 sys_api 获取所需权限
+// 未配置的 protobuf 接口(operation 以 /admin. 开头)默认拒绝, 其余未配置的自定义路由不做限制
+// 超级管理员角色跳过接口权限校验
+func (a *AdminV1SysAuthService) SysAuthCheckAPIPermission(ctx context.Context, adminID, method string, paths ...string) error {
+	admin, err := a.sysAdminRepo.FindOneCacheByID(ctx, adminID)
+	if err != nil {
+		return pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if admin == nil || admin.ID == "" {
+		return pb.ErrorReasonAccountNotFound()
+	}
+	if a.sysRoleRepo.IsSuperRole(admin.RoleID) {
+		return nil
+	}
+	var permissionID string
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		id, err := a.sysAPIRepo.GetPermissionID(ctx, method, path)
+		if err != nil {
+			return pb.ErrorReasonDataRedisErr(pb.WithError(err))
+		}
+		if id != "" {
+			permissionID = id
+			break
+		}
+	}
+	if permissionID == "" {
+		if lo.ContainsBy(paths, func(path string) bool {
+			return strings.HasPrefix(path, sysAPIOperationPrefix)
+		}) {
+			return pb.ErrorReasonAccountNoAPIPermission()
+		}
+		return nil
+	}
+	menu, err := a.sysMenuRepo.FindOneCacheByID(ctx, permissionID)
+	if err != nil {
+		return pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if menu == nil || menu.ID == "" || menu.Permission == "" || menu.Status != int16(constant.StatusEnable) {
+		return pb.ErrorReasonAccountNoAPIPermission()
+	}
+	permissions, err := a.getSysRolePermissions(ctx, admin.RoleID)
+	if err != nil {
+		return err
+	}
+	if !lo.Contains(permissions, menu.Permission) {
+		return pb.ErrorReasonAccountNoAPIPermission()
+	}
+	return nil
+}
+
+// getSysRolePermissions 获取角色的权限标识集合, 只包含开启的菜单, 结果缓存至角色或菜单变更
+// 超级管理员角色拥有全部开启菜单的权限标识
+func (a *AdminV1SysAuthService) getSysRolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	if roleID == "" {
+		return []string{}, nil
+	}
+	if a.sysRoleRepo.IsSuperRole(roleID) {
+		permissions, err := a.sysMenuRepo.EnabledPermissions(ctx)
+		if err != nil {
+			return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+		return permissions, nil
+	}
+	permissions, ok, err := a.sysRoleRepo.GetPermissionCache(ctx, roleID)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to get role permission cache: %v", err)
+	}
+	if ok {
+		return permissions, nil
+	}
+	role, err := a.sysRoleRepo.FindOneCacheByID(ctx, roleID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	permissions = []string{}
+	if role != nil && role.ID != "" && role.Status == int16(constant.StatusEnable) {
+		menuIDs := make([]string, 0)
+		if role.MenuIds.String() != "" {
+			if unmarshalErr := jsonutil.Unmarshal(role.MenuIds, &menuIDs); unmarshalErr != nil {
+				return nil, pb.ErrorReasonDataFormattingError(pb.WithError(unmarshalErr))
+			}
+		}
+		if len(menuIDs) > 0 {
+			menus, err := a.sysMenuRepo.FindMultiCacheByIDS(ctx, menuIDs)
+			if err != nil {
+				return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+			}
+			menus = lo.Filter(menus, func(menu *ai_boilerplate_model.SysMenu, _ int) bool {
+				return menu.Status == int16(constant.StatusEnable)
+			})
+			permissions, err = a.sysMenuRepo.TraversePermissions(ctx, menus)
+			if err != nil {
+				return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+			}
+		}
+	}
+	err = a.sysRoleRepo.SetPermissionCache(ctx, roleID, permissions)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to set role permission cache: %v", err)
+	}
+	return permissions, nil
+}
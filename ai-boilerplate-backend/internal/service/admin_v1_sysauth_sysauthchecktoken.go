@@ -18,5 +18,6 @@ func (a *AdminV1SysAuthService) SysAuthCheckToken(ctx context.Context, req *pb.S
 	resp.AdminId = uid["uid"].(string)
 	resp.Nickname = uid["nickname"].(string)
 	resp.TenantId = uid["tenant_id"].(string)
+	resp.SessionId = uid["sid"].(string)
 	return resp, nil
 }
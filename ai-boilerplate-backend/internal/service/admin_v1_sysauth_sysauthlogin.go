@@ -4,15 +4,41 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/goutil/cryptutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // SysAuthLogin Auth-登录
 func (a *AdminV1SysAuthService) SysAuthLogin(ctx context.Context, req *pb.SysAuthLoginReq) (*pb.SysAuthLoginReply, error) {
 	resp := &pb.SysAuthLoginReply{
-		Token:     "",
-		ExpiredAt: 0,
-		RefreshAt: 0,
+		Token:                  "",
+		ExpiredAt:              0,
+		RefreshAt:              0,
+		RefreshToken:           "",
+		RefreshExpiredAt:       0,
+		TwoFactorRequired:      false,
+		TwoFactorSetupRequired: false,
+		TwoFactorChallenge:     "",
+	}
+	ip := meta.GetMetadataFromClient(ctx, constant.XMdIP)
+	// 校验账号与 IP 是否已被锁定
+	remaining, err := a.loginLimitRepo.CheckLock(ctx, constant.LoginSceneAdmin, req.GetUsername(), ip)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	if remaining > 0 {
+		return nil, pb.ErrorReasonAccountLocked(pb.WithFmtMsg(remaining))
+	}
+	attempt := &data.LoginAttempt{
+		Scene:     constant.LoginSceneAdmin,
+		Username:  req.GetUsername(),
+		IP:        ip,
+		UserAgent: meta.GetMetadataFromClient(ctx, constant.XMdUseragent),
+		URI:       requestPath(ctx),
 	}
 	// 查询用户
 	sysAdmin, err := a.sysAdminRepo.FindOneCacheByUsername(ctx, req.GetUsername())
@@ -20,19 +46,71 @@ func (a *AdminV1SysAuthService) SysAuthLogin(ctx context.Context, req *pb.SysAut
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
 	if sysAdmin == nil || sysAdmin.ID == "" {
+		a.recordLoginFailure(ctx, attempt)
 		return nil, pb.ErrorReasonAccountNotFound()
 	}
 	// 验证密码
 	if compareErr := cryptutil.Compare(sysAdmin.Password, req.GetPassword()); compareErr != nil {
+		attempt.TenantID = sysAdmin.TenantID
+		attempt.AdminID = sysAdmin.ID
+		a.recordLoginFailure(ctx, attempt)
 		return nil, pb.ErrorReasonAccountPasswordError()
 	}
-	// 生成token
-	token, err := a.sysAdminRepo.GenerateToken(ctx, sysAdmin)
+	// 已禁用的管理员不能登录
+	if sysAdmin.Status != int16(constant.StatusEnable) {
+		return nil, pb.ErrorReasonAccountDisabled()
+	}
+	// 已开启两步验证或角色要求开启时返回登录挑战, 验证通过后再签发token
+	required, err := a.twoFactorRepo.Required(ctx, sysAdmin)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if sysAdmin.TwoFactorEnabled || required {
+		challenge, err := a.twoFactorRepo.CreateChallenge(ctx, sysAdmin.ID)
+		if err != nil {
+			return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+		}
+		resp.TwoFactorRequired = true
+		resp.TwoFactorSetupRequired = !sysAdmin.TwoFactorEnabled
+		resp.TwoFactorChallenge = challenge
+		return resp, nil
+	}
+	// 登录成功, 清除失败次数
+	if err = a.loginLimitRepo.ResetFailure(ctx, constant.LoginSceneAdmin, req.GetUsername()); err != nil {
+		a.log.WithContext(ctx).Errorf("failed to reset login failure %s: %v", req.GetUsername(), err)
+	}
+	// 生成token, 记录登录会话
+	token, err := a.sysAdminRepo.GenerateToken(ctx, sysAdmin, &data.TokenSession{
+		Device:    meta.GetMetadataFromClient(ctx, constant.XMdDevice),
+		IP:        ip,
+		UserAgent: attempt.UserAgent,
+	})
 	if err != nil {
 		return nil, pb.ErrorReasonTokenErr(pb.WithError(err))
 	}
-	resp.Token = token.Token
+	resp.Token = token.AccessToken
 	resp.ExpiredAt = token.ExpiredAt
 	resp.RefreshAt = token.RefreshAt
+	resp.RefreshToken = token.RefreshToken
+	resp.RefreshExpiredAt = token.RefreshExpiredAt
 	return resp, nil
 }
+
+// recordLoginFailure 记录登录失败, 失败次数统计出错时不影响登录结果的返回
+func (a *AdminV1SysAuthService) recordLoginFailure(ctx context.Context, attempt *data.LoginAttempt) {
+	if _, err := a.loginLimitRepo.RecordFailure(ctx, attempt); err != nil {
+		a.log.WithContext(ctx).Errorf("failed to record login failure %s: %v", attempt.Username, err)
+	}
+}
+
+// requestPath 当前请求的路径, 非 HTTP 请求时返回 operation
+func requestPath(ctx context.Context) string {
+	info, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ht, ok := info.(http.Transporter); ok && ht.Request() != nil {
+		return ht.Request().URL.Path
+	}
+	return info.Operation()
+}
@@ -4,11 +4,19 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // SysAuthLogout Auth-退出
-func (a *AdminV1SysAuthService) SysAuthLogout(_ context.Context, _ *pb.SysAuthLogoutReq) (*pb.SysAuthLogoutReply, error) {
+func (a *AdminV1SysAuthService) SysAuthLogout(ctx context.Context, _ *pb.SysAuthLogoutReq) (*pb.SysAuthLogoutReply, error) {
 	resp := &pb.SysAuthLogoutReply{}
-	// TODO
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	sessionID := meta.GetMetadataFromClient(ctx, constant.XMdSessionID)
+	// 注销当前登录会话: 按会话编号加入黑名单, 当前 token 及同一会话内刷新过的 token 全部失效, 刷新 token 一并删除
+	err := a.sysAdminRepo.RevokeSession(ctx, adminID, sessionID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
 	return resp, nil
 }
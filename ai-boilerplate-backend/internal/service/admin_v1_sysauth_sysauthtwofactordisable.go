@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// SysAuthTwoFactorDisable Auth-两步验证关闭
+func (a *AdminV1SysAuthService) SysAuthTwoFactorDisable(ctx context.Context, req *pb.SysAuthTwoFactorDisableReq) (*pb.SysAuthTwoFactorDisableReply, error) {
+	resp := &pb.SysAuthTwoFactorDisableReply{}
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	admin, err := a.sysAdminRepo.FindOneCacheByID(ctx, adminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if admin == nil || admin.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	if !admin.TwoFactorEnabled {
+		return nil, pb.ErrorReasonTwoFactorNotEnabled()
+	}
+	// 租户策略要求开启时不允许关闭
+	required, err := a.twoFactorRepo.Required(ctx, admin)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if required {
+		return nil, pb.ErrorReasonTwoFactorRequired()
+	}
+	ok, err := a.twoFactorRepo.Verify(ctx, admin, req.GetCode())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonTwoFactorCodeError()
+	}
+	err = a.twoFactorRepo.Disable(ctx, admin)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	return resp, nil
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// SysAuthTwoFactorEnable Auth-两步验证开启
+func (a *AdminV1SysAuthService) SysAuthTwoFactorEnable(ctx context.Context, req *pb.SysAuthTwoFactorEnableReq) (*pb.SysAuthTwoFactorEnableReply, error) {
+	resp := &pb.SysAuthTwoFactorEnableReply{}
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	admin, err := a.sysAdminRepo.FindOneCacheByID(ctx, adminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if admin == nil || admin.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	if admin.TwoFactorEnabled {
+		return nil, pb.ErrorReasonTwoFactorAlreadyEnabled()
+	}
+	// 校验待绑定密钥的验证码, 通过后返回恢复码(仅展示一次)
+	ok, recoveryCodes, err := a.twoFactorRepo.Enable(ctx, admin, req.GetCode())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonTwoFactorCodeError()
+	}
+	resp.RecoveryCodes = recoveryCodes
+	return resp, nil
+}
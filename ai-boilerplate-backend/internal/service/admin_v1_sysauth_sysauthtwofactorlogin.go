@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// SysAuthTwoFactorLogin Auth-两步验证登录
+func (a *AdminV1SysAuthService) SysAuthTwoFactorLogin(ctx context.Context, req *pb.SysAuthTwoFactorLoginReq) (*pb.SysAuthTwoFactorLoginReply, error) {
+	resp := &pb.SysAuthTwoFactorLoginReply{
+		Token:            "",
+		ExpiredAt:        0,
+		RefreshAt:        0,
+		RefreshToken:     "",
+		RefreshExpiredAt: 0,
+		RecoveryCodes:    []string{},
+	}
+	adminID, err := a.twoFactorRepo.GetChallenge(ctx, req.GetChallenge())
+	if err != nil {
+		if errors.Is(err, data.ErrTwoFactorChallengeInvalid) {
+			return nil, pb.ErrorReasonTwoFactorChallengeInvalid()
+		}
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	sysAdmin, err := a.sysAdminRepo.FindOneCacheByID(ctx, adminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if sysAdmin == nil || sysAdmin.ID == "" {
+		return nil, pb.ErrorReasonAccountNotFound()
+	}
+	// 挑战签发后管理员被禁用时不再签发token
+	if sysAdmin.Status != int16(constant.StatusEnable) {
+		return nil, pb.ErrorReasonAccountDisabled()
+	}
+	ip := meta.GetMetadataFromClient(ctx, constant.XMdIP)
+	// 验证码错误同样计入登录失败次数, 防止暴力猜测验证码
+	remaining, err := a.loginLimitRepo.CheckLock(ctx, constant.LoginSceneAdmin, sysAdmin.Username, ip)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	if remaining > 0 {
+		return nil, pb.ErrorReasonAccountLocked(pb.WithFmtMsg(remaining))
+	}
+	var ok bool
+	if sysAdmin.TwoFactorEnabled {
+		ok, err = a.twoFactorRepo.Verify(ctx, sysAdmin, req.GetCode())
+	} else {
+		// 角色要求开启两步验证但尚未绑定, 登录时完成绑定并返回恢复码
+		ok, resp.RecoveryCodes, err = a.twoFactorRepo.Enable(ctx, sysAdmin, req.GetCode())
+	}
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if !ok {
+		if failErr := a.twoFactorRepo.RecordChallengeFailure(ctx, req.GetChallenge()); failErr != nil {
+			a.log.WithContext(ctx).Errorf("failed to record two factor challenge failure %s: %v", sysAdmin.ID, failErr)
+		}
+		a.recordLoginFailure(ctx, &data.LoginAttempt{
+			Scene:     constant.LoginSceneAdmin,
+			Username:  sysAdmin.Username,
+			IP:        ip,
+			UserAgent: meta.GetMetadataFromClient(ctx, constant.XMdUseragent),
+			URI:       requestPath(ctx),
+			TenantID:  sysAdmin.TenantID,
+			AdminID:   sysAdmin.ID,
+		})
+		return nil, pb.ErrorReasonTwoFactorCodeError()
+	}
+	// 登录挑战只能使用一次
+	if err = a.twoFactorRepo.DeleteChallenge(ctx, req.GetChallenge()); err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	// 登录成功, 清除失败次数
+	if err = a.loginLimitRepo.ResetFailure(ctx, constant.LoginSceneAdmin, sysAdmin.Username); err != nil {
+		a.log.WithContext(ctx).Errorf("failed to reset login failure %s: %v", sysAdmin.Username, err)
+	}
+	// 生成token, 记录登录会话
+	token, err := a.sysAdminRepo.GenerateToken(ctx, sysAdmin, &data.TokenSession{
+		Device:    meta.GetMetadataFromClient(ctx, constant.XMdDevice),
+		IP:        ip,
+		UserAgent: meta.GetMetadataFromClient(ctx, constant.XMdUseragent),
+	})
+	if err != nil {
+		return nil, pb.ErrorReasonTokenErr(pb.WithError(err))
+	}
+	resp.Token = token.AccessToken
+	resp.ExpiredAt = token.ExpiredAt
+	resp.RefreshAt = token.RefreshAt
+	resp.RefreshToken = token.RefreshToken
+	resp.RefreshExpiredAt = token.RefreshExpiredAt
+	return resp, nil
+}
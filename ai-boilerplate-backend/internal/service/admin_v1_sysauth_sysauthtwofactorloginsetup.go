@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+)
+
+// SysAuthTwoFactorLoginSetup Auth-两步验证登录时绑定
+func (a *AdminV1SysAuthService) SysAuthTwoFactorLoginSetup(ctx context.Context, req *pb.SysAuthTwoFactorLoginSetupReq) (*pb.SysAuthTwoFactorLoginSetupReply, error) {
+	resp := &pb.SysAuthTwoFactorLoginSetupReply{}
+	adminID, err := a.twoFactorRepo.GetChallenge(ctx, req.GetChallenge())
+	if err != nil {
+		if errors.Is(err, data.ErrTwoFactorChallengeInvalid) {
+			return nil, pb.ErrorReasonTwoFactorChallengeInvalid()
+		}
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	sysAdmin, err := a.sysAdminRepo.FindOneCacheByID(ctx, adminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if sysAdmin == nil || sysAdmin.ID == "" {
+		return nil, pb.ErrorReasonAccountNotFound()
+	}
+	if sysAdmin.TwoFactorEnabled {
+		return nil, pb.ErrorReasonTwoFactorAlreadyEnabled()
+	}
+	resp.Secret, resp.ProvisioningUri, err = a.twoFactorRepo.Setup(ctx, sysAdmin)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	return resp, nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// SysAuthTwoFactorRecoveryCodes Auth-两步验证重新生成恢复码
+func (a *AdminV1SysAuthService) SysAuthTwoFactorRecoveryCodes(ctx context.Context, req *pb.SysAuthTwoFactorRecoveryCodesReq) (*pb.SysAuthTwoFactorRecoveryCodesReply, error) {
+	resp := &pb.SysAuthTwoFactorRecoveryCodesReply{}
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	admin, err := a.sysAdminRepo.FindOneCacheByID(ctx, adminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if admin == nil || admin.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	if !admin.TwoFactorEnabled {
+		return nil, pb.ErrorReasonTwoFactorNotEnabled()
+	}
+	// 只接受验证器应用的验证码, 避免用恢复码换取新的恢复码
+	ok, err := a.twoFactorRepo.VerifyTOTP(ctx, admin, req.GetCode())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	if !ok {
+		return nil, pb.ErrorReasonTwoFactorCodeError()
+	}
+	resp.RecoveryCodes, err = a.twoFactorRepo.RegenerateRecoveryCodes(ctx, admin)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	return resp, nil
+}
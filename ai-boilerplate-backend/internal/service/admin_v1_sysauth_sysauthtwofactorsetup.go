@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// SysAuthTwoFactorSetup Auth-两步验证绑定
+func (a *AdminV1SysAuthService) SysAuthTwoFactorSetup(ctx context.Context, _ *pb.SysAuthTwoFactorSetupReq) (*pb.SysAuthTwoFactorSetupReply, error) {
+	resp := &pb.SysAuthTwoFactorSetupReply{}
+	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+	admin, err := a.sysAdminRepo.FindOneCacheByID(ctx, adminID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if admin == nil || admin.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	if admin.TwoFactorEnabled {
+		return nil, pb.ErrorReasonTwoFactorAlreadyEnabled()
+	}
+	resp.Secret, resp.ProvisioningUri, err = a.twoFactorRepo.Setup(ctx, admin)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	return resp, nil
+}
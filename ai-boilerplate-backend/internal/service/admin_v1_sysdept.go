@@ -10,18 +10,21 @@ func NewAdminV1SysDeptService(
 	logger log.Logger,
 	sysDeptRepo *data.SysDeptRepo,
 	sysAdminRepo *data.SysAdminRepo,
+	dataScopeRepo *data.DataScopeRepo,
 ) *AdminV1SysDeptService {
 	l := log.NewHelper(log.With(logger, "module", "service/sysDept"))
 	return &AdminV1SysDeptService{
-		log:          l,
-		sysDeptRepo:  sysDeptRepo,
-		sysAdminRepo: sysAdminRepo,
+		log:           l,
+		sysDeptRepo:   sysDeptRepo,
+		sysAdminRepo:  sysAdminRepo,
+		dataScopeRepo: dataScopeRepo,
 	}
 }
 
 type AdminV1SysDeptService struct {
 	pb.UnimplementedSysDeptServer
-	log          *log.Helper
-	sysDeptRepo  *data.SysDeptRepo
-	sysAdminRepo *data.SysAdminRepo
+	log           *log.Helper
+	sysDeptRepo   *data.SysDeptRepo
+	sysAdminRepo  *data.SysAdminRepo
+	dataScopeRepo *data.DataScopeRepo
 }
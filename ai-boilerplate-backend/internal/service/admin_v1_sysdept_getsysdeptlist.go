@@ -5,8 +5,10 @@ import (
 	"time"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/kratos-contrib/meta"
 	"github.com/samber/lo"
 )
 
@@ -24,6 +26,12 @@ func (a *AdminV1SysDeptService) GetSysDeptList(ctx context.Context, _ *pb.GetSys
 			},
 		},
 	}
+	// 按角色数据范围过滤: 部门范围只返回范围内的部门, 仅本人数据只返回本人负责的部门
+	dataScope, err := a.dataScopeRepo.GetDataScope(ctx, meta.GetMetadataFromClient(ctx, constant.XMdAdminID))
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	param.Query = append(param.Query, dataScope.DeptQueryParams("id", "admin_id")...)
 	list, _, err := a.sysDeptRepo.FindMultiCacheByCondition(ctx, param)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
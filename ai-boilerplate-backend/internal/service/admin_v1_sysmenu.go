@@ -9,11 +9,13 @@ import (
 func NewAdminV1SysMenuService(
 	logger log.Logger,
 	sysMenuRepo *data.SysMenuRepo,
+	sysRoleRepo *data.SysRoleRepo,
 ) *AdminV1SysMenuService {
 	l := log.NewHelper(log.With(logger, "module", "service/sysMenu"))
 	return &AdminV1SysMenuService{
 		log:         l,
 		sysMenuRepo: sysMenuRepo,
+		sysRoleRepo: sysRoleRepo,
 	}
 }
 
@@ -21,4 +23,5 @@ type AdminV1SysMenuService struct {
 	pb.UnimplementedSysMenuServer
 	log         *log.Helper
 	sysMenuRepo *data.SysMenuRepo
+	sysRoleRepo *data.SysRoleRepo
 }
@@ -22,5 +22,10 @@ func (a *AdminV1SysMenuService) UpdateSysMenuStatus(ctx context.Context, req *pb
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	// 菜单变更影响所有角色, 删除全部角色权限缓存
+	err = a.sysRoleRepo.DelPermissionCache(ctx)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
 	return resp, nil
 }
@@ -3,17 +3,25 @@ package service
 import (
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/goutil/jsonutil"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 func NewAdminV1SysNoticeService(
 	logger log.Logger,
 	sysNoticeRepo *data.SysNoticeRepo,
+	sysTenantRepo *data.SysTenantRepo,
+	sysRoleRepo *data.SysRoleRepo,
+	sysDeptRepo *data.SysDeptRepo,
 ) *AdminV1SysNoticeService {
 	l := log.NewHelper(log.With(logger, "module", "service/sysNotice"))
 	return &AdminV1SysNoticeService{
 		log:           l,
 		sysNoticeRepo: sysNoticeRepo,
+		sysTenantRepo: sysTenantRepo,
+		sysRoleRepo:   sysRoleRepo,
+		sysDeptRepo:   sysDeptRepo,
 	}
 }
 
@@ -21,4 +29,16 @@ type AdminV1SysNoticeService struct {
 	pb.UnimplementedSysNoticeServer
 	log           *log.Helper
 	sysNoticeRepo *data.SysNoticeRepo
+	sysTenantRepo *data.SysTenantRepo
+	sysRoleRepo   *data.SysRoleRepo
+	sysDeptRepo   *data.SysDeptRepo
+}
+
+// sysNoticeTargetIDs 公告发布对象编号
+func sysNoticeTargetIDs(data *ai_boilerplate_model.SysNotice) []string {
+	targetIDs := make([]string, 0)
+	if len(data.TargetIds) > 0 {
+		_ = jsonutil.Unmarshal(data.TargetIds, &targetIDs)
+	}
+	return targetIDs
 }
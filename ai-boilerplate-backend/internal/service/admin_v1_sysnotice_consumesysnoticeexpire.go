@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+)
+
+// ConsumeSysNoticeExpire 系统-公告-消费过期任务
+func (a *AdminV1SysNoticeService) ConsumeSysNoticeExpire(ctx context.Context, payload []byte) error {
+	msg := &data.SysNoticeTaskMessage{}
+	err := json.Unmarshal(payload, msg)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to unmarshal sys notice expire message: %v", err)
+		return nil
+	}
+	return a.sysNoticeRepo.Expire(ctx, msg)
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+)
+
+// ConsumeSysNoticePublish 系统-公告-消费定时发布任务
+func (a *AdminV1SysNoticeService) ConsumeSysNoticePublish(ctx context.Context, payload []byte) error {
+	msg := &data.SysNoticeTaskMessage{}
+	err := json.Unmarshal(payload, msg)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to unmarshal sys notice publish message: %v", err)
+		return nil
+	}
+	return a.sysNoticeRepo.Publish(ctx, msg)
+}
@@ -15,13 +15,20 @@ func (a *AdminV1SysNoticeService) GetSysNoticeInfo(ctx context.Context, req *pb.
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
 	resp.Info = &pb.SysNoticeInfo{
-		Id:        data.ID,
-		Type:      data.Type,
-		Title:     data.Title,
-		Content:   data.Content,
-		Status:    int32(data.Status),
-		CreatedAt: timeutil.RFC3339(data.CreatedAt),
-		UpdatedAt: timeutil.RFC3339(data.UpdatedAt),
+		Id:             data.ID,
+		Type:           data.Type,
+		Title:          data.Title,
+		Content:        data.Content,
+		Status:         int32(data.Status),
+		CreatedAt:      timeutil.RFC3339(data.CreatedAt),
+		UpdatedAt:      timeutil.RFC3339(data.UpdatedAt),
+		TargetType:     data.TargetType,
+		TargetIds:      sysNoticeTargetIDs(data),
+		PublishStatus:  int32(data.PublishStatus),
+		PublishTime:    timeutil.RFC3339(data.PublishTime.Time),
+		ExpireTime:     timeutil.RFC3339(data.ExpireTime.Time),
+		PublishedAt:    timeutil.RFC3339(data.PublishedAt.Time),
+		RecipientCount: data.RecipientCount,
 	}
 	return resp, nil
 }
@@ -7,6 +7,7 @@ import (
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/goutil/timeutil"
 	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
@@ -67,13 +68,20 @@ func (a *AdminV1SysNoticeService) GetSysNoticeList(ctx context.Context, req *pb.
 	if len(list) > 0 {
 		for _, v := range list {
 			resp.List = append(resp.List, &pb.SysNoticeInfo{
-				Id:        v.ID,
-				Type:      v.Type,
-				Title:     v.Title,
-				Content:   v.Content,
-				Status:    int32(v.Status),
-				CreatedAt: v.CreatedAt.Format(time.RFC3339),
-				UpdatedAt: v.UpdatedAt.Format(time.RFC3339),
+				Id:             v.ID,
+				Type:           v.Type,
+				Title:          v.Title,
+				Content:        v.Content,
+				Status:         int32(v.Status),
+				CreatedAt:      v.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:      v.UpdatedAt.Format(time.RFC3339),
+				TargetType:     v.TargetType,
+				TargetIds:      sysNoticeTargetIDs(v),
+				PublishStatus:  int32(v.PublishStatus),
+				PublishTime:    timeutil.RFC3339(v.PublishTime.Time),
+				ExpireTime:     timeutil.RFC3339(v.ExpireTime.Time),
+				PublishedAt:    timeutil.RFC3339(v.PublishedAt.Time),
+				RecipientCount: v.RecipientCount,
 			})
 		}
 	}
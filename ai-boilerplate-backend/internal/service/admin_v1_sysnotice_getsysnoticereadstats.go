@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// GetSysNoticeReadStats 系统-公告-阅读统计
+func (a *AdminV1SysNoticeService) GetSysNoticeReadStats(ctx context.Context, req *pb.GetSysNoticeReadStatsReq) (*pb.GetSysNoticeReadStatsReply, error) {
+	resp := &pb.GetSysNoticeReadStatsReply{}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	data, err := a.sysNoticeRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前租户的公告
+	if data.TenantID != tenantID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	stats, err := a.sysNoticeRepo.ReadStats(ctx, data)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.RecipientCount = stats.RecipientCount
+	resp.ReadCount = stats.ReadCount
+	resp.UnreadCount = stats.UnreadCount
+	resp.ReadRate = stats.ReadRate
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/goutil/jsonutil"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+)
+
+// PublishSysNotice 系统-公告-发布
+// 保存发布对象、发布时间与过期时间并投递定时任务, 到达发布时间后按发布对象分批生成通知消息
+// 草稿、待发布与发布失败的公告可以发布, 待发布的公告重新发布时以最新的计划为准
+func (a *AdminV1SysNoticeService) PublishSysNotice(ctx context.Context, req *pb.PublishSysNoticeReq) (*pb.PublishSysNoticeReply, error) {
+	resp := &pb.PublishSysNoticeReply{}
+	tenantID := meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+	data, err := a.sysNoticeRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonDataRecordNotFound()
+	}
+	// 判断是否是当前租户的公告
+	if data.TenantID != tenantID {
+		return nil, pb.ErrorReasonAccountNoDataPermission()
+	}
+	if data.Status != int16(constant.StatusEnable) {
+		return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("notice is disabled")))
+	}
+	switch constant.SysNoticePublishStatus(data.PublishStatus) {
+	case constant.SysNoticePublishStatusDraft, constant.SysNoticePublishStatusScheduled, constant.SysNoticePublishStatusFailed:
+	default:
+		return nil, pb.ErrorReasonSysNoticePublishStatusInvalid()
+	}
+	targetIDs := lo.Uniq(req.GetTargetIds())
+	if req.GetTargetType() == constant.SysNoticeTargetTypeAll.String() {
+		targetIDs = []string{}
+	} else if len(targetIDs) == 0 {
+		return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("target ids is empty")))
+	}
+	err = a.checkSysNoticeTarget(ctx, tenantID, req.GetTargetType(), targetIDs)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	publishTime := now
+	if req.GetPublishTime() != "" {
+		c := carbon.Parse(req.GetPublishTime())
+		if c.IsInvalid() {
+			return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("publish time is invalid")))
+		}
+		// 发布时间早于当前时间时立即发布
+		if c.StdTime().After(now) {
+			publishTime = c.StdTime()
+		}
+	}
+	var expireTime sql.NullTime
+	if req.GetExpireTime() != "" {
+		c := carbon.Parse(req.GetExpireTime())
+		if c.IsInvalid() {
+			return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("expire time is invalid")))
+		}
+		if !c.StdTime().After(publishTime) {
+			return nil, pb.ErrorReasonParamError(pb.WithError(errors.New("expire time must be after publish time")))
+		}
+		expireTime = sql.NullTime{Time: c.StdTime(), Valid: true}
+	}
+	targetIDsJSON, err := jsonutil.Marshal(targetIDs)
+	if err != nil {
+		return nil, pb.ErrorReasonParamError(pb.WithError(err))
+	}
+	oldData := a.sysNoticeRepo.DeepCopy(data)
+	data.TargetType = req.GetTargetType()
+	data.TargetIds = datatypes.JSON(targetIDsJSON)
+	data.PublishStatus = int16(constant.SysNoticePublishStatusScheduled)
+	data.PublishTime = sql.NullTime{Time: publishTime, Valid: true}
+	data.ExpireTime = expireTime
+	data.PublishedAt = sql.NullTime{}
+	data.RecipientCount = 0
+	err = a.sysNoticeRepo.UpdateOneCacheWithZero(ctx, data, oldData)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	err = a.sysNoticeRepo.Schedule(ctx, data)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	return resp, nil
+}
+
+// checkSysNoticeTarget 校验发布对象是否在当前租户的数据范围内
+// 全部与指定租户只允许平台租户发布, 指定角色与部门需属于当前租户
+func (a *AdminV1SysNoticeService) checkSysNoticeTarget(ctx context.Context, tenantID, targetType string, targetIDs []string) error {
+	switch constant.SysNoticeTargetType(targetType) {
+	case constant.SysNoticeTargetTypeAll, constant.SysNoticeTargetTypeTenant:
+		if !a.sysTenantRepo.IsPlatformTenant(tenantID) {
+			return pb.ErrorReasonAccountNoDataPermission()
+		}
+	case constant.SysNoticeTargetTypeRole:
+		roles, err := a.sysRoleRepo.FindMultiCacheByIDS(ctx, targetIDs)
+		if err != nil {
+			return pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+		if len(roles) != len(targetIDs) {
+			return pb.ErrorReasonDataRecordNotFound()
+		}
+		for _, role := range roles {
+			if role.TenantID != tenantID {
+				return pb.ErrorReasonAccountNoDataPermission()
+			}
+		}
+	case constant.SysNoticeTargetTypeDept:
+		depts, err := a.sysDeptRepo.FindMultiCacheByIDS(ctx, targetIDs)
+		if err != nil {
+			return pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+		if len(depts) != len(targetIDs) {
+			return pb.ErrorReasonDataRecordNotFound()
+		}
+		for _, dept := range depts {
+			if dept.TenantID != tenantID {
+				return pb.ErrorReasonAccountNoDataPermission()
+			}
+		}
+	default:
+		return pb.ErrorReasonParamError(pb.WithError(constant.ErrInvalidSysNoticeTargetType))
+	}
+	return nil
+}
@@ -14,10 +14,10 @@ func (a *AdminV1SysNotifyMessageService) GetSysNotifyMessageMyUnreadCount(ctx co
 
 	resp := &pb.GetSysNotifyMessageMyUnreadCountReply{}
 	adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
-	list, err := a.sysNotifyMessageRepo.FindMultiCacheByReceiverReadTime(ctx, adminID, "")
+	count, err := a.sysNotifyMessageRepo.UnreadCount(ctx, adminID)
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
-	resp.Count = int32(len(list))
+	resp.Count = count
 	return resp, nil
 }
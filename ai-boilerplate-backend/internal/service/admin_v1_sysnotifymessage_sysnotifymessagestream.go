@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// OperationSysNotifyMessageStream 实时事件长连接的 operation, 只推送自身的通知, 登录即可访问
+const OperationSysNotifyMessageStream = "/admin.v1.SysNotifyMessage/SysNotifyMessageStream"
+
+const (
+	// 实时事件心跳间隔, 同时用于感知客户端断开
+	sysNotifyMessageStreamHeartbeat = 15 * time.Second
+	// 客户端断线重连间隔(毫秒)
+	sysNotifyMessageStreamRetry = "3000"
+)
+
+// SysNotifyMessageStreamHandler 系统-通知消息-我的-实时事件 (SSE 长连接)
+// 推送新消息、未读数量变更与 AI 任务完成事件, 支持 Last-Event-ID 请求头或 lastEventId 参数断线续传
+func (a *AdminV1SysNotifyMessageService) SysNotifyMessageStreamHandler(ctx http.Context) error {
+	w := ctx.Response()
+	lastEventID := ctx.Request().Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = ctx.Query().Get("lastEventId")
+	}
+	http.SetOperation(ctx, OperationSysNotifyMessageStream)
+	h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+		// 服务端超时只适用于普通请求, 长连接脱离超时控制, 客户端断开通过心跳写入失败感知
+		ctx = context.WithoutCancel(ctx)
+		// 先订阅再补发, 补发期间产生的事件按事件编号去重
+		events, unsubscribe := a.sysNotifyMessageRepo.SubscribeEvents(adminID)
+		defer unsubscribe()
+		replay, err := a.sysNotifyMessageRepo.ReplayEvents(ctx, adminID, lastEventID)
+		if err != nil {
+			if errors.Is(err, data.ErrSysNotifyEventIDInvalid) {
+				return nil, pb.ErrorReasonParamError(pb.WithError(err))
+			}
+			return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+		}
+		count, err := a.sysNotifyMessageRepo.UnreadCount(ctx, adminID)
+		if err != nil {
+			return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+		unreadCount, err := json.Marshal(&data.SysNotifyEventUnreadCount{Count: count})
+		if err != nil {
+			return nil, err
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(nethttp.StatusOK)
+		err = writeSysNotifyMessageStream(w, "retry: "+sysNotifyMessageStreamRetry+"\n\n")
+		if err != nil {
+			return nil, nil
+		}
+		for _, event := range replay {
+			err = writeSysNotifyEvent(w, event)
+			if err != nil {
+				return nil, nil
+			}
+			lastEventID = event.ID
+		}
+		// 连接建立时推送当前未读数量, 不带事件编号, 不影响续传位置
+		err = writeSysNotifyEvent(w, &data.SysNotifyEvent{
+			Type: constant.SysNotifyEventTypeUnreadCount.String(),
+			Data: unreadCount,
+		})
+		if err != nil {
+			return nil, nil
+		}
+		ticker := time.NewTicker(sysNotifyMessageStreamHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					// 消费过慢被断开, 客户端重连后按事件编号续传
+					return nil, nil
+				}
+				if !data.SysNotifyEventIDAfter(event.ID, lastEventID) {
+					continue
+				}
+				err = writeSysNotifyEvent(w, event)
+				if err != nil {
+					return nil, nil
+				}
+				lastEventID = event.ID
+			case <-ticker.C:
+				err = writeSysNotifyMessageStream(w, ": ping\n\n")
+				if err != nil {
+					return nil, nil
+				}
+			}
+		}
+	})
+	_, err := h(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// writeSysNotifyEvent 按 SSE 格式写入事件
+func writeSysNotifyEvent(w nethttp.ResponseWriter, event *data.SysNotifyEvent) error {
+	var b strings.Builder
+	if event.ID != "" {
+		b.WriteString("id: " + event.ID + "\n")
+	}
+	b.WriteString("event: " + event.Type + "\n")
+	b.WriteString("data: " + string(event.Data) + "\n\n")
+	return writeSysNotifyMessageStream(w, b.String())
+}
+
+// writeSysNotifyMessageStream 写入并立即刷新到客户端
+func writeSysNotifyMessageStream(w nethttp.ResponseWriter, s string) error {
+	_, err := io.WriteString(w, s)
+	if err != nil {
+		return err
+	}
+	return nethttp.NewResponseController(w).Flush()
+}
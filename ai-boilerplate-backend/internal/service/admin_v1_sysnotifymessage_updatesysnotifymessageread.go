@@ -38,5 +38,6 @@ func (a *AdminV1SysNotifyMessageService) UpdateSysNotifyMessageRead(ctx context.
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	a.sysNotifyMessageRepo.PublishUnreadCount(ctx, adminID)
 	return resp, nil
 }
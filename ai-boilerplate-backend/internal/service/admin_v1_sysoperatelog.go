@@ -10,12 +10,14 @@ func NewAdminV1SysOperateLogService(
 	logger log.Logger,
 	sysOperateLogRepo *data.SysOperateLogRepo,
 	sysAdminRepo *data.SysAdminRepo,
+	dataScopeRepo *data.DataScopeRepo,
 ) *AdminV1SysOperateLogService {
 	l := log.NewHelper(log.With(logger, "module", "service/sysOperateLog"))
 	return &AdminV1SysOperateLogService{
 		log:               l,
 		sysOperateLogRepo: sysOperateLogRepo,
 		sysAdminRepo:      sysAdminRepo,
+		dataScopeRepo:     dataScopeRepo,
 	}
 }
 
@@ -24,4 +26,5 @@ type AdminV1SysOperateLogService struct {
 	log               *log.Helper
 	sysOperateLogRepo *data.SysOperateLogRepo
 	sysAdminRepo      *data.SysAdminRepo
+	dataScopeRepo     *data.DataScopeRepo
 }
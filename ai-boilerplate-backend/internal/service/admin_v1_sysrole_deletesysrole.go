@@ -13,5 +13,10 @@ func (a *AdminV1SysRoleService) DeleteSysRole(ctx context.Context, req *pb.Delet
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	// 删除角色权限缓存
+	err = a.sysRoleRepo.DelPermissionCache(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
 	return resp, nil
 }
@@ -32,5 +32,10 @@ func (a *AdminV1SysRoleService) UpdateSysRole(ctx context.Context, req *pb.Updat
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	// 删除角色权限缓存
+	err = a.sysRoleRepo.DelPermissionCache(ctx, data.ID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
 	return resp, nil
 }
@@ -27,17 +27,25 @@ func (a *AdminV1SysTenantService) GetSysTenantInfo(ctx context.Context, req *pb.
 			return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
 		}
 	}
+	twoFactorRoleIDs := make([]string, 0)
+	if data.TwoFactorRoleIds.String() != "" {
+		err = jsonutil.Unmarshal(data.TwoFactorRoleIds, &twoFactorRoleIDs)
+		if err != nil {
+			return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
+		}
+	}
 	resp.Info = &pb.SysTenantInfo{
-		Id:         data.ID,
-		Name:       data.Name,
-		Remark:     data.Remark,
-		AdminId:    data.AdminID,
-		ExpireTime: timeutil.RFC3339(data.ExpireTime.Time),
-		MenuIds:    menuIDs,
-		Status:     int32(data.Status),
-		CreatedAt:  timeutil.RFC3339(data.CreatedAt.In(time.Local)),
-		UpdatedAt:  timeutil.RFC3339(data.UpdatedAt.In(time.Local)),
-		AdminName:  adminName[data.AdminID],
+		Id:               data.ID,
+		Name:             data.Name,
+		Remark:           data.Remark,
+		AdminId:          data.AdminID,
+		ExpireTime:       timeutil.RFC3339(data.ExpireTime.Time),
+		MenuIds:          menuIDs,
+		Status:           int32(data.Status),
+		CreatedAt:        timeutil.RFC3339(data.CreatedAt.In(time.Local)),
+		UpdatedAt:        timeutil.RFC3339(data.UpdatedAt.In(time.Local)),
+		AdminName:        adminName[data.AdminID],
+		TwoFactorRoleIds: twoFactorRoleIDs,
 	}
 	return resp, nil
 }
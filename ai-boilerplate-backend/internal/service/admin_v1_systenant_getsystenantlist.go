@@ -81,17 +81,25 @@ func (a *AdminV1SysTenantService) GetSysTenantList(ctx context.Context, req *pb.
 					return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
 				}
 			}
+			twoFactorRoleIDs := make([]string, 0)
+			if v.TwoFactorRoleIds.String() != "" {
+				err = jsonutil.Unmarshal(v.TwoFactorRoleIds, &twoFactorRoleIDs)
+				if err != nil {
+					return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
+				}
+			}
 			resp.List = append(resp.List, &pb.SysTenantInfo{
-				Id:         v.ID,
-				Name:       v.Name,
-				Remark:     v.Remark,
-				AdminId:    v.AdminID,
-				ExpireTime: timeutil.RFC3339(v.ExpireTime.Time),
-				MenuIds:    menuIDs,
-				Status:     int32(v.Status),
-				CreatedAt:  v.CreatedAt.Format(time.RFC3339),
-				UpdatedAt:  v.UpdatedAt.Format(time.RFC3339),
-				AdminName:  adminNameMap[v.AdminID],
+				Id:               v.ID,
+				Name:             v.Name,
+				Remark:           v.Remark,
+				AdminId:          v.AdminID,
+				ExpireTime:       timeutil.RFC3339(v.ExpireTime.Time),
+				MenuIds:          menuIDs,
+				Status:           int32(v.Status),
+				CreatedAt:        v.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:        v.UpdatedAt.Format(time.RFC3339),
+				AdminName:        adminNameMap[v.AdminID],
+				TwoFactorRoleIds: twoFactorRoleIDs,
 			})
 		}
 	}
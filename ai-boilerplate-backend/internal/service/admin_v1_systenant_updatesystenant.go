@@ -23,8 +23,13 @@ func (a *AdminV1SysTenantService) UpdateSysTenant(ctx context.Context, req *pb.U
 	if err != nil {
 		return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
 	}
+	twoFactorRoleIDs, err := jsonutil.Marshal(req.GetTwoFactorRoleIds())
+	if err != nil {
+		return nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
+	}
 	oldData := a.sysTenantRepo.DeepCopy(data)
 	data.MenuIds = menuIDs
+	data.TwoFactorRoleIds = twoFactorRoleIDs
 	data.Name = req.GetName()
 	data.Remark = req.GetRemark()
 	data.ExpireTime = timeutil.TimeToSQLNullTime(carbon.Parse(req.GetExpireTime()).StdTime())
@@ -10,12 +10,14 @@ func NewAdminV1UserService(
 	logger log.Logger,
 	userRepo *data.UserRepo,
 	userMembershipRepo *data.UserMembershipRepo,
+	loginLimitRepo *data.LoginLimitRepo,
 ) *AdminV1UserService {
 	l := log.NewHelper(log.With(logger, "module", "service/user"))
 	return &AdminV1UserService{
 		log:                l,
 		userRepo:           userRepo,
 		userMembershipRepo: userMembershipRepo,
+		loginLimitRepo:     loginLimitRepo,
 	}
 }
 
@@ -24,4 +26,5 @@ type AdminV1UserService struct {
 	log                *log.Helper
 	userRepo           *data.UserRepo
 	userMembershipRepo *data.UserMembershipRepo
+	loginLimitRepo     *data.LoginLimitRepo
 }
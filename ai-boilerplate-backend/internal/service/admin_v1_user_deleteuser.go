@@ -13,5 +13,10 @@ func (a *AdminV1UserService) DeleteUser(ctx context.Context, req *pb.DeleteUserR
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	// 删除后注销用户的全部登录会话
+	err = a.userRepo.RevokeAllSessions(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
 	return resp, nil
 }
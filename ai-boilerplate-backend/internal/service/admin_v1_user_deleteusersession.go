@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+)
+
+// DeleteUserSession 用户表-强制下线登录会话
+func (a *AdminV1UserService) DeleteUserSession(ctx context.Context, req *pb.DeleteUserSessionReq) (*pb.DeleteUserSessionReply, error) {
+	resp := &pb.DeleteUserSessionReply{}
+	err := a.userRepo.RevokeSession(ctx, req.GetId(), req.GetSessionId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	return resp, nil
+}
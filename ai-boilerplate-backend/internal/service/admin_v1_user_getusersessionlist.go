@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/goutil/timeutil"
+)
+
+// GetUserSessionList 用户表-登录会话列表
+func (a *AdminV1UserService) GetUserSessionList(ctx context.Context, req *pb.GetUserSessionListReq) (*pb.GetUserSessionListReply, error) {
+	resp := &pb.GetUserSessionListReply{
+		List: []*pb.UserSessionInfo{},
+	}
+	list, err := a.userRepo.GetSessionList(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	for _, v := range list {
+		resp.List = append(resp.List, &pb.UserSessionInfo{
+			Id:         v.ID,
+			Device:     v.Device,
+			Ip:         v.IP,
+			UserAgent:  v.UserAgent,
+			LoginAt:    timeutil.RFC3339(time.Unix(v.LoginAt, 0)),
+			LastSeenAt: timeutil.RFC3339(time.Unix(v.LastSeenAt, 0)),
+			ExpiredAt:  timeutil.RFC3339(time.Unix(v.ExpiredAt, 0)),
+		})
+	}
+	return resp, nil
+}
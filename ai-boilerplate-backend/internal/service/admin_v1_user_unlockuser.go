@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+)
+
+// UnlockUser 用户表-解除登录锁定
+func (a *AdminV1UserService) UnlockUser(ctx context.Context, req *pb.UnlockUserReq) (*pb.UnlockUserReply, error) {
+	resp := &pb.UnlockUserReply{}
+	user, err := a.userRepo.FindOneCacheByID(ctx, req.GetId())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if user == nil || user.ID == "" {
+		return nil, pb.ErrorReasonAccountNotFound()
+	}
+	// app 端登录账号为手机号
+	err = a.loginLimitRepo.Unlock(ctx, constant.LoginSceneApp, user.Phone, req.GetIp())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	return resp, nil
+}
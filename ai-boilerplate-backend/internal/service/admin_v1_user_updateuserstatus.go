@@ -4,6 +4,7 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
 )
 
 // UpdateUserStatus 用户表-更新状态
@@ -22,5 +23,12 @@ func (a *AdminV1UserService) UpdateUserStatus(ctx context.Context, req *pb.Updat
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
+	// 禁用后注销用户的全部登录会话
+	if data.Status != int32(constant.StatusEnable) {
+		err = a.userRepo.RevokeAllSessions(ctx, data.ID)
+		if err != nil {
+			return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+		}
+	}
 	return resp, nil
 }
@@ -0,0 +1,27 @@
+package service
+
+import (
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewAdminV1UserNotifyLogService(
+	logger log.Logger,
+	userNotifyLogRepo *data.UserNotifyLogRepo,
+	notifyRepo *data.NotifyRepo,
+) *AdminV1UserNotifyLogService {
+	l := log.NewHelper(log.With(logger, "module", "service/userNotifyLog"))
+	return &AdminV1UserNotifyLogService{
+		log:               l,
+		userNotifyLogRepo: userNotifyLogRepo,
+		notifyRepo:        notifyRepo,
+	}
+}
+
+type AdminV1UserNotifyLogService struct {
+	pb.UnimplementedUserNotifyLogServer
+	log               *log.Helper
+	userNotifyLogRepo *data.UserNotifyLogRepo
+	notifyRepo        *data.NotifyRepo
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+)
+
+// ConsumeNotifyDispatch 用户通知投递日志表-消费用户通知投递任务
+func (a *AdminV1UserNotifyLogService) ConsumeNotifyDispatch(ctx context.Context, payload []byte) error {
+	msg := &data.NotifyDispatchMessage{}
+	err := json.Unmarshal(payload, msg)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("failed to unmarshal notify dispatch message: %v", err)
+		return nil
+	}
+	return a.notifyRepo.Dispatch(ctx, msg.NotifyID)
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/godb/orm/condition"
+	"github.com/fzf-labs/goutil/timeutil"
+)
+
+// GetUserNotifyLogList 用户通知投递日志表-列表数据查询
+func (a *AdminV1UserNotifyLogService) GetUserNotifyLogList(ctx context.Context, req *pb.GetUserNotifyLogListReq) (*pb.GetUserNotifyLogListReply, error) {
+	resp := &pb.GetUserNotifyLogListReply{
+		Total: 0,
+		List:  []*pb.UserNotifyLogInfo{},
+	}
+	param := &condition.Req{
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+		Query:    []*condition.QueryParam{},
+		Order: []*condition.OrderParam{
+			{
+				Field: "created_at",
+				Order: condition.DESC,
+			},
+		},
+	}
+	if req.GetNotifyId() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "notify_id",
+			Value: req.GetNotifyId(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if req.GetUserId() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "user_id",
+			Value: req.GetUserId(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if req.GetCategory() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "category",
+			Value: req.GetCategory(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if req.GetChannel() != "" {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "channel",
+			Value: req.GetChannel(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	if req.GetStatus() != 0 {
+		param.Query = append(param.Query, &condition.QueryParam{
+			Field: "status",
+			Value: req.GetStatus(),
+			Exp:   condition.EQ,
+			Logic: condition.AND,
+		})
+	}
+	list, p, err := a.userNotifyLogRepo.FindMultiCacheByCondition(ctx, param)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.Total = p.Total
+	if len(list) > 0 {
+		for _, v := range list {
+			resp.List = append(resp.List, &pb.UserNotifyLogInfo{
+				Id:           v.ID,
+				NotifyId:     v.NotifyID,
+				UserId:       v.UserID,
+				Category:     v.Category,
+				Channel:      v.Channel,
+				Title:        v.Title,
+				Content:      v.Content,
+				Status:       v.Status,
+				BizId:        v.BizID,
+				ErrorMessage: v.ErrorMessage,
+				PlanTime:     timeutil.RFC3339(v.PlanTime),
+				SendTime:     timeutil.RFC3339(v.SendTime.Time),
+				CreatedAt:    v.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:    v.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/goutil/timeutil"
+)
+
+// SendUserNotify 用户通知投递日志表-发送用户通知
+// 按用户通知设置投递到可用的渠道, 用户关闭了该类别的通知时不投递, 返回空的通知编号
+func (a *AdminV1UserNotifyLogService) SendUserNotify(ctx context.Context, req *pb.SendUserNotifyReq) (*pb.SendUserNotifyReply, error) {
+	resp := &pb.SendUserNotifyReply{
+		List: []*pb.UserNotifyLogInfo{},
+	}
+	channels := make([]constant.NotifyChannel, 0, len(req.GetChannels()))
+	for _, v := range req.GetChannels() {
+		channels = append(channels, constant.NotifyChannel(v))
+	}
+	notifyID, logs, err := a.notifyRepo.Notify(ctx, req.GetUserId(), constant.NotifyCategory(req.GetCategory()), &data.NotifyPayload{
+		Title:            req.GetTitle(),
+		Content:          req.GetContent(),
+		URL:              req.GetUrl(),
+		Params:           req.GetParams(),
+		SmsTemplateCode:  req.GetSmsTemplateCode(),
+		MailTemplateCode: req.GetMailTemplateCode(),
+		Mail:             req.GetMail(),
+		WxGzhTemplateID:  req.GetWxGzhTemplateId(),
+		Channels:         channels,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, data.ErrNotifyUserNotFound):
+			return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(err))
+		case errors.Is(err, data.ErrNotifyCategoryInvalid):
+			return nil, pb.ErrorReasonParamError(pb.WithError(err))
+		}
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	resp.NotifyId = notifyID
+	for _, v := range logs {
+		resp.List = append(resp.List, &pb.UserNotifyLogInfo{
+			Id:           v.ID,
+			NotifyId:     v.NotifyID,
+			UserId:       v.UserID,
+			Category:     v.Category,
+			Channel:      v.Channel,
+			Title:        v.Title,
+			Content:      v.Content,
+			Status:       v.Status,
+			BizId:        v.BizID,
+			ErrorMessage: v.ErrorMessage,
+			PlanTime:     timeutil.RFC3339(v.PlanTime),
+			SendTime:     timeutil.RFC3339(v.SendTime.Time),
+			CreatedAt:    v.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:    v.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
@@ -9,16 +9,25 @@ import (
 func NewAppV1UserService(
 	logger log.Logger,
 	userRepo *data.UserRepo,
+	loginLimitRepo *data.LoginLimitRepo,
+	smsCodeRepo *data.SmsCodeRepo,
+	smsSendRepo *data.SmsSendRepo,
 ) *AppV1UserService {
 	l := log.NewHelper(log.With(logger, "module", "service/user"))
 	return &AppV1UserService{
-		log:      l,
-		userRepo: userRepo,
+		log:            l,
+		userRepo:       userRepo,
+		loginLimitRepo: loginLimitRepo,
+		smsCodeRepo:    smsCodeRepo,
+		smsSendRepo:    smsSendRepo,
 	}
 }
 
 type AppV1UserService struct {
 	pb.UnimplementedUserServer
-	log      *log.Helper
-	userRepo *data.UserRepo
+	log            *log.Helper
+	userRepo       *data.UserRepo
+	loginLimitRepo *data.LoginLimitRepo
+	smsCodeRepo    *data.SmsCodeRepo
+	smsSendRepo    *data.SmsSendRepo
 }
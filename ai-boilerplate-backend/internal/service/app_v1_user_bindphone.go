@@ -4,11 +4,46 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/app/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // BindPhone 绑定手机号
-func (a *AppV1UserService) BindPhone(_ context.Context, _ *pb.BindPhoneReq) (*pb.BindPhoneReply, error) {
+func (a *AppV1UserService) BindPhone(ctx context.Context, req *pb.BindPhoneReq) (*pb.BindPhoneReply, error) {
 	resp := &pb.BindPhoneReply{}
-	// TODO
+	userID := meta.GetMetadataFromClient(ctx, constant.XMdUserID)
+	user, err := a.userRepo.FindOneCacheByID(ctx, userID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if user == nil || user.ID == "" {
+		return nil, pb.ErrorReasonAccountNotFound()
+	}
+	// 校验验证码
+	_, err = a.smsCodeRepo.CheckSmsCodeWithPhone(ctx, data.SmsCodeSceneBind, req.GetCodeId(), req.GetPhone(), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	// 手机号不能已被其他用户使用
+	exist, err := a.userRepo.FindOneCacheByPhone(ctx, req.GetPhone())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if exist != nil && exist.ID != "" && exist.ID != user.ID {
+		return nil, pb.ErrorReasonAccountAlreadyExists()
+	}
+	if user.Phone != req.GetPhone() {
+		oldData := a.userRepo.DeepCopy(user)
+		user.Phone = req.GetPhone()
+		err = a.userRepo.UpdateOneCache(ctx, user, oldData)
+		if err != nil {
+			return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+	}
+	// 验证码使用后作废
+	if err = a.smsCodeRepo.ClearSmsCode(ctx, data.SmsCodeSceneBind, req.GetCodeId()); err != nil {
+		a.log.WithContext(ctx).Errorf("failed to clear sms code %s: %v", req.GetCodeId(), err)
+	}
 	return resp, nil
 }
@@ -20,6 +20,7 @@ func (a *AppV1UserService) CheckToken(ctx context.Context, req *pb.CheckTokenReq
 		return nil, pb.ErrorReasonTokenInvalidErr()
 	}
 	resp.UserId = uid
+	resp.SessionId, _ = claims["sid"].(string)
 	if wxGzhUserID, ok := claims["wxGzhUserId"].(string); ok {
 		resp.WxGzhUserId = wxGzhUserID
 	}
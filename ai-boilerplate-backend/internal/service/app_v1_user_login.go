@@ -4,30 +4,72 @@ import (
 	"context"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/app/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // Login 登录
 func (a *AppV1UserService) Login(ctx context.Context, req *pb.LoginReq) (*pb.LoginReply, error) {
 	resp := &pb.LoginReply{}
+	ip := meta.GetMetadataFromClient(ctx, constant.XMdIP)
+	// 校验账号与 IP 是否已被锁定
+	remaining, err := a.loginLimitRepo.CheckLock(ctx, constant.LoginSceneApp, req.GetUsername(), ip)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	if remaining > 0 {
+		return nil, pb.ErrorReasonAccountLocked(pb.WithFmtMsg(remaining))
+	}
+	attempt := &data.LoginAttempt{
+		Scene:     constant.LoginSceneApp,
+		Username:  req.GetUsername(),
+		IP:        ip,
+		UserAgent: meta.GetMetadataFromClient(ctx, constant.XMdUseragent),
+		URI:       requestPath(ctx),
+	}
 	// 查询用户(默认 username=phone)
 	user, err := a.userRepo.FindOneCacheByPhone(ctx, req.GetUsername())
 	if err != nil {
 		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
 	}
 	if user == nil || user.ID == "" {
+		a.recordLoginFailure(ctx, attempt)
 		return nil, pb.ErrorReasonAccountNotFound()
 	}
 	// 验证密码
 	if !a.userRepo.VerifyPassword(user.Salt, req.GetPassword(), user.Password) {
+		a.recordLoginFailure(ctx, attempt)
 		return nil, pb.ErrorReasonAccountPasswordError()
 	}
-	// 生成token
-	token, err := a.userRepo.GenerateToken(ctx, user.ID, user.WxGzhUserID, user.WxGzhXcxID)
+	// 已禁用的用户不能登录
+	if user.Status != int32(constant.StatusEnable) {
+		return nil, pb.ErrorReasonAccountDisabled()
+	}
+	// 登录成功, 清除失败次数
+	if err = a.loginLimitRepo.ResetFailure(ctx, constant.LoginSceneApp, req.GetUsername()); err != nil {
+		a.log.WithContext(ctx).Errorf("failed to reset login failure %s: %v", req.GetUsername(), err)
+	}
+	// 生成token, 记录登录会话
+	token, err := a.userRepo.GenerateToken(ctx, user.ID, user.WxGzhUserID, user.WxGzhXcxID, &data.TokenSession{
+		Device:    meta.GetMetadataFromClient(ctx, constant.XMdDevice),
+		IP:        ip,
+		UserAgent: attempt.UserAgent,
+	})
 	if err != nil {
 		return nil, pb.ErrorReasonTokenErr(pb.WithError(err))
 	}
-	resp.Token = token.Token
+	resp.Token = token.AccessToken
 	resp.ExpiredAt = token.ExpiredAt
 	resp.RefreshAt = token.RefreshAt
+	resp.RefreshToken = token.RefreshToken
+	resp.RefreshExpiredAt = token.RefreshExpiredAt
 	return resp, nil
 }
+
+// recordLoginFailure 记录登录失败, 失败次数统计出错时不影响登录结果的返回
+func (a *AppV1UserService) recordLoginFailure(ctx context.Context, attempt *data.LoginAttempt) {
+	if _, err := a.loginLimitRepo.RecordFailure(ctx, attempt); err != nil {
+		a.log.WithContext(ctx).Errorf("failed to record login failure %s: %v", attempt.Username, err)
+	}
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/app/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+)
+
+// Logout 退出登录
+func (a *AppV1UserService) Logout(ctx context.Context, _ *pb.LogoutReq) (*pb.LogoutReply, error) {
+	resp := &pb.LogoutReply{}
+	userID := meta.GetMetadataFromClient(ctx, constant.XMdUserID)
+	sessionID := meta.GetMetadataFromClient(ctx, constant.XMdSessionID)
+	// 注销当前登录会话: 按会话编号加入黑名单, 当前 token 及同一会话内刷新过的 token 全部失效, 刷新 token 一并删除
+	err := a.userRepo.RevokeSession(ctx, userID, sessionID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	return resp, nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/app/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+)
+
+// RefreshToken 刷新token
+func (a *AppV1UserService) RefreshToken(ctx context.Context, req *pb.RefreshTokenReq) (*pb.RefreshTokenReply, error) {
+	resp := &pb.RefreshTokenReply{}
+	// 刷新token轮换, 重复使用已轮换的刷新token会注销整个会话
+	token, err := a.userRepo.RefreshToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		if errors.Is(err, data.ErrRefreshTokenInvalid) || errors.Is(err, data.ErrRefreshTokenReused) {
+			return nil, pb.ErrorReasonTokenInvalidErr(pb.WithError(err))
+		}
+		return nil, pb.ErrorReasonTokenErr(pb.WithError(err))
+	}
+	resp.Token = token.AccessToken
+	resp.ExpiredAt = token.ExpiredAt
+	resp.RefreshAt = token.RefreshAt
+	resp.RefreshToken = token.RefreshToken
+	resp.RefreshExpiredAt = token.RefreshExpiredAt
+	return resp, nil
+}
@@ -2,13 +2,57 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/app/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
 )
 
 // SendVerifyCode 发送验证码
-func (a *AppV1UserService) SendVerifyCode(_ context.Context, _ *pb.SendVerifyCodeReq) (*pb.SendVerifyCodeReply, error) {
+func (a *AppV1UserService) SendVerifyCode(ctx context.Context, req *pb.SendVerifyCodeReq) (*pb.SendVerifyCodeReply, error) {
 	resp := &pb.SendVerifyCodeReply{}
-	// TODO
+	scene := data.SmsCodeScene(req.GetScene())
+	userID := meta.GetMetadataFromClient(ctx, constant.XMdUserID)
+	// 绑定手机号时, 手机号不能已被其他用户使用
+	if scene == data.SmsCodeSceneBind {
+		user, err := a.userRepo.FindOneCacheByPhone(ctx, req.GetPhone())
+		if err != nil {
+			return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+		if user != nil && user.ID != "" && user.ID != userID {
+			return nil, pb.ErrorReasonAccountAlreadyExists()
+		}
+	}
+	// 校验发送频率
+	err := a.smsCodeRepo.CheckSmsCodeFrequency(ctx, scene, req.GetPhone())
+	if err != nil {
+		return nil, err
+	}
+	codeData, err := a.smsCodeRepo.GenerateSmsCodeData(scene, req.GetPhone(), userID)
+	if err != nil {
+		return nil, pb.ErrorReasonDataProcessingError(pb.WithError(err))
+	}
+	// 使用场景对应的短信模板发送, 发送结果写入短信日志
+	err = a.smsCodeRepo.SendSmsCode(ctx, codeData, func(ctx context.Context, codeData *data.SmsCodeData) error {
+		_, err := a.smsSendRepo.SendCode(ctx, string(codeData.Scene), codeData.Phone, codeData.UID, codeData.Code)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, data.ErrSmsTemplateNotFound) || errors.Is(err, data.ErrSmsChannelUnavailable) {
+			return nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(err))
+		}
+		return nil, pb.ErrorReasonAPIThirdErr(pb.WithError(err))
+	}
+	err = a.smsCodeRepo.SetSmsCode(ctx, codeData)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	err = a.smsCodeRepo.SetSmsCodeFrequency(ctx, scene, req.GetPhone())
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	resp.CodeId = codeData.CodeID
 	return resp, nil
 }
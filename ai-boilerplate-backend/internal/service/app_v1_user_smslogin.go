@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/app/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/google/uuid"
+)
+
+// SmsLogin 短信验证码登录(手机号未注册时自动注册)
+func (a *AppV1UserService) SmsLogin(ctx context.Context, req *pb.SmsLoginReq) (*pb.SmsLoginReply, error) {
+	resp := &pb.SmsLoginReply{}
+	ip := meta.GetMetadataFromClient(ctx, constant.XMdIP)
+	// 校验账号与 IP 是否已被锁定
+	remaining, err := a.loginLimitRepo.CheckLock(ctx, constant.LoginSceneApp, req.GetPhone(), ip)
+	if err != nil {
+		return nil, pb.ErrorReasonDataRedisErr(pb.WithError(err))
+	}
+	if remaining > 0 {
+		return nil, pb.ErrorReasonAccountLocked(pb.WithFmtMsg(remaining))
+	}
+	attempt := &data.LoginAttempt{
+		Scene:     constant.LoginSceneApp,
+		Username:  req.GetPhone(),
+		IP:        ip,
+		UserAgent: meta.GetMetadataFromClient(ctx, constant.XMdUseragent),
+		URI:       requestPath(ctx),
+	}
+	// 校验验证码
+	_, err = a.smsCodeRepo.CheckSmsCodeWithPhone(ctx, data.SmsCodeSceneLogin, req.GetCodeId(), req.GetPhone(), req.GetCode())
+	if err != nil {
+		a.recordLoginFailure(ctx, attempt)
+		return nil, err
+	}
+	user, err := a.userRepo.FindOneCacheByPhone(ctx, req.GetPhone())
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	// 手机号未注册, 自动注册(随机密码, 可通过修改密码重置)
+	if user == nil || user.ID == "" {
+		salt := a.userRepo.GenerateSalt()
+		password, err := a.userRepo.GeneratePassword(salt, uuid.New().String())
+		if err != nil {
+			return nil, pb.ErrorReasonDataProcessingError(pb.WithError(err))
+		}
+		user = a.userRepo.NewData()
+		user.Phone = req.GetPhone()
+		user.Password = password
+		user.Salt = salt
+		user.Nickname = a.userRepo.GenerateNicknameByPhone(req.GetPhone())
+		user.Status = int32(constant.StatusEnable)
+		err = a.userRepo.CreateOneCache(ctx, user)
+		if err != nil {
+			return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+		}
+	}
+	// 已禁用的用户不能登录
+	if user.Status != int32(constant.StatusEnable) {
+		return nil, pb.ErrorReasonAccountDisabled()
+	}
+	// 登录成功, 清除失败次数并作废验证码
+	if err = a.loginLimitRepo.ResetFailure(ctx, constant.LoginSceneApp, req.GetPhone()); err != nil {
+		a.log.WithContext(ctx).Errorf("failed to reset login failure %s: %v", req.GetPhone(), err)
+	}
+	if err = a.smsCodeRepo.ClearSmsCode(ctx, data.SmsCodeSceneLogin, req.GetCodeId()); err != nil {
+		a.log.WithContext(ctx).Errorf("failed to clear sms code %s: %v", req.GetCodeId(), err)
+	}
+	// 生成token, 记录登录会话
+	token, err := a.userRepo.GenerateToken(ctx, user.ID, user.WxGzhUserID, user.WxGzhXcxID, &data.TokenSession{
+		Device:    meta.GetMetadataFromClient(ctx, constant.XMdDevice),
+		IP:        ip,
+		UserAgent: attempt.UserAgent,
+	})
+	if err != nil {
+		return nil, pb.ErrorReasonTokenErr(pb.WithError(err))
+	}
+	resp.Token = token.AccessToken
+	resp.ExpiredAt = token.ExpiredAt
+	resp.RefreshAt = token.RefreshAt
+	resp.RefreshToken = token.RefreshToken
+	resp.RefreshExpiredAt = token.RefreshExpiredAt
+	return resp, nil
+}
@@ -6,7 +6,6 @@ import (
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/app/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
-	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
 	"github.com/fzf-labs/godb/orm/condition"
 	"github.com/fzf-labs/goutil/uuidutil"
 	"github.com/fzf-labs/kratos-contrib/meta"
@@ -38,15 +37,8 @@ func (a *AppV1UserNotificationSettingService) GetNotificationSettings(ctx contex
 
 	// 如果不存在，创建默认设置
 	if len(settings) == 0 {
-		defaultSettings := &ai_boilerplate_model.UserNotificationSetting{
-			ID:                   uuidutil.GenUUID(),
-			UserID:               userID,
-			SystemNotification:   true,
-			ActivityNotification: true,
-			OrderNotification:    true,
-			DndStartTime:         "22:00",
-			DndEndTime:           "08:00",
-		}
+		defaultSettings := a.userNotificationSettingRepo.DefaultSetting(userID)
+		defaultSettings.ID = uuidutil.GenUUID()
 
 		err = a.userNotificationSettingRepo.CreateOne(ctx, defaultSettings)
 		if err != nil {
@@ -59,8 +51,11 @@ func (a *AppV1UserNotificationSettingService) GetNotificationSettings(ctx contex
 			SystemNotification:   defaultSettings.SystemNotification,
 			ActivityNotification: defaultSettings.ActivityNotification,
 			OrderNotification:    defaultSettings.OrderNotification,
+			MessageNotification:  defaultSettings.MessageNotification,
+			DndEnabled:           defaultSettings.DndEnabled,
 			DndStartTime:         defaultSettings.DndStartTime,
 			DndEndTime:           defaultSettings.DndEndTime,
+			DndTimeZone:          defaultSettings.DndTimeZone,
 			CreatedAt:            defaultSettings.CreatedAt.Format(time.RFC3339),
 			UpdatedAt:            defaultSettings.UpdatedAt.Format(time.RFC3339),
 		}
@@ -72,8 +67,11 @@ func (a *AppV1UserNotificationSettingService) GetNotificationSettings(ctx contex
 			SystemNotification:   setting.SystemNotification,
 			ActivityNotification: setting.ActivityNotification,
 			OrderNotification:    setting.OrderNotification,
+			MessageNotification:  setting.MessageNotification,
+			DndEnabled:           setting.DndEnabled,
 			DndStartTime:         setting.DndStartTime,
 			DndEndTime:           setting.DndEndTime,
+			DndTimeZone:          setting.DndTimeZone,
 			CreatedAt:            setting.CreatedAt.Format(time.RFC3339),
 			UpdatedAt:            setting.UpdatedAt.Format(time.RFC3339),
 		}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	pb "github.com/fzf-labs/ai-boilerplate-backend/api/app/v1"
 	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
@@ -43,6 +44,8 @@ func (a *AppV1UserNotificationSettingService) UpdateNotificationSettings(ctx con
 	setting.SystemNotification = req.SystemNotification
 	setting.ActivityNotification = req.ActivityNotification
 	setting.OrderNotification = req.OrderNotification
+	setting.MessageNotification = req.MessageNotification
+	setting.DndEnabled = req.DndEnabled
 
 	if req.DndStartTime != "" {
 		setting.DndStartTime = req.DndStartTime
@@ -50,6 +53,12 @@ func (a *AppV1UserNotificationSettingService) UpdateNotificationSettings(ctx con
 	if req.DndEndTime != "" {
 		setting.DndEndTime = req.DndEndTime
 	}
+	if req.DndTimeZone != "" {
+		if _, err = time.LoadLocation(req.DndTimeZone); err != nil {
+			return nil, pb.ErrorReasonParamError(pb.WithError(err))
+		}
+		setting.DndTimeZone = req.DndTimeZone
+	}
 
 	// 使用 UpsertOneByFields 更新记录（基于 ID 字段）
 	err = a.userNotificationSettingRepo.UpsertOneByFields(ctx, setting, []string{"id"})
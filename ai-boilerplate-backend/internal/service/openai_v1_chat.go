@@ -0,0 +1,82 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewOpenAIV1ChatService(
+	logger log.Logger,
+	aiAPIKeyRepo *data.AiAPIKeyRepo,
+	aiAPICallLogRepo *data.AiAPICallLogRepo,
+	aiProviderModelRepo *data.AiProviderModelRepo,
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo,
+	aiTokenUsageRepo *data.AiTokenUsageRepo,
+) *OpenAIV1ChatService {
+	l := log.NewHelper(log.With(logger, "module", "service/openaiChat"))
+	return &OpenAIV1ChatService{
+		log:                    l,
+		aiAPIKeyRepo:           aiAPIKeyRepo,
+		aiAPICallLogRepo:       aiAPICallLogRepo,
+		aiProviderModelRepo:    aiProviderModelRepo,
+		aiProviderPlatformRepo: aiProviderPlatformRepo,
+		aiTokenUsageRepo:       aiTokenUsageRepo,
+	}
+}
+
+// OpenAIV1ChatService 兼容 OpenAI Chat Completions 协议的模型网关
+type OpenAIV1ChatService struct {
+	log                    *log.Helper
+	aiAPIKeyRepo           *data.AiAPIKeyRepo
+	aiAPICallLogRepo       *data.AiAPICallLogRepo
+	aiProviderModelRepo    *data.AiProviderModelRepo
+	aiProviderPlatformRepo *data.AiProviderPlatformRepo
+	aiTokenUsageRepo       *data.AiTokenUsageRepo
+}
+
+// OpenAIErrorReply OpenAI 格式的错误响应, OpenAI SDK 按该格式解析错误
+type OpenAIErrorReply struct {
+	Error *OpenAIError `json:"error"`
+}
+
+// OpenAIError 错误详情
+type OpenAIError struct {
+	Message string  `json:"message"`
+	Type    string  `json:"type"`
+	Param   *string `json:"param"`
+	Code    string  `json:"code"`
+}
+
+// openAIError 将 kratos 错误转换为 OpenAI 格式, 返回 HTTP 状态码与错误响应
+func openAIError(err error) (int, *OpenAIErrorReply) {
+	e := errors.FromError(err)
+	status := int(e.Code)
+	if status < http.StatusBadRequest || status > 599 {
+		status = http.StatusInternalServerError
+	}
+	message := e.Message
+	if cause := e.Metadata["cause"]; cause != "" {
+		message = cause
+	}
+	errType := "api_error"
+	switch {
+	case status == http.StatusUnauthorized:
+		errType = "authentication_error"
+	case status == http.StatusForbidden:
+		errType = "permission_error"
+	case status == http.StatusTooManyRequests:
+		errType = "rate_limit_error"
+	case status < http.StatusInternalServerError:
+		errType = "invalid_request_error"
+	}
+	return status, &OpenAIErrorReply{
+		Error: &OpenAIError{
+			Message: message,
+			Type:    errType,
+			Code:    e.Reason,
+		},
+	}
+}
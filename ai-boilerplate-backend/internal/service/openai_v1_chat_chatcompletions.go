@@ -0,0 +1,423 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+	"github.com/fzf-labs/kratos-contrib/meta"
+	"github.com/fzf-labs/kratos-contrib/pkg/sse"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+)
+
+// OperationOpenAIChatCompletions OpenAI 兼容网关的路由标识, 用于接口密钥鉴权中间件匹配
+const OperationOpenAIChatCompletions = "/openai.v1.Chat/ChatCompletions"
+
+// OpenAIChatCompletionsReq OpenAI Chat Completions 请求
+type OpenAIChatCompletionsReq struct {
+	Model       string                  `json:"model"`
+	Messages    []*OpenAIChatMessage    `json:"messages"`
+	Stream      bool                    `json:"stream"`
+	Temperature *float32                `json:"temperature,omitempty"`
+	TopP        *float32                `json:"top_p,omitempty"`
+	MaxTokens   *int                    `json:"max_tokens,omitempty"`
+	Stop        json.RawMessage         `json:"stop,omitempty"`
+	User        string                  `json:"user,omitempty"`
+	StreamOpts  *OpenAIChatStreamOption `json:"stream_options,omitempty"`
+}
+
+// OpenAIChatStreamOption 流式请求选项
+type OpenAIChatStreamOption struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+// OpenAIChatMessage 对话消息, content 可以是字符串或内容片段数组
+type OpenAIChatMessage struct {
+	Role    string          `json:"role"`
+	Content json.RawMessage `json:"content"`
+	Name    string          `json:"name,omitempty"`
+}
+
+// OpenAIChatContentPart 内容片段
+type OpenAIChatContentPart struct {
+	Type     string `json:"type"`
+	Text     string `json:"text,omitempty"`
+	ImageURL *struct {
+		URL string `json:"url"`
+	} `json:"image_url,omitempty"`
+}
+
+// OpenAIChatCompletionsReply OpenAI Chat Completions 响应, 流式时 object 为 chat.completion.chunk
+type OpenAIChatCompletionsReply struct {
+	ID      string              `json:"id"`
+	Object  string              `json:"object"`
+	Created int64               `json:"created"`
+	Model   string              `json:"model"`
+	Choices []*OpenAIChatChoice `json:"choices"`
+	Usage   *OpenAIChatUsage    `json:"usage,omitempty"`
+}
+
+// OpenAIChatChoice 候选回复
+type OpenAIChatChoice struct {
+	Index        int                   `json:"index"`
+	Message      *OpenAIChatReplyDelta `json:"message,omitempty"`
+	Delta        *OpenAIChatReplyDelta `json:"delta,omitempty"`
+	FinishReason *string               `json:"finish_reason"`
+}
+
+// OpenAIChatReplyDelta 回复内容
+type OpenAIChatReplyDelta struct {
+	Role             string `json:"role,omitempty"`
+	Content          string `json:"content"`
+	ReasoningContent string `json:"reasoning_content,omitempty"`
+}
+
+// OpenAIChatUsage Token 用量
+type OpenAIChatUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// ChatCompletionsHandler OpenAI 兼容网关-对话补全 (stream 为 true 时 SSE 流式返回)
+func (o *OpenAIV1ChatService) ChatCompletionsHandler(ctx http.Context) error {
+	var in OpenAIChatCompletionsReq
+	if err := ctx.Bind(&in); err != nil {
+		return ctx.JSON(openAIError(pb.ErrorReasonParamError(pb.WithError(err))))
+	}
+	http.SetOperation(ctx, OperationOpenAIChatCompletions)
+	h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		startAt := time.Now()
+		callLog := o.aiAPICallLogRepo.NewData()
+		callLog.TenantID = meta.GetMetadataFromClient(ctx, constant.XMdTenantID)
+		callLog.APIKeyID = meta.GetMetadataFromClient(ctx, constant.XMdAPIKeyID)
+		callLog.IP = meta.GetMetadataFromClient(ctx, constant.XMdIP)
+		callLog.Model = in.Model
+		callLog.Stream = in.Stream
+		// 每次调用都记录日志, 包括鉴权之后的参数错误与模型错误
+		var usage *schema.TokenUsage
+		var providerModel *ai_boilerplate_model.AiProviderModel
+		var callErr error
+		defer func() {
+			saveCtx := context.WithoutCancel(ctx)
+			o.createAiAPICallLog(saveCtx, callLog, usage, callErr, startAt)
+			// 记录 Token 用量
+			adminID := meta.GetMetadataFromClient(ctx, constant.XMdAdminID)
+			usageErr := o.aiTokenUsageRepo.RecordUsage(saveCtx, callLog.TenantID, adminID, constant.AiTokenUsageSceneAPI, providerModel, callLog.ID, usage)
+			if usageErr != nil {
+				o.log.Errorf("record token usage failed: %v", usageErr)
+			}
+		}()
+
+		messages, err := buildOpenAIChatMessages(in.Messages)
+		if err != nil {
+			callErr = err
+			return nil, pb.ErrorReasonParamError(pb.WithError(err))
+		}
+		// 校验租户 Token 配额
+		if err := o.aiTokenUsageRepo.CheckTokenQuota(ctx, callLog.TenantID); err != nil {
+			callErr = err
+			return nil, err
+		}
+		chatModel, opts, providerModel, err := o.newOpenAIChatModel(ctx, callLog.TenantID, &in)
+		if err != nil {
+			callErr = err
+			return nil, err
+		}
+		callLog.ModelID = providerModel.ID
+
+		reply := &OpenAIChatCompletionsReply{
+			ID:      "chatcmpl-" + uuid.New().String(),
+			Object:  "chat.completion",
+			Created: startAt.Unix(),
+			Model:   in.Model,
+		}
+		finishReason := "stop"
+		if !in.Stream {
+			result, err := chatModel.Generate(ctx, messages, opts...)
+			if err != nil {
+				callErr = err
+				return nil, pb.ErrorReasonAPIThirdErr(pb.WithError(err))
+			}
+			if result.ResponseMeta != nil {
+				usage = result.ResponseMeta.Usage
+				if result.ResponseMeta.FinishReason != "" {
+					finishReason = result.ResponseMeta.FinishReason
+				}
+			}
+			reply.Choices = []*OpenAIChatChoice{
+				{
+					Index: 0,
+					Message: &OpenAIChatReplyDelta{
+						Role:             string(schema.Assistant),
+						Content:          result.Content,
+						ReasoningContent: result.ReasoningContent,
+					},
+					FinishReason: &finishReason,
+				},
+			}
+			reply.Usage = openAIChatUsage(usage)
+			return reply, nil
+		}
+
+		// 创建 SSE Writer
+		sseWriter, streamCtx, err := sse.NewWriter(ctx)
+		if err != nil {
+			o.log.Errorf("create sse writer failed: %v", err)
+			callErr = err
+			return nil, err
+		}
+		streamResult, err := chatModel.Stream(streamCtx, messages, opts...)
+		if err != nil {
+			o.log.Errorf("generate response failed: %v", err)
+			callErr = err
+			// 流式响应开始后的错误以 OpenAI 格式的事件写出
+			_, errReply := openAIError(pb.ErrorReasonAPIThirdErr(pb.WithError(err)))
+			if writeErr := sseWriter.WriteEvent(errReply); writeErr != nil {
+				o.log.Errorf("write error event failed: %v", writeErr)
+			}
+			return nil, nil
+		}
+		defer streamResult.Close()
+
+		reply.Object = "chat.completion.chunk"
+		for {
+			chunk, err := streamResult.Recv()
+			if err == io.EOF {
+				// 结束块携带结束原因, 请求要求时再追加一个只含用量的块
+				reply.Choices = []*OpenAIChatChoice{{Index: 0, Delta: &OpenAIChatReplyDelta{}, FinishReason: &finishReason}}
+				if writeErr := sseWriter.WriteEvent(reply); writeErr != nil {
+					o.log.Errorf("write event failed: %v", writeErr)
+				}
+				if in.StreamOpts != nil && in.StreamOpts.IncludeUsage {
+					reply.Choices = []*OpenAIChatChoice{}
+					reply.Usage = openAIChatUsage(usage)
+					if writeErr := sseWriter.WriteEvent(reply); writeErr != nil {
+						o.log.Errorf("write event failed: %v", writeErr)
+					}
+				}
+				// 发送结束标记
+				if writeErr := sseWriter.WriteDone(); writeErr != nil {
+					o.log.Errorf("write done failed: %v", writeErr)
+				}
+				return nil, nil
+			}
+			if err != nil {
+				o.log.Errorf("receive response failed: %v", err)
+				callErr = err
+				_, errReply := openAIError(pb.ErrorReasonAPIThirdErr(pb.WithError(err)))
+				if writeErr := sseWriter.WriteEvent(errReply); writeErr != nil {
+					o.log.Errorf("write error event failed: %v", writeErr)
+				}
+				return nil, nil
+			}
+			if chunk.ResponseMeta != nil {
+				if chunk.ResponseMeta.Usage != nil {
+					usage = chunk.ResponseMeta.Usage
+				}
+				if chunk.ResponseMeta.FinishReason != "" {
+					finishReason = chunk.ResponseMeta.FinishReason
+				}
+			}
+			if chunk.Content == "" && chunk.ReasoningContent == "" {
+				continue
+			}
+			reply.Choices = []*OpenAIChatChoice{
+				{
+					Index: 0,
+					Delta: &OpenAIChatReplyDelta{
+						Role:             string(schema.Assistant),
+						Content:          chunk.Content,
+						ReasoningContent: chunk.ReasoningContent,
+					},
+				},
+			}
+			if err := sseWriter.WriteEvent(reply); err != nil {
+				o.log.Errorf("write event failed: %v", err)
+				callErr = err
+				return nil, err
+			}
+		}
+	})
+	out, err := h(ctx, &in)
+	if err != nil {
+		// 流式响应开始前的错误(包括鉴权中间件的错误)按 OpenAI 格式返回
+		return ctx.JSON(openAIError(err))
+	}
+	// 流式响应已经由 SSE Writer 写出
+	if reply, ok := out.(*OpenAIChatCompletionsReply); ok && reply != nil {
+		return ctx.JSON(200, reply)
+	}
+	return nil
+}
+
+// newOpenAIChatModel 根据请求的 model 匹配租户下启用的模型, 获取聊天模型及调用参数
+func (o *OpenAIV1ChatService) newOpenAIChatModel(ctx context.Context, tenantID string, in *OpenAIChatCompletionsReq) (model.ToolCallingChatModel, []model.Option, *ai_boilerplate_model.AiProviderModel, error) {
+	if in.Model == "" {
+		return nil, nil, nil, pb.ErrorReasonParamError(pb.WithError(errors.New("model is empty")))
+	}
+	providerModel, err := o.aiProviderModelRepo.FindOneCacheEnableByModelID(ctx, tenantID, in.Model)
+	if err != nil {
+		return nil, nil, nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if providerModel == nil || providerModel.ID == "" {
+		return nil, nil, nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("model is not found")))
+	}
+	platform, err := o.aiProviderPlatformRepo.FindOneCacheByID(ctx, providerModel.PlatformID)
+	if err != nil {
+		return nil, nil, nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if platform == nil || platform.ID == "" || platform.Status != int32(constant.StatusEnable) {
+		return nil, nil, nil, pb.ErrorReasonDataRecordNotFound(pb.WithError(errors.New("platform is not found")))
+	}
+	chatModel, err := o.aiProviderPlatformRepo.GetChatModel(ctx, platform)
+	if err != nil {
+		return nil, nil, nil, pb.ErrorReasonAPIThirdErr(pb.WithError(err))
+	}
+	// 模型配置作为默认参数, 请求参数优先
+	opts, err := o.aiProviderModelRepo.ChatModelOptions(providerModel)
+	if err != nil {
+		return nil, nil, nil, pb.ErrorReasonDataFormattingError(pb.WithError(err))
+	}
+	if in.Temperature != nil {
+		opts = append(opts, model.WithTemperature(*in.Temperature))
+	}
+	if in.TopP != nil {
+		opts = append(opts, model.WithTopP(*in.TopP))
+	}
+	if in.MaxTokens != nil && *in.MaxTokens > 0 {
+		opts = append(opts, model.WithMaxTokens(*in.MaxTokens))
+	}
+	if len(in.Stop) > 0 {
+		stop, err := decodeOpenAIStringOrSlice(in.Stop)
+		if err != nil {
+			return nil, nil, nil, pb.ErrorReasonParamError(pb.WithError(err))
+		}
+		if len(stop) > 0 {
+			opts = append(opts, model.WithStop(stop))
+		}
+	}
+	return chatModel, opts, providerModel, nil
+}
+
+// createAiAPICallLog 记录一次接口调用
+func (o *OpenAIV1ChatService) createAiAPICallLog(ctx context.Context, data *ai_boilerplate_model.AiAPICallLog, usage *schema.TokenUsage, callErr error, startAt time.Time) {
+	data.Success = callErr == nil
+	if callErr != nil {
+		errorMessage := []rune(callErr.Error())
+		if len(errorMessage) > 1024 {
+			errorMessage = errorMessage[:1024]
+		}
+		data.ErrorMessage = string(errorMessage)
+	}
+	if usage != nil {
+		data.PromptTokens = int32(usage.PromptTokens)
+		data.CompletionTokens = int32(usage.CompletionTokens)
+		data.TotalTokens = int32(usage.TotalTokens)
+	}
+	data.Duration = int32(time.Since(startAt).Milliseconds())
+	err := o.aiAPICallLogRepo.CreateOne(ctx, data)
+	if err != nil {
+		o.log.Errorf("create api call log failed: %v", err)
+	}
+}
+
+// openAIChatUsage 转换 Token 用量
+func openAIChatUsage(usage *schema.TokenUsage) *OpenAIChatUsage {
+	if usage == nil {
+		return &OpenAIChatUsage{}
+	}
+	return &OpenAIChatUsage{
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		TotalTokens:      usage.TotalTokens,
+	}
+}
+
+// buildOpenAIChatMessages 将 OpenAI 格式的消息转换为模型消息
+func buildOpenAIChatMessages(items []*OpenAIChatMessage) ([]*schema.Message, error) {
+	if len(items) == 0 {
+		return nil, errors.New("messages is empty")
+	}
+	messages := make([]*schema.Message, 0, len(items))
+	for _, v := range items {
+		role := schema.RoleType(v.Role)
+		switch role {
+		case schema.System, schema.User, schema.Assistant:
+		case "developer":
+			role = schema.System
+		default:
+			return nil, errors.New("unsupported message role: " + v.Role)
+		}
+		message := &schema.Message{Role: role, Name: v.Name}
+		// content 为字符串
+		var text string
+		if len(v.Content) == 0 || string(v.Content) == "null" {
+			messages = append(messages, message)
+			continue
+		}
+		if err := json.Unmarshal(v.Content, &text); err == nil {
+			message.Content = text
+			messages = append(messages, message)
+			continue
+		}
+		// content 为内容片段数组
+		var parts []*OpenAIChatContentPart
+		if err := json.Unmarshal(v.Content, &parts); err != nil {
+			return nil, errors.New("invalid message content")
+		}
+		texts := make([]string, 0)
+		inputParts := make([]schema.MessageInputPart, 0)
+		multimodal := false
+		for _, part := range parts {
+			switch {
+			case part.Type == "image_url" && part.ImageURL != nil && part.ImageURL.URL != "":
+				imageURL := part.ImageURL.URL
+				inputParts = append(inputParts, schema.MessageInputPart{
+					Type:  schema.ChatMessagePartTypeImageURL,
+					Image: &schema.MessageInputImage{MessagePartCommon: schema.MessagePartCommon{URL: &imageURL}},
+				})
+				multimodal = true
+			case part.Type == "text":
+				texts = append(texts, part.Text)
+				inputParts = append(inputParts, schema.MessageInputPart{
+					Type: schema.ChatMessagePartTypeText,
+					Text: part.Text,
+				})
+			}
+		}
+		// 仅用户消息支持多模态输入, 其他角色合并文本
+		if multimodal && role == schema.User {
+			message.UserInputMultiContent = inputParts
+		} else {
+			message.Content = strings.Join(texts, "\n")
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// decodeOpenAIStringOrSlice 解析字符串或字符串数组
+func decodeOpenAIStringOrSlice(raw json.RawMessage) ([]string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if s == "" {
+			return nil, nil
+		}
+		return []string{s}, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, errors.New("invalid stop")
+	}
+	return list, nil
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/fzf-labs/ai-boilerplate-backend/api/admin/v1"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/constant"
+	"github.com/fzf-labs/ai-boilerplate-backend/internal/data/gorm/ai_boilerplate_model"
+)
+
+// CheckAPIKey 校验接口密钥, 校验通过后按刷新间隔更新最后使用时间
+func (o *OpenAIV1ChatService) CheckAPIKey(ctx context.Context, key string) (*ai_boilerplate_model.AiAPIKey, error) {
+	data, err := o.aiAPIKeyRepo.FindOneCacheByKeyHash(ctx, o.aiAPIKeyRepo.HashKey(key))
+	if err != nil {
+		return nil, pb.ErrorReasonDataSQLError(pb.WithError(err))
+	}
+	if data == nil || data.ID == "" {
+		return nil, pb.ErrorReasonTokenInvalidErr(pb.WithError(errors.New("api key is invalid")))
+	}
+	if data.Status != int32(constant.StatusEnable) {
+		return nil, pb.ErrorReasonTokenInvalidErr(pb.WithError(errors.New("api key is disabled")))
+	}
+	if data.ExpiredAt.Valid && data.ExpiredAt.Time.Before(time.Now()) {
+		return nil, pb.ErrorReasonTokenExpiredErr(pb.WithError(errors.New("api key is expired")))
+	}
+	// 最后使用时间只用于展示, 刷新失败不影响调用
+	err = o.aiAPIKeyRepo.TouchLastUsed(ctx, data)
+	if err != nil {
+		o.log.WithContext(ctx).Errorf("touch api key last used failed: %v", err)
+	}
+	return data, nil
+}
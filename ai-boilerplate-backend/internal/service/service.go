@@ -4,6 +4,8 @@ import "github.com/google/wire"
 
 // ProviderSet is service providers.
 var ProviderSet = wire.NewSet(
+	NewAdminV1AiAPICallLogService,
+	NewAdminV1AiAPIKeyService,
 	NewAdminV1AiAudioRecordService,
 	NewAdminV1AiChatConversationService,
 	NewAdminV1AiChatMessageService,
@@ -13,9 +15,12 @@ var ProviderSet = wire.NewSet(
 	NewAdminV1AiIndexImageService,
 	NewAdminV1AiIndexPromptService,
 	NewAdminV1AiIndexVideoService,
+	NewAdminV1AiIndexWriteService,
 	NewAdminV1AiPromptService,
 	NewAdminV1AiProviderModelService,
 	NewAdminV1AiProviderPlatformService,
+	NewAdminV1AiTokenQuotaService,
+	NewAdminV1AiTokenUsageService,
 	NewAdminV1AiVideoRecordService,
 	NewAdminV1AiWriteRecordService,
 	NewAdminV1ConfigDatumService,
@@ -51,6 +56,7 @@ var ProviderSet = wire.NewSet(
 	NewAdminV1SysRoleService,
 	NewAdminV1SysTenantService,
 	NewAdminV1UserMembershipService,
+	NewAdminV1UserNotifyLogService,
 	NewAdminV1UserService,
 	NewAdminV1WxGzhAccountService,
 	NewAdminV1WxGzhAutoReplyService,
@@ -66,4 +72,5 @@ var ProviderSet = wire.NewSet(
 	NewAppV1HomeService,
 	NewAppV1UserNotificationSettingService,
 	NewAppV1UserService,
+	NewOpenAIV1ChatService,
 )